@@ -65,6 +65,44 @@ type SubnetSelector struct {
 type IngressGroup struct {
 	// Name is the name of IngressGroup.
 	Name string `json:"name"`
+
+	// Sharding partitions the IngressGroup across multiple LoadBalancers.
+	// +optional
+	Sharding *IngressGroupSharding `json:"sharding,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Host;Ingress
+// IngressGroupShardingKey is the key Ingresses of a sharded IngressGroup are partitioned by.
+type IngressGroupShardingKey string
+
+const (
+	IngressGroupShardingKeyHost    IngressGroupShardingKey = "Host"
+	IngressGroupShardingKeyIngress IngressGroupShardingKey = "Ingress"
+)
+
+// IngressGroupSharding defines how an IngressGroup is partitioned across LoadBalancers.
+// Ingresses stay on the shard they were deployed to while it's configured. New Ingresses are assigned to a shard by the hash
+// of their key, unless it would exceed MaxRulesPerShard, in which case they're assigned to the shard with the fewest rules
+// that has room for them, or to an additional shard when all shards are full.
+type IngressGroupSharding struct {
+	// Shards is the number of LoadBalancers the IngressGroup is partitioned across.
+	// Additional LoadBalancers are added when all of them are full.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	Shards int32 `json:"shards"`
+
+	// Key is the key Ingresses are partitioned by.
+	// * Host: the hosts of the Ingress, Ingresses connected by common hosts, directly or through other Ingresses, are kept together. Ingresses without host are partitioned by namespace and name.
+	// * Ingress: the namespace and name of the Ingress.
+	// Defaults to Host.
+	// +optional
+	Key IngressGroupShardingKey `json:"key,omitempty"`
+
+	// MaxRulesPerShard is the number of listener rules new Ingresses are assigned to a shard up to, estimated by their paths on each listen port.
+	// Defaults to 100, the default quota of rules per ALB.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxRulesPerShard int32 `json:"maxRulesPerShard,omitempty"`
 }
 
 // Tag defines a AWS Tag on resources.
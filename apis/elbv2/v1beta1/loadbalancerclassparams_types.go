@@ -0,0 +1,85 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LoadBalancerClassParamsSpec defines the desired state of LoadBalancerClassParams
+type LoadBalancerClassParamsSpec struct {
+	// LoadBalancerClass is the loadBalancerClass of Services this LoadBalancerClassParams applies to.
+	// Services with this loadBalancerClass are handled by the controller.
+	// +kubebuilder:validation:MinLength=1
+	LoadBalancerClass string `json:"loadBalancerClass"`
+
+	// NamespaceSelector restrict the namespaces of Services that are allowed to specify the loadBalancerClass of this LoadBalancerClassParams.
+	// * if absent or present but empty, it selects all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Scheme defines the scheme for all Services with the loadBalancerClass of this LoadBalancerClassParams.
+	// +optional
+	Scheme *LoadBalancerScheme `json:"scheme,omitempty"`
+
+	// Subnets defines the subnets for all Services with the loadBalancerClass of this LoadBalancerClassParams.
+	// +optional
+	Subnets *SubnetSelector `json:"subnets,omitempty"`
+
+	// InboundCIDRs specifies the CIDRs that are allowed to access the Services with the loadBalancerClass of this LoadBalancerClassParams.
+	// +optional
+	InboundCIDRs []string `json:"inboundCIDRs,omitempty"`
+
+	// SSLPolicy specifies the SSL Policy of TLS listeners for all Services with the loadBalancerClass of this LoadBalancerClassParams.
+	// +optional
+	SSLPolicy string `json:"sslPolicy,omitempty"`
+
+	// Tags defines list of Tags on AWS resources provisioned for Services with the loadBalancerClass of this LoadBalancerClassParams.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// LoadBalancerAttributes define the custom attributes to LoadBalancers for all Services with the loadBalancerClass of this LoadBalancerClassParams.
+	// +optional
+	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="LOAD-BALANCER-CLASS",type="string",JSONPath=".spec.loadBalancerClass",description="The loadBalancerClass of Services"
+// +kubebuilder:printcolumn:name="SCHEME",type="string",JSONPath=".spec.scheme",description="The AWS Load Balancer scheme"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// LoadBalancerClassParams is the Schema for the LoadBalancerClassParams API
+type LoadBalancerClassParams struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec LoadBalancerClassParamsSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerClassParamsList contains a list of LoadBalancerClassParams
+type LoadBalancerClassParamsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancerClassParams `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LoadBalancerClassParams{}, &LoadBalancerClassParamsList{})
+}
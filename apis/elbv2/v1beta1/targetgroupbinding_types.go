@@ -150,6 +150,11 @@ type TargetGroupBindingSpec struct {
 	// +optional
 	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
 
+	// podSelector for ip type target groups to only register the pods of the Service matching it.
+	// Multiple TargetGroupBindings can split the pods of a Service into separate target groups by their labels, e.g. for canaries.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
 	// ipAddressType specifies whether the target group is of type IPv4 or IPv6. If unspecified, it will be automatically inferred.
 	// +optional
 	IPAddressType *TargetGroupIPAddressType `json:"ipAddressType,omitempty"`
@@ -167,11 +172,57 @@ type TargetGroupBindingSpec struct {
 	AssumeRoleExternalId string `json:"assumeRoleExternalId,omitempty"`
 }
 
+// TargetGroupBindingConditionType is a type of condition of a TargetGroupBinding.
+type TargetGroupBindingConditionType string
+
+const (
+	// TargetGroupBindingConditionReady indicates the targets of the TargetGroupBinding are reconciled into the TargetGroup.
+	TargetGroupBindingConditionReady TargetGroupBindingConditionType = "Ready"
+	// TargetGroupBindingConditionTargetGroupNotFound indicates the TargetGroup of the TargetGroupBinding doesn't exist.
+	TargetGroupBindingConditionTargetGroupNotFound TargetGroupBindingConditionType = "TargetGroupNotFound"
+	// TargetGroupBindingConditionNetworkingReconciled indicates the security group rules for traffic to the targets are reconciled.
+	TargetGroupBindingConditionNetworkingReconciled TargetGroupBindingConditionType = "NetworkingReconciled"
+	// TargetGroupBindingConditionAssumeRoleFailed indicates the IAM role to manage the TargetGroup couldn't be assumed.
+	TargetGroupBindingConditionAssumeRoleFailed TargetGroupBindingConditionType = "AssumeRoleFailed"
+)
+
+// TargetGroupBindingTargetsStatus counts the targets of the TargetGroup by their health.
+type TargetGroupBindingTargetsStatus struct {
+	// Registered is the number of targets registered into the TargetGroup, excluding the draining ones.
+	Registered int32 `json:"registered"`
+
+	// Healthy is the number of registered targets that are healthy.
+	Healthy int32 `json:"healthy"`
+
+	// Unhealthy is the number of registered targets that are neither healthy nor initial.
+	Unhealthy int32 `json:"unhealthy"`
+
+	// Initial is the number of registered targets whose registration or initial health checks are in progress.
+	Initial int32 `json:"initial"`
+
+	// Draining is the number of targets being deregistered from the TargetGroup.
+	Draining int32 `json:"draining"`
+}
+
 // TargetGroupBindingStatus defines the observed state of TargetGroupBinding
 type TargetGroupBindingStatus struct {
 	// The generation observed by the TargetGroupBinding controller.
 	// +optional
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describe the state of the TargetGroupBinding.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Targets counts the targets of the TargetGroup by their health, as of the last reconcile that listed them.
+	// +optional
+	Targets *TargetGroupBindingTargetsStatus `json:"targets,omitempty"`
+
+	// LastReconcileError is the error of the last reconcile, empty if it succeeded.
+	// +optional
+	LastReconcileError string `json:"lastReconcileError,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -180,6 +231,12 @@ type TargetGroupBindingStatus struct {
 // +kubebuilder:printcolumn:name="SERVICE-NAME",type="string",JSONPath=".spec.serviceRef.name",description="The Kubernetes Service's name"
 // +kubebuilder:printcolumn:name="SERVICE-PORT",type="string",JSONPath=".spec.serviceRef.port",description="The Kubernetes Service's port"
 // +kubebuilder:printcolumn:name="TARGET-TYPE",type="string",JSONPath=".spec.targetType",description="The AWS TargetGroup's TargetType"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the targets are reconciled into the AWS TargetGroup"
+// +kubebuilder:printcolumn:name="HEALTHY",type="integer",JSONPath=".status.targets.healthy",description="The number of healthy targets"
+// +kubebuilder:printcolumn:name="REGISTERED",type="integer",JSONPath=".status.targets.registered",description="The number of registered targets"
+// +kubebuilder:printcolumn:name="UNHEALTHY",type="integer",JSONPath=".status.targets.unhealthy",description="The number of unhealthy targets",priority=1
+// +kubebuilder:printcolumn:name="INITIAL",type="integer",JSONPath=".status.targets.initial",description="The number of targets in initial health checks",priority=1
+// +kubebuilder:printcolumn:name="DRAINING",type="integer",JSONPath=".status.targets.draining",description="The number of draining targets",priority=1
 // +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".spec.targetGroupARN",description="The AWS TargetGroup's Amazon Resource Name",priority=1
 // +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.targetGroupName",description="The AWS TargetGroup's Name",priority=2
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
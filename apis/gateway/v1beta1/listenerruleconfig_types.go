@@ -0,0 +1,145 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +kubebuilder:validation:Enum=cognito;oidc
+// AuthenticationType is the identity provider type used to authenticate users.
+type AuthenticationType string
+
+const (
+	AuthenticationTypeCognito AuthenticationType = "cognito"
+	AuthenticationTypeOIDC    AuthenticationType = "oidc"
+)
+
+// +kubebuilder:validation:Enum=deny;allow;authenticate
+// OnUnauthenticatedRequest is the behavior when the user is not authenticated.
+type OnUnauthenticatedRequest string
+
+const (
+	OnUnauthenticatedRequestDeny         OnUnauthenticatedRequest = "deny"
+	OnUnauthenticatedRequestAllow        OnUnauthenticatedRequest = "allow"
+	OnUnauthenticatedRequestAuthenticate OnUnauthenticatedRequest = "authenticate"
+)
+
+// AuthenticationCognitoConfig the Amazon Cognito user pool used to authenticate users.
+type AuthenticationCognitoConfig struct {
+	// userPoolARN The Amazon Resource Name (ARN) of the Amazon Cognito user pool.
+	UserPoolARN string `json:"userPoolARN"`
+
+	// userPoolClientID The ID of the Amazon Cognito user pool client.
+	UserPoolClientID string `json:"userPoolClientID"`
+
+	// userPoolDomain The domain prefix or fully-qualified domain name of the Amazon Cognito user pool.
+	UserPoolDomain string `json:"userPoolDomain"`
+}
+
+// AuthenticationOIDCConfig the OpenID Connect (OIDC) compliant identity provider used to authenticate users.
+type AuthenticationOIDCConfig struct {
+	// issuer The OIDC issuer identifier of the IdP.
+	Issuer string `json:"issuer"`
+
+	// authorizationEndpoint The authorization endpoint of the IdP.
+	AuthorizationEndpoint string `json:"authorizationEndpoint"`
+
+	// tokenEndpoint The token endpoint of the IdP.
+	TokenEndpoint string `json:"tokenEndpoint"`
+
+	// userInfoEndpoint The user info endpoint of the IdP.
+	UserInfoEndpoint string `json:"userInfoEndpoint"`
+
+	// secretName The name of the Secret holding the OAuth 2.0 client credentials under the keys clientID and clientSecret.
+	// The Secret must be in the same namespace as the ListenerRuleConfiguration.
+	SecretName string `json:"secretName"`
+}
+
+// AuthenticationConfiguration authenticates users before the request is routed to the backends.
+type AuthenticationConfiguration struct {
+	// type The identity provider type.
+	Type AuthenticationType `json:"type"`
+
+	// cognito The Amazon Cognito configuration, required when type is cognito.
+	// +optional
+	Cognito *AuthenticationCognitoConfig `json:"cognito,omitempty"`
+
+	// oidc The OIDC configuration, required when type is oidc.
+	// +optional
+	OIDC *AuthenticationOIDCConfig `json:"oidc,omitempty"`
+
+	// onUnauthenticatedRequest The behavior if the user is not authenticated.
+	// +optional
+	// +kubebuilder:default=authenticate
+	OnUnauthenticatedRequest *OnUnauthenticatedRequest `json:"onUnauthenticatedRequest,omitempty"`
+
+	// scope The set of user claims to be requested from the IdP.
+	// +optional
+	// +kubebuilder:default=openid
+	Scope *string `json:"scope,omitempty"`
+
+	// sessionCookieName The name of the cookie used to maintain session information.
+	// +optional
+	// +kubebuilder:default=AWSELBAuthSessionCookie
+	SessionCookieName *string `json:"sessionCookieName,omitempty"`
+
+	// sessionTimeout The maximum duration of the authentication session in seconds.
+	// +optional
+	// +kubebuilder:default=604800
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=604800
+	SessionTimeout *int64 `json:"sessionTimeout,omitempty"`
+
+	// authenticationRequestExtraParams The query parameters (up to 10) to include in the redirect request to the authorization endpoint.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=10
+	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
+}
+
+// ListenerRuleConfigurationSpec defines the listener rule properties for the route rules that reference it.
+type ListenerRuleConfigurationSpec struct {
+	// authentication authenticates users before requests are forwarded to the backends of the route rule.
+	// +optional
+	Authentication *AuthenticationConfiguration `json:"authentication,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="AUTH-TYPE",type="string",JSONPath=".spec.authentication.type",description="The authentication type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// ListenerRuleConfiguration is the Schema for customizing the listener rules of HTTPRoute rules.
+// It's referenced by an HTTPRoute rule through an ExtensionRef filter.
+type ListenerRuleConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ListenerRuleConfigurationSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerRuleConfigurationList contains a list of ListenerRuleConfiguration
+type ListenerRuleConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ListenerRuleConfiguration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ListenerRuleConfiguration{}, &ListenerRuleConfigurationList{})
+}
@@ -256,6 +256,41 @@ type LoadBalancerConfigurationSpec struct {
 	// MinimumLoadBalancerCapacity define the capacity reservation for LoadBalancers
 	// +optional
 	MinimumLoadBalancerCapacity *MinimumLoadBalancerCapacity `json:"minimumLoadBalancerCapacity,omitempty"`
+
+	// wafV2 [Application LoadBalancer]
+	// the WAFv2 web ACL to associate with the LB. Web ACLs associated outside of the controller are kept when unspecified.
+	// +optional
+	WAFv2 *WAFv2Configuration `json:"wafV2,omitempty"`
+
+	// wafRegional [Application LoadBalancer]
+	// the WAF Classic Regional web ACL to associate with the LB. Web ACLs associated outside of the controller are kept when unspecified.
+	// +optional
+	WAFRegional *WAFRegionalConfiguration `json:"wafRegional,omitempty"`
+
+	// shieldAdvanced [Application LoadBalancer]
+	// the AWS Shield Advanced protection of the LB. Protections created outside of the controller are kept when unspecified.
+	// +optional
+	ShieldAdvanced *ShieldAdvancedConfiguration `json:"shieldAdvanced,omitempty"`
+}
+
+// WAFv2Configuration the WAFv2 web ACL to associate with the LB.
+type WAFv2Configuration struct {
+	// webACL is the ARN of the WAFv2 web ACL, or "none" to remove the web ACL associated with the LB.
+	// +kubebuilder:validation:MinLength=1
+	WebACL string `json:"webACL"`
+}
+
+// WAFRegionalConfiguration the WAF Classic Regional web ACL to associate with the LB.
+type WAFRegionalConfiguration struct {
+	// webACLId is the ID of the WAF Classic Regional web ACL, or "none" to remove the web ACL associated with the LB.
+	// +kubebuilder:validation:MinLength=1
+	WebACLID string `json:"webACLId"`
+}
+
+// ShieldAdvancedConfiguration the AWS Shield Advanced protection of the LB.
+type ShieldAdvancedConfiguration struct {
+	// enabled whether the LB is protected by AWS Shield Advanced, false removes the protection.
+	Enabled bool `json:"enabled"`
 }
 
 // TODO -- these can be used to set what generation the gateway is currently on to track progress on reconcile.
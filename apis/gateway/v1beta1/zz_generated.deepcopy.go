@@ -25,6 +25,88 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationCognitoConfig) DeepCopyInto(out *AuthenticationCognitoConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationCognitoConfig.
+func (in *AuthenticationCognitoConfig) DeepCopy() *AuthenticationCognitoConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationCognitoConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationConfiguration) DeepCopyInto(out *AuthenticationConfiguration) {
+	*out = *in
+	if in.Cognito != nil {
+		in, out := &in.Cognito, &out.Cognito
+		*out = new(AuthenticationCognitoConfig)
+		**out = **in
+	}
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(AuthenticationOIDCConfig)
+		**out = **in
+	}
+	if in.OnUnauthenticatedRequest != nil {
+		in, out := &in.OnUnauthenticatedRequest, &out.OnUnauthenticatedRequest
+		*out = new(OnUnauthenticatedRequest)
+		**out = **in
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionCookieName != nil {
+		in, out := &in.SessionCookieName, &out.SessionCookieName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionTimeout != nil {
+		in, out := &in.SessionTimeout, &out.SessionTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AuthenticationRequestExtraParams != nil {
+		in, out := &in.AuthenticationRequestExtraParams, &out.AuthenticationRequestExtraParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationConfiguration.
+func (in *AuthenticationConfiguration) DeepCopy() *AuthenticationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationOIDCConfig) DeepCopyInto(out *AuthenticationOIDCConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationOIDCConfig.
+func (in *AuthenticationOIDCConfig) DeepCopy() *AuthenticationOIDCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationOIDCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthCheckConfiguration) DeepCopyInto(out *HealthCheckConfiguration) {
 	*out = *in
@@ -171,6 +253,84 @@ func (in *ListenerConfiguration) DeepCopy() *ListenerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerRuleConfiguration) DeepCopyInto(out *ListenerRuleConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRuleConfiguration.
+func (in *ListenerRuleConfiguration) DeepCopy() *ListenerRuleConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerRuleConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenerRuleConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerRuleConfigurationList) DeepCopyInto(out *ListenerRuleConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ListenerRuleConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRuleConfigurationList.
+func (in *ListenerRuleConfigurationList) DeepCopy() *ListenerRuleConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerRuleConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenerRuleConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerRuleConfigurationSpec) DeepCopyInto(out *ListenerRuleConfigurationSpec) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRuleConfigurationSpec.
+func (in *ListenerRuleConfigurationSpec) DeepCopy() *ListenerRuleConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerRuleConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerAttribute) DeepCopyInto(out *LoadBalancerAttribute) {
 	*out = *in
@@ -388,6 +548,21 @@ func (in *LoadBalancerConfigurationSpec) DeepCopyInto(out *LoadBalancerConfigura
 		*out = new(MinimumLoadBalancerCapacity)
 		**out = **in
 	}
+	if in.WAFv2 != nil {
+		in, out := &in.WAFv2, &out.WAFv2
+		*out = new(WAFv2Configuration)
+		**out = **in
+	}
+	if in.WAFRegional != nil {
+		in, out := &in.WAFRegional, &out.WAFRegional
+		*out = new(WAFRegionalConfiguration)
+		**out = **in
+	}
+	if in.ShieldAdvanced != nil {
+		in, out := &in.ShieldAdvanced, &out.ShieldAdvanced
+		*out = new(ShieldAdvancedConfiguration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfigurationSpec.
@@ -511,6 +686,21 @@ func (in *RouteConfiguration) DeepCopy() *RouteConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShieldAdvancedConfiguration) DeepCopyInto(out *ShieldAdvancedConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShieldAdvancedConfiguration.
+func (in *ShieldAdvancedConfiguration) DeepCopy() *ShieldAdvancedConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShieldAdvancedConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetConfiguration) DeepCopyInto(out *SubnetConfiguration) {
 	*out = *in
@@ -743,3 +933,33 @@ func (in *TargetGroupProps) DeepCopy() *TargetGroupProps {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFRegionalConfiguration) DeepCopyInto(out *WAFRegionalConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFRegionalConfiguration.
+func (in *WAFRegionalConfiguration) DeepCopy() *WAFRegionalConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFRegionalConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFv2Configuration) DeepCopyInto(out *WAFv2Configuration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFv2Configuration.
+func (in *WAFv2Configuration) DeepCopy() *WAFv2Configuration {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFv2Configuration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,123 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// lbc is the command line companion of the AWS Load Balancer Controller.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	zapraw "go.uber.org/zap"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/throttle"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/render"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+const (
+	flagFilename        = "filename"
+	flagEnvironment     = "environment"
+	flagNamespace       = "namespace"
+	defaultNamespace    = "default"
+	defaultClusterName  = "render"
+	usage               = "Usage: lbc render -f <manifest> [-f <manifest> ...] --environment <environment-file> [controller flags]"
+	exitCodeRenderError = 1
+	exitCodeUsageError  = 2
+)
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "render" {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(exitCodeUsageError)
+	}
+	if err := runRender(os.Args[2:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exitCodeRenderError)
+	}
+}
+
+// runRender builds the resource stacks from the manifests and prints them to stdout.
+// It fails if any stack failed to build, so it can gate changes in CI.
+func runRender(args []string) error {
+	controllerCFG := config.ControllerConfig{
+		AWSConfig: aws.CloudConfig{
+			ThrottleConfig: throttle.NewDefaultServiceOperationsThrottleConfig(),
+		},
+		FeatureGates: config.NewFeatureGates(),
+	}
+	var filenames []string
+	var environmentFile string
+	var namespace string
+	fs := pflag.NewFlagSet("render", pflag.ContinueOnError)
+	controllerCFG.BindFlags(fs)
+	fs.StringArrayVarP(&filenames, flagFilename, "f", nil, "Manifest file or directory to render, can be repeated")
+	fs.StringVar(&environmentFile, flagEnvironment, "", "File describing the VPC, subnets, security groups and certificates to render against")
+	fs.StringVar(&namespace, flagNamespace, defaultNamespace, "Namespace of manifests that don't specify one")
+	if err := fs.Set("cluster-name", defaultClusterName); err != nil {
+		return err
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(filenames) == 0 || environmentFile == "" {
+		return errors.New(usage)
+	}
+	if err := controllerCFG.Validate(); err != nil {
+		return err
+	}
+
+	logger := zap.New(zap.UseDevMode(false),
+		zap.Level(zapraw.NewAtomicLevelAt(zapraw.WarnLevel)),
+		zap.StacktraceLevel(zapraw.NewAtomicLevelAt(zapraw.FatalLevel)))
+	env, err := render.LoadEnvironment(environmentFile)
+	if err != nil {
+		return err
+	}
+	scheme := render.NewScheme()
+	objects, err := render.LoadManifests(scheme, filenames, namespace)
+	if err != nil {
+		return err
+	}
+	renderer := render.NewDefaultRenderer(scheme, objects, env, controllerCFG, logger)
+	renderedStacks, err := renderer.Render(context.Background())
+	if err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(struct {
+		Stacks []render.RenderedStack `json:"stacks"`
+	}{Stacks: renderedStacks}, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	failedStacks := 0
+	for _, renderedStack := range renderedStacks {
+		if renderedStack.Error != "" {
+			failedStacks++
+		}
+	}
+	if failedStacks != 0 {
+		return errors.Errorf("%d of %d stacks failed to render", failedStacks, len(renderedStacks))
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package eventhandlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// podEventEnqueueDelay is the delay before reconciling TargetGroupBindings for pod events.
+// Pods are registered from the pod info repository, which is fed by a separate watch and needs to catch up with the change.
+const podEventEnqueueDelay = 1 * time.Second
+
+// NewEnqueueRequestsForPodEvent constructs new enqueueRequestsForPodEvent.
+// It only needs the metadata of pods, pods joining or leaving services are already handled by endpoints events.
+func NewEnqueueRequestsForPodEvent(k8sClient client.Client, logger logr.Logger) handler.EventHandler {
+	return &enqueueRequestsForPodEvent{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForPodEvent)(nil)
+
+type enqueueRequestsForPodEvent struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+// Create is called in response to an create event - e.g. Pod Creation.
+func (h *enqueueRequestsForPodEvent) Create(context.Context, event.CreateEvent, workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// nothing to do here
+}
+
+// Update is called in response to an update event -  e.g. Pod Updated.
+func (h *enqueueRequestsForPodEvent) Update(ctx context.Context, e event.UpdateEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	podOld := e.ObjectOld
+	podNew := e.ObjectNew
+	labelsChanged := !labels.Equals(podOld.GetLabels(), podNew.GetLabels())
+	annotationsChanged := podOld.GetAnnotations()[annotations.AnnotationTargetPortOverrides] != podNew.GetAnnotations()[annotations.AnnotationTargetPortOverrides] ||
+		podOld.GetAnnotations()[annotations.AnnotationTargetAvailabilityZone] != podNew.GetAnnotations()[annotations.AnnotationTargetAvailabilityZone]
+	if labelsChanged || annotationsChanged {
+		h.enqueueImpactedTargetGroupBindings(ctx, queue, podOld, podNew, annotationsChanged)
+	}
+}
+
+// Delete is called in response to a delete event - e.g. Pod Deleted.
+func (h *enqueueRequestsForPodEvent) Delete(context.Context, event.DeleteEvent, workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// nothing to do here
+}
+
+// Generic is called in response to an event of an unknown type or a synthetic event triggered as a cron or
+// external trigger request - e.g. reconcile AutoScaling, or a WebHook.
+func (h *enqueueRequestsForPodEvent) Generic(context.Context, event.GenericEvent, workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// nothing to do here
+}
+
+// enqueueImpactedTargetGroupBindings will enqueue the ip TargetGroupBindings whose service selects the pod.
+// For label changes, only TargetGroupBindings with a podSelector are impacted.
+func (h *enqueueRequestsForPodEvent) enqueueImpactedTargetGroupBindings(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request],
+	podOld client.Object, podNew client.Object, annotationsChanged bool) {
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := h.k8sClient.List(ctx, tgbList, client.InNamespace(podNew.GetNamespace())); err != nil {
+		h.logger.Error(err, "failed to fetch targetGroupBindings")
+		return
+	}
+
+	podKey := k8s.NamespacedName(podNew)
+	for _, tgb := range tgbList.Items {
+		if tgb.Spec.TargetType == nil || (*tgb.Spec.TargetType) != elbv2api.TargetTypeIP {
+			continue
+		}
+		if !annotationsChanged && tgb.Spec.PodSelector == nil {
+			continue
+		}
+		svc := &corev1.Service{}
+		if err := h.k8sClient.Get(ctx, types.NamespacedName{Namespace: tgb.Namespace, Name: tgb.Spec.ServiceRef.Name}, svc); err != nil {
+			continue
+		}
+		svcSelector := labels.SelectorFromSet(svc.Spec.Selector)
+		if !svcSelector.Matches(labels.Set(podOld.GetLabels())) && !svcSelector.Matches(labels.Set(podNew.GetLabels())) {
+			continue
+		}
+
+		h.logger.V(1).Info("enqueue targetGroupBinding for pod event",
+			"pod", podKey,
+			"targetGroupBinding", k8s.NamespacedName(&tgb),
+		)
+		queue.AddAfter(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: tgb.Namespace,
+				Name:      tgb.Name,
+			},
+		}, podEventEnqueueDelay)
+	}
+}
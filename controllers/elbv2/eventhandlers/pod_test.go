@@ -0,0 +1,143 @@
+package eventhandlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/testutils"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func Test_enqueueRequestsForPodEvent_Update(t *testing.T) {
+	ipTargetType := elbv2api.TargetTypeIP
+	instanceTargetType := elbv2api.TargetTypeInstance
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-svc",
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "awesome"},
+		},
+	}
+	tgbWithPodSelector := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "tgb-canary",
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetType: &ipTargetType,
+			ServiceRef: elbv2api.ServiceReference{Name: "awesome-svc", Port: intstr.FromInt(80)},
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"track": "canary"},
+			},
+		},
+	}
+	tgbWithoutPodSelector := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "tgb-all",
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetType: &ipTargetType,
+			ServiceRef: elbv2api.ServiceReference{Name: "awesome-svc", Port: intstr.FromInt(80)},
+		},
+	}
+	tgbInstance := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "tgb-instance",
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetType: &instanceTargetType,
+			ServiceRef: elbv2api.ServiceReference{Name: "awesome-svc", Port: intstr.FromInt(80)},
+		},
+	}
+	tgbOtherSvc := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "tgb-other",
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetType: &ipTargetType,
+			ServiceRef: elbv2api.ServiceReference{Name: "other-svc", Port: intstr.FromInt(80)},
+		},
+	}
+	newPod := func(podLabels map[string]string, podAnnotations map[string]string) *metav1.PartialObjectMetadata {
+		return &metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "awesome-ns",
+				Name:        "pod-1",
+				Labels:      podLabels,
+				Annotations: podAnnotations,
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		podOld       *metav1.PartialObjectMetadata
+		podNew       *metav1.PartialObjectMetadata
+		wantRequests []reconcile.Request
+	}{
+		{
+			name:   "label change enqueues TGBs with podSelector",
+			podOld: newPod(map[string]string{"app": "awesome", "track": "stable"}, nil),
+			podNew: newPod(map[string]string{"app": "awesome", "track": "canary"}, nil),
+			wantRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Namespace: "awesome-ns", Name: "tgb-canary"}},
+			},
+		},
+		{
+			name:   "target annotation change enqueues all ip TGBs selecting the pod",
+			podOld: newPod(map[string]string{"app": "awesome"}, nil),
+			podNew: newPod(map[string]string{"app": "awesome"}, map[string]string{"elbv2.k8s.aws/target-port-overrides": "8080=15006"}),
+			wantRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Namespace: "awesome-ns", Name: "tgb-all"}},
+				{NamespacedName: types.NamespacedName{Namespace: "awesome-ns", Name: "tgb-canary"}},
+			},
+		},
+		{
+			name:   "pod not selected by the service",
+			podOld: newPod(map[string]string{"app": "other", "track": "stable"}, nil),
+			podNew: newPod(map[string]string{"app": "other", "track": "canary"}, nil),
+		},
+		{
+			name:   "unrelated changes",
+			podOld: newPod(map[string]string{"app": "awesome"}, map[string]string{"some": "annotation"}),
+			podNew: newPod(map[string]string{"app": "awesome"}, map[string]string{"some": "other-annotation"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).
+				WithObjects(svc.DeepCopy(), tgbWithPodSelector.DeepCopy(), tgbWithoutPodSelector.DeepCopy(), tgbInstance.DeepCopy(), tgbOtherSvc.DeepCopy()).
+				Build()
+
+			h := NewEnqueueRequestsForPodEvent(k8sClient, logr.New(&log.NullLogSink{}))
+			queue := &controllertest.TypedQueue[reconcile.Request]{TypedInterface: workqueue.NewTyped[reconcile.Request]()}
+			h.Update(context.Background(), event.UpdateEvent{ObjectOld: tt.podOld, ObjectNew: tt.podNew}, queue)
+			gotRequests := testutils.ExtractCTRLRequestsFromQueue(queue)
+			assert.True(t, cmp.Equal(tt.wantRequests, gotRequests),
+				"diff", cmp.Diff(tt.wantRequests, gotRequests))
+		})
+	}
+}
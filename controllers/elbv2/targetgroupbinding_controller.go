@@ -28,6 +28,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/elbv2/eventhandlers"
@@ -36,7 +37,9 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/go-logr/logr"
 	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
@@ -91,7 +94,7 @@ type targetGroupBindingReconciler struct {
 
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupbindings,verbs=get;list;watch;update;patch;create;delete
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupbindings/status,verbs=update;patch
-// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;patch;watch
 // +kubebuilder:rbac:groups="",resources=pods/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=endpoints,verbs=get;list;watch
@@ -135,23 +138,36 @@ func (r *targetGroupBindingReconciler) reconcileTargetGroupBinding(ctx context.C
 	}
 
 	var deferred bool
+	var reconcileErr error
+	statusOld := tgb.Status.DeepCopy()
 	tgbResourceFn := func() {
-		deferred, err = r.tgbResourceManager.Reconcile(ctx, tgb)
+		deferred, reconcileErr = r.tgbResourceManager.Reconcile(ctx, tgb)
 	}
 	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "reconcile_targetgroupbinding", tgbResourceFn)
-	if err != nil {
-		return errmetrics.NewErrorWithMetrics(controllerName, "reconcile_targetgroupbinding_error", err, r.metricsCollector)
-	}
 
-	if deferred {
+	if reconcileErr == nil && deferred {
 		r.deferredTargetGroupBindingReconciler.Enqueue(tgb)
+		// the targets status is refreshed by deferred reconciles as well.
+		updateTargetGroupBindingStatusFn := func() {
+			err = r.updateTargetGroupBindingStatus(ctx, tgb, *statusOld, nil)
+		}
+		r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "update_status", updateTargetGroupBindingStatusFn)
+		if err != nil {
+			return errmetrics.NewErrorWithMetrics(controllerName, "update_status_error", err, r.metricsCollector)
+		}
 		return nil
 	}
 
 	updateTargetGroupBindingStatusFn := func() {
-		err = r.updateTargetGroupBindingStatus(ctx, tgb)
+		err = r.updateTargetGroupBindingStatus(ctx, tgb, *statusOld, reconcileErr)
 	}
 	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "update_status", updateTargetGroupBindingStatusFn)
+	if reconcileErr != nil {
+		if err != nil {
+			r.logger.Error(err, "failed to update targetGroupBinding status", "tgb", k8s.NamespacedName(tgb))
+		}
+		return errmetrics.NewErrorWithMetrics(controllerName, "reconcile_targetgroupbinding_error", reconcileErr, r.metricsCollector)
+	}
 	if err != nil {
 		return errmetrics.NewErrorWithMetrics(controllerName, "update_status_error", err, r.metricsCollector)
 	}
@@ -174,14 +190,20 @@ func (r *targetGroupBindingReconciler) cleanupTargetGroupBinding(ctx context.Con
 	return nil
 }
 
-func (r *targetGroupBindingReconciler) updateTargetGroupBindingStatus(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
-	if aws.ToInt64(tgb.Status.ObservedGeneration) == tgb.Generation {
+// updateTargetGroupBindingStatus patches the status of the TargetGroupBinding, from the status before the reconcile, when it changed.
+// The targets and networking status set during the reconcile are kept.
+func (r *targetGroupBindingReconciler) updateTargetGroupBindingStatus(ctx context.Context, tgb *elbv2api.TargetGroupBinding,
+	statusOld elbv2api.TargetGroupBindingStatus, reconcileErr error) error {
+	targetgroupbinding.SetReconcileStatus(tgb, reconcileErr)
+	if reconcileErr == nil {
+		tgb.Status.ObservedGeneration = aws.Int64(tgb.Generation)
+	}
+	if equality.Semantic.DeepEqual(statusOld, tgb.Status) {
 		return nil
 	}
 
 	tgbOld := tgb.DeepCopy()
-
-	tgb.Status.ObservedGeneration = aws.Int64(tgb.Generation)
+	tgbOld.Status = statusOld
 	if err := r.k8sClient.Status().Patch(ctx, tgb, client.MergeFrom(tgbOld)); err != nil {
 		return errors.Wrapf(err, "failed to update targetGroupBinding status: %v", k8s.NamespacedName(tgb))
 	}
@@ -198,6 +220,8 @@ func (r *targetGroupBindingReconciler) SetupWithManager(ctx context.Context, mgr
 		r.logger.WithName("eventHandlers").WithName("service"))
 	nodeEventsHandler := eventhandlers.NewEnqueueRequestsForNodeEvent(r.k8sClient,
 		r.logger.WithName("eventHandlers").WithName("node"))
+	podEventsHandler := eventhandlers.NewEnqueueRequestsForPodEvent(r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("pod"))
 
 	var eventHandler handler.EventHandler
 	var clientObj client.Object
@@ -212,12 +236,15 @@ func (r *targetGroupBindingReconciler) SetupWithManager(ctx context.Context, mgr
 			r.logger.WithName("eventHandlers").WithName("endpoints"))
 	}
 
+	// status patches don't bump the generation, so they don't re-enqueue the TargetGroupBinding.
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&elbv2api.TargetGroupBinding{}).
+		For(&elbv2api.TargetGroupBinding{}, builder.WithPredicates(
+			predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
 		Named(controllerName).
 		Watches(&corev1.Service{}, svcEventHandler).
 		Watches(clientObj, eventHandler).
 		Watches(&corev1.Node{}, nodeEventsHandler).
+		Watches(&corev1.Pod{}, podEventsHandler, builder.OnlyMetadata).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: r.maxConcurrentReconciles,
 			RateLimiter:             workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](5*time.Millisecond, r.maxExponentialBackoffDelay)}).
@@ -0,0 +1,113 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	listenerRuleConfigurationKind = "ListenerRuleConfiguration"
+)
+
+// NewEnqueueRequestsForListenerRuleConfigurationEvent creates handler for ListenerRuleConfiguration resources
+func NewEnqueueRequestsForListenerRuleConfigurationEvent(httpRouteEventChan chan<- event.TypedGenericEvent[*gwv1.HTTPRoute],
+	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) handler.TypedEventHandler[*elbv2gw.ListenerRuleConfiguration, reconcile.Request] {
+	return &enqueueRequestsForListenerRuleConfigurationEvent{
+		httpRouteEventChan: httpRouteEventChan,
+		k8sClient:          k8sClient,
+		eventRecorder:      eventRecorder,
+		logger:             logger,
+	}
+}
+
+var _ handler.TypedEventHandler[*elbv2gw.ListenerRuleConfiguration, reconcile.Request] = (*enqueueRequestsForListenerRuleConfigurationEvent)(nil)
+
+// enqueueRequestsForListenerRuleConfigurationEvent handles ListenerRuleConfiguration events
+type enqueueRequestsForListenerRuleConfigurationEvent struct {
+	httpRouteEventChan chan<- event.TypedGenericEvent[*gwv1.HTTPRoute]
+	k8sClient          client.Client
+	eventRecorder      record.EventRecorder
+	logger             logr.Logger
+}
+
+func (h *enqueueRequestsForListenerRuleConfigurationEvent) Create(ctx context.Context, e event.TypedCreateEvent[*elbv2gw.ListenerRuleConfiguration], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	ruleConfigNew := e.Object
+	h.logger.V(1).Info("enqueue listenerruleconfiguration create event", "listenerruleconfiguration", ruleConfigNew.Name)
+	h.enqueueImpactedRoutes(ctx, ruleConfigNew)
+}
+
+func (h *enqueueRequestsForListenerRuleConfigurationEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*elbv2gw.ListenerRuleConfiguration], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	ruleConfigNew := e.ObjectNew
+	h.logger.V(1).Info("enqueue listenerruleconfiguration update event", "listenerruleconfiguration", ruleConfigNew.Name)
+	h.enqueueImpactedRoutes(ctx, ruleConfigNew)
+}
+
+func (h *enqueueRequestsForListenerRuleConfigurationEvent) Delete(ctx context.Context, e event.TypedDeleteEvent[*elbv2gw.ListenerRuleConfiguration], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	ruleConfig := e.Object
+	h.logger.V(1).Info("enqueue listenerruleconfiguration delete event", "listenerruleconfiguration", ruleConfig.Name)
+	h.enqueueImpactedRoutes(ctx, ruleConfig)
+}
+
+func (h *enqueueRequestsForListenerRuleConfigurationEvent) Generic(ctx context.Context, e event.TypedGenericEvent[*elbv2gw.ListenerRuleConfiguration], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	ruleConfig := e.Object
+	h.logger.V(1).Info("enqueue listenerruleconfiguration generic event", "listenerruleconfiguration", ruleConfig.Name)
+	h.enqueueImpactedRoutes(ctx, ruleConfig)
+}
+
+func (h *enqueueRequestsForListenerRuleConfigurationEvent) enqueueImpactedRoutes(ctx context.Context, ruleConfig *elbv2gw.ListenerRuleConfiguration) {
+	routes, err := GetHTTPRoutesReferencingListenerRuleConfiguration(ctx, h.k8sClient, ruleConfig)
+	if err != nil {
+		h.logger.Error(err, "failed to list httproutes for listenerruleconfiguration event",
+			"listenerruleconfiguration", k8s.NamespacedName(ruleConfig))
+		return
+	}
+	for _, route := range routes {
+		h.logger.V(1).Info("enqueue httproute for listenerruleconfiguration event",
+			"listenerruleconfiguration", k8s.NamespacedName(ruleConfig),
+			"httproute", k8s.NamespacedName(route))
+		h.httpRouteEventChan <- event.TypedGenericEvent[*gwv1.HTTPRoute]{
+			Object: route,
+		}
+	}
+}
+
+// GetHTTPRoutesReferencingListenerRuleConfiguration returns the HTTPRoutes with a rule referencing the ListenerRuleConfiguration through an ExtensionRef filter.
+// HTTPRoutes can only reference ListenerRuleConfigurations in their own namespace.
+func GetHTTPRoutesReferencingListenerRuleConfiguration(ctx context.Context, k8sClient client.Client, ruleConfig *elbv2gw.ListenerRuleConfiguration) ([]*gwv1.HTTPRoute, error) {
+	routeList := &gwv1.HTTPRouteList{}
+	if err := k8sClient.List(ctx, routeList, client.InNamespace(ruleConfig.Namespace)); err != nil {
+		return nil, err
+	}
+	var routes []*gwv1.HTTPRoute
+	for i := range routeList.Items {
+		route := &routeList.Items[i]
+		if httpRouteReferencesListenerRuleConfiguration(route, ruleConfig.Name) {
+			routes = append(routes, route)
+		}
+	}
+	return routes, nil
+}
+
+func httpRouteReferencesListenerRuleConfiguration(route *gwv1.HTTPRoute, name string) bool {
+	for _, rule := range route.Spec.Rules {
+		for _, filter := range rule.Filters {
+			if filter.Type != gwv1.HTTPRouteFilterExtensionRef || filter.ExtensionRef == nil {
+				continue
+			}
+			ref := filter.ExtensionRef
+			if string(ref.Group) == elbv2gw.GroupVersion.Group && ref.Kind == listenerRuleConfigurationKind && string(ref.Name) == name {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/testutils"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"testing"
+)
+
+func Test_GetHTTPRoutesReferencingListenerRuleConfiguration(t *testing.T) {
+	newRoute := func(namespace string, name string, ref *gwv1.LocalObjectReference) *gwv1.HTTPRoute {
+		rule := gwv1.HTTPRouteRule{}
+		if ref != nil {
+			rule.Filters = []gwv1.HTTPRouteFilter{
+				{
+					Type:         gwv1.HTTPRouteFilterExtensionRef,
+					ExtensionRef: ref,
+				},
+			}
+		}
+		return &gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: gwv1.HTTPRouteSpec{
+				Rules: []gwv1.HTTPRouteRule{rule},
+			},
+		}
+	}
+	authRef := &gwv1.LocalObjectReference{Group: "gateway.k8s.aws", Kind: "ListenerRuleConfiguration", Name: "auth"}
+	routes := []*gwv1.HTTPRoute{
+		newRoute("ns", "referencing", authRef),
+		newRoute("ns", "other-config", &gwv1.LocalObjectReference{Group: "gateway.k8s.aws", Kind: "ListenerRuleConfiguration", Name: "other"}),
+		newRoute("ns", "other-kind", &gwv1.LocalObjectReference{Group: "example.com", Kind: "ListenerRuleConfiguration", Name: "auth"}),
+		newRoute("ns", "no-filter", nil),
+		newRoute("other-ns", "other-namespace", authRef),
+	}
+
+	k8sClient := testutils.GenerateTestClient()
+	ctx := context.Background()
+	for _, route := range routes {
+		assert.NoError(t, k8sClient.Create(ctx, route))
+	}
+
+	ruleConfig := &elbv2gw.ListenerRuleConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "auth"},
+	}
+	got, err := GetHTTPRoutesReferencingListenerRuleConfiguration(ctx, k8sClient, ruleConfig)
+	assert.NoError(t, err)
+	var gotNames []types.NamespacedName
+	for _, route := range got {
+		gotNames = append(gotNames, k8s.NamespacedName(route))
+	}
+	assert.Equal(t, []types.NamespacedName{{Namespace: "ns", Name: "referencing"}}, gotNames)
+}
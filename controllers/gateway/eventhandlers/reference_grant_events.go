@@ -0,0 +1,125 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/routeutils"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwbeta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// NewEnqueueRequestsForReferenceGrantEvent creates handler for ReferenceGrant resources.
+// Granting or revoking a reference enqueues the gateways that may hold the reference, either directly or through their routes.
+func NewEnqueueRequestsForReferenceGrantEvent(k8sClient client.Client, eventRecorder record.EventRecorder, gwController string, logger logr.Logger) handler.TypedEventHandler[*gwbeta1.ReferenceGrant, reconcile.Request] {
+	return &enqueueRequestsForReferenceGrantEvent{
+		k8sClient:     k8sClient,
+		eventRecorder: eventRecorder,
+		gwController:  gwController,
+		logger:        logger,
+	}
+}
+
+var _ handler.TypedEventHandler[*gwbeta1.ReferenceGrant, reconcile.Request] = (*enqueueRequestsForReferenceGrantEvent)(nil)
+
+// enqueueRequestsForReferenceGrantEvent handles ReferenceGrant events
+type enqueueRequestsForReferenceGrantEvent struct {
+	k8sClient     client.Client
+	eventRecorder record.EventRecorder
+	gwController  string
+	logger        logr.Logger
+}
+
+func (h *enqueueRequestsForReferenceGrantEvent) Create(ctx context.Context, e event.TypedCreateEvent[*gwbeta1.ReferenceGrant], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	grantNew := e.Object
+	h.logger.V(1).Info("enqueue referencegrant create event", "referencegrant", k8s.NamespacedName(grantNew))
+	h.enqueueImpactedGateways(ctx, grantNew.Spec.From, queue)
+}
+
+func (h *enqueueRequestsForReferenceGrantEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*gwbeta1.ReferenceGrant], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	grantOld := e.ObjectOld
+	grantNew := e.ObjectNew
+	h.logger.V(1).Info("enqueue referencegrant update event", "referencegrant", k8s.NamespacedName(grantNew))
+	// Gateways that lost the grant need to be reconciled as well as the ones that gained it.
+	grantFroms := make([]gwbeta1.ReferenceGrantFrom, 0, len(grantOld.Spec.From)+len(grantNew.Spec.From))
+	grantFroms = append(grantFroms, grantOld.Spec.From...)
+	grantFroms = append(grantFroms, grantNew.Spec.From...)
+	h.enqueueImpactedGateways(ctx, grantFroms, queue)
+}
+
+func (h *enqueueRequestsForReferenceGrantEvent) Delete(ctx context.Context, e event.TypedDeleteEvent[*gwbeta1.ReferenceGrant], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	grant := e.Object
+	h.logger.V(1).Info("enqueue referencegrant delete event", "referencegrant", k8s.NamespacedName(grant))
+	h.enqueueImpactedGateways(ctx, grant.Spec.From, queue)
+}
+
+func (h *enqueueRequestsForReferenceGrantEvent) Generic(ctx context.Context, e event.TypedGenericEvent[*gwbeta1.ReferenceGrant], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	grant := e.Object
+	h.logger.V(1).Info("enqueue referencegrant generic event", "referencegrant", k8s.NamespacedName(grant))
+	h.enqueueImpactedGateways(ctx, grant.Spec.From, queue)
+}
+
+func (h *enqueueRequestsForReferenceGrantEvent) enqueueImpactedGateways(ctx context.Context, grantFroms []gwbeta1.ReferenceGrantFrom, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	for _, gw := range GetImpactedGatewaysFromReferenceGrantFroms(ctx, h.k8sClient, grantFroms, h.gwController) {
+		h.logger.V(1).Info("enqueue gateway for referencegrant event", "gateway", gw)
+		queue.Add(reconcile.Request{NamespacedName: gw})
+	}
+}
+
+// GetImpactedGatewaysFromReferenceGrantFroms identifies Gateways that may hold a reference permitted by the ReferenceGrant from entries.
+// These are Gateways in a from namespace, or Gateways referenced by routes in a from namespace.
+func GetImpactedGatewaysFromReferenceGrantFroms(ctx context.Context, k8sClient client.Client, grantFroms []gwbeta1.ReferenceGrantFrom, gwController string) []types.NamespacedName {
+	gatewayNamespaces := sets.New[string]()
+	routeNamespacesByKind := make(map[routeutils.RouteKind]sets.Set[string])
+	for _, from := range grantFroms {
+		if from.Group != gwv1.GroupName {
+			continue
+		}
+		if from.Kind == "Gateway" {
+			gatewayNamespaces.Insert(string(from.Namespace))
+			continue
+		}
+		routeKind := routeutils.RouteKind(from.Kind)
+		if _, ok := routeNamespacesByKind[routeKind]; !ok {
+			routeNamespacesByKind[routeKind] = sets.New[string]()
+		}
+		routeNamespacesByKind[routeKind].Insert(string(from.Namespace))
+	}
+
+	impactedGateways := sets.New[types.NamespacedName]()
+	if gatewayNamespaces.Len() != 0 {
+		for _, gw := range GetGatewaysManagedByLBController(ctx, k8sClient, gwController) {
+			if gatewayNamespaces.Has(gw.Namespace) {
+				impactedGateways.Insert(k8s.NamespacedName(gw))
+			}
+		}
+	}
+
+	if len(routeNamespacesByKind) != 0 {
+		listRoutes := routeutils.ListL7Routes
+		if gwController == constants.NLBGatewayController {
+			listRoutes = routeutils.ListL4Routes
+		}
+		// Routes that could be listed are still processed when listing other route kinds fails.
+		routes, _ := listRoutes(ctx, k8sClient)
+		for _, route := range routes {
+			routeNamespace := route.GetRouteNamespacedName().Namespace
+			if namespaces, ok := routeNamespacesByKind[route.GetRouteKind()]; !ok || !namespaces.Has(routeNamespace) {
+				continue
+			}
+			// Unknown gateways are ignored, the remaining gateways are still returned.
+			gateways, _ := GetImpactedGatewaysFromParentRefs(ctx, k8sClient, route.GetParentRefs(), routeNamespace, gwController)
+			impactedGateways.Insert(gateways...)
+		}
+	}
+	return impactedGateways.UnsortedList()
+}
@@ -0,0 +1,89 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/testutils"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwbeta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"testing"
+)
+
+func Test_GetImpactedGatewaysFromReferenceGrantFroms(t *testing.T) {
+	gwClass := &gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "alb-class"},
+		Spec:       gwv1.GatewayClassSpec{ControllerName: constants.ALBGatewayController},
+	}
+	newGateway := func(namespace string, name string) *gwv1.Gateway {
+		return &gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: "alb-class"},
+		}
+	}
+	routeGateway := newGateway("gw-ns", "route-gw")
+	tenantGateway := newGateway("tenant-ns", "tenant-gw")
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "route-ns", Name: "route"},
+		Spec: gwv1.HTTPRouteSpec{
+			CommonRouteSpec: gwv1.CommonRouteSpec{
+				ParentRefs: []gwv1.ParentReference{
+					{
+						Name:      "route-gw",
+						Namespace: (*gwv1.Namespace)(&routeGateway.Namespace),
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		grantFroms []gwbeta1.ReferenceGrantFrom
+		want       []types.NamespacedName
+	}{
+		{
+			name: "gateways in the from namespace",
+			grantFroms: []gwbeta1.ReferenceGrantFrom{
+				{Group: gwv1.GroupName, Kind: "Gateway", Namespace: "tenant-ns"},
+			},
+			want: []types.NamespacedName{{Namespace: "tenant-ns", Name: "tenant-gw"}},
+		},
+		{
+			name: "gateways referenced by routes in the from namespace",
+			grantFroms: []gwbeta1.ReferenceGrantFrom{
+				{Group: gwv1.GroupName, Kind: "HTTPRoute", Namespace: "route-ns"},
+			},
+			want: []types.NamespacedName{{Namespace: "gw-ns", Name: "route-gw"}},
+		},
+		{
+			name: "route kind doesn't match",
+			grantFroms: []gwbeta1.ReferenceGrantFrom{
+				{Group: gwv1.GroupName, Kind: "GRPCRoute", Namespace: "route-ns"},
+			},
+			want: []types.NamespacedName{},
+		},
+		{
+			name: "other group is ignored",
+			grantFroms: []gwbeta1.ReferenceGrantFrom{
+				{Group: "example.com", Kind: "Gateway", Namespace: "tenant-ns"},
+			},
+			want: []types.NamespacedName{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := testutils.GenerateTestClient()
+			ctx := context.Background()
+			assert.NoError(t, k8sClient.Create(ctx, gwClass.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, routeGateway.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, tenantGateway.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, route.DeepCopy()))
+
+			got := GetImpactedGatewaysFromReferenceGrantFroms(ctx, k8sClient, tt.grantFroms, constants.ALBGatewayController)
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
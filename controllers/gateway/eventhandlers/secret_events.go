@@ -0,0 +1,139 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// NewEnqueueRequestsForSecretEvent creates handler for the Secret events emitted by the secrets manager.
+// Rotating or deleting a certificate Secret enqueues the gateways referencing it from a listener, and rotating or deleting
+// an OIDC client Secret notifies the ListenerRuleConfigurations referencing it through ruleConfigEventChan, when it's set.
+func NewEnqueueRequestsForSecretEvent(ruleConfigEventChan chan<- event.TypedGenericEvent[*elbv2gw.ListenerRuleConfiguration],
+	k8sClient client.Client, eventRecorder record.EventRecorder, gwController string, logger logr.Logger) handler.TypedEventHandler[*corev1.Secret, reconcile.Request] {
+	return &enqueueRequestsForSecretEvent{
+		ruleConfigEventChan: ruleConfigEventChan,
+		k8sClient:           k8sClient,
+		eventRecorder:       eventRecorder,
+		gwController:        gwController,
+		logger:              logger,
+	}
+}
+
+var _ handler.TypedEventHandler[*corev1.Secret, reconcile.Request] = (*enqueueRequestsForSecretEvent)(nil)
+
+// enqueueRequestsForSecretEvent handles Secret events
+type enqueueRequestsForSecretEvent struct {
+	ruleConfigEventChan chan<- event.TypedGenericEvent[*elbv2gw.ListenerRuleConfiguration]
+	k8sClient           client.Client
+	eventRecorder       record.EventRecorder
+	gwController        string
+	logger              logr.Logger
+}
+
+func (h *enqueueRequestsForSecretEvent) Create(ctx context.Context, e event.TypedCreateEvent[*corev1.Secret], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueImpactedGateways(ctx, e.Object, queue)
+}
+
+func (h *enqueueRequestsForSecretEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*corev1.Secret], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	secretOld := e.ObjectOld
+	secretNew := e.ObjectNew
+	// only secret data updates and deletions require the certificate to be re-imported or the client credentials to be updated.
+	if equality.Semantic.DeepEqual(secretOld.Data, secretNew.Data) &&
+		equality.Semantic.DeepEqual(secretOld.DeletionTimestamp.IsZero(), secretNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+	h.enqueueImpactedGateways(ctx, secretNew, queue)
+}
+
+func (h *enqueueRequestsForSecretEvent) Delete(ctx context.Context, e event.TypedDeleteEvent[*corev1.Secret], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueImpactedGateways(ctx, e.Object, queue)
+}
+
+func (h *enqueueRequestsForSecretEvent) Generic(ctx context.Context, e event.TypedGenericEvent[*corev1.Secret], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueImpactedGateways(ctx, e.Object, queue)
+}
+
+func (h *enqueueRequestsForSecretEvent) enqueueImpactedGateways(ctx context.Context, secret *corev1.Secret, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	for _, gw := range GetGatewaysReferencingSecret(ctx, h.k8sClient, k8s.NamespacedName(secret), h.gwController) {
+		h.logger.V(1).Info("enqueue gateway for secret event",
+			"secret", k8s.NamespacedName(secret),
+			"gateway", gw)
+		queue.Add(reconcile.Request{NamespacedName: gw})
+	}
+	if h.ruleConfigEventChan == nil {
+		return
+	}
+	ruleConfigs, err := GetListenerRuleConfigurationsReferencingSecret(ctx, h.k8sClient, k8s.NamespacedName(secret))
+	if err != nil {
+		h.logger.Error(err, "failed to list listenerruleconfigurations for secret event",
+			"secret", k8s.NamespacedName(secret))
+		return
+	}
+	for _, ruleConfig := range ruleConfigs {
+		h.logger.V(1).Info("enqueue listenerruleconfiguration for secret event",
+			"secret", k8s.NamespacedName(secret),
+			"listenerruleconfiguration", k8s.NamespacedName(ruleConfig))
+		h.ruleConfigEventChan <- event.TypedGenericEvent[*elbv2gw.ListenerRuleConfiguration]{
+			Object: ruleConfig,
+		}
+	}
+}
+
+// GetListenerRuleConfigurationsReferencingSecret returns the ListenerRuleConfigurations using the secret as OIDC client secret.
+// ListenerRuleConfigurations can only reference secrets in their own namespace.
+func GetListenerRuleConfigurationsReferencingSecret(ctx context.Context, k8sClient client.Client, secret types.NamespacedName) ([]*elbv2gw.ListenerRuleConfiguration, error) {
+	ruleConfigList := &elbv2gw.ListenerRuleConfigurationList{}
+	if err := k8sClient.List(ctx, ruleConfigList, client.InNamespace(secret.Namespace)); err != nil {
+		return nil, err
+	}
+	var ruleConfigs []*elbv2gw.ListenerRuleConfiguration
+	for i := range ruleConfigList.Items {
+		ruleConfig := &ruleConfigList.Items[i]
+		auth := ruleConfig.Spec.Authentication
+		if auth != nil && auth.OIDC != nil && auth.OIDC.SecretName == secret.Name {
+			ruleConfigs = append(ruleConfigs, ruleConfig)
+		}
+	}
+	return ruleConfigs, nil
+}
+
+// GetGatewaysReferencingSecret identifies Gateways with a listener tls.certificateRefs referencing the secret.
+func GetGatewaysReferencingSecret(ctx context.Context, k8sClient client.Client, secret types.NamespacedName, gwController string) []types.NamespacedName {
+	var impactedGateways []types.NamespacedName
+	for _, gw := range GetGatewaysManagedByLBController(ctx, k8sClient, gwController) {
+		if isSecretReferencedByGateway(gw, secret) {
+			impactedGateways = append(impactedGateways, k8s.NamespacedName(gw))
+		}
+	}
+	return impactedGateways
+}
+
+func isSecretReferencedByGateway(gw *gwv1.Gateway, secret types.NamespacedName) bool {
+	for _, listener := range gw.Spec.Listeners {
+		if listener.TLS == nil {
+			continue
+		}
+		for _, certRef := range listener.TLS.CertificateRefs {
+			certRefNamespace := gw.Namespace
+			if certRef.Namespace != nil {
+				certRefNamespace = string(*certRef.Namespace)
+			}
+			if certRefNamespace == secret.Namespace && string(certRef.Name) == secret.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
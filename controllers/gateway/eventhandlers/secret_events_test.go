@@ -0,0 +1,63 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/testutils"
+	"testing"
+)
+
+func Test_GetListenerRuleConfigurationsReferencingSecret(t *testing.T) {
+	newRuleConfig := func(namespace string, name string, auth *elbv2gw.AuthenticationConfiguration) *elbv2gw.ListenerRuleConfiguration {
+		return &elbv2gw.ListenerRuleConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: elbv2gw.ListenerRuleConfigurationSpec{
+				Authentication: auth,
+			},
+		}
+	}
+	oidcAuth := func(secretName string) *elbv2gw.AuthenticationConfiguration {
+		return &elbv2gw.AuthenticationConfiguration{
+			Type: elbv2gw.AuthenticationTypeOIDC,
+			OIDC: &elbv2gw.AuthenticationOIDCConfig{
+				Issuer:                "https://idp.example.com",
+				AuthorizationEndpoint: "https://idp.example.com/authorize",
+				TokenEndpoint:         "https://idp.example.com/token",
+				UserInfoEndpoint:      "https://idp.example.com/userinfo",
+				SecretName:            secretName,
+			},
+		}
+	}
+	ruleConfigs := []*elbv2gw.ListenerRuleConfiguration{
+		newRuleConfig("ns", "referencing", oidcAuth("oidc-secret")),
+		newRuleConfig("ns", "other-secret", oidcAuth("other-secret")),
+		newRuleConfig("ns", "cognito", &elbv2gw.AuthenticationConfiguration{
+			Type: elbv2gw.AuthenticationTypeCognito,
+			Cognito: &elbv2gw.AuthenticationCognitoConfig{
+				UserPoolARN:      "arn:aws:cognito-idp:us-west-2:123456789012:userpool/pool",
+				UserPoolClientID: "client",
+				UserPoolDomain:   "domain",
+			},
+		}),
+		newRuleConfig("ns", "no-auth", nil),
+		newRuleConfig("other-ns", "other-namespace", oidcAuth("oidc-secret")),
+	}
+
+	k8sClient := testutils.GenerateTestClient()
+	ctx := context.Background()
+	for _, ruleConfig := range ruleConfigs {
+		assert.NoError(t, k8sClient.Create(ctx, ruleConfig))
+	}
+
+	got, err := GetListenerRuleConfigurationsReferencingSecret(ctx, k8sClient, types.NamespacedName{Namespace: "ns", Name: "oidc-secret"})
+	assert.NoError(t, err)
+	var gotNames []types.NamespacedName
+	for _, ruleConfig := range got {
+		gotNames = append(gotNames, k8s.NamespacedName(ruleConfig))
+	}
+	assert.Equal(t, []types.NamespacedName{{Namespace: "ns", Name: "referencing"}}, gotNames)
+}
@@ -2,18 +2,24 @@ package gateway
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/gateway/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/drift"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/constants"
@@ -35,6 +41,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwalpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwbeta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 var _ Reconciler = &gatewayReconciler{}
@@ -57,6 +64,8 @@ func newGatewayReconciler(controllerName string, lbType elbv2model.LoadBalancerT
 
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
 	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, elbv2TaggingManager, controllerConfig, gatewayTagPrefix, logger, metricsCollector, controllerName)
+	driftAuditor := drift.NewDefaultAuditor(stackDeployer, eventRecorder, metricsCollector, controllerName,
+		controllerConfig.DriftDetectionInterval, controllerConfig.DriftPolicy, logger.WithName("drift-auditor"))
 
 	cfgResolver := newGatewayConfigResolver()
 
@@ -72,12 +81,15 @@ func newGatewayReconciler(controllerName string, lbType elbv2model.LoadBalancerT
 		backendSGProvider:       backendSGProvider,
 		stackMarshaller:         stackMarshaller,
 		stackDeployer:           stackDeployer,
+		driftAuditor:            driftAuditor,
 		finalizerManager:        finalizerManager,
 		eventRecorder:           eventRecorder,
 		logger:                  logger,
 		metricsCollector:        metricsCollector,
 		reconcileTracker:        reconcileTracker,
 		cfgResolver:             cfgResolver,
+		featureGates:            controllerConfig.FeatureGates,
+		dryRun:                  controllerConfig.DryRun,
 	}
 }
 
@@ -94,6 +106,7 @@ type gatewayReconciler struct {
 	backendSGProvider       networking.BackendSGProvider
 	stackMarshaller         deploy.StackMarshaller
 	stackDeployer           deploy.StackDeployer
+	driftAuditor            drift.Auditor
 	finalizerManager        k8s.FinalizerManager
 	eventRecorder           record.EventRecorder
 	logger                  logr.Logger
@@ -101,6 +114,12 @@ type gatewayReconciler struct {
 	reconcileTracker        func(namespaceName types.NamespacedName)
 
 	cfgResolver gatewayConfigResolver
+
+	featureGates config.FeatureGates
+	dryRun       bool
+	// secretsManager watches the listener certificate secrets and the OIDC client secrets,
+	// it's only set for ALB gateways or when certificate import is enabled.
+	secretsManager k8s.SecretsManager
 }
 
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch;patch
@@ -131,6 +150,8 @@ type gatewayReconciler struct {
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tlsroutes/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tlsroutes/finalizers,verbs=update
 
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencegrants,verbs=get;list;watch
+
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes/finalizers,verbs=update
@@ -141,6 +162,7 @@ type gatewayReconciler struct {
 
 //+kubebuilder:rbac:groups=gateway.k8s.aws,resources=loadbalancerconfigurations,verbs=get;list;watch
 //+kubebuilder:rbac:groups=gateway.k8s.aws,resources=targetgroupconfigurations,verbs=get;list;watch
+//+kubebuilder:rbac:groups=gateway.k8s.aws,resources=listenerruleconfigurations,verbs=get;list;watch
 
 func (r *gatewayReconciler) Reconcile(ctx context.Context, req reconcile.Request) (ctrl.Result, error) {
 	r.reconcileTracker(req.NamespacedName)
@@ -155,6 +177,9 @@ func (r *gatewayReconciler) reconcileHelper(ctx context.Context, req reconcile.R
 
 	gw := &gwv1.Gateway{}
 	if err := r.k8sClient.Get(ctx, req.NamespacedName, gw); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.driftAuditor.Forget(req)
+		}
 		return client.IgnoreNotFound(err)
 	}
 
@@ -183,27 +208,49 @@ func (r *gatewayReconciler) reconcileHelper(ctx context.Context, req reconcile.R
 		return err
 	}
 
-	allRoutes, err := r.gatewayLoader.LoadRoutesForGateway(ctx, *gw, r.routeFilter)
+	loaderResult, err := r.gatewayLoader.LoadRoutesForGateway(ctx, *gw, r.routeFilter)
+
+	if err != nil {
+		return err
+	}
+
+	if err := r.updateRoutesStatus(ctx, gw, loaderResult.RouteStatuses); err != nil {
+		return err
+	}
 
+	listenerCertificates, err := routeutils.LoadListenerCertificates(ctx, r.k8sClient, *gw)
 	if err != nil {
 		return err
 	}
+	allRoutes := loaderResult.Routes
+	r.monitorSecrets(gw, listenerCertificates, allRoutes)
 
-	stack, lb, backendSGRequired, err := r.buildModel(ctx, gw, mergedLbConfig, allRoutes)
+	stack, lb, backendSGRequired, err := r.buildModel(ctx, gw, mergedLbConfig, allRoutes, listenerCertificates)
 
 	if err != nil {
+		programmedStatus := gatewayProgrammedStatus{
+			reason:  gwv1.GatewayReasonInvalid,
+			message: fmt.Sprintf("Failed build model due to %v", err),
+		}
+		if statusErr := r.updateGatewayStatus(ctx, gw, "", loaderResult.AttachedRoutes, listenerCertificates, programmedStatus); statusErr != nil {
+			r.logger.Error(statusErr, "Failed to update gateway status")
+		}
 		return err
 	}
 
 	if lb == nil {
-		err = r.reconcileDelete(ctx, gw, allRoutes)
+		err = r.reconcileDelete(ctx, gw, stack, allRoutes)
 		if err != nil {
 			r.logger.Error(err, "Failed to process gateway delete")
 		}
 		return err
 	}
 
-	return r.reconcileUpdate(ctx, gw, stack, lb, backendSGRequired)
+	if r.isDryRun(gw) {
+		return r.planModel(ctx, gw, stack)
+	}
+
+	return r.reconcileUpdate(ctx, gw, stack, lb, backendSGRequired, loaderResult.AttachedRoutes, listenerCertificates)
 }
 
 func (r *gatewayReconciler) resolveLoadBalancerConfig(ctx context.Context, k8sClient client.Client, reference *gwv1.ParametersReference) (*elbv2gw.LoadBalancerConfiguration, error) {
@@ -223,7 +270,7 @@ func (r *gatewayReconciler) resolveLoadBalancerConfig(ctx context.Context, k8sCl
 	return lbConf, err
 }
 
-func (r *gatewayReconciler) reconcileDelete(ctx context.Context, gw *gwv1.Gateway, routes map[int32][]routeutils.RouteDescriptor) error {
+func (r *gatewayReconciler) reconcileDelete(ctx context.Context, gw *gwv1.Gateway, stack core.Stack, routes map[int32][]routeutils.RouteDescriptor) error {
 	for _, routeList := range routes {
 		if len(routeList) != 0 {
 			// TODO - Better error messaging (e.g. tell user the routes that are still attached)
@@ -231,11 +278,29 @@ func (r *gatewayReconciler) reconcileDelete(ctx context.Context, gw *gwv1.Gatewa
 		}
 	}
 
-	return r.finalizerManager.RemoveFinalizers(ctx, gw, r.finalizer)
+	if !k8s.HasFinalizer(gw, r.finalizer) {
+		return nil
+	}
+	if r.isDryRun(gw) {
+		return r.holdDeletion(ctx, gw, stack)
+	}
+	// the stack of a deleted gateway is empty, deploying it deletes the load balancer along with the certificates imported for its listeners.
+	if err := r.deployModel(ctx, gw, stack); err != nil {
+		return err
+	}
+	r.driftAuditor.Forget(reconcile.Request{NamespacedName: k8s.NamespacedName(gw)})
+	if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeGateway, []types.NamespacedName{k8s.NamespacedName(gw)}); err != nil {
+		return err
+	}
+	if err := r.finalizerManager.RemoveFinalizers(ctx, gw, r.finalizer); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+		return err
+	}
+	return nil
 }
 
 func (r *gatewayReconciler) reconcileUpdate(ctx context.Context, gw *gwv1.Gateway, stack core.Stack,
-	lb *elbv2model.LoadBalancer, backendSGRequired bool) error {
+	lb *elbv2model.LoadBalancer, backendSGRequired bool, attachedRoutes map[gwv1.SectionName]int32, listenerCertificates map[gwv1.SectionName]routeutils.ListenerCertificates) error {
 
 	if err := r.finalizerManager.AddFinalizers(ctx, gw, r.finalizer); err != nil {
 		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
@@ -243,20 +308,32 @@ func (r *gatewayReconciler) reconcileUpdate(ctx context.Context, gw *gwv1.Gatewa
 	}
 	err := r.deployModel(ctx, gw, stack)
 	if err != nil {
+		programmedStatus := gatewayProgrammedStatus{
+			reason:  gwv1.GatewayReasonPending,
+			message: fmt.Sprintf("Failed deploy model due to %v", err),
+		}
+		if statusErr := r.updateGatewayStatus(ctx, gw, "", attachedRoutes, listenerCertificates, programmedStatus); statusErr != nil {
+			r.logger.Error(statusErr, "Failed to update gateway status")
+		}
 		return err
 	}
+	// auditing the stack sets the status of its resources, so it's only audited once the gateway status is resolved from it.
+	defer r.driftAuditor.Track(reconcile.Request{NamespacedName: k8s.NamespacedName(gw)}, drift.Target{
+		Stack:   stack,
+		Objects: []client.Object{gw},
+	})
 	lbDNS, err := lb.DNSName().Resolve(ctx)
 	if err != nil {
 		return err
 	}
 
 	if !backendSGRequired {
-		if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(gw)}); err != nil {
+		if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeGateway, []types.NamespacedName{k8s.NamespacedName(gw)}); err != nil {
 			return err
 		}
 	}
 
-	if err = r.updateGatewayStatus(ctx, lbDNS, gw); err != nil {
+	if err = r.updateGatewayStatus(ctx, gw, lbDNS, attachedRoutes, listenerCertificates, gatewayProgrammedStatus{programmed: true}); err != nil {
 		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 		return err
 	}
@@ -277,8 +354,44 @@ func (r *gatewayReconciler) deployModel(ctx context.Context, gw *gwv1.Gateway, s
 	return nil
 }
 
-func (r *gatewayReconciler) buildModel(ctx context.Context, gw *gwv1.Gateway, cfg elbv2gw.LoadBalancerConfiguration, listenerToRoute map[int32][]routeutils.RouteDescriptor) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
-	stack, lb, backendSGRequired, err := r.modelBuilder.Build(ctx, gw, cfg, listenerToRoute)
+// planModel reports the changes deploying the stack would make, without making them.
+// Finalizers and status of the gateway are left untouched.
+func (r *gatewayReconciler) planModel(ctx context.Context, gw *gwv1.Gateway, stack core.Stack) error {
+	plan, err := r.stackDeployer.Plan(ctx, stack, r.metricsCollector, r.controllerName, nil)
+	if err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	planJSON, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("successfully planned model", "gateway", k8s.NamespacedName(gw), "plan", string(planJSON))
+	r.eventRecorder.Event(gw, corev1.EventTypeNormal, k8s.ServiceEventReasonDryRun, plan.Describe())
+	return nil
+}
+
+// holdDeletion reports the deletion of the load balancer resources of a deleted gateway in dry-run mode, without making it.
+// The finalizer is kept, so that the load balancer resources are deleted once dry-run is turned off.
+func (r *gatewayReconciler) holdDeletion(ctx context.Context, gw *gwv1.Gateway, stack core.Stack) error {
+	if err := r.planModel(ctx, gw, stack); err != nil {
+		return err
+	}
+	r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.ServiceEventReasonDeletionHeld, "Deletion is held in dry-run mode, the load balancer is deleted and the finalizer removed once dry-run is turned off")
+	return nil
+}
+
+// isDryRun checks whether changes to the gateway should only be planned.
+func (r *gatewayReconciler) isDryRun(gw *gwv1.Gateway) bool {
+	if r.dryRun {
+		return true
+	}
+	dryRun, err := strconv.ParseBool(gw.Annotations[constants.GatewayDryRunAnnotation])
+	return err == nil && dryRun
+}
+
+func (r *gatewayReconciler) buildModel(ctx context.Context, gw *gwv1.Gateway, cfg elbv2gw.LoadBalancerConfiguration, listenerToRoute map[int32][]routeutils.RouteDescriptor, listenerCertificates map[gwv1.SectionName]routeutils.ListenerCertificates) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
+	stack, lb, backendSGRequired, err := r.modelBuilder.Build(ctx, gw, cfg, listenerToRoute, listenerCertificates)
 	if err != nil {
 		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
 		return nil, nil, false, err
@@ -292,30 +405,32 @@ func (r *gatewayReconciler) buildModel(ctx context.Context, gw *gwv1.Gateway, cf
 	return stack, lb, backendSGRequired, nil
 }
 
-func (r *gatewayReconciler) updateGatewayStatus(ctx context.Context, lbDNS string, gw *gwv1.Gateway) error {
-	// TODO Consider LB ARN.
-
-	// Gateway Address Status
-	if len(gw.Status.Addresses) != 1 ||
-		gw.Status.Addresses[0].Value != "" ||
-		gw.Status.Addresses[0].Value != lbDNS {
-		gwOld := gw.DeepCopy()
-		ipAddressType := gwv1.HostnameAddressType
-		gw.Status.Addresses = []gwv1.GatewayStatusAddress{
-			{
-				Type:  &ipAddressType,
-				Value: lbDNS,
-			},
+// monitorSecrets watches the certificate secrets of the gateway listeners, so that rotated certificates are re-imported,
+// and the OIDC client secrets of the attached routes, so that rotated client credentials are applied to the listener rules.
+func (r *gatewayReconciler) monitorSecrets(gw *gwv1.Gateway, listenerCertificates map[gwv1.SectionName]routeutils.ListenerCertificates, routes map[int32][]routeutils.RouteDescriptor) {
+	if r.secretsManager == nil {
+		return
+	}
+	secrets := sets.New[types.NamespacedName]()
+	if gw.DeletionTimestamp.IsZero() {
+		if r.featureGates.Enabled(config.GatewayCertificateImport) {
+			for _, certificates := range listenerCertificates {
+				for _, secret := range certificates.Secrets {
+					secrets.Insert(k8s.NamespacedName(secret))
+				}
+			}
 		}
-		if err := r.k8sClient.Status().Patch(ctx, gw, client.MergeFrom(gwOld)); err != nil {
-			return errors.Wrapf(err, "failed to update gw status: %v", k8s.NamespacedName(gw))
+		for _, routeList := range routes {
+			for _, route := range routeList {
+				for _, rule := range route.GetAttachedRules() {
+					if ruleConfig := rule.GetListenerRuleConfig(); ruleConfig != nil && ruleConfig.OIDCSecret != nil {
+						secrets.Insert(*ruleConfig.OIDCSecret)
+					}
+				}
+			}
 		}
 	}
-
-	// TODO: Listener status ListenerStatus
-	// https://github.com/aws/aws-application-networking-k8s/blob/main/pkg/controllers/gateway_controller.go#L350
-
-	return nil
+	r.secretsManager.MonitorSecrets(k8s.NamespacedName(gw).String(), secrets.UnsortedList())
 }
 
 func (r *gatewayReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) (controller.Controller, error) {
@@ -377,16 +492,46 @@ func (r *gatewayReconciler) setupCommonGatewayControllerWatches(ctrl controller.
 	if err := ctrl.Watch(source.Kind(mgr.GetCache(), &elbv2gw.LoadBalancerConfiguration{}, lbConfigEventHandler)); err != nil {
 		return err
 	}
+	if err := ctrl.Watch(r.driftAuditor.Source()); err != nil {
+		return err
+	}
+	if err := mgr.Add(r.driftAuditor); err != nil {
+		return err
+	}
+	referenceGrantEventHandler := eventhandlers.NewEnqueueRequestsForReferenceGrantEvent(r.k8sClient, r.eventRecorder, r.controllerName,
+		loggerPrefix.WithName("ReferenceGrant"))
+	if err := ctrl.Watch(source.Kind(mgr.GetCache(), &gwbeta1.ReferenceGrant{}, referenceGrantEventHandler)); err != nil {
+		return err
+	}
 	return nil
 
 }
 
+// setupSecretWatches sets up the secrets manager, Secrets aren't cached by the manager, the secrets referenced by gateways
+// are watched individually instead. Events of OIDC client secrets are sent to ruleConfigEventChan when it's set.
+func (r *gatewayReconciler) setupSecretWatches(ctrl controller.Controller, mgr ctrl.Manager, ruleConfigEventChan chan<- event.TypedGenericEvent[*elbv2gw.ListenerRuleConfiguration]) error {
+	loggerPrefix := r.logger.WithName("eventHandlers")
+	secretEventsChan := make(chan event.TypedGenericEvent[*corev1.Secret])
+	secretEventHandler := eventhandlers.NewEnqueueRequestsForSecretEvent(ruleConfigEventChan, r.k8sClient, r.eventRecorder, r.controllerName,
+		loggerPrefix.WithName("Secret"))
+	if err := ctrl.Watch(source.Channel(secretEventsChan, secretEventHandler)); err != nil {
+		return err
+	}
+	clientSet, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+	r.secretsManager = k8s.NewSecretsManager(clientSet, secretEventsChan, r.logger.WithName("secrets-manager"))
+	return nil
+}
+
 func (r *gatewayReconciler) setupALBGatewayControllerWatches(ctrl controller.Controller, mgr ctrl.Manager) error {
 	loggerPrefix := r.logger.WithName("eventHandlers")
 	tbConfigEventChan := make(chan event.TypedGenericEvent[*elbv2gw.TargetGroupConfiguration])
 	httpRouteEventChan := make(chan event.TypedGenericEvent[*gwv1.HTTPRoute])
 	grpcRouteEventChan := make(chan event.TypedGenericEvent[*gwv1.GRPCRoute])
 	svcEventChan := make(chan event.TypedGenericEvent[*corev1.Service])
+	listenerRuleConfigEventChan := make(chan event.TypedGenericEvent[*elbv2gw.ListenerRuleConfiguration])
 	tgConfigEventHandler := eventhandlers.NewEnqueueRequestsForTargetGroupConfigurationEvent(svcEventChan, r.k8sClient, r.eventRecorder,
 		loggerPrefix.WithName("TargetGroupConfiguration"))
 	grpcRouteEventHandler := eventhandlers.NewEnqueueRequestsForGRPCRouteEvent(r.k8sClient, r.eventRecorder,
@@ -395,6 +540,8 @@ func (r *gatewayReconciler) setupALBGatewayControllerWatches(ctrl controller.Con
 		loggerPrefix.WithName("HTTPRoute"))
 	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(httpRouteEventChan, grpcRouteEventChan, nil, nil, nil, r.k8sClient, r.eventRecorder,
 		loggerPrefix.WithName("Service"), constants.ALBGatewayController)
+	listenerRuleConfigEventHandler := eventhandlers.NewEnqueueRequestsForListenerRuleConfigurationEvent(httpRouteEventChan, r.k8sClient, r.eventRecorder,
+		loggerPrefix.WithName("ListenerRuleConfiguration"))
 	if err := ctrl.Watch(source.Channel(tbConfigEventChan, tgConfigEventHandler)); err != nil {
 		return err
 	}
@@ -419,7 +566,14 @@ func (r *gatewayReconciler) setupALBGatewayControllerWatches(ctrl controller.Con
 	if err := ctrl.Watch(source.Kind(mgr.GetCache(), &gwv1.GRPCRoute{}, grpcRouteEventHandler)); err != nil {
 		return err
 	}
-	return nil
+	if err := ctrl.Watch(source.Channel(listenerRuleConfigEventChan, listenerRuleConfigEventHandler)); err != nil {
+		return err
+	}
+	if err := ctrl.Watch(source.Kind(mgr.GetCache(), &elbv2gw.ListenerRuleConfiguration{}, listenerRuleConfigEventHandler)); err != nil {
+		return err
+	}
+	// OIDC client secrets can be referenced by any ALB gateway, certificate secrets only when certificate import is enabled.
+	return r.setupSecretWatches(ctrl, mgr, listenerRuleConfigEventChan)
 }
 
 func (r *gatewayReconciler) setupNLBGatewayControllerWatches(ctrl controller.Controller, mgr ctrl.Manager) error {
@@ -469,6 +623,9 @@ func (r *gatewayReconciler) setupNLBGatewayControllerWatches(ctrl controller.Con
 	if err := ctrl.Watch(source.Kind(mgr.GetCache(), &gwalpha2.TLSRoute{}, tlsRouteEventHandler)); err != nil {
 		return err
 	}
+	if r.featureGates.Enabled(config.GatewayCertificateImport) {
+		return r.setupSecretWatches(ctrl, mgr, nil)
+	}
 	return nil
 
 }
@@ -0,0 +1,174 @@
+package gateway
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/drift"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/dryrun"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_reconcileDelete_dryRun(t *testing.T) {
+	finalizer := "gateway.k8s.aws/alb"
+	now := metav1.Now()
+	tests := []struct {
+		name        string
+		dryRun      bool
+		annotations map[string]string
+		finalizers  []string
+		wantPlanned bool
+		wantEvents  []string
+	}{
+		{
+			name:        "deletion is held by the controller-wide dry-run setting",
+			dryRun:      true,
+			finalizers:  []string{finalizer},
+			wantPlanned: true,
+			wantEvents: []string{
+				"Normal DryRun 0 to create, 0 to update, 1 to delete\nDelete DeleteLoadBalancer lb",
+				"Warning DeletionHeld Deletion is held in dry-run mode, the load balancer is deleted and the finalizer removed once dry-run is turned off",
+			},
+		},
+		{
+			name:        "deletion is held by the dry-run annotation",
+			annotations: map[string]string{constants.GatewayDryRunAnnotation: "true"},
+			finalizers:  []string{finalizer},
+			wantPlanned: true,
+			wantEvents: []string{
+				"Normal DryRun 0 to create, 0 to update, 1 to delete\nDelete DeleteLoadBalancer lb",
+				"Warning DeletionHeld Deletion is held in dry-run mode, the load balancer is deleted and the finalizer removed once dry-run is turned off",
+			},
+		},
+		{
+			name:   "gateway without finalizer is left alone",
+			dryRun: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			gw := &gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "gw-ns",
+					Name:              "gw",
+					Annotations:       tt.annotations,
+					Finalizers:        tt.finalizers,
+					DeletionTimestamp: &now,
+				},
+			}
+			stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(gw)))
+			stackDeployer := deploy.NewMockStackDeployer(ctrl)
+			if tt.wantPlanned {
+				stackDeployer.EXPECT().Plan(gomock.Any(), stack, gomock.Any(), gomock.Any(), gomock.Any()).Return(&dryrun.Plan{
+					Changes: []dryrun.Change{{Type: dryrun.ChangeTypeDelete, Operation: "DeleteLoadBalancer", Resource: "lb"}},
+				}, nil)
+			}
+			// the finalizer manager must not be called, the finalizer is kept while deletion is held.
+			finalizerManager := k8s.NewMockFinalizerManager(ctrl)
+			eventRecorder := record.NewFakeRecorder(10)
+
+			r := &gatewayReconciler{
+				finalizer:        finalizer,
+				stackDeployer:    stackDeployer,
+				finalizerManager: finalizerManager,
+				eventRecorder:    eventRecorder,
+				logger:           logr.Discard(),
+				dryRun:           tt.dryRun,
+			}
+			err := r.reconcileDelete(context.Background(), gw, stack, nil)
+			assert.NoError(t, err)
+
+			close(eventRecorder.Events)
+			var gotEvents []string
+			for event := range eventRecorder.Events {
+				gotEvents = append(gotEvents, event)
+			}
+			assert.Equal(t, tt.wantEvents, gotEvents)
+		})
+	}
+}
+
+// auditingDriftAuditor audits every tracked stack right away in the background, setting the status of its load balancers
+// the way planning a stack whose load balancer was deleted out of band does.
+type auditingDriftAuditor struct {
+	drift.Auditor
+	audits sync.WaitGroup
+}
+
+func (a *auditingDriftAuditor) Track(_ reconcile.Request, target drift.Target) {
+	a.audits.Add(1)
+	go func() {
+		defer a.audits.Done()
+		var lbs []*elbv2model.LoadBalancer
+		_ = target.Stack.ListResources(&lbs)
+		for _, lb := range lbs {
+			lb.SetStatus(elbv2model.LoadBalancerStatus{
+				LoadBalancerARN: "arn:aws:elasticloadbalancing:us-west-2:000000000000:loadbalancer/app/placeholder/0000000000000000",
+				DNSName:         "placeholder.invalid",
+			})
+		}
+	}()
+}
+
+// Test_reconcileUpdate_driftAudit runs with -race in CI, the audit of the deployed stack must not race with the gateway status.
+func Test_reconcileUpdate_driftAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "gw"}}
+	k8sSchema := runtime.NewScheme()
+	assert.NoError(t, gwv1.AddToScheme(k8sSchema))
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(gw).WithStatusSubresource(gw).Build()
+
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(gw)))
+	lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{})
+	stackDeployer := deploy.NewMockStackDeployer(ctrl)
+	stackDeployer.EXPECT().Deploy(gomock.Any(), stack, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ core.Stack, _ lbcmetrics.MetricCollector, _ string, _ *core.FrontendNlbTargetGroupDesiredState) error {
+			lb.SetStatus(elbv2model.LoadBalancerStatus{
+				LoadBalancerARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/gw/1234567890abcdef",
+				DNSName:         "gw.elb.amazonaws.com",
+			})
+			return nil
+		})
+	finalizerManager := k8s.NewMockFinalizerManager(ctrl)
+	finalizerManager.EXPECT().AddFinalizers(gomock.Any(), gw, gomock.Any()).Return(nil)
+	driftAuditor := &auditingDriftAuditor{}
+
+	r := &gatewayReconciler{
+		controllerName:   constants.ALBGatewayController,
+		lbType:           elbv2model.LoadBalancerTypeApplication,
+		k8sClient:        k8sClient,
+		stackDeployer:    stackDeployer,
+		finalizerManager: finalizerManager,
+		driftAuditor:     driftAuditor,
+		eventRecorder:    record.NewFakeRecorder(10),
+		logger:           logr.Discard(),
+	}
+	err := r.reconcileUpdate(context.Background(), gw, stack, lb, true, nil, nil)
+	assert.NoError(t, err)
+	driftAuditor.audits.Wait()
+
+	updatedGW := &gwv1.Gateway{}
+	assert.NoError(t, k8sClient.Get(context.Background(), k8s.NamespacedName(gw), updatedGW))
+	assert.Len(t, updatedGW.Status.Addresses, 1)
+	assert.Equal(t, "gw.elb.amazonaws.com", updatedGW.Status.Addresses[0].Value)
+}
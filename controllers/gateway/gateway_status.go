@@ -0,0 +1,241 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/routeutils"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// supportedRouteKindsByProtocol are the route kinds each listener protocol can serve, per load balancer type.
+var supportedRouteKindsByProtocol = map[elbv2model.LoadBalancerType]map[gwv1.ProtocolType][]routeutils.RouteKind{
+	elbv2model.LoadBalancerTypeApplication: {
+		gwv1.HTTPProtocolType:  {routeutils.HTTPRouteKind, routeutils.GRPCRouteKind},
+		gwv1.HTTPSProtocolType: {routeutils.HTTPRouteKind, routeutils.GRPCRouteKind},
+	},
+	elbv2model.LoadBalancerTypeNetwork: {
+		gwv1.TCPProtocolType: {routeutils.TCPRouteKind},
+		gwv1.UDPProtocolType: {routeutils.UDPRouteKind},
+		gwv1.TLSProtocolType: {routeutils.TLSRouteKind, routeutils.TCPRouteKind},
+	},
+}
+
+// gatewayProgrammedStatus describes the result of programming the gateway onto the load balancer.
+type gatewayProgrammedStatus struct {
+	programmed bool
+	reason     gwv1.GatewayConditionReason
+	message    string
+}
+
+// updateGatewayStatus updates the gateway address, the gateway conditions and the status of every listener.
+// When lbDNS is empty, the existing gateway addresses are kept.
+func (r *gatewayReconciler) updateGatewayStatus(ctx context.Context, gw *gwv1.Gateway, lbDNS string, attachedRoutes map[gwv1.SectionName]int32, listenerCertificates map[gwv1.SectionName]routeutils.ListenerCertificates, programmedStatus gatewayProgrammedStatus) error {
+	gwOld := gw.DeepCopy()
+
+	if lbDNS != "" {
+		hostnameAddressType := gwv1.HostnameAddressType
+		gw.Status.Addresses = []gwv1.GatewayStatusAddress{
+			{
+				Type:  &hostnameAddressType,
+				Value: lbDNS,
+			},
+		}
+	}
+
+	gw.Status.Listeners = buildListenerStatuses(gw, r.lbType, attachedRoutes, listenerCertificates, programmedStatus)
+	for _, condition := range buildGatewayConditions(gw, programmedStatus) {
+		meta.SetStatusCondition(&gw.Status.Conditions, condition)
+	}
+
+	if equality.Semantic.DeepEqual(gw.Status, gwOld.Status) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, gw, client.MergeFrom(gwOld)); err != nil {
+		return errors.Wrapf(err, "failed to update gw status: %v", k8s.NamespacedName(gw))
+	}
+	return nil
+}
+
+func buildGatewayConditions(gw *gwv1.Gateway, programmedStatus gatewayProgrammedStatus) []metav1.Condition {
+	accepted := metav1.Condition{
+		Type:               string(gwv1.GatewayConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwv1.GatewayReasonAccepted),
+		Message:            "Gateway is accepted",
+		ObservedGeneration: gw.Generation,
+	}
+	for _, listenerStatus := range gw.Status.Listeners {
+		if !meta.IsStatusConditionTrue(listenerStatus.Conditions, string(gwv1.ListenerConditionAccepted)) ||
+			meta.IsStatusConditionTrue(listenerStatus.Conditions, string(gwv1.ListenerConditionConflicted)) {
+			accepted.Reason = string(gwv1.GatewayReasonListenersNotValid)
+			accepted.Message = fmt.Sprintf("Listener %v is not valid", listenerStatus.Name)
+			break
+		}
+	}
+
+	programmed := metav1.Condition{
+		Type:               string(gwv1.GatewayConditionProgrammed),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwv1.GatewayReasonProgrammed),
+		Message:            "Gateway is programmed",
+		ObservedGeneration: gw.Generation,
+	}
+	if !programmedStatus.programmed {
+		programmed.Status = metav1.ConditionFalse
+		programmed.Reason = string(programmedStatus.reason)
+		programmed.Message = programmedStatus.message
+	}
+	return []metav1.Condition{accepted, programmed}
+}
+
+// buildListenerStatuses builds the status of every listener, existing listener conditions are updated in place to keep their transition times.
+func buildListenerStatuses(gw *gwv1.Gateway, lbType elbv2model.LoadBalancerType, attachedRoutes map[gwv1.SectionName]int32, listenerCertificates map[gwv1.SectionName]routeutils.ListenerCertificates, programmedStatus gatewayProgrammedStatus) []gwv1.ListenerStatus {
+	existingConditions := make(map[gwv1.SectionName][]metav1.Condition)
+	for _, listenerStatus := range gw.Status.Listeners {
+		existingConditions[listenerStatus.Name] = listenerStatus.Conditions
+	}
+
+	listenerStatuses := make([]gwv1.ListenerStatus, 0, len(gw.Spec.Listeners))
+	for _, listener := range gw.Spec.Listeners {
+		supportedKinds, invalidKinds := resolveListenerRouteKinds(listener, lbType)
+		conditions := existingConditions[listener.Name]
+		certificates, hasCertificates := listenerCertificates[listener.Name]
+		if !hasCertificates {
+			certificates.ResolvedRefs = true
+		}
+		for _, condition := range buildListenerConditions(gw, listener, lbType, invalidKinds, certificates, programmedStatus) {
+			condition.ObservedGeneration = gw.Generation
+			meta.SetStatusCondition(&conditions, condition)
+		}
+		listenerStatuses = append(listenerStatuses, gwv1.ListenerStatus{
+			Name:           listener.Name,
+			SupportedKinds: supportedKinds,
+			AttachedRoutes: attachedRoutes[listener.Name],
+			Conditions:     conditions,
+		})
+	}
+	return listenerStatuses
+}
+
+func buildListenerConditions(gw *gwv1.Gateway, listener gwv1.Listener, lbType elbv2model.LoadBalancerType, invalidKinds []string, certificates routeutils.ListenerCertificates, programmedStatus gatewayProgrammedStatus) []metav1.Condition {
+	accepted := metav1.Condition{
+		Type:    string(gwv1.ListenerConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(gwv1.ListenerReasonAccepted),
+		Message: "Listener is accepted",
+	}
+	if _, ok := supportedRouteKindsByProtocol[lbType][listener.Protocol]; !ok {
+		accepted.Status = metav1.ConditionFalse
+		accepted.Reason = string(gwv1.ListenerReasonUnsupportedProtocol)
+		accepted.Message = fmt.Sprintf("Protocol %v is not supported", listener.Protocol)
+	}
+
+	resolvedRefs := metav1.Condition{
+		Type:    string(gwv1.ListenerConditionResolvedRefs),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(gwv1.ListenerReasonResolvedRefs),
+		Message: "All references are resolved",
+	}
+	switch {
+	case !certificates.ResolvedRefs:
+		resolvedRefs.Status = metav1.ConditionFalse
+		resolvedRefs.Reason = string(certificates.Reason)
+		resolvedRefs.Message = certificates.Message
+	case len(invalidKinds) != 0:
+		resolvedRefs.Status = metav1.ConditionFalse
+		resolvedRefs.Reason = string(gwv1.ListenerReasonInvalidRouteKinds)
+		resolvedRefs.Message = fmt.Sprintf("Route kinds %v are not supported by protocol %v", invalidKinds, listener.Protocol)
+	}
+
+	conflicted := metav1.Condition{
+		Type:    string(gwv1.ListenerConditionConflicted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(gwv1.ListenerReasonNoConflicts),
+		Message: "Listener has no conflicts",
+	}
+	if conflictReason, conflictingListener := findListenerConflict(gw, listener); conflictReason != "" {
+		conflicted.Status = metav1.ConditionTrue
+		conflicted.Reason = string(conflictReason)
+		conflicted.Message = fmt.Sprintf("Listener conflicts with listener %v", conflictingListener)
+	}
+
+	programmed := metav1.Condition{
+		Type:    string(gwv1.ListenerConditionProgrammed),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(gwv1.ListenerReasonProgrammed),
+		Message: "Listener is programmed",
+	}
+	switch {
+	case accepted.Status != metav1.ConditionTrue || conflicted.Status == metav1.ConditionTrue:
+		programmed.Status = metav1.ConditionFalse
+		programmed.Reason = string(gwv1.ListenerReasonInvalid)
+		programmed.Message = "Listener is not valid"
+	case !programmedStatus.programmed:
+		programmed.Status = metav1.ConditionFalse
+		programmed.Reason = string(gwv1.ListenerReasonPending)
+		programmed.Message = programmedStatus.message
+	}
+	return []metav1.Condition{accepted, resolvedRefs, conflicted, programmed}
+}
+
+// resolveListenerRouteKinds determines the route kinds the listener supports.
+// Route kinds allowed by the listener that can't be served by the listener protocol are returned as invalid.
+func resolveListenerRouteKinds(listener gwv1.Listener, lbType elbv2model.LoadBalancerType) ([]gwv1.RouteGroupKind, []string) {
+	protocolKinds := supportedRouteKindsByProtocol[lbType][listener.Protocol]
+	supportedByProtocol := sets.New[routeutils.RouteKind](protocolKinds...)
+
+	allowedKinds := protocolKinds
+	var invalidKinds []string
+	if listener.AllowedRoutes != nil && len(listener.AllowedRoutes.Kinds) != 0 {
+		allowedKinds = nil
+		for _, kind := range listener.AllowedRoutes.Kinds {
+			if (kind.Group != nil && *kind.Group != gwv1.GroupName) || !supportedByProtocol.Has(routeutils.RouteKind(kind.Kind)) {
+				invalidKinds = append(invalidKinds, string(kind.Kind))
+				continue
+			}
+			allowedKinds = append(allowedKinds, routeutils.RouteKind(kind.Kind))
+		}
+	}
+
+	supportedKinds := make([]gwv1.RouteGroupKind, 0, len(allowedKinds))
+	for _, kind := range allowedKinds {
+		group := gwv1.Group(gwv1.GroupName)
+		supportedKinds = append(supportedKinds, gwv1.RouteGroupKind{
+			Group: &group,
+			Kind:  gwv1.Kind(kind),
+		})
+	}
+	return supportedKinds, invalidKinds
+}
+
+// findListenerConflict finds another listener on the same port that can't be distinguished from this listener.
+// Listeners sharing a port conflict when they use a different protocol, or when they use the same hostname.
+func findListenerConflict(gw *gwv1.Gateway, listener gwv1.Listener) (gwv1.ListenerConditionReason, gwv1.SectionName) {
+	for _, other := range gw.Spec.Listeners {
+		if other.Name == listener.Name || other.Port != listener.Port {
+			continue
+		}
+		if other.Protocol != listener.Protocol {
+			return gwv1.ListenerReasonProtocolConflict, other.Name
+		}
+		if listenerHostname(other) == listenerHostname(listener) {
+			return gwv1.ListenerReasonHostnameConflict, other.Name
+		}
+	}
+	return "", ""
+}
+
+func listenerHostname(listener gwv1.Listener) gwv1.Hostname {
+	if listener.Hostname == nil {
+		return ""
+	}
+	return *listener.Hostname
+}
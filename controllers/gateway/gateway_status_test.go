@@ -0,0 +1,279 @@
+package gateway
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/routeutils"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"testing"
+)
+
+func Test_updateGatewayStatus(t *testing.T) {
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "gw",
+			Namespace:  "gw-ns",
+			Generation: 2,
+		},
+		Spec: gwv1.GatewaySpec{
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "http",
+					Protocol: gwv1.HTTPProtocolType,
+					Port:     80,
+				},
+				{
+					Name:     "tcp",
+					Protocol: gwv1.TCPProtocolType,
+					Port:     8080,
+				},
+			},
+		},
+	}
+
+	k8sSchema := runtime.NewScheme()
+	assert.NoError(t, gwv1.AddToScheme(k8sSchema))
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(gw).WithStatusSubresource(gw).Build()
+
+	r := &gatewayReconciler{
+		controllerName: constants.ALBGatewayController,
+		lbType:         elbv2model.LoadBalancerTypeApplication,
+		k8sClient:      k8sClient,
+		logger:         logr.Discard(),
+	}
+
+	err := r.updateGatewayStatus(context.Background(), gw, "", map[gwv1.SectionName]int32{"http": 2}, nil, gatewayProgrammedStatus{
+		reason:  gwv1.GatewayReasonPending,
+		message: "Failed deploy model due to throttling",
+	})
+	assert.NoError(t, err)
+
+	updatedGW := &gwv1.Gateway{}
+	assert.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{Namespace: "gw-ns", Name: "gw"}, updatedGW))
+	assert.Empty(t, updatedGW.Status.Addresses)
+	accepted := meta.FindStatusCondition(updatedGW.Status.Conditions, string(gwv1.GatewayConditionAccepted))
+	assert.Equal(t, string(gwv1.GatewayReasonListenersNotValid), accepted.Reason)
+	assert.Equal(t, int64(2), accepted.ObservedGeneration)
+	programmed := meta.FindStatusCondition(updatedGW.Status.Conditions, string(gwv1.GatewayConditionProgrammed))
+	assert.Equal(t, metav1.ConditionFalse, programmed.Status)
+	assert.Equal(t, string(gwv1.GatewayReasonPending), programmed.Reason)
+	assert.Len(t, updatedGW.Status.Listeners, 2)
+	assert.Equal(t, int32(2), updatedGW.Status.Listeners[0].AttachedRoutes)
+	assert.Equal(t, string(gwv1.ListenerReasonPending), meta.FindStatusCondition(updatedGW.Status.Listeners[0].Conditions, string(gwv1.ListenerConditionProgrammed)).Reason)
+	assert.Equal(t, string(gwv1.ListenerReasonUnsupportedProtocol), meta.FindStatusCondition(updatedGW.Status.Listeners[1].Conditions, string(gwv1.ListenerConditionAccepted)).Reason)
+	assert.Equal(t, string(gwv1.ListenerReasonInvalid), meta.FindStatusCondition(updatedGW.Status.Listeners[1].Conditions, string(gwv1.ListenerConditionProgrammed)).Reason)
+
+	err = r.updateGatewayStatus(context.Background(), updatedGW, "lb.elb.amazonaws.com", map[gwv1.SectionName]int32{"http": 2}, nil, gatewayProgrammedStatus{programmed: true})
+	assert.NoError(t, err)
+
+	assert.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{Namespace: "gw-ns", Name: "gw"}, updatedGW))
+	assert.Len(t, updatedGW.Status.Addresses, 1)
+	assert.Equal(t, "lb.elb.amazonaws.com", updatedGW.Status.Addresses[0].Value)
+	assert.True(t, meta.IsStatusConditionTrue(updatedGW.Status.Conditions, string(gwv1.GatewayConditionProgrammed)))
+	assert.True(t, meta.IsStatusConditionTrue(updatedGW.Status.Listeners[0].Conditions, string(gwv1.ListenerConditionProgrammed)))
+}
+
+func Test_buildListenerConditions(t *testing.T) {
+	hostnameA := gwv1.Hostname("a.example.com")
+	hostnameB := gwv1.Hostname("b.example.com")
+	tests := []struct {
+		name             string
+		listeners        []gwv1.Listener
+		lbType           elbv2model.LoadBalancerType
+		certificates     routeutils.ListenerCertificates
+		programmedStatus gatewayProgrammedStatus
+		wantReasons      map[gwv1.ListenerConditionType]gwv1.ListenerConditionReason
+	}{
+		{
+			name: "valid programmed listener",
+			listeners: []gwv1.Listener{
+				{Name: "http", Protocol: gwv1.HTTPProtocolType, Port: 80},
+			},
+			lbType:           elbv2model.LoadBalancerTypeApplication,
+			certificates:     routeutils.ListenerCertificates{ResolvedRefs: true},
+			programmedStatus: gatewayProgrammedStatus{programmed: true},
+			wantReasons: map[gwv1.ListenerConditionType]gwv1.ListenerConditionReason{
+				gwv1.ListenerConditionAccepted:     gwv1.ListenerReasonAccepted,
+				gwv1.ListenerConditionResolvedRefs: gwv1.ListenerReasonResolvedRefs,
+				gwv1.ListenerConditionConflicted:   gwv1.ListenerReasonNoConflicts,
+				gwv1.ListenerConditionProgrammed:   gwv1.ListenerReasonProgrammed,
+			},
+		},
+		{
+			name: "unsupported protocol",
+			listeners: []gwv1.Listener{
+				{Name: "http", Protocol: gwv1.HTTPProtocolType, Port: 80},
+			},
+			lbType:           elbv2model.LoadBalancerTypeNetwork,
+			certificates:     routeutils.ListenerCertificates{ResolvedRefs: true},
+			programmedStatus: gatewayProgrammedStatus{programmed: true},
+			wantReasons: map[gwv1.ListenerConditionType]gwv1.ListenerConditionReason{
+				gwv1.ListenerConditionAccepted:     gwv1.ListenerReasonUnsupportedProtocol,
+				gwv1.ListenerConditionResolvedRefs: gwv1.ListenerReasonResolvedRefs,
+				gwv1.ListenerConditionConflicted:   gwv1.ListenerReasonNoConflicts,
+				gwv1.ListenerConditionProgrammed:   gwv1.ListenerReasonInvalid,
+			},
+		},
+		{
+			name: "invalid route kinds",
+			listeners: []gwv1.Listener{
+				{
+					Name:     "tcp",
+					Protocol: gwv1.TCPProtocolType,
+					Port:     80,
+					AllowedRoutes: &gwv1.AllowedRoutes{
+						Kinds: []gwv1.RouteGroupKind{{Kind: "HTTPRoute"}},
+					},
+				},
+			},
+			lbType:           elbv2model.LoadBalancerTypeNetwork,
+			certificates:     routeutils.ListenerCertificates{ResolvedRefs: true},
+			programmedStatus: gatewayProgrammedStatus{programmed: true},
+			wantReasons: map[gwv1.ListenerConditionType]gwv1.ListenerConditionReason{
+				gwv1.ListenerConditionAccepted:     gwv1.ListenerReasonAccepted,
+				gwv1.ListenerConditionResolvedRefs: gwv1.ListenerReasonInvalidRouteKinds,
+				gwv1.ListenerConditionConflicted:   gwv1.ListenerReasonNoConflicts,
+				gwv1.ListenerConditionProgrammed:   gwv1.ListenerReasonProgrammed,
+			},
+		},
+		{
+			name: "protocol conflict",
+			listeners: []gwv1.Listener{
+				{Name: "tcp", Protocol: gwv1.TCPProtocolType, Port: 80},
+				{Name: "udp", Protocol: gwv1.UDPProtocolType, Port: 80},
+			},
+			lbType:           elbv2model.LoadBalancerTypeNetwork,
+			certificates:     routeutils.ListenerCertificates{ResolvedRefs: true},
+			programmedStatus: gatewayProgrammedStatus{programmed: true},
+			wantReasons: map[gwv1.ListenerConditionType]gwv1.ListenerConditionReason{
+				gwv1.ListenerConditionAccepted:     gwv1.ListenerReasonAccepted,
+				gwv1.ListenerConditionResolvedRefs: gwv1.ListenerReasonResolvedRefs,
+				gwv1.ListenerConditionConflicted:   gwv1.ListenerReasonProtocolConflict,
+				gwv1.ListenerConditionProgrammed:   gwv1.ListenerReasonInvalid,
+			},
+		},
+		{
+			name: "hostname conflict",
+			listeners: []gwv1.Listener{
+				{Name: "http-1", Protocol: gwv1.HTTPProtocolType, Port: 80, Hostname: &hostnameA},
+				{Name: "http-2", Protocol: gwv1.HTTPProtocolType, Port: 80, Hostname: &hostnameA},
+			},
+			lbType:           elbv2model.LoadBalancerTypeApplication,
+			certificates:     routeutils.ListenerCertificates{ResolvedRefs: true},
+			programmedStatus: gatewayProgrammedStatus{programmed: true},
+			wantReasons: map[gwv1.ListenerConditionType]gwv1.ListenerConditionReason{
+				gwv1.ListenerConditionAccepted:     gwv1.ListenerReasonAccepted,
+				gwv1.ListenerConditionResolvedRefs: gwv1.ListenerReasonResolvedRefs,
+				gwv1.ListenerConditionConflicted:   gwv1.ListenerReasonHostnameConflict,
+				gwv1.ListenerConditionProgrammed:   gwv1.ListenerReasonInvalid,
+			},
+		},
+		{
+			name: "distinct hostnames on the same port don't conflict",
+			listeners: []gwv1.Listener{
+				{Name: "http-1", Protocol: gwv1.HTTPProtocolType, Port: 80, Hostname: &hostnameA},
+				{Name: "http-2", Protocol: gwv1.HTTPProtocolType, Port: 80, Hostname: &hostnameB},
+			},
+			lbType:       elbv2model.LoadBalancerTypeApplication,
+			certificates: routeutils.ListenerCertificates{ResolvedRefs: true},
+			programmedStatus: gatewayProgrammedStatus{
+				reason:  gwv1.GatewayReasonInvalid,
+				message: "Failed build model",
+			},
+			wantReasons: map[gwv1.ListenerConditionType]gwv1.ListenerConditionReason{
+				gwv1.ListenerConditionAccepted:     gwv1.ListenerReasonAccepted,
+				gwv1.ListenerConditionResolvedRefs: gwv1.ListenerReasonResolvedRefs,
+				gwv1.ListenerConditionConflicted:   gwv1.ListenerReasonNoConflicts,
+				gwv1.ListenerConditionProgrammed:   gwv1.ListenerReasonPending,
+			},
+		},
+		{
+			name: "certificate reference not permitted",
+			listeners: []gwv1.Listener{
+				{Name: "https", Protocol: gwv1.HTTPSProtocolType, Port: 443},
+			},
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			certificates: routeutils.ListenerCertificates{
+				Reason:  gwv1.ListenerReasonRefNotPermitted,
+				Message: "Certificate reference to other-ns/cert is not permitted by any ReferenceGrant",
+			},
+			programmedStatus: gatewayProgrammedStatus{programmed: true},
+			wantReasons: map[gwv1.ListenerConditionType]gwv1.ListenerConditionReason{
+				gwv1.ListenerConditionAccepted:     gwv1.ListenerReasonAccepted,
+				gwv1.ListenerConditionResolvedRefs: gwv1.ListenerReasonRefNotPermitted,
+				gwv1.ListenerConditionConflicted:   gwv1.ListenerReasonNoConflicts,
+				gwv1.ListenerConditionProgrammed:   gwv1.ListenerReasonProgrammed,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := &gwv1.Gateway{
+				Spec: gwv1.GatewaySpec{
+					Listeners: tt.listeners,
+				},
+			}
+			_, invalidKinds := resolveListenerRouteKinds(tt.listeners[0], tt.lbType)
+			conditions := buildListenerConditions(gw, tt.listeners[0], tt.lbType, invalidKinds, tt.certificates, tt.programmedStatus)
+			gotReasons := make(map[gwv1.ListenerConditionType]gwv1.ListenerConditionReason)
+			for _, condition := range conditions {
+				gotReasons[gwv1.ListenerConditionType(condition.Type)] = gwv1.ListenerConditionReason(condition.Reason)
+			}
+			assert.Equal(t, tt.wantReasons, gotReasons)
+		})
+	}
+}
+
+func Test_resolveListenerRouteKinds(t *testing.T) {
+	otherGroup := gwv1.Group("example.com")
+	tests := []struct {
+		name             string
+		listener         gwv1.Listener
+		lbType           elbv2model.LoadBalancerType
+		wantKinds        []gwv1.Kind
+		wantInvalidKinds []string
+	}{
+		{
+			name:      "defaults to the kinds of the protocol",
+			listener:  gwv1.Listener{Protocol: gwv1.HTTPSProtocolType},
+			lbType:    elbv2model.LoadBalancerTypeApplication,
+			wantKinds: []gwv1.Kind{gwv1.Kind(routeutils.HTTPRouteKind), gwv1.Kind(routeutils.GRPCRouteKind)},
+		},
+		{
+			name: "allowed kinds are filtered by protocol",
+			listener: gwv1.Listener{
+				Protocol: gwv1.TLSProtocolType,
+				AllowedRoutes: &gwv1.AllowedRoutes{
+					Kinds: []gwv1.RouteGroupKind{
+						{Kind: "TLSRoute"},
+						{Kind: "UDPRoute"},
+						{Kind: "TCPRoute", Group: &otherGroup},
+					},
+				},
+			},
+			lbType:           elbv2model.LoadBalancerTypeNetwork,
+			wantKinds:        []gwv1.Kind{gwv1.Kind(routeutils.TLSRouteKind)},
+			wantInvalidKinds: []string{"UDPRoute", "TCPRoute"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			supportedKinds, invalidKinds := resolveListenerRouteKinds(tt.listener, tt.lbType)
+			var gotKinds []gwv1.Kind
+			for _, kind := range supportedKinds {
+				assert.Equal(t, gwv1.Group(gwv1.GroupName), *kind.Group)
+				gotKinds = append(gotKinds, kind.Kind)
+			}
+			assert.Equal(t, tt.wantKinds, gotKinds)
+			assert.Equal(t, tt.wantInvalidKinds, invalidKinds)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/routeutils"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwalpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// updateRoutesStatus reports the Accepted and ResolvedRefs conditions of every route that references the gateway.
+func (r *gatewayReconciler) updateRoutesStatus(ctx context.Context, gw *gwv1.Gateway, routeStatuses []routeutils.RouteStatusInfo) error {
+	for _, routeStatus := range routeStatuses {
+		conditions := buildRouteConditions(routeStatus)
+		if err := r.updateRouteParentConditions(ctx, gw, routeStatus.Kind, routeStatus.NamespacedName, conditions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func buildRouteConditions(routeStatus routeutils.RouteStatusInfo) []metav1.Condition {
+	accepted := metav1.Condition{
+		Type:    string(gwv1.RouteConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(gwv1.RouteReasonAccepted),
+		Message: "Route is accepted",
+	}
+	if !routeStatus.Accepted {
+		accepted.Status = metav1.ConditionFalse
+		accepted.Reason = string(routeStatus.Reason)
+		accepted.Message = routeStatus.Message
+	}
+	resolvedRefs := metav1.Condition{
+		Type:    string(gwv1.RouteConditionResolvedRefs),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(gwv1.RouteReasonResolvedRefs),
+		Message: "All references are resolved",
+	}
+	if !routeStatus.ResolvedRefs {
+		resolvedRefs.Status = metav1.ConditionFalse
+		resolvedRefs.Reason = string(routeStatus.Reason)
+		resolvedRefs.Message = routeStatus.Message
+	}
+	conditions := []metav1.Condition{accepted, resolvedRefs}
+	if routeStatus.Accepted && len(routeStatus.DroppedRules) != 0 {
+		conditions = append(conditions, metav1.Condition{
+			Type:    string(gwv1.RouteConditionPartiallyInvalid),
+			Status:  metav1.ConditionTrue,
+			Reason:  string(gwv1.RouteReasonUnsupportedValue),
+			Message: strings.Join(routeStatus.DroppedRules, "; "),
+		})
+	}
+	return conditions
+}
+
+// updateRouteParentConditions sets the conditions on every route parent status that references the gateway.
+func (r *gatewayReconciler) updateRouteParentConditions(ctx context.Context, gw *gwv1.Gateway, routeKind routeutils.RouteKind, routeNamespacedName types.NamespacedName, conditions []metav1.Condition) error {
+	route, err := newRouteObject(routeKind)
+	if err != nil {
+		return err
+	}
+	if err := r.k8sClient.Get(ctx, routeNamespacedName, route); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	routeOld := route.DeepCopyObject().(client.Object)
+	parentRefs, routeStatus := getRouteParentRefsAndStatus(route)
+	_, routeStatusOld := getRouteParentRefsAndStatus(routeOld)
+
+	for _, parentRef := range parentRefs {
+		if !isParentRefForGateway(parentRef, route.GetNamespace(), gw) {
+			continue
+		}
+		parentStatus := findOrAddRouteParentStatus(routeStatus, parentRef, gwv1.GatewayController(r.controllerName))
+		for _, condition := range conditions {
+			condition.ObservedGeneration = route.GetGeneration()
+			meta.SetStatusCondition(&parentStatus.Conditions, condition)
+		}
+		// the PartiallyInvalid condition must only be set while some rules of the route are dropped.
+		if meta.FindStatusCondition(conditions, string(gwv1.RouteConditionPartiallyInvalid)) == nil {
+			meta.RemoveStatusCondition(&parentStatus.Conditions, string(gwv1.RouteConditionPartiallyInvalid))
+		}
+	}
+
+	if equality.Semantic.DeepEqual(routeStatus, routeStatusOld) {
+		return nil
+	}
+
+	if err := r.k8sClient.Status().Patch(ctx, route, client.MergeFrom(routeOld)); err != nil {
+		return errors.Wrapf(err, "failed to update %v status: %v", routeKind, k8s.NamespacedName(route))
+	}
+	return nil
+}
+
+func newRouteObject(routeKind routeutils.RouteKind) (client.Object, error) {
+	switch routeKind {
+	case routeutils.HTTPRouteKind:
+		return &gwv1.HTTPRoute{}, nil
+	case routeutils.GRPCRouteKind:
+		return &gwv1.GRPCRoute{}, nil
+	case routeutils.TCPRouteKind:
+		return &gwalpha2.TCPRoute{}, nil
+	case routeutils.UDPRouteKind:
+		return &gwalpha2.UDPRoute{}, nil
+	case routeutils.TLSRouteKind:
+		return &gwalpha2.TLSRoute{}, nil
+	}
+	return nil, fmt.Errorf("unknown route kind %v", routeKind)
+}
+
+func getRouteParentRefsAndStatus(route client.Object) ([]gwv1.ParentReference, *gwv1.RouteStatus) {
+	switch typedRoute := route.(type) {
+	case *gwv1.HTTPRoute:
+		return typedRoute.Spec.ParentRefs, &typedRoute.Status.RouteStatus
+	case *gwv1.GRPCRoute:
+		return typedRoute.Spec.ParentRefs, &typedRoute.Status.RouteStatus
+	case *gwalpha2.TCPRoute:
+		return typedRoute.Spec.ParentRefs, &typedRoute.Status.RouteStatus
+	case *gwalpha2.UDPRoute:
+		return typedRoute.Spec.ParentRefs, &typedRoute.Status.RouteStatus
+	case *gwalpha2.TLSRoute:
+		return typedRoute.Spec.ParentRefs, &typedRoute.Status.RouteStatus
+	}
+	return nil, &gwv1.RouteStatus{}
+}
+
+// isParentRefForGateway checks if the parentRef references the gateway, the parentRef namespace defaults to the route namespace.
+func isParentRefForGateway(parentRef gwv1.ParentReference, routeNamespace string, gw *gwv1.Gateway) bool {
+	if parentRef.Group != nil && *parentRef.Group != gwv1.GroupName {
+		return false
+	}
+	if parentRef.Kind != nil && *parentRef.Kind != "Gateway" {
+		return false
+	}
+	namespace := routeNamespace
+	if parentRef.Namespace != nil {
+		namespace = string(*parentRef.Namespace)
+	}
+	return string(parentRef.Name) == gw.Name && namespace == gw.Namespace
+}
+
+func findOrAddRouteParentStatus(routeStatus *gwv1.RouteStatus, parentRef gwv1.ParentReference, controllerName gwv1.GatewayController) *gwv1.RouteParentStatus {
+	for i := range routeStatus.Parents {
+		parentStatus := &routeStatus.Parents[i]
+		if parentStatus.ControllerName == controllerName && isSameParentRef(parentStatus.ParentRef, parentRef) {
+			return parentStatus
+		}
+	}
+	routeStatus.Parents = append(routeStatus.Parents, gwv1.RouteParentStatus{
+		ParentRef:      parentRef,
+		ControllerName: controllerName,
+	})
+	return &routeStatus.Parents[len(routeStatus.Parents)-1]
+}
+
+func isSameParentRef(a gwv1.ParentReference, b gwv1.ParentReference) bool {
+	return a.Name == b.Name &&
+		equalPtr(a.Group, b.Group) &&
+		equalPtr(a.Kind, b.Kind) &&
+		equalPtr(a.Namespace, b.Namespace) &&
+		equalPtr(a.SectionName, b.SectionName) &&
+		equalPtr(a.Port, b.Port)
+}
+
+func equalPtr[T comparable](a *T, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
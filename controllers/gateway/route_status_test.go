@@ -0,0 +1,194 @@
+package gateway
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/routeutils"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"testing"
+)
+
+func Test_updateRoutesStatus(t *testing.T) {
+	otherNamespace := gwv1.Namespace("other-ns")
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "gw-ns",
+		},
+	}
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "route",
+			Namespace:  "gw-ns",
+			Generation: 3,
+		},
+		Spec: gwv1.HTTPRouteSpec{
+			CommonRouteSpec: gwv1.CommonRouteSpec{
+				ParentRefs: []gwv1.ParentReference{
+					{
+						Name: "gw",
+					},
+					{
+						Name:      "gw",
+						Namespace: &otherNamespace,
+					},
+				},
+			},
+		},
+	}
+
+	k8sSchema := runtime.NewScheme()
+	assert.NoError(t, gwv1.AddToScheme(k8sSchema))
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(route).WithStatusSubresource(route).Build()
+
+	r := &gatewayReconciler{
+		controllerName: constants.ALBGatewayController,
+		k8sClient:      k8sClient,
+		logger:         logr.Discard(),
+	}
+	err := r.updateRoutesStatus(context.Background(), gw, []routeutils.RouteStatusInfo{
+		{
+			Kind:           routeutils.HTTPRouteKind,
+			NamespacedName: types.NamespacedName{Namespace: "gw-ns", Name: "route"},
+			ResolvedRefs:   true,
+			Reason:         gwv1.RouteReasonUnsupportedValue,
+			Message:        "HTTPRoute filter type URLRewrite is not supported",
+		},
+		{
+			Kind:           routeutils.HTTPRouteKind,
+			NamespacedName: types.NamespacedName{Namespace: "gw-ns", Name: "deleted-route"},
+			ResolvedRefs:   true,
+			Reason:         gwv1.RouteReasonUnsupportedValue,
+			Message:        "HTTPRoute filter type URLRewrite is not supported",
+		},
+	})
+	assert.NoError(t, err)
+
+	updatedRoute := &gwv1.HTTPRoute{}
+	assert.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{Namespace: "gw-ns", Name: "route"}, updatedRoute))
+	assert.Len(t, updatedRoute.Status.Parents, 1)
+	parentStatus := updatedRoute.Status.Parents[0]
+	assert.Equal(t, gwv1.ParentReference{Name: "gw"}, parentStatus.ParentRef)
+	assert.Equal(t, gwv1.GatewayController(constants.ALBGatewayController), parentStatus.ControllerName)
+	assert.Len(t, parentStatus.Conditions, 2)
+	assert.Equal(t, string(gwv1.RouteConditionAccepted), parentStatus.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionFalse, parentStatus.Conditions[0].Status)
+	assert.Equal(t, string(gwv1.RouteReasonUnsupportedValue), parentStatus.Conditions[0].Reason)
+	assert.Equal(t, int64(3), parentStatus.Conditions[0].ObservedGeneration)
+	assert.Equal(t, string(gwv1.RouteConditionResolvedRefs), parentStatus.Conditions[1].Type)
+	assert.Equal(t, metav1.ConditionTrue, parentStatus.Conditions[1].Status)
+	assert.Equal(t, int64(3), parentStatus.Conditions[1].ObservedGeneration)
+}
+
+func Test_buildRouteConditions(t *testing.T) {
+	tests := []struct {
+		name        string
+		routeStatus routeutils.RouteStatusInfo
+		want        []metav1.Condition
+	}{
+		{
+			name: "accepted route with resolved refs",
+			routeStatus: routeutils.RouteStatusInfo{
+				Accepted:     true,
+				ResolvedRefs: true,
+			},
+			want: []metav1.Condition{
+				{
+					Type:    string(gwv1.RouteConditionAccepted),
+					Status:  metav1.ConditionTrue,
+					Reason:  string(gwv1.RouteReasonAccepted),
+					Message: "Route is accepted",
+				},
+				{
+					Type:    string(gwv1.RouteConditionResolvedRefs),
+					Status:  metav1.ConditionTrue,
+					Reason:  string(gwv1.RouteReasonResolvedRefs),
+					Message: "All references are resolved",
+				},
+			},
+		},
+		{
+			name: "accepted route with unresolved backend",
+			routeStatus: routeutils.RouteStatusInfo{
+				Accepted: true,
+				Reason:   gwv1.RouteReasonBackendNotFound,
+				Message:  "Service ns/svc not found",
+			},
+			want: []metav1.Condition{
+				{
+					Type:    string(gwv1.RouteConditionAccepted),
+					Status:  metav1.ConditionTrue,
+					Reason:  string(gwv1.RouteReasonAccepted),
+					Message: "Route is accepted",
+				},
+				{
+					Type:    string(gwv1.RouteConditionResolvedRefs),
+					Status:  metav1.ConditionFalse,
+					Reason:  string(gwv1.RouteReasonBackendNotFound),
+					Message: "Service ns/svc not found",
+				},
+			},
+		},
+		{
+			name: "accepted route with dropped rules",
+			routeStatus: routeutils.RouteStatusInfo{
+				Accepted:     true,
+				ResolvedRefs: true,
+				DroppedRules: []string{"Dropped Rule 1: all backend references have weight 0, which isn't supported by NLB listeners"},
+			},
+			want: []metav1.Condition{
+				{
+					Type:    string(gwv1.RouteConditionAccepted),
+					Status:  metav1.ConditionTrue,
+					Reason:  string(gwv1.RouteReasonAccepted),
+					Message: "Route is accepted",
+				},
+				{
+					Type:    string(gwv1.RouteConditionResolvedRefs),
+					Status:  metav1.ConditionTrue,
+					Reason:  string(gwv1.RouteReasonResolvedRefs),
+					Message: "All references are resolved",
+				},
+				{
+					Type:    string(gwv1.RouteConditionPartiallyInvalid),
+					Status:  metav1.ConditionTrue,
+					Reason:  string(gwv1.RouteReasonUnsupportedValue),
+					Message: "Dropped Rule 1: all backend references have weight 0, which isn't supported by NLB listeners",
+				},
+			},
+		},
+		{
+			name: "route not allowed by listeners",
+			routeStatus: routeutils.RouteStatusInfo{
+				ResolvedRefs: true,
+				Reason:       gwv1.RouteReasonNotAllowedByListeners,
+				Message:      "Route is not allowed by any listener",
+			},
+			want: []metav1.Condition{
+				{
+					Type:    string(gwv1.RouteConditionAccepted),
+					Status:  metav1.ConditionFalse,
+					Reason:  string(gwv1.RouteReasonNotAllowedByListeners),
+					Message: "Route is not allowed by any listener",
+				},
+				{
+					Type:    string(gwv1.RouteConditionResolvedRefs),
+					Status:  metav1.ConditionTrue,
+					Reason:  string(gwv1.RouteReasonResolvedRefs),
+					Message: "All references are resolved",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildRouteConditions(tt.routeStatus))
+		})
+	}
+}
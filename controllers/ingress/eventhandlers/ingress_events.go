@@ -47,7 +47,7 @@ func (h *enqueueRequestsForIngressEvent) Update(ctx context.Context, e event.Typ
 	//	2. Ingress spec updates
 	//	3. Ingress deletion
 	if !equality.Semantic.DeepEqual(ingOld.ResourceVersion, ingNew.ResourceVersion) {
-		if equality.Semantic.DeepEqual(ingOld.Annotations, ingNew.Annotations) &&
+		if k8s.EqualAnnotationsIgnoringStatus(ingOld.Annotations, ingNew.Annotations) &&
 			equality.Semantic.DeepEqual(ingOld.Spec, ingNew.Spec) &&
 			equality.Semantic.DeepEqual(ingOld.DeletionTimestamp.IsZero(), ingNew.DeletionTimestamp.IsZero()) {
 			return
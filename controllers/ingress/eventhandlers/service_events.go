@@ -50,7 +50,7 @@ func (h *enqueueRequestsForServiceEvent) Update(ctx context.Context, e event.Typ
 	//	1. Service annotation updates
 	//	2. Service spec updates
 	//	3. Service deletions
-	if equality.Semantic.DeepEqual(svcOld.Annotations, svcNew.Annotations) &&
+	if k8s.EqualAnnotationsIgnoringStatus(svcOld.Annotations, svcNew.Annotations) &&
 		equality.Semantic.DeepEqual(svcOld.Spec, svcNew.Spec) &&
 		equality.Semantic.DeepEqual(svcOld.DeletionTimestamp.IsZero(), svcNew.DeletionTimestamp.IsZero()) {
 		return
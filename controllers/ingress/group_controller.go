@@ -2,7 +2,9 @@ package ingress
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -20,6 +22,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/drift"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	errmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/error"
@@ -74,6 +77,8 @@ func NewGroupReconciler(cloud services.Cloud, k8sClient client.Client, eventReco
 	manageIngressesWithoutIngressClass := controllerConfig.IngressConfig.IngressClass == ""
 	groupLoader := ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass)
 	groupFinalizerManager := ingress.NewDefaultFinalizerManager(finalizerManager)
+	driftAuditor := drift.NewDefaultAuditor(stackDeployer, eventRecorder, metricsCollector, controllerName,
+		controllerConfig.DriftDetectionInterval, controllerConfig.DriftPolicy, logger.WithName("drift-auditor"))
 
 	return &groupReconciler{
 		k8sClient:         k8sClient,
@@ -82,7 +87,10 @@ func NewGroupReconciler(cloud services.Cloud, k8sClient client.Client, eventReco
 		modelBuilder:      modelBuilder,
 		stackMarshaller:   stackMarshaller,
 		stackDeployer:     stackDeployer,
+		driftAuditor:      driftAuditor,
 		backendSGProvider: backendSGProvider,
+		annotationParser:  annotationParser,
+		dryRun:            controllerConfig.DryRun,
 
 		groupLoader:           groupLoader,
 		groupFinalizerManager: groupFinalizerManager,
@@ -103,8 +111,11 @@ type groupReconciler struct {
 	modelBuilder      ingress.ModelBuilder
 	stackMarshaller   deploy.StackMarshaller
 	stackDeployer     deploy.StackDeployer
+	driftAuditor      drift.Auditor
 	backendSGProvider networkingpkg.BackendSGProvider
 	secretsManager    k8s.SecretsManager
+	annotationParser  annotations.Parser
+	dryRun            bool
 
 	groupLoader           ingress.GroupLoader
 	groupFinalizerManager ingress.FinalizerManager
@@ -142,44 +153,75 @@ func (r *groupReconciler) reconcile(ctx context.Context, req reconcile.Request)
 		return errmetrics.NewErrorWithMetrics(controllerName, "fetch_ingress_error", err, r.metricsCollector)
 	}
 
+	if r.isDryRun(ingGroup) {
+		return r.buildAndPlanModel(ctx, ingGroup)
+	}
+	if ingGroup.ID.IsExplicit() {
+		for _, member := range ingGroup.Members {
+			if r.hasDryRunAnnotation(member.Ing) {
+				r.eventRecorder.Event(member.Ing, corev1.EventTypeWarning, k8s.IngressEventReasonDryRun,
+					"Dry-run annotation is ignored for Ingresses of an explicit IngressGroup, as they share the load balancer, use the controller flag --dry-run instead")
+			}
+		}
+	}
+
+	lbByIngress, deployedStacks, err := r.reconcileIngressGroup(ctx, ingGroup)
+	err = r.updateIngressGroupReconcileStatus(ctx, ingGroup, lbByIngress, err)
+	// auditing the stacks sets the status of their resources, so they're only audited once the status of the Ingresses is resolved from them.
+	for _, deployed := range deployedStacks {
+		r.trackDrift(ingGroupID, deployed.ingGroup, deployed.stack, deployed.frontendNlbTargetGroupDesiredState)
+	}
+	return err
+}
+
+// deployedStack is the resource stack deployed for the IngressGroup or a shard of it.
+type deployedStack struct {
+	ingGroup                           ingress.Group
+	stack                              core.Stack
+	frontendNlbTargetGroupDesiredState *core.FrontendNlbTargetGroupDesiredState
+}
+
+// reconcileIngressGroup reconciles the load balancer resources and the finalizers and status of the Ingresses for the IngressGroup.
+// It returns the load balancers of the Ingresses once deployed, which differ by shard for sharded IngressGroups, and the deployed stacks.
+func (r *groupReconciler) reconcileIngressGroup(ctx context.Context, ingGroup ingress.Group) (map[types.NamespacedName]*elbv2model.LoadBalancer, []deployedStack, error) {
+	ingGroupID := ingGroup.ID
+	var err error
 	addFinalizerFn := func() {
 		err = r.groupFinalizerManager.AddGroupFinalizer(ctx, ingGroupID, ingGroup.Members)
 	}
 	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "add_group_finalizer", addFinalizerFn)
 	if err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
-		return errmetrics.NewErrorWithMetrics(controllerName, "add_group_finalizer_error", err, r.metricsCollector)
+		return nil, nil, errmetrics.NewErrorWithMetrics(controllerName, "add_group_finalizer_error", err, r.metricsCollector)
 	}
 
-	_, lb, frontendNlb, err := r.buildAndDeployModel(ctx, ingGroup)
+	shards, err := ingress.ShardGroup(r.annotationParser, ingGroup)
 	if err != nil {
-		return err
-	}
-
-	if len(ingGroup.Members) > 0 && lb != nil {
-		var statusErr error
-		dnsResolveAndUpdateStatus := func() {
-			var lbDNS string
-			lbDNS, statusErr = lb.DNSName().Resolve(ctx)
-			if statusErr != nil {
-				return
-			}
-			var frontendNlbDNS string
-			if frontendNlb != nil {
-				frontendNlbDNS, statusErr = frontendNlb.DNSName().Resolve(ctx)
-				if statusErr != nil {
-					return
-				}
-			}
-			statusErr = r.updateIngressGroupStatus(ctx, ingGroup, lbDNS, frontendNlbDNS)
-			if statusErr != nil {
-				r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus,
-					fmt.Sprintf("Failed update status due to %v", statusErr))
-			}
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return nil, nil, errmetrics.NewErrorWithMetrics(controllerName, "shard_ingress_group_error", err, r.metricsCollector)
+	}
+	// all shards are deployed before the shards of the Ingresses are updated, so that the LoadBalancers of shards
+	// which are no longer configured are deleted before the Ingresses forget about them.
+	lbs := make([]*elbv2model.LoadBalancer, len(shards))
+	frontendNlbs := make([]*elbv2model.LoadBalancer, len(shards))
+	lbByIngress := make(map[types.NamespacedName]*elbv2model.LoadBalancer)
+	var deployedStacks []deployedStack
+	for i, shard := range shards {
+		var deployed *deployedStack
+		deployed, lbs[i], frontendNlbs[i], err = r.buildAndDeployModel(ctx, ingGroupID, shard.Group)
+		if deployed != nil {
+			deployedStacks = append(deployedStacks, *deployed)
 		}
-		r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "dns_resolve_and_update_status", dnsResolveAndUpdateStatus)
-		if statusErr != nil {
-			return errmetrics.NewErrorWithMetrics(controllerName, "dns_resolve_and_update_status_error", statusErr, r.metricsCollector)
+		if err != nil {
+			return lbByIngress, deployedStacks, err
+		}
+		for _, member := range shard.Group.Members {
+			lbByIngress[k8s.NamespacedName(member.Ing)] = lbs[i]
+		}
+	}
+	for i, shard := range shards {
+		if err := r.updateIngressGroupShardStatus(ctx, shard, lbs[i], frontendNlbs[i]); err != nil {
+			return lbByIngress, deployedStacks, err
 		}
 	}
 
@@ -190,15 +232,56 @@ func (r *groupReconciler) reconcile(ctx context.Context, req reconcile.Request)
 		r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "remove_group_finalizer", removeGroupFinalizerFn)
 		if err != nil {
 			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
-			return errmetrics.NewErrorWithMetrics(controllerName, "remove_group_finalizer_error", err, r.metricsCollector)
+			return lbByIngress, deployedStacks, errmetrics.NewErrorWithMetrics(controllerName, "remove_group_finalizer_error", err, r.metricsCollector)
 		}
 	}
 
 	r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeNormal, k8s.IngressEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	return lbByIngress, deployedStacks, nil
+}
+
+// updateIngressGroupShardStatus updates the status of the Ingresses of the shard with the DNS names of its load balancers,
+// and the shard they are deployed to.
+func (r *groupReconciler) updateIngressGroupShardStatus(ctx context.Context, shard ingress.GroupShard, lb *elbv2model.LoadBalancer, frontendNlb *elbv2model.LoadBalancer) error {
+	if len(shard.Group.Members) == 0 || lb == nil {
+		return nil
+	}
+	var statusErr error
+	dnsResolveAndUpdateStatus := func() {
+		var lbDNS string
+		lbDNS, statusErr = lb.DNSName().Resolve(ctx)
+		if statusErr != nil {
+			return
+		}
+		var frontendNlbDNS string
+		if frontendNlb != nil {
+			frontendNlbDNS, statusErr = frontendNlb.DNSName().Resolve(ctx)
+			if statusErr != nil {
+				return
+			}
+		}
+		statusErr = r.updateIngressGroupStatus(ctx, shard.Group, lbDNS, frontendNlbDNS)
+		if statusErr != nil {
+			r.recordIngressGroupEvent(ctx, shard.Group, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus,
+				fmt.Sprintf("Failed update status due to %v", statusErr))
+		}
+	}
+	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "dns_resolve_and_update_status", dnsResolveAndUpdateStatus)
+	if statusErr != nil {
+		return errmetrics.NewErrorWithMetrics(controllerName, "dns_resolve_and_update_status_error", statusErr, r.metricsCollector)
+	}
+	for _, member := range shard.Group.Members {
+		if err := r.updateIngressShard(ctx, member.Ing, shard); err != nil {
+			r.eventRecorder.Event(member.Ing, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+			return errmetrics.NewErrorWithMetrics(controllerName, "update_ingress_group_shard_error", err, r.metricsCollector)
+		}
+	}
 	return nil
 }
 
-func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroup ingress.Group) (core.Stack, *elbv2model.LoadBalancer, *elbv2model.LoadBalancer, error) {
+// buildAndDeployModel builds and deploys the resource stack of the IngressGroup, or of a shard of the IngressGroup identified by ingGroupID.
+// The deployed stack is returned once deployed, even if releasing the backend security group fails afterwards.
+func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroupID ingress.GroupID, ingGroup ingress.Group) (*deployedStack, *elbv2model.LoadBalancer, *elbv2model.LoadBalancer, error) {
 	var stack core.Stack
 	var lb *elbv2model.LoadBalancer
 	var secrets []types.NamespacedName
@@ -234,6 +317,11 @@ func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroup ingr
 		return nil, nil, nil, errmetrics.NewErrorWithMetrics(controllerName, "deploy_model_error", err, r.metricsCollector)
 	}
 	r.logger.Info("successfully deployed model", "ingressGroup", ingGroup.ID)
+	deployed := &deployedStack{
+		ingGroup:                           ingGroup,
+		stack:                              stack,
+		frontendNlbTargetGroupDesiredState: frontendNlbTargetGroupDesiredState,
+	}
 	r.secretsManager.MonitorSecrets(ingGroup.ID.String(), secrets)
 	var inactiveResources []types.NamespacedName
 	inactiveResources = append(inactiveResources, k8s.ToSliceOfNamespacedNames(ingGroup.InactiveMembers)...)
@@ -241,9 +329,111 @@ func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroup ingr
 		inactiveResources = append(inactiveResources, k8s.ToSliceOfNamespacedNames(ingGroup.Members)...)
 	}
 	if err := r.backendSGProvider.Release(ctx, networkingpkg.ResourceTypeIngress, inactiveResources); err != nil {
-		return nil, nil, nil, errmetrics.NewErrorWithMetrics(controllerName, "release_auto_generated_backend_sg_error", err, r.metricsCollector)
+		return deployed, nil, nil, errmetrics.NewErrorWithMetrics(controllerName, "release_auto_generated_backend_sg_error", err, r.metricsCollector)
+	}
+	return deployed, lb, frontendNlb, nil
+}
+
+// trackDrift audits the stack deployed for the IngressGroup or its shard for drift, until it has no members left.
+// Drift of shards is healed by reconciling the IngressGroup identified by ingGroupID.
+func (r *groupReconciler) trackDrift(ingGroupID ingress.GroupID, ingGroup ingress.Group, stack core.Stack, frontendNlbTargetGroupDesiredState *core.FrontendNlbTargetGroupDesiredState) {
+	req := ingress.EncodeGroupIDToReconcileRequest(ingGroup.ID)
+	if len(ingGroup.Members) == 0 {
+		r.driftAuditor.Forget(req)
+		return
+	}
+	objects := make([]client.Object, 0, len(ingGroup.Members))
+	for _, member := range ingGroup.Members {
+		objects = append(objects, member.Ing)
+	}
+	target := drift.Target{
+		Stack:                              stack,
+		Objects:                            objects,
+		FrontendNlbTargetGroupDesiredState: frontendNlbTargetGroupDesiredState,
+	}
+	if ingGroup.ID != ingGroupID {
+		healReq := ingress.EncodeGroupIDToReconcileRequest(ingGroupID)
+		target.HealRequest = &healReq
+	}
+	r.driftAuditor.Track(req, target)
+}
+
+// buildAndPlanModel reports the changes deploying the IngressGroup would make, without making them.
+// Finalizers and status of the Ingresses are left untouched, the planned changes are stored in their reconcile status annotation.
+// Ingresses leaving the IngressGroup are told that their deletion is held.
+func (r *groupReconciler) buildAndPlanModel(ctx context.Context, ingGroup ingress.Group) error {
+	shards, err := ingress.ShardGroup(r.annotationParser, ingGroup)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return errmetrics.NewErrorWithMetrics(controllerName, "shard_ingress_group_error", err, r.metricsCollector)
+	}
+	for _, shard := range shards {
+		if err := r.buildAndPlanShardModel(ctx, shard.Group); err != nil {
+			return err
+		}
 	}
-	return stack, lb, frontendNlb, nil
+	// Ingresses leaving the IngressGroup keep their finalizer, so that their rules are removed from the load balancer once dry-run is turned off.
+	for _, ing := range ingGroup.InactiveMembers {
+		r.eventRecorder.Event(ing, corev1.EventTypeWarning, k8s.IngressEventReasonDeletionHeld,
+			"Deletion is held in dry-run mode, the load balancer resources are deleted and the finalizer removed once dry-run is turned off")
+	}
+	return nil
+}
+
+// buildAndPlanShardModel reports the changes deploying the IngressGroup or a shard of it would make.
+func (r *groupReconciler) buildAndPlanShardModel(ctx context.Context, ingGroup ingress.Group) error {
+	stack, _, _, _, frontendNlbTargetGroupDesiredState, _, err := r.modelBuilder.Build(ctx, ingGroup, r.metricsCollector)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return errmetrics.NewErrorWithMetrics(controllerName, "build_model_error", err, r.metricsCollector)
+	}
+	plan, err := r.stackDeployer.Plan(ctx, stack, r.metricsCollector, "ingress", frontendNlbTargetGroupDesiredState)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return errmetrics.NewErrorWithMetrics(controllerName, "plan_model_error", err, r.metricsCollector)
+	}
+	planJSON, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("successfully planned model", "ingressGroup", ingGroup.ID, "plan", string(planJSON))
+	r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeNormal, k8s.IngressEventReasonDryRun, plan.Describe())
+	for _, member := range ingGroup.Members {
+		if err := k8s.UpdateReconcileStatusPlannedChanges(ctx, r.k8sClient, member.Ing, plan.PlannedChanges()); err != nil {
+			r.eventRecorder.Event(member.Ing, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+			return errmetrics.NewErrorWithMetrics(controllerName, "update_reconcile_status_error", err, r.metricsCollector)
+		}
+	}
+	return nil
+}
+
+// isDryRun checks whether changes to the IngressGroup should only be planned.
+// The dry-run annotation only applies to implicit IngressGroups, the load balancer of an explicit IngressGroup is shared by all its members.
+func (r *groupReconciler) isDryRun(ingGroup ingress.Group) bool {
+	if r.dryRun {
+		return true
+	}
+	if ingGroup.ID.IsExplicit() {
+		return false
+	}
+	for _, member := range ingGroup.Members {
+		if r.hasDryRunAnnotation(member.Ing) {
+			return true
+		}
+	}
+	for _, ing := range ingGroup.InactiveMembers {
+		if r.hasDryRunAnnotation(ing) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDryRunAnnotation checks whether the Ingress asks for its changes to be planned only.
+func (r *groupReconciler) hasDryRunAnnotation(ing *networking.Ingress) bool {
+	var dryRun bool
+	exists, err := r.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixDryRun, &dryRun, ing.Annotations)
+	return err == nil && exists && dryRun
 }
 
 func (r *groupReconciler) recordIngressGroupEvent(_ context.Context, ingGroup ingress.Group, eventType string, reason string, message string) {
@@ -290,6 +480,51 @@ func (r *groupReconciler) updateIngressStatus(ctx context.Context, lbDNS string,
 	return nil
 }
 
+// updateIngressGroupReconcileStatus stores the outcome of the reconcile into the reconcile status annotation of the Ingresses
+// of the IngressGroup, and returns the reconcile error. Failures to store it are only returned if the reconcile succeeded.
+func (r *groupReconciler) updateIngressGroupReconcileStatus(ctx context.Context, ingGroup ingress.Group, lbByIngress map[types.NamespacedName]*elbv2model.LoadBalancer, reconcileErr error) error {
+	for _, member := range ingGroup.Members {
+		var deployedLB k8s.DeployedLoadBalancer
+		if lb := lbByIngress[k8s.NamespacedName(member.Ing)]; lb != nil {
+			// the ARN is only resolvable once the load balancer is deployed.
+			deployedLB.ARN, _ = lb.LoadBalancerARN().Resolve(ctx)
+			deployedLB.Scheme = string(lb.Spec.Scheme)
+		}
+		if err := k8s.UpdateReconcileStatus(ctx, r.k8sClient, member.Ing, deployedLB, reconcileErr); err != nil {
+			r.eventRecorder.Event(member.Ing, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+			if reconcileErr != nil {
+				r.logger.Error(err, "failed to update reconcile status", "ingress", k8s.NamespacedName(member.Ing))
+				continue
+			}
+			return errmetrics.NewErrorWithMetrics(controllerName, "update_reconcile_status_error", err, r.metricsCollector)
+		}
+	}
+	return reconcileErr
+}
+
+// updateIngressShard stores the shard of its sharded IngressGroup the Ingress is deployed to into its annotation,
+// so that the Ingress is kept on it. The annotation is removed when the IngressGroup isn't sharded.
+func (r *groupReconciler) updateIngressShard(ctx context.Context, ing *networking.Ingress, shard ingress.GroupShard) error {
+	rawShardIndex, exists := ing.Annotations[annotations.AnnotationIngressGroupShard]
+	shardIndex := strconv.Itoa(shard.Index)
+	if (!shard.Sharded && !exists) || (shard.Sharded && exists && rawShardIndex == shardIndex) {
+		return nil
+	}
+	ingOld := ing.DeepCopy()
+	if !shard.Sharded {
+		delete(ing.Annotations, annotations.AnnotationIngressGroupShard)
+	} else {
+		if ing.Annotations == nil {
+			ing.Annotations = make(map[string]string)
+		}
+		ing.Annotations[annotations.AnnotationIngressGroupShard] = shardIndex
+	}
+	if err := r.k8sClient.Patch(ctx, ing, client.MergeFrom(ingOld)); err != nil {
+		return errors.Wrapf(err, "failed to update ingress shard: %v", k8s.NamespacedName(ing))
+	}
+	return nil
+}
+
 func (r *groupReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, clientSet *kubernetes.Clientset) error {
 	c, err := controller.New(controllerName, mgr, controller.Options{
 		MaxConcurrentReconciles: r.maxConcurrentReconciles,
@@ -310,7 +545,10 @@ func (r *groupReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager
 	if err := r.setupWatches(ctx, c, mgr, ingressClassResourceAvailable, clientSet); err != nil {
 		return err
 	}
-	return nil
+	if err := c.Watch(r.driftAuditor.Source()); err != nil {
+		return err
+	}
+	return mgr.Add(r.driftAuditor)
 }
 
 func (r *groupReconciler) setupIndexes(ctx context.Context, fieldIndexer client.FieldIndexer, ingressClassResourceAvailable bool) error {
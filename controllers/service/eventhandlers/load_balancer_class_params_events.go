@@ -0,0 +1,91 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	svcpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForLoadBalancerClassParamsEvent constructs new enqueueRequestsForLoadBalancerClassParamsEvent.
+func NewEnqueueRequestsForLoadBalancerClassParamsEvent(k8sClient client.Client, groupLoader svcpkg.GroupLoader, logger logr.Logger) handler.TypedEventHandler[*elbv2api.LoadBalancerClassParams, reconcile.Request] {
+	return &enqueueRequestsForLoadBalancerClassParamsEvent{
+		k8sClient:   k8sClient,
+		groupLoader: groupLoader,
+		logger:      logger,
+	}
+}
+
+var _ handler.TypedEventHandler[*elbv2api.LoadBalancerClassParams, reconcile.Request] = (*enqueueRequestsForLoadBalancerClassParamsEvent)(nil)
+
+type enqueueRequestsForLoadBalancerClassParamsEvent struct {
+	k8sClient   client.Client
+	groupLoader svcpkg.GroupLoader
+	logger      logr.Logger
+}
+
+func (h *enqueueRequestsForLoadBalancerClassParamsEvent) Create(ctx context.Context, e event.TypedCreateEvent[*elbv2api.LoadBalancerClassParams], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueImpactedServices(ctx, queue, e.Object)
+}
+
+func (h *enqueueRequestsForLoadBalancerClassParamsEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*elbv2api.LoadBalancerClassParams], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	lbClassParamsOld := e.ObjectOld
+	lbClassParamsNew := e.ObjectNew
+
+	// we only care below update event:
+	//	1. LoadBalancerClassParams spec updates
+	//	2. LoadBalancerClassParams deletion
+	if equality.Semantic.DeepEqual(lbClassParamsOld.Spec, lbClassParamsNew.Spec) &&
+		equality.Semantic.DeepEqual(lbClassParamsOld.DeletionTimestamp.IsZero(), lbClassParamsNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedServices(ctx, queue, lbClassParamsNew)
+	if lbClassParamsOld.Spec.LoadBalancerClass != lbClassParamsNew.Spec.LoadBalancerClass {
+		h.enqueueImpactedServices(ctx, queue, lbClassParamsOld)
+	}
+}
+
+func (h *enqueueRequestsForLoadBalancerClassParamsEvent) Delete(ctx context.Context, e event.TypedDeleteEvent[*elbv2api.LoadBalancerClassParams], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueImpactedServices(ctx, queue, e.Object)
+}
+
+func (h *enqueueRequestsForLoadBalancerClassParamsEvent) Generic(context.Context, event.TypedGenericEvent[*elbv2api.LoadBalancerClassParams], workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// we don't have any generic event for LoadBalancerClassParams.
+}
+
+// enqueueImpactedServices enqueues the Service groups of Services with the loadBalancerClass of LoadBalancerClassParams.
+func (h *enqueueRequestsForLoadBalancerClassParamsEvent) enqueueImpactedServices(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request], lbClassParams *elbv2api.LoadBalancerClassParams) {
+	svcList := &corev1.ServiceList{}
+	if err := h.k8sClient.List(ctx, svcList); err != nil {
+		h.logger.Error(err, "failed to fetch services")
+		return
+	}
+	for index := range svcList.Items {
+		svc := &svcList.Items[index]
+		if svc.Spec.LoadBalancerClass == nil || *svc.Spec.LoadBalancerClass != lbClassParams.Spec.LoadBalancerClass {
+			continue
+		}
+
+		groupIDs := h.groupLoader.LoadGroupIDsPendingFinalization(ctx, svc)
+		if groupID, err := h.groupLoader.LoadGroupIDIfAny(ctx, svc); err == nil && groupID != nil {
+			groupIDs = append(groupIDs, *groupID)
+		}
+		for _, groupID := range groupIDs {
+			h.logger.V(1).Info("enqueue serviceGroup for loadBalancerClassParams event",
+				"loadBalancerClassParams", lbClassParams.GetName(),
+				"service", k8s.NamespacedName(svc),
+				"serviceGroup", groupID)
+			queue.Add(svcpkg.EncodeGroupIDToReconcileRequest(groupID))
+		}
+	}
+}
@@ -2,13 +2,14 @@ package eventhandlers
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	svcpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/service"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -17,10 +18,10 @@ import (
 
 // NewEnqueueRequestForServiceEvent constructs new enqueueRequestsForServiceEvent.
 func NewEnqueueRequestForServiceEvent(eventRecorder record.EventRecorder,
-	serviceUtils svcpkg.ServiceUtils, logger logr.Logger) *enqueueRequestsForServiceEvent {
+	groupLoader svcpkg.GroupLoader, logger logr.Logger) *enqueueRequestsForServiceEvent {
 	return &enqueueRequestsForServiceEvent{
 		eventRecorder: eventRecorder,
-		serviceUtils:  serviceUtils,
+		groupLoader:   groupLoader,
 		logger:        logger,
 	}
 }
@@ -29,7 +30,7 @@ var _ handler.EventHandler = (*enqueueRequestsForServiceEvent)(nil)
 
 type enqueueRequestsForServiceEvent struct {
 	eventRecorder record.EventRecorder
-	serviceUtils  svcpkg.ServiceUtils
+	groupLoader   svcpkg.GroupLoader
 	logger        logr.Logger
 }
 
@@ -42,7 +43,7 @@ func (h *enqueueRequestsForServiceEvent) Update(ctx context.Context, e event.Upd
 	newSvc := e.ObjectNew.(*corev1.Service)
 
 	if !equality.Semantic.DeepEqual(oldSvc.ResourceVersion, newSvc.ResourceVersion) {
-		if equality.Semantic.DeepEqual(oldSvc.Annotations, newSvc.Annotations) &&
+		if k8s.EqualAnnotationsIgnoringStatus(oldSvc.Annotations, newSvc.Annotations) &&
 			equality.Semantic.DeepEqual(oldSvc.Spec, newSvc.Spec) &&
 			equality.Semantic.DeepEqual(oldSvc.DeletionTimestamp.IsZero(), newSvc.DeletionTimestamp.IsZero()) {
 			return
@@ -61,15 +62,24 @@ func (h *enqueueRequestsForServiceEvent) Delete(ctx context.Context, e event.Del
 func (h *enqueueRequestsForServiceEvent) Generic(ctx context.Context, e event.GenericEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 }
 
+// enqueueManagedService enqueues the Service groups the service belongs to or is pending finalization for.
+// Standalone services are groups of themselves, so they're enqueued by their own name.
 func (h *enqueueRequestsForServiceEvent) enqueueManagedService(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request], service *corev1.Service) {
-	// Check if the svc needs to be handled
-	if !h.serviceUtils.IsServicePendingFinalization(service) && !h.serviceUtils.IsServiceSupported(service) {
-		return
+	groupIDsSet := make(map[svcpkg.GroupID]struct{})
+	for _, groupID := range h.groupLoader.LoadGroupIDsPendingFinalization(ctx, service) {
+		groupIDsSet[groupID] = struct{}{}
+	}
+	if groupID, err := h.groupLoader.LoadGroupIDIfAny(ctx, service); err != nil {
+		h.eventRecorder.Event(service, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedLoadGroupID, fmt.Sprintf("failed load groupID due to %v", err))
+	} else if groupID != nil {
+		groupIDsSet[*groupID] = struct{}{}
+	}
+
+	for groupID := range groupIDsSet {
+		h.logger.V(1).Info("enqueue serviceGroup for service event",
+			"service", k8s.NamespacedName(service).String(),
+			"serviceGroup", groupID,
+		)
+		queue.Add(svcpkg.EncodeGroupIDToReconcileRequest(groupID))
 	}
-	queue.Add(reconcile.Request{
-		NamespacedName: types.NamespacedName{
-			Namespace: service.Namespace,
-			Name:      service.Name,
-		},
-	})
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/shared_constants"
 
@@ -10,13 +11,18 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/service/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/drift"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	errmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/error"
@@ -31,12 +37,18 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 const (
 	serviceTagPrefix        = "service.k8s.aws"
 	serviceAnnotationPrefix = "service.beta.kubernetes.io"
 	controllerName          = "service"
+
+	loadBalancerClassParamsKind = "LoadBalancerClassParams"
+
+	deletionHeldMessage              = "Deletion is held in dry-run mode, the load balancer resources are deleted and the finalizer removed once dry-run is turned off"
+	serviceGroupDryRunIgnoredMessage = "Dry-run annotation is ignored for Services of a service group, as they share the load balancer, use the controller flag --dry-run instead"
 )
 
 func NewServiceReconciler(cloud services.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
@@ -47,13 +59,18 @@ func NewServiceReconciler(cloud services.Cloud, k8sClient client.Client, eventRe
 
 	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
 	trackingProvider := tracking.NewDefaultProvider(serviceTagPrefix, controllerConfig.ClusterName)
-	serviceUtils := service.NewServiceUtils(annotationParser, shared_constants.ServiceFinalizer, controllerConfig.ServiceConfig.LoadBalancerClass, controllerConfig.FeatureGates)
+	lbClassParamsLoader := service.NewDefaultLoadBalancerClassParamsLoader(k8sClient)
+	serviceUtils := service.NewServiceUtils(annotationParser, shared_constants.ServiceFinalizer, controllerConfig.ServiceConfig.LoadBalancerClass, lbClassParamsLoader, controllerConfig.FeatureGates)
+	groupLoader := service.NewDefaultGroupLoader(k8sClient, annotationParser, serviceUtils)
+	groupFinalizerManager := service.NewDefaultFinalizerManager(finalizerManager)
 	modelBuilder := service.NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, cloud.VpcID(), trackingProvider,
 		elbv2TaggingManager, cloud.EC2(), controllerConfig.FeatureGates, controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
 		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.DefaultLoadBalancerScheme, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), serviceUtils,
-		backendSGProvider, sgResolver, controllerConfig.EnableBackendSecurityGroup, controllerConfig.EnableManageBackendSecurityGroupRules, controllerConfig.DisableRestrictedSGRules, logger, metricsCollector, controllerConfig.FeatureGates.Enabled(config.EnableTCPUDPListenerType))
+		lbClassParamsLoader, backendSGProvider, sgResolver, controllerConfig.EnableBackendSecurityGroup, controllerConfig.EnableManageBackendSecurityGroupRules, controllerConfig.DisableRestrictedSGRules, logger, metricsCollector, controllerConfig.FeatureGates.Enabled(config.EnableTCPUDPListenerType))
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
 	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, elbv2TaggingManager, controllerConfig, serviceTagPrefix, logger, metricsCollector, controllerName)
+	driftAuditor := drift.NewDefaultAuditor(stackDeployer, eventRecorder, metricsCollector, controllerName,
+		controllerConfig.DriftDetectionInterval, controllerConfig.DriftPolicy, logger.WithName("drift-auditor"))
 	return &serviceReconciler{
 		k8sClient:         k8sClient,
 		eventRecorder:     eventRecorder,
@@ -62,10 +79,15 @@ func NewServiceReconciler(cloud services.Cloud, k8sClient client.Client, eventRe
 		loadBalancerClass: controllerConfig.ServiceConfig.LoadBalancerClass,
 		serviceUtils:      serviceUtils,
 		backendSGProvider: backendSGProvider,
+		dryRun:            controllerConfig.DryRun,
+
+		groupLoader:           groupLoader,
+		groupFinalizerManager: groupFinalizerManager,
 
 		modelBuilder:    modelBuilder,
 		stackMarshaller: stackMarshaller,
 		stackDeployer:   stackDeployer,
+		driftAuditor:    driftAuditor,
 		logger:          logger,
 
 		maxConcurrentReconciles: controllerConfig.ServiceMaxConcurrentReconciles,
@@ -82,10 +104,15 @@ type serviceReconciler struct {
 	loadBalancerClass string
 	serviceUtils      service.ServiceUtils
 	backendSGProvider networking.BackendSGProvider
+	dryRun            bool
+
+	groupLoader           service.GroupLoader
+	groupFinalizerManager service.FinalizerManager
 
 	modelBuilder      service.ModelBuilder
 	stackMarshaller   deploy.StackMarshaller
 	stackDeployer     deploy.StackDeployer
+	driftAuditor      drift.Auditor
 	logger            logr.Logger
 	metricsCollector  lbcmetrics.MetricCollector
 	reconcileCounters *metricsutil.ReconcileCounters
@@ -96,6 +123,7 @@ type serviceReconciler struct {
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=services/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerclassparams,verbs=get;list;watch
 
 func (r *serviceReconciler) Reconcile(ctx context.Context, req reconcile.Request) (ctrl.Result, error) {
 	r.reconcileCounters.IncrementService(req.NamespacedName)
@@ -103,6 +131,9 @@ func (r *serviceReconciler) Reconcile(ctx context.Context, req reconcile.Request
 }
 
 func (r *serviceReconciler) reconcile(ctx context.Context, req reconcile.Request) error {
+	if groupID := service.DecodeGroupIDFromReconcileRequest(req); groupID.IsExplicit() {
+		return r.reconcileServiceGroup(ctx, groupID)
+	}
 	svc := &corev1.Service{}
 	var err error
 	fetchServiceFn := func() {
@@ -110,6 +141,9 @@ func (r *serviceReconciler) reconcile(ctx context.Context, req reconcile.Request
 	}
 	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "fetch_service", fetchServiceFn)
 	if err != nil {
+		if apierrors.IsNotFound(err) {
+			r.driftAuditor.Forget(req)
+		}
 		return client.IgnoreNotFound(err)
 	}
 
@@ -121,7 +155,11 @@ func (r *serviceReconciler) reconcile(ctx context.Context, req reconcile.Request
 	}
 	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "build_model", buildModelFn)
 	if err != nil {
-		return errmetrics.NewErrorWithMetrics(controllerName, "build_model_error", err, r.metricsCollector)
+		err = errmetrics.NewErrorWithMetrics(controllerName, "build_model_error", err, r.metricsCollector)
+		if r.isDryRun(svc) {
+			return err
+		}
+		return r.updateServiceReconcileStatus(ctx, svc, nil, err)
 	}
 
 	if lb == nil {
@@ -132,8 +170,29 @@ func (r *serviceReconciler) reconcile(ctx context.Context, req reconcile.Request
 		if err != nil {
 			return errmetrics.NewErrorWithMetrics(controllerName, "cleanup_load_balancer_error", err, r.metricsCollector)
 		}
+		return nil
+	}
+
+	if r.isDryRun(svc) {
+		planModelFn := func() {
+			err = r.planModel(ctx, svc, stack)
+		}
+		r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "plan_model", planModelFn)
+		if err != nil {
+			return errmetrics.NewErrorWithMetrics(controllerName, "plan_model_error", err, r.metricsCollector)
+		}
+		return nil
+	}
+	deployed, err := r.reconcileLoadBalancerResources(ctx, svc, stack, lb, backendSGRequired)
+	err = r.updateServiceReconcileStatus(ctx, svc, lb, err)
+	if deployed {
+		// auditing the stack sets the status of its resources, so it's only audited once the status of the service is resolved from it.
+		r.driftAuditor.Track(reconcile.Request{NamespacedName: k8s.NamespacedName(svc)}, drift.Target{
+			Stack:   stack,
+			Objects: []client.Object{svc},
+		})
 	}
-	return r.reconcileLoadBalancerResources(ctx, svc, stack, lb, backendSGRequired)
+	return err
 }
 
 func (r *serviceReconciler) buildModel(ctx context.Context, svc *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
@@ -165,8 +224,53 @@ func (r *serviceReconciler) deployModel(ctx context.Context, svc *corev1.Service
 	return nil
 }
 
+// planModel reports the changes deploying the stack would make, without making them.
+// Finalizers and status of the service are left untouched, the planned changes are stored in its reconcile status annotation.
+func (r *serviceReconciler) planModel(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
+	plan, err := r.stackDeployer.Plan(ctx, stack, r.metricsCollector, "service", nil)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	planJSON, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("successfully planned model", "service", k8s.NamespacedName(svc), "plan", string(planJSON))
+	r.eventRecorder.Event(svc, corev1.EventTypeNormal, k8s.ServiceEventReasonDryRun, plan.Describe())
+	if err := k8s.UpdateReconcileStatusPlannedChanges(ctx, r.k8sClient, svc, plan.PlannedChanges()); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	return nil
+}
+
+// holdDeletion reports the deletion of the load balancer resources of the service in dry-run mode, without making it.
+// The finalizer is kept, so that the load balancer resources are deleted once dry-run is turned off.
+func (r *serviceReconciler) holdDeletion(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
+	if err := r.planModel(ctx, svc, stack); err != nil {
+		return err
+	}
+	r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonDeletionHeld, deletionHeldMessage)
+	return nil
+}
+
+// isDryRun checks whether changes to the service should only be planned.
+func (r *serviceReconciler) isDryRun(svc *corev1.Service) bool {
+	return r.dryRun || r.hasDryRunAnnotation(svc)
+}
+
+// hasDryRunAnnotation checks whether the service asks for its changes to be planned only.
+func (r *serviceReconciler) hasDryRunAnnotation(svc *corev1.Service) bool {
+	var dryRun bool
+	exists, err := r.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixDryRun, &dryRun, svc.Annotations)
+	return err == nil && exists && dryRun
+}
+
+// reconcileLoadBalancerResources reconciles the load balancer resources and the finalizer and status of the service.
+// It returns whether the stack got deployed.
 func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context, svc *corev1.Service, stack core.Stack,
-	lb *elbv2model.LoadBalancer, backendSGRequired bool) error {
+	lb *elbv2model.LoadBalancer, backendSGRequired bool) (bool, error) {
 
 	var err error
 	addFinalizersFn := func() {
@@ -175,7 +279,7 @@ func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context,
 	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "add_finalizers", addFinalizersFn)
 	if err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
-		return errmetrics.NewErrorWithMetrics(controllerName, "add_finalizers_error", err, r.metricsCollector)
+		return false, errmetrics.NewErrorWithMetrics(controllerName, "add_finalizers_error", err, r.metricsCollector)
 	}
 
 	deployModelFn := func() {
@@ -183,7 +287,7 @@ func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context,
 	}
 	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "deploy_model", deployModelFn)
 	if err != nil {
-		return errmetrics.NewErrorWithMetrics(controllerName, "deploy_model_error", err, r.metricsCollector)
+		return false, errmetrics.NewErrorWithMetrics(controllerName, "deploy_model_error", err, r.metricsCollector)
 	}
 
 	var lbDNS string
@@ -192,12 +296,12 @@ func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context,
 	}
 	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "DNS_resolve", dnsResolveFn)
 	if err != nil {
-		return errmetrics.NewErrorWithMetrics(controllerName, "dns_resolve_error", err, r.metricsCollector)
+		return true, errmetrics.NewErrorWithMetrics(controllerName, "dns_resolve_error", err, r.metricsCollector)
 	}
 
 	if !backendSGRequired {
 		if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(svc)}); err != nil {
-			return errmetrics.NewErrorWithMetrics(controllerName, "release_auto_generated_backend_sg_error", err, r.metricsCollector)
+			return true, errmetrics.NewErrorWithMetrics(controllerName, "release_auto_generated_backend_sg_error", err, r.metricsCollector)
 		}
 	}
 
@@ -207,25 +311,36 @@ func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context,
 	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "update_status", updateStatusFn)
 	if err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
-		return errmetrics.NewErrorWithMetrics(controllerName, "update_status_error", err, r.metricsCollector)
+		return true, errmetrics.NewErrorWithMetrics(controllerName, "update_status_error", err, r.metricsCollector)
 	}
 	r.eventRecorder.Event(svc, corev1.EventTypeNormal, k8s.ServiceEventReasonSuccessfullyReconciled, "Successfully reconciled")
-	return nil
+	return true, nil
 }
 
 func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
 	if k8s.HasFinalizer(svc, shared_constants.ServiceFinalizer) {
+		if r.isDryRun(svc) {
+			return r.holdDeletion(ctx, svc, stack)
+		}
 		err := r.deployModel(ctx, svc, stack)
 		if err != nil {
 			return err
 		}
+		r.driftAuditor.Forget(reconcile.Request{NamespacedName: k8s.NamespacedName(svc)})
 		if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(svc)}); err != nil {
 			return err
 		}
-		if err = r.cleanupServiceStatus(ctx, svc); err != nil {
-			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedCleanupStatus, fmt.Sprintf("Failed update status due to %v", err))
+		// services joining a Service group are still managed, their status is updated with the load balancer of the group.
+		supported, err := r.serviceUtils.IsServiceSupported(ctx, svc)
+		if err != nil {
 			return err
 		}
+		if !supported {
+			if err = r.cleanupServiceStatus(ctx, svc); err != nil {
+				r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedCleanupStatus, fmt.Sprintf("Failed update status due to %v", err))
+				return err
+			}
+		}
 		if err := r.finalizerManager.RemoveFinalizers(ctx, svc, shared_constants.ServiceFinalizer); err != nil {
 			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
 			return err
@@ -251,6 +366,248 @@ func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS strin
 	return nil
 }
 
+// updateServiceReconcileStatus stores the outcome of the reconcile into the reconcile status annotation of the service,
+// and returns the reconcile error. Failures to store it are only returned if the reconcile succeeded.
+func (r *serviceReconciler) updateServiceReconcileStatus(ctx context.Context, svc *corev1.Service, lb *elbv2model.LoadBalancer, reconcileErr error) error {
+	var deployedLB k8s.DeployedLoadBalancer
+	if lb != nil {
+		// the ARN is only resolvable once the load balancer is deployed.
+		deployedLB.ARN, _ = lb.LoadBalancerARN().Resolve(ctx)
+		deployedLB.Scheme = string(lb.Spec.Scheme)
+	}
+	if err := k8s.UpdateReconcileStatus(ctx, r.k8sClient, svc, deployedLB, reconcileErr); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		if reconcileErr != nil {
+			r.logger.Error(err, "failed to update reconcile status", "service", k8s.NamespacedName(svc))
+			return reconcileErr
+		}
+		return errmetrics.NewErrorWithMetrics(controllerName, "update_reconcile_status_error", err, r.metricsCollector)
+	}
+	return reconcileErr
+}
+
+// reconcileServiceGroup reconciles the load balancer shared by the members of an explicit Service group.
+func (r *serviceReconciler) reconcileServiceGroup(ctx context.Context, groupID service.GroupID) error {
+	var err error
+	var svcGroup service.Group
+	loadServiceGroupFn := func() {
+		svcGroup, err = r.groupLoader.Load(ctx, groupID)
+	}
+	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "fetch_service_group", loadServiceGroupFn)
+	if err != nil {
+		return errmetrics.NewErrorWithMetrics(controllerName, "fetch_service_group_error", err, r.metricsCollector)
+	}
+	svcGroup, conflicts, err := service.ExcludeConflictingGroupMembers(r.annotationParser, svcGroup)
+	if err != nil {
+		// members that already joined the load balancer conflict with each other, the deployed load balancer is kept as is.
+		r.recordServiceGroupEvent(svcGroup, corev1.EventTypeWarning, k8s.ServiceEventReasonConflictingGroupMember, fmt.Sprintf("Failed reconcile service group due to %v", err))
+		return r.updateServiceGroupReconcileStatus(ctx, svcGroup, nil,
+			errmetrics.NewErrorWithMetrics(controllerName, "conflicting_group_member_error", err, r.metricsCollector))
+	}
+	for _, conflict := range conflicts {
+		r.eventRecorder.Event(conflict.Service, corev1.EventTypeWarning, k8s.ServiceEventReasonConflictingGroupMember, fmt.Sprintf("Excluded from service group due to %v", conflict.Err))
+	}
+
+	// the load balancer of the Service group is shared by its members, it's only planned when dry-run is enabled for all Services.
+	if r.dryRun {
+		return r.buildAndPlanServiceGroupModel(ctx, svcGroup)
+	}
+	for _, svc := range svcGroup.Members {
+		if r.hasDryRunAnnotation(svc) {
+			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonDryRun, serviceGroupDryRunIgnoredMessage)
+		}
+	}
+	if err := r.updateServiceGroupConflictsReconcileStatus(ctx, conflicts); err != nil {
+		return err
+	}
+
+	stack, lb, err := r.reconcileServiceGroupResources(ctx, svcGroup)
+	err = r.updateServiceGroupReconcileStatus(ctx, svcGroup, lb, err)
+	if stack != nil {
+		// auditing the stack sets the status of its resources, so it's only audited once the status of the Services is resolved from it.
+		r.trackServiceGroupDrift(svcGroup, stack)
+	}
+	return err
+}
+
+// reconcileServiceGroupResources reconciles the load balancer resources and the finalizers and status of the Services for the Service group.
+// It returns the stack of the Service group once deployed, and its load balancer, nil if the Service group has no members left.
+func (r *serviceReconciler) reconcileServiceGroupResources(ctx context.Context, svcGroup service.Group) (core.Stack, *elbv2model.LoadBalancer, error) {
+	var err error
+	addFinalizerFn := func() {
+		err = r.groupFinalizerManager.AddGroupFinalizer(ctx, svcGroup.ID, svcGroup.Members)
+	}
+	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "add_group_finalizer", addFinalizerFn)
+	if err != nil {
+		r.recordServiceGroupEvent(svcGroup, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+		return nil, nil, errmetrics.NewErrorWithMetrics(controllerName, "add_group_finalizer_error", err, r.metricsCollector)
+	}
+
+	var stack core.Stack
+	var lb *elbv2model.LoadBalancer
+	var backendSGRequired bool
+	buildModelFn := func() {
+		stack, lb, backendSGRequired, err = r.modelBuilder.BuildGroup(ctx, svcGroup, r.metricsCollector)
+	}
+	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "build_model", buildModelFn)
+	if err != nil {
+		r.recordServiceGroupEvent(svcGroup, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return nil, nil, errmetrics.NewErrorWithMetrics(controllerName, "build_model_error", err, r.metricsCollector)
+	}
+	stackJSON, err := r.stackMarshaller.Marshal(stack)
+	if err != nil {
+		r.recordServiceGroupEvent(svcGroup, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return nil, nil, err
+	}
+	r.logger.Info("successfully built model", "model", stackJSON)
+
+	deployModelFn := func() {
+		err = r.stackDeployer.Deploy(ctx, stack, r.metricsCollector, "service", nil)
+	}
+	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "deploy_model", deployModelFn)
+	if err != nil {
+		var requeueNeededAfter *runtime.RequeueNeededAfter
+		if errors.As(err, &requeueNeededAfter) {
+			return nil, nil, err
+		}
+		r.recordServiceGroupEvent(svcGroup, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return nil, nil, errmetrics.NewErrorWithMetrics(controllerName, "deploy_model_error", err, r.metricsCollector)
+	}
+	r.logger.Info("successfully deployed model", "serviceGroup", svcGroup.ID)
+
+	if lb != nil {
+		var lbDNS string
+		dnsResolveFn := func() {
+			lbDNS, err = lb.DNSName().Resolve(ctx)
+		}
+		r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "DNS_resolve", dnsResolveFn)
+		if err != nil {
+			return stack, lb, errmetrics.NewErrorWithMetrics(controllerName, "dns_resolve_error", err, r.metricsCollector)
+		}
+		for _, svc := range svcGroup.Members {
+			if err := r.updateServiceStatus(ctx, lbDNS, svc); err != nil {
+				r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+				return stack, lb, errmetrics.NewErrorWithMetrics(controllerName, "update_status_error", err, r.metricsCollector)
+			}
+		}
+	}
+
+	inactiveResources := k8s.ToSliceOfNamespacedNames(svcGroup.InactiveMembers)
+	if !backendSGRequired {
+		inactiveResources = append(inactiveResources, k8s.ToSliceOfNamespacedNames(svcGroup.Members)...)
+	}
+	if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeService, inactiveResources); err != nil {
+		return stack, lb, errmetrics.NewErrorWithMetrics(controllerName, "release_auto_generated_backend_sg_error", err, r.metricsCollector)
+	}
+
+	for _, svc := range svcGroup.InactiveMembers {
+		// services leaving the Service group but still managed get their status updated with their new load balancer.
+		supported, err := r.serviceUtils.IsServiceSupported(ctx, svc)
+		if err != nil {
+			return stack, lb, errmetrics.NewErrorWithMetrics(controllerName, "cleanup_status_error", err, r.metricsCollector)
+		}
+		if supported {
+			continue
+		}
+		if err := r.cleanupServiceStatus(ctx, svc); err != nil {
+			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedCleanupStatus, fmt.Sprintf("Failed update status due to %v", err))
+			return stack, lb, errmetrics.NewErrorWithMetrics(controllerName, "cleanup_status_error", err, r.metricsCollector)
+		}
+	}
+	if len(svcGroup.InactiveMembers) > 0 {
+		removeGroupFinalizerFn := func() {
+			err = r.groupFinalizerManager.RemoveGroupFinalizer(ctx, svcGroup.ID, svcGroup.InactiveMembers)
+		}
+		r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "remove_group_finalizer", removeGroupFinalizerFn)
+		if err != nil {
+			r.recordServiceGroupEvent(svcGroup, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+			return stack, lb, errmetrics.NewErrorWithMetrics(controllerName, "remove_group_finalizer_error", err, r.metricsCollector)
+		}
+	}
+
+	r.recordServiceGroupEvent(svcGroup, corev1.EventTypeNormal, k8s.ServiceEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	return stack, lb, nil
+}
+
+// trackServiceGroupDrift audits the stack deployed for the Service group for drift, until it has no members left.
+func (r *serviceReconciler) trackServiceGroupDrift(svcGroup service.Group, stack core.Stack) {
+	req := service.EncodeGroupIDToReconcileRequest(svcGroup.ID)
+	if len(svcGroup.Members) == 0 {
+		r.driftAuditor.Forget(req)
+		return
+	}
+	objects := make([]client.Object, 0, len(svcGroup.Members))
+	for _, svc := range svcGroup.Members {
+		objects = append(objects, svc)
+	}
+	r.driftAuditor.Track(req, drift.Target{
+		Stack:   stack,
+		Objects: objects,
+	})
+}
+
+// buildAndPlanServiceGroupModel reports the changes deploying the Service group would make, without making them.
+// Finalizers and status of the Services are left untouched, the planned changes are stored in their reconcile status annotation.
+func (r *serviceReconciler) buildAndPlanServiceGroupModel(ctx context.Context, svcGroup service.Group) error {
+	stack, _, _, err := r.modelBuilder.BuildGroup(ctx, svcGroup, r.metricsCollector)
+	if err != nil {
+		r.recordServiceGroupEvent(svcGroup, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return errmetrics.NewErrorWithMetrics(controllerName, "build_model_error", err, r.metricsCollector)
+	}
+	plan, err := r.stackDeployer.Plan(ctx, stack, r.metricsCollector, "service", nil)
+	if err != nil {
+		r.recordServiceGroupEvent(svcGroup, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return errmetrics.NewErrorWithMetrics(controllerName, "plan_model_error", err, r.metricsCollector)
+	}
+	planJSON, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("successfully planned model", "serviceGroup", svcGroup.ID, "plan", string(planJSON))
+	r.recordServiceGroupEvent(svcGroup, corev1.EventTypeNormal, k8s.ServiceEventReasonDryRun, plan.Describe())
+	for _, svc := range svcGroup.Members {
+		if err := k8s.UpdateReconcileStatusPlannedChanges(ctx, r.k8sClient, svc, plan.PlannedChanges()); err != nil {
+			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+			return errmetrics.NewErrorWithMetrics(controllerName, "update_reconcile_status_error", err, r.metricsCollector)
+		}
+	}
+	// services leaving the Service group keep their finalizer, so that they are removed from the load balancer once dry-run is turned off.
+	for _, svc := range svcGroup.InactiveMembers {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonDeletionHeld, deletionHeldMessage)
+	}
+	return nil
+}
+
+func (r *serviceReconciler) recordServiceGroupEvent(svcGroup service.Group, eventType string, reason string, message string) {
+	for _, svc := range svcGroup.Members {
+		r.eventRecorder.Event(svc, eventType, reason, message)
+	}
+}
+
+// updateServiceGroupConflictsReconcileStatus stores the conflicts of the members excluded from the Service group into their reconcile status annotation.
+// The other members of the Service group are reconciled regardless.
+func (r *serviceReconciler) updateServiceGroupConflictsReconcileStatus(ctx context.Context, conflicts []service.GroupMemberConflict) error {
+	for _, conflict := range conflicts {
+		conflictErr := errmetrics.NewErrorWithMetrics(controllerName, "conflicting_group_member_error", conflict.Err, r.metricsCollector)
+		if err := k8s.UpdateReconcileStatus(ctx, r.k8sClient, conflict.Service, k8s.DeployedLoadBalancer{}, conflictErr); err != nil {
+			r.eventRecorder.Event(conflict.Service, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+			return errmetrics.NewErrorWithMetrics(controllerName, "update_reconcile_status_error", err, r.metricsCollector)
+		}
+	}
+	return nil
+}
+
+// updateServiceGroupReconcileStatus stores the outcome of the reconcile into the reconcile status annotation of the Services
+// of the Service group, and returns the reconcile error. Failures to store it are only returned if the reconcile succeeded.
+func (r *serviceReconciler) updateServiceGroupReconcileStatus(ctx context.Context, svcGroup service.Group, lb *elbv2model.LoadBalancer, reconcileErr error) error {
+	for _, svc := range svcGroup.Members {
+		if err := r.updateServiceReconcileStatus(ctx, svc, lb, reconcileErr); err != reconcileErr {
+			return err
+		}
+	}
+	return reconcileErr
+}
+
 func (r *serviceReconciler) cleanupServiceStatus(ctx context.Context, svc *corev1.Service) error {
 	svcOld := svc.DeepCopy()
 	svc.Status.LoadBalancer = corev1.LoadBalancerStatus{}
@@ -260,15 +617,41 @@ func (r *serviceReconciler) cleanupServiceStatus(ctx context.Context, svc *corev
 	return nil
 }
 
-func (r *serviceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+func (r *serviceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, clientSet *kubernetes.Clientset) error {
 	svcEventHandler := eventhandlers.NewEnqueueRequestForServiceEvent(r.eventRecorder,
-		r.serviceUtils, r.logger.WithName("eventHandlers").WithName("service"))
+		r.groupLoader, r.logger.WithName("eventHandlers").WithName("service"))
 
-	return ctrl.NewControllerManagedBy(mgr).
+	if err := mgr.Add(r.driftAuditor); err != nil {
+		return err
+	}
+	blder := ctrl.NewControllerManagedBy(mgr).
 		Named(controllerName).
 		Watches(&corev1.Service{}, svcEventHandler).
+		WatchesRawSource(r.driftAuditor.Source())
+
+	// the LoadBalancerClassParams CRD isn't upgraded along with the controller by helm, so it might not be installed yet.
+	resList, err := clientSet.ServerResourcesForGroupVersion(elbv2api.GroupVersion.String())
+	if err != nil {
+		return err
+	}
+	if isResourceKindAvailable(resList, loadBalancerClassParamsKind) {
+		lbClassParamsEventHandler := eventhandlers.NewEnqueueRequestsForLoadBalancerClassParamsEvent(r.k8sClient, r.groupLoader,
+			r.logger.WithName("eventHandlers").WithName("loadBalancerClassParams"))
+		blder = blder.WatchesRawSource(source.Kind(mgr.GetCache(), &elbv2api.LoadBalancerClassParams{}, lbClassParamsEventHandler))
+	}
+	return blder.
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: r.maxConcurrentReconciles,
 		}).
 		Complete(r)
 }
+
+// isResourceKindAvailable checks whether specific kind is available.
+func isResourceKindAvailable(resList *metav1.APIResourceList, kind string) bool {
+	for _, res := range resList.APIResources {
+		if res.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwalpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwbeta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sync"
 
 	"k8s.io/client-go/util/workqueue"
@@ -61,6 +62,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/version"
 	corewebhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/core"
 	elbv2webhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/elbv2"
+	gatewaywebhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/gateway"
 	networkingwebhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/networking"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -81,6 +83,7 @@ func init() {
 	_ = elbv2gw.AddToScheme(scheme)
 	_ = gwv1.AddToScheme(scheme)
 	_ = gwalpha2.AddToScheme(scheme)
+	_ = gwbeta1.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -195,7 +198,7 @@ func main() {
 
 	// Setup service reconciler only if AllowServiceType is set to true.
 	if controllerCFG.FeatureGates.Enabled(config.EnableServiceController) {
-		if err = svcReconciler.SetupWithManager(ctx, mgr); err != nil {
+		if err = svcReconciler.SetupWithManager(ctx, mgr, clientSet); err != nil {
 			setupLog.Error(err, "Unable to create controller", "controller", "Service")
 			os.Exit(1)
 		}
@@ -291,12 +294,18 @@ func main() {
 
 	podReadinessGateInjector := inject.NewPodReadinessGate(controllerCFG.PodWebhookConfig,
 		mgr.GetClient(), ctrl.Log.WithName("pod-readiness-gate-injector"))
-	corewebhook.NewPodMutator(podReadinessGateInjector, lbcMetricsCollector).SetupWithManager(mgr)
+	podTerminationDrainInjector := inject.NewPodTerminationDrain(mgr.GetClient(), cloud.ELBV2(),
+		ctrl.Log.WithName("pod-termination-drain-injector"))
+	corewebhook.NewPodMutator(podReadinessGateInjector, podTerminationDrainInjector, lbcMetricsCollector).SetupWithManager(mgr)
 	corewebhook.NewServiceMutator(controllerCFG.ServiceConfig.LoadBalancerClass, ctrl.Log, lbcMetricsCollector).SetupWithManager(mgr)
+	corewebhook.NewServiceValidator(mgr.GetClient(), controllerCFG.ServiceConfig.LoadBalancerClass, controllerCFG.FeatureGates, ctrl.Log, lbcMetricsCollector).SetupWithManager(mgr)
 	elbv2webhook.NewIngressClassParamsValidator(lbcMetricsCollector).SetupWithManager(mgr)
+	elbv2webhook.NewLoadBalancerClassParamsValidator(mgr.GetClient(), lbcMetricsCollector).SetupWithManager(mgr)
 	elbv2webhook.NewTargetGroupBindingMutator(cloud.ELBV2(), ctrl.Log, lbcMetricsCollector).SetupWithManager(mgr)
 	elbv2webhook.NewTargetGroupBindingValidator(mgr.GetClient(), cloud.ELBV2(), cloud.VpcID(), ctrl.Log, lbcMetricsCollector).SetupWithManager(mgr)
 	networkingwebhook.NewIngressValidator(mgr.GetClient(), controllerCFG.IngressConfig, ctrl.Log, lbcMetricsCollector).SetupWithManager(mgr)
+	gatewaywebhook.NewLoadBalancerConfigurationValidator(lbcMetricsCollector).SetupWithManager(mgr)
+	gatewaywebhook.NewTargetGroupConfigurationValidator(mgr.GetClient(), lbcMetricsCollector).SetupWithManager(mgr)
 	//+kubebuilder:scaffold:builder
 
 	go func() {
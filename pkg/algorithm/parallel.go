@@ -0,0 +1,59 @@
+package algorithm
+
+import (
+	"sync"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// ParallelForEach invokes fn on each element of the slice, with up to maxConcurrency invocations in flight.
+// Once an invocation fails, no more invocations are started and the ones in flight are waited for.
+// The errors are returned in the order of the elements, a single error is returned as is.
+func ParallelForEach[S ~[]E, E any](s S, maxConcurrency int, fn func(E) error) error {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+	errs := make([]error, len(s))
+	var failed bool
+	var failedMutex sync.Mutex
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, maxConcurrency)
+	for i, e := range s {
+		slots <- struct{}{}
+		failedMutex.Lock()
+		stop := failed
+		failedMutex.Unlock()
+		if stop {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			if err := fn(e); err != nil {
+				errs[i] = err
+				failedMutex.Lock()
+				failed = true
+				failedMutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	var failedErrs []error
+	for _, err := range errs {
+		if err != nil {
+			failedErrs = append(failedErrs, err)
+		}
+	}
+	switch len(failedErrs) {
+	case 0:
+		return nil
+	case 1:
+		return failedErrs[0]
+	default:
+		return utilerrors.NewAggregate(failedErrs)
+	}
+}
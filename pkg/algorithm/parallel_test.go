@@ -0,0 +1,83 @@
+package algorithm
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParallelForEach(t *testing.T) {
+	tests := []struct {
+		name           string
+		data           []string
+		maxConcurrency int
+		failed         map[string]error
+		wantVisited    []string
+		wantErr        error
+	}{
+		{
+			name:           "empty",
+			data:           nil,
+			maxConcurrency: 2,
+		},
+		{
+			name:           "visits all elements",
+			data:           []string{"a", "b", "c"},
+			maxConcurrency: 2,
+			wantVisited:    []string{"a", "b", "c"},
+		},
+		{
+			name:           "stops after failure",
+			data:           []string{"a", "b", "c"},
+			maxConcurrency: 1,
+			failed:         map[string]error{"b": errors.New("b failed")},
+			wantVisited:    []string{"a", "b"},
+			wantErr:        errors.New("b failed"),
+		},
+		{
+			name:           "aggregates errors in order",
+			data:           []string{"a", "b", "c"},
+			maxConcurrency: 0,
+			failed: map[string]error{
+				"a": errors.New("a failed"),
+			},
+			wantVisited: []string{"a"},
+			wantErr:     errors.New("a failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mutex sync.Mutex
+			var gotVisited []string
+			err := ParallelForEach(tt.data, tt.maxConcurrency, func(e string) error {
+				mutex.Lock()
+				defer mutex.Unlock()
+				gotVisited = append(gotVisited, e)
+				return tt.failed[e]
+			})
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.ElementsMatch(t, tt.wantVisited, gotVisited)
+		})
+	}
+}
+
+func Test_ParallelForEach_aggregatesConcurrentErrors(t *testing.T) {
+	// every invocation waits for the others, so all of them are in flight before any fails.
+	var started sync.WaitGroup
+	started.Add(3)
+	err := ParallelForEach([]string{"a", "b", "c"}, 3, func(e string) error {
+		started.Done()
+		started.Wait()
+		if e == "b" {
+			return nil
+		}
+		return errors.Errorf("%v failed", e)
+	})
+	assert.EqualError(t, err, "[a failed, c failed]")
+}
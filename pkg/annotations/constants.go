@@ -8,6 +8,29 @@ const (
 	// AnnotationCheckPointTimestamp is the annotation used to store the last checkpointed time. The value is stored in seconds.
 	AnnotationCheckPointTimestamp = AnnotationCheckPoint + "-timestamp"
 
+	// AnnotationTargetPortOverrides is the Pod annotation used to register the Pod into TargetGroups with other ports than its endpoint ports.
+	// It contains comma separated containerPort=targetPort pairs, e.g. "8080=15006".
+	AnnotationTargetPortOverrides = "elbv2.k8s.aws/target-port-overrides"
+
+	// AnnotationTargetAvailabilityZone is the Pod annotation used to register the Pod into TargetGroups with an explicit availabilityZone.
+	// It contains "all", or the name or ID of an availabilityZone of the VPC.
+	AnnotationTargetAvailabilityZone = "elbv2.k8s.aws/target-availability-zone"
+
+	// AnnotationPodTerminationDrain is the Pod annotation used to opt in delaying the termination of the Pod until its targets are drained.
+	// The value must be "enabled" to wait for the targets to drain, up to their deregistration delay, or "sleep" to sleep for the deregistration delay.
+	AnnotationPodTerminationDrain = "elbv2.k8s.aws/pod-termination-drain"
+
+	// AnnotationPrefixPodTargetsDrained is the prefix of the Pod annotations tracking whether the targets of the Pod are drained from each TargetGroupBinding.
+	// The annotations are suffixed by the TargetGroupBinding name, their value is "false" until the targets of the terminating Pod are drained.
+	AnnotationPrefixPodTargetsDrained = "targets-drained.elbv2.k8s.aws"
+
+	// AnnotationReconcileStatus is the annotation used to store the outcome of the last reconcile of Ingresses and Services.
+	// It contains a JSON encoded condition with the ARN of the load balancer.
+	AnnotationReconcileStatus = "elbv2.k8s.aws/reconcile-status"
+
+	// AnnotationIngressGroupShard is the annotation used to store the shard of the sharded IngressGroup an Ingress was last deployed to.
+	AnnotationIngressGroupShard = "elbv2.k8s.aws/ingress-group-shard"
+
 	// IngressClass
 	IngressClass = "kubernetes.io/ingress.class"
 
@@ -72,6 +95,7 @@ const (
 	IngressSuffixFrontendNlbHealthCheckHealthyThresholdCount   = "frontend-nlb-healthcheck-healthy-threshold-count"
 	IngressSuffixFrontendNlHealthCheckbUnhealthyThresholdCount = "frontend-nlb-healthcheck-unhealthy-threshold-count"
 	IngressSuffixFrontendNlbHealthCheckSuccessCodes            = "frontend-nlb-healthcheck-success-codes"
+	IngressSuffixDryRun                                        = "dry-run"
 
 	// NLB annotation suffixes
 	// prefixes service.beta.kubernetes.io, service.kubernetes.io
@@ -120,4 +144,7 @@ const (
 	SvcLBSuffixLoadBalancerCapacityReservation           = "aws-load-balancer-minimum-load-balancer-capacity"
 	SvcLBSuffixEnableIcmpForPathMtuDiscovery             = "aws-load-balancer-enable-icmp-for-path-mtu-discovery"
 	SvcLBSuffixEnableTCPUDPListener                      = "aws-load-balancer-enable-tcp-udp-listener"
+	SvcLBSuffixDryRun                                    = "aws-load-balancer-dry-run"
+	SvcLBSuffixAllowLoadBalancerReplacement              = "aws-load-balancer-allow-replacement"
+	SvcLBSuffixGroupName                                 = "aws-load-balancer-group-name"
 )
@@ -11,6 +11,9 @@ type ACM interface {
 	// wrapper to ListCertificatesPagesWithContext API, which aggregates paged results into list.
 	ListCertificatesAsList(ctx context.Context, input *acm.ListCertificatesInput) ([]types.CertificateSummary, error)
 	DescribeCertificateWithContext(ctx context.Context, req *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error)
+	ImportCertificateWithContext(ctx context.Context, req *acm.ImportCertificateInput) (*acm.ImportCertificateOutput, error)
+	DeleteCertificateWithContext(ctx context.Context, req *acm.DeleteCertificateInput) (*acm.DeleteCertificateOutput, error)
+	AddTagsToCertificateWithContext(ctx context.Context, req *acm.AddTagsToCertificateInput) (*acm.AddTagsToCertificateOutput, error)
 }
 
 // NewACM constructs new ACM implementation.
@@ -48,3 +51,27 @@ func (c *acmClient) DescribeCertificateWithContext(ctx context.Context, input *a
 	}
 	return client.DescribeCertificate(ctx, input)
 }
+
+func (c *acmClient) ImportCertificateWithContext(ctx context.Context, input *acm.ImportCertificateInput) (*acm.ImportCertificateOutput, error) {
+	client, err := c.awsClientsProvider.GetACMClient(ctx, "ImportCertificate")
+	if err != nil {
+		return nil, err
+	}
+	return client.ImportCertificate(ctx, input)
+}
+
+func (c *acmClient) DeleteCertificateWithContext(ctx context.Context, input *acm.DeleteCertificateInput) (*acm.DeleteCertificateOutput, error) {
+	client, err := c.awsClientsProvider.GetACMClient(ctx, "DeleteCertificate")
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteCertificate(ctx, input)
+}
+
+func (c *acmClient) AddTagsToCertificateWithContext(ctx context.Context, input *acm.AddTagsToCertificateInput) (*acm.AddTagsToCertificateOutput, error) {
+	client, err := c.awsClientsProvider.GetACMClient(ctx, "AddTagsToCertificate")
+	if err != nil {
+		return nil, err
+	}
+	return client.AddTagsToCertificate(ctx, input)
+}
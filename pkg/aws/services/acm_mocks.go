@@ -36,6 +36,36 @@ func (m *MockACM) EXPECT() *MockACMMockRecorder {
 	return m.recorder
 }
 
+// AddTagsToCertificateWithContext mocks base method.
+func (m *MockACM) AddTagsToCertificateWithContext(arg0 context.Context, arg1 *acm.AddTagsToCertificateInput) (*acm.AddTagsToCertificateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagsToCertificateWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*acm.AddTagsToCertificateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTagsToCertificateWithContext indicates an expected call of AddTagsToCertificateWithContext.
+func (mr *MockACMMockRecorder) AddTagsToCertificateWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagsToCertificateWithContext", reflect.TypeOf((*MockACM)(nil).AddTagsToCertificateWithContext), arg0, arg1)
+}
+
+// DeleteCertificateWithContext mocks base method.
+func (m *MockACM) DeleteCertificateWithContext(arg0 context.Context, arg1 *acm.DeleteCertificateInput) (*acm.DeleteCertificateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertificateWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*acm.DeleteCertificateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCertificateWithContext indicates an expected call of DeleteCertificateWithContext.
+func (mr *MockACMMockRecorder) DeleteCertificateWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertificateWithContext", reflect.TypeOf((*MockACM)(nil).DeleteCertificateWithContext), arg0, arg1)
+}
+
 // DescribeCertificateWithContext mocks base method.
 func (m *MockACM) DescribeCertificateWithContext(arg0 context.Context, arg1 *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +81,21 @@ func (mr *MockACMMockRecorder) DescribeCertificateWithContext(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCertificateWithContext", reflect.TypeOf((*MockACM)(nil).DescribeCertificateWithContext), arg0, arg1)
 }
 
+// ImportCertificateWithContext mocks base method.
+func (m *MockACM) ImportCertificateWithContext(arg0 context.Context, arg1 *acm.ImportCertificateInput) (*acm.ImportCertificateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCertificateWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*acm.ImportCertificateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportCertificateWithContext indicates an expected call of ImportCertificateWithContext.
+func (mr *MockACMMockRecorder) ImportCertificateWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCertificateWithContext", reflect.TypeOf((*MockACM)(nil).ImportCertificateWithContext), arg0, arg1)
+}
+
 // ListCertificatesAsList mocks base method.
 func (m *MockACM) ListCertificatesAsList(arg0 context.Context, arg1 *acm.ListCertificatesInput) ([]types.CertificateSummary, error) {
 	m.ctrl.T.Helper()
@@ -62,6 +62,7 @@ type ELBV2 interface {
 	ModifyCapacityReservationWithContext(ctx context.Context, input *elasticloadbalancingv2.ModifyCapacityReservationInput) (*elasticloadbalancingv2.ModifyCapacityReservationOutput, error)
 	DescribeCapacityReservationWithContext(ctx context.Context, input *elasticloadbalancingv2.DescribeCapacityReservationInput) (*elasticloadbalancingv2.DescribeCapacityReservationOutput, error)
 	ModifyIPPoolsWithContext(ctx context.Context, input *elasticloadbalancingv2.ModifyIpPoolsInput) (*elasticloadbalancingv2.ModifyIpPoolsOutput, error)
+	DescribeAccountLimitsWithContext(ctx context.Context, input *elasticloadbalancingv2.DescribeAccountLimitsInput) (*elasticloadbalancingv2.DescribeAccountLimitsOutput, error)
 	AssumeRole(ctx context.Context, assumeRoleArn string, externalId string) (ELBV2, error)
 }
 
@@ -494,6 +495,14 @@ func (c *elbv2Client) ModifyIPPoolsWithContext(ctx context.Context, input *elast
 	return client.ModifyIpPools(ctx, input)
 }
 
+func (c *elbv2Client) DescribeAccountLimitsWithContext(ctx context.Context, input *elasticloadbalancingv2.DescribeAccountLimitsInput) (*elasticloadbalancingv2.DescribeAccountLimitsOutput, error) {
+	client, err := c.getClient(ctx, "DescribeAccountLimits")
+	if err != nil {
+		return nil, err
+	}
+	return client.DescribeAccountLimits(ctx, input)
+}
+
 func (c *elbv2Client) getClient(ctx context.Context, operation string) (*elasticloadbalancingv2.Client, error) {
 	if c.staticELBClient != nil {
 		return c.staticELBClient, nil
@@ -231,6 +231,21 @@ func (mr *MockELBV2MockRecorder) DescribeCapacityReservationWithContext(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCapacityReservationWithContext", reflect.TypeOf((*MockELBV2)(nil).DescribeCapacityReservationWithContext), arg0, arg1)
 }
 
+// DescribeAccountLimitsWithContext mocks base method.
+func (m *MockELBV2) DescribeAccountLimitsWithContext(arg0 context.Context, arg1 *elasticloadbalancingv2.DescribeAccountLimitsInput) (*elasticloadbalancingv2.DescribeAccountLimitsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAccountLimitsWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.DescribeAccountLimitsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAccountLimitsWithContext indicates an expected call of DescribeAccountLimitsWithContext.
+func (mr *MockELBV2MockRecorder) DescribeAccountLimitsWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAccountLimitsWithContext", reflect.TypeOf((*MockELBV2)(nil).DescribeAccountLimitsWithContext), arg0, arg1)
+}
+
 // DescribeListenerAttributesWithContext mocks base method.
 func (m *MockELBV2) DescribeListenerAttributesWithContext(arg0 context.Context, arg1 *elasticloadbalancingv2.DescribeListenerAttributesInput) (*elasticloadbalancingv2.DescribeListenerAttributesOutput, error) {
 	m.ctrl.T.Helper()
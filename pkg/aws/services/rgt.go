@@ -11,6 +11,7 @@ import (
 const (
 	ResourceTypeELBTargetGroup  = "elasticloadbalancing:targetgroup"
 	ResourceTypeELBLoadBalancer = "elasticloadbalancing:loadbalancer"
+	ResourceTypeACMCertificate  = "acm:certificate"
 )
 
 type RGT interface {
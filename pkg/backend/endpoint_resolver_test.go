@@ -693,6 +693,11 @@ func Test_defaultEndpointResolver_ResolvePodEndpoints(t *testing.T) {
 		endpointsList  []*corev1.Endpoints
 		endpointSlices []*discovery.EndpointSlice
 	}
+	canaryPod1 := pod1
+	canaryPod1.Labels = map[string]string{"track": "canary"}
+	canaryPod1.TargetPortOverrides = map[int32]int32{8080: 15006}
+	stablePod4 := pod4
+	stablePod4.Labels = map[string]string{"track": "stable"}
 	type fields struct {
 		podInfoRepoGetCalls  []podInfoRepoGetCall
 		failOpenEnabled      bool
@@ -851,6 +856,73 @@ func Test_defaultEndpointResolver_ResolvePodEndpoints(t *testing.T) {
 			},
 			wantContainsPotentialReadyEndpoints: false,
 		},
+		{
+			name: "[with endpointSlices][with failOpen] choose every ready pod matching podSelector with target port overrides",
+			env: env{
+				nodes:          []*corev1.Node{nodeA, nodeB, nodeC},
+				services:       []*corev1.Service{svc1},
+				endpointSlices: []*discovery.EndpointSlice{eps1},
+			},
+			fields: fields{
+				failOpenEnabled:      true,
+				endpointSliceEnabled: true,
+				podInfoRepoGetCalls: []podInfoRepoGetCall{
+					{
+						key:    pod1.Key,
+						pod:    canaryPod1,
+						exists: true,
+					},
+					{
+						key:    pod2.Key,
+						pod:    pod2,
+						exists: true,
+					},
+					{
+						key:    pod3.Key,
+						pod:    pod3,
+						exists: true,
+					},
+					{
+						key:    pod4.Key,
+						pod:    stablePod4,
+						exists: true,
+					},
+					{
+						key:    pod5.Key,
+						pod:    pod5,
+						exists: true,
+					},
+					{
+						key:    pod6.Key,
+						pod:    pod6,
+						exists: true,
+					},
+					{
+						key:    pod7.Key,
+						pod:    pod7,
+						exists: true,
+					},
+					{
+						key:    pod8.Key,
+						pod:    pod8,
+						exists: true,
+					},
+				},
+			},
+			args: args{
+				svcKey: k8s.NamespacedName(svc1),
+				port:   intstr.FromString("http"),
+				opts:   []EndpointResolveOption{WithPodSelector(labels.SelectorFromSet(labels.Set{"track": "canary"}))},
+			},
+			want: []PodEndpoint{
+				{
+					IP:   "192.168.1.1",
+					Port: 15006,
+					Pod:  canaryPod1,
+				},
+			},
+			wantContainsPotentialReadyEndpoints: false,
+		},
 		{
 			name: "[with endpointSlices][without failOpen] choose every ready pod only when there are ready pods",
 			env: env{
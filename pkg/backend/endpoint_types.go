@@ -60,6 +60,10 @@ type EndpointResolveOptions struct {
 	// [Pod Endpoint] if pod readinessGates is defined, then pods from unready addresses with any of these readinessGates and containersReady condition will be included as well.
 	// By default, no readinessGate is specified.
 	PodReadinessGates []corev1.PodConditionType
+
+	// [Pod Endpoint] only pods that are matched by podSelector will be included.
+	// By default, all pods will be selected.
+	PodSelector labels.Selector
 }
 
 func (opts *EndpointResolveOptions) ApplyOptions(options []EndpointResolveOption) {
@@ -84,10 +88,18 @@ func WithPodReadinessGate(cond corev1.PodConditionType) EndpointResolveOption {
 	}
 }
 
+// WithPodSelector is a option that sets podSelector.
+func WithPodSelector(podSelector labels.Selector) EndpointResolveOption {
+	return func(opts *EndpointResolveOptions) {
+		opts.PodSelector = podSelector
+	}
+}
+
 // defaultEndpointResolveOptions returns the default value for EndpointResolveOptions.
 func defaultEndpointResolveOptions() EndpointResolveOptions {
 	return EndpointResolveOptions{
 		NodeSelector:      labels.Nothing(),
 		PodReadinessGates: nil,
+		PodSelector:       labels.Everything(),
 	}
 }
@@ -45,12 +45,27 @@ func TestEndpointResolveOptions_ApplyOptions(t *testing.T) {
 				PodReadinessGates: []corev1.PodConditionType{"target-health.ingress.k8s.aws/some-tgb"},
 			},
 		},
+		{
+			name: "set podSelector",
+			opts: []EndpointResolveOption{
+				WithPodSelector(labels.SelectorFromSet(labels.Set{"track": "canary"})),
+			},
+			want: EndpointResolveOptions{
+				NodeSelector: labels.Nothing(),
+				PodSelector:  labels.SelectorFromSet(labels.Set{"track": "canary"}),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			opts := defaultEndpointResolveOptions()
 			opts.ApplyOptions(tt.opts)
 			assert.Equal(t, tt.want.NodeSelector, opts.NodeSelector)
+			if tt.want.PodSelector != nil {
+				assert.Equal(t, tt.want.PodSelector, opts.PodSelector)
+			} else {
+				assert.Equal(t, labels.Everything(), opts.PodSelector)
+			}
 		})
 	}
 }
@@ -59,6 +59,14 @@ func GetTrafficProxyNodeSelector(tgb *elbv2api.TargetGroupBinding) (labels.Selec
 	return selector, nil
 }
 
+// GetPodSelector returns the selector for pods of the Service to register into the TargetGroup of the TargetGroupBinding.
+func GetPodSelector(tgb *elbv2api.TargetGroupBinding) (labels.Selector, error) {
+	if tgb.Spec.PodSelector == nil {
+		return labels.Everything(), nil
+	}
+	return metav1.LabelSelectorAsSelector(tgb.Spec.PodSelector)
+}
+
 // IsNodeSuitableAsTrafficProxy check whether node is suitable as a traffic proxy.
 // This should be checked in additional to the nodeSelector defined in TargetGroupBinding.
 func IsNodeSuitableAsTrafficProxy(node *corev1.Node) bool {
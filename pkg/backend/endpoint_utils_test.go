@@ -78,6 +78,58 @@ func TestGetTrafficProxyNodeSelector(t *testing.T) {
 	}
 }
 
+func TestGetPodSelector(t *testing.T) {
+	tests := []struct {
+		name               string
+		targetGroupBinding *elbv2api.TargetGroupBinding
+		want               labels.Selector
+		wantErr            error
+	}{
+		{
+			name:               "every pod when not specified",
+			targetGroupBinding: &elbv2api.TargetGroupBinding{},
+			want:               labels.Everything(),
+		},
+		{
+			name: "selector from TargetGroupBinding",
+			targetGroupBinding: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"track": "canary",
+						},
+					},
+				},
+			},
+			want: labels.SelectorFromSet(labels.Set{"track": "canary"}),
+		},
+		{
+			name: "error with bad selector",
+			targetGroupBinding: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					PodSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "track", Operator: "BadOperatorValue", Values: []string{"canary"}},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("\"BadOperatorValue\" is not a valid label selector operator"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetPodSelector(tt.targetGroupBinding)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.String(), got.String())
+			}
+		})
+	}
+}
+
 func TestIsNodeSuitableAsTrafficProxy(t *testing.T) {
 	type args struct {
 		node *corev1.Node
@@ -34,6 +34,10 @@ const (
 	flagBackendSecurityGroup                         = "backend-security-group"
 	flagEnableEndpointSlices                         = "enable-endpoint-slices"
 	flagDisableRestrictedSGRules                     = "disable-restricted-sg-rules"
+	flagDryRun                                       = "dry-run"
+	flagDriftDetectionInterval                       = "drift-detection-interval"
+	flagDriftPolicy                                  = "drift-policy"
+	flagStackDeployConcurrency                       = "stack-deploy-concurrency"
 	defaultLogLevel                                  = "info"
 	defaultMaxConcurrentReconciles                   = 3
 	defaultMaxExponentialBackoffDelay                = time.Second * 1000
@@ -42,9 +46,23 @@ const (
 	defaultEnableManageBackendSGRules                = false
 	defaultEnableEndpointSlices                      = false
 	defaultDisableRestrictedSGRules                  = false
+	defaultDryRun                                    = false
+	defaultDriftDetectionInterval                    = 0
+	defaultDriftPolicy                               = DriftPolicyReportOnly
+	defaultStackDeployConcurrency                    = 1
 	defaultLbStabilizationMonitorInterval            = time.Second * 120
 )
 
+// DriftPolicy specifies how drift of load balancer resources from the deployed stack is handled.
+type DriftPolicy string
+
+const (
+	// DriftPolicyReportOnly reports drift through events and metrics, it's reverted on the next reconcile.
+	DriftPolicyReportOnly DriftPolicy = "report-only"
+	// DriftPolicyAutoHeal reports drift and reconciles the object right away to revert it.
+	DriftPolicyAutoHeal DriftPolicy = "auto-heal"
+)
+
 var (
 	trackingTagKeys = sets.NewString(
 		shared_constants.TagKeyK8sCluster,
@@ -130,6 +148,18 @@ type ControllerConfig struct {
 	// DisableRestrictedSGRules specifies whether to use restricted security group rules
 	DisableRestrictedSGRules bool
 
+	// DryRun specifies whether to only plan the changes to load balancer resources, without making them
+	DryRun bool
+
+	// DriftDetectionInterval specifies the interval to audit deployed load balancer resources for drift, 0 disables drift detection
+	DriftDetectionInterval time.Duration
+
+	// DriftPolicy specifies how detected drift is handled
+	DriftPolicy DriftPolicy
+
+	// StackDeployConcurrency specifies the max number of concurrent operations when deploying a load balancer resource stack
+	StackDeployConcurrency int
+
 	// LBStabilizationMonitorInterval specifies the duration of interval to monitor the load balancer state for stabilization
 	LBStabilizationMonitorInterval time.Duration
 
@@ -175,6 +205,14 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 		"Enable EndpointSlices for IP targets instead of Endpoints")
 	fs.BoolVar(&cfg.DisableRestrictedSGRules, flagDisableRestrictedSGRules, defaultDisableRestrictedSGRules,
 		"Disable the usage of restricted security group rules")
+	fs.BoolVar(&cfg.DryRun, flagDryRun, defaultDryRun,
+		"Only plan the changes to load balancer resources and report them through events, without making them")
+	fs.DurationVar(&cfg.DriftDetectionInterval, flagDriftDetectionInterval, defaultDriftDetectionInterval,
+		"Interval to audit deployed load balancer resources for drift, 0 disables drift detection")
+	fs.StringVar((*string)(&cfg.DriftPolicy), flagDriftPolicy, string(defaultDriftPolicy),
+		"How detected drift is handled - report-only, auto-heal")
+	fs.IntVar(&cfg.StackDeployConcurrency, flagStackDeployConcurrency, defaultStackDeployConcurrency,
+		"Maximum number of concurrent operations when deploying the AWS resources of a load balancer")
 	fs.StringToStringVar(&cfg.ServiceTargetENISGTags, flagServiceTargetENISGTags, nil,
 		"AWS Tags, in addition to cluster tags, for finding the target ENI security group to which to add inbound rules from NLBs")
 	cfg.FeatureGates.BindFlags(fs)
@@ -214,6 +252,9 @@ func (cfg *ControllerConfig) Validate() error {
 	if err := cfg.validateManageBackendSecurityGroupRulesConfiguration(); err != nil {
 		return err
 	}
+	if err := cfg.validateDriftPolicy(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -279,3 +320,12 @@ func (cfg *ControllerConfig) validateManageBackendSecurityGroupRulesConfiguratio
 	}
 	return nil
 }
+
+func (cfg *ControllerConfig) validateDriftPolicy() error {
+	switch cfg.DriftPolicy {
+	case "", DriftPolicyReportOnly, DriftPolicyAutoHeal:
+		return nil
+	default:
+		return errors.Errorf("invalid value %v for drift policy", cfg.DriftPolicy)
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/shared_constants"
 	"testing"
@@ -219,3 +220,76 @@ func TestControllerConfig_validateManageBackendSecurityGroupRulesConfiguration(t
 		})
 	}
 }
+
+func TestControllerConfig_validateDriftPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		driftPolicy DriftPolicy
+		wantErr     bool
+	}{
+		{
+			name:        "report-only",
+			driftPolicy: DriftPolicyReportOnly,
+			wantErr:     false,
+		},
+		{
+			name:        "auto-heal",
+			driftPolicy: DriftPolicyAutoHeal,
+			wantErr:     false,
+		},
+		{
+			name:        "unset",
+			driftPolicy: "",
+			wantErr:     false,
+		},
+		{
+			name:        "unknown policy - expect error",
+			driftPolicy: "auto-revert",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				DriftPolicy: tt.driftPolicy,
+			}
+
+			err := cfg.validateDriftPolicy()
+
+			if tt.wantErr {
+				assert.EqualError(t, err, "invalid value auto-revert for drift policy")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_BindFlags_stackDeployConcurrency(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{
+			name: "resources are deployed one by one by default",
+			args: []string{},
+			want: 1,
+		},
+		{
+			name: "resources are deployed concurrently when specified",
+			args: []string{"--stack-deploy-concurrency=5"},
+			want: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{FeatureGates: NewFeatureGates()}
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			cfg.BindFlags(fs)
+			assert.NoError(t, fs.Parse(tt.args))
+			assert.Equal(t, tt.want, cfg.StackDeployConcurrency)
+		})
+	}
+}
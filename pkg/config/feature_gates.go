@@ -27,6 +27,8 @@ const (
 	SubnetDiscoveryByReachability Feature = "SubnetDiscoveryByReachability"
 	NLBGatewayAPI                 Feature = "NLBGatewayAPI"
 	ALBGatewayAPI                 Feature = "ALBGatewayAPI"
+	GatewayCertificateImport      Feature = "GatewayCertificateImport"
+	ListenerRulesMakeBeforeBreak  Feature = "ListenerRulesMakeBeforeBreak"
 )
 
 type FeatureGates interface {
@@ -69,7 +71,9 @@ func NewFeatureGates() FeatureGates {
 			LBCapacityReservation:         true,
 			NLBGatewayAPI:                 false,
 			ALBGatewayAPI:                 false,
+			GatewayCertificateImport:      false,
 			EnableTCPUDPListenerType:      false,
+			ListenerRulesMakeBeforeBreak:  false,
 		},
 	}
 }
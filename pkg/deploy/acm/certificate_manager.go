@@ -0,0 +1,195 @@
+package acm
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	acmsdk "github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	rgtsdk "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	rgttypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+)
+
+const (
+	// CertificateDigestTagKey is the tag holding the digest of the imported certificate material.
+	CertificateDigestTagKey = "elbv2.k8s.aws/certificate-digest"
+)
+
+// CertificateWithTags is an imported ACM certificate along with its tags.
+type CertificateWithTags struct {
+	CertificateARN string
+	Tags           map[string]string
+}
+
+// CertificateManager is responsible for import/re-import/delete certificates in ACM.
+type CertificateManager interface {
+	// Import imports the certificate into ACM.
+	Import(ctx context.Context, resCert *acmmodel.Certificate) (acmmodel.CertificateStatus, error)
+
+	// Reimport re-imports the certificate into the existing ACM certificate when its material changed.
+	Reimport(ctx context.Context, resCert *acmmodel.Certificate, sdkCert CertificateWithTags) (acmmodel.CertificateStatus, error)
+
+	// Delete deletes the certificate from ACM.
+	Delete(ctx context.Context, sdkCert CertificateWithTags) error
+
+	// ListImportedCertificates returns the imported certificates that have all the tags.
+	ListImportedCertificates(ctx context.Context, tagFilters map[string]string) ([]CertificateWithTags, error)
+}
+
+// NewDefaultCertificateManager constructs new defaultCertificateManager.
+func NewDefaultCertificateManager(acmClient services.ACM, rgt services.RGT, trackingProvider tracking.Provider, logger logr.Logger) *defaultCertificateManager {
+	return &defaultCertificateManager{
+		acmClient:        acmClient,
+		rgt:              rgt,
+		trackingProvider: trackingProvider,
+		logger:           logger,
+	}
+}
+
+var _ CertificateManager = &defaultCertificateManager{}
+
+type defaultCertificateManager struct {
+	acmClient        services.ACM
+	rgt              services.RGT
+	trackingProvider tracking.Provider
+	logger           logr.Logger
+}
+
+func (m *defaultCertificateManager) Import(ctx context.Context, resCert *acmmodel.Certificate) (acmmodel.CertificateStatus, error) {
+	req := buildSDKImportCertificateInput(resCert.Spec)
+	req.Tags = buildSDKTags(m.desiredTags(resCert))
+	m.logger.Info("importing certificate",
+		"stackID", resCert.Stack().StackID(),
+		"resourceID", resCert.ID())
+	resp, err := m.acmClient.ImportCertificateWithContext(ctx, req)
+	if err != nil {
+		return acmmodel.CertificateStatus{}, err
+	}
+	certARN := awssdk.ToString(resp.CertificateArn)
+	m.logger.Info("imported certificate",
+		"stackID", resCert.Stack().StackID(),
+		"resourceID", resCert.ID(),
+		"arn", certARN)
+	return acmmodel.CertificateStatus{
+		CertificateARN: certARN,
+	}, nil
+}
+
+func (m *defaultCertificateManager) Reimport(ctx context.Context, resCert *acmmodel.Certificate, sdkCert CertificateWithTags) (acmmodel.CertificateStatus, error) {
+	desiredTags := m.desiredTags(resCert)
+	if sdkCert.Tags[CertificateDigestTagKey] != resCert.Spec.Digest {
+		// tags can't be specified when re-importing, they are updated afterwards.
+		req := buildSDKImportCertificateInput(resCert.Spec)
+		req.CertificateArn = awssdk.String(sdkCert.CertificateARN)
+		m.logger.Info("re-importing certificate",
+			"stackID", resCert.Stack().StackID(),
+			"resourceID", resCert.ID(),
+			"arn", sdkCert.CertificateARN)
+		if _, err := m.acmClient.ImportCertificateWithContext(ctx, req); err != nil {
+			return acmmodel.CertificateStatus{}, err
+		}
+		m.logger.Info("re-imported certificate",
+			"stackID", resCert.Stack().StackID(),
+			"resourceID", resCert.ID(),
+			"arn", sdkCert.CertificateARN)
+	}
+
+	tagsToUpdate := make(map[string]string)
+	for key, value := range desiredTags {
+		if currentValue, ok := sdkCert.Tags[key]; !ok || currentValue != value {
+			tagsToUpdate[key] = value
+		}
+	}
+	if len(tagsToUpdate) != 0 {
+		req := &acmsdk.AddTagsToCertificateInput{
+			CertificateArn: awssdk.String(sdkCert.CertificateARN),
+			Tags:           buildSDKTags(tagsToUpdate),
+		}
+		if _, err := m.acmClient.AddTagsToCertificateWithContext(ctx, req); err != nil {
+			return acmmodel.CertificateStatus{}, err
+		}
+	}
+	return acmmodel.CertificateStatus{
+		CertificateARN: sdkCert.CertificateARN,
+	}, nil
+}
+
+func (m *defaultCertificateManager) Delete(ctx context.Context, sdkCert CertificateWithTags) error {
+	req := &acmsdk.DeleteCertificateInput{
+		CertificateArn: awssdk.String(sdkCert.CertificateARN),
+	}
+	m.logger.Info("deleting certificate",
+		"arn", sdkCert.CertificateARN)
+	if _, err := m.acmClient.DeleteCertificateWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("deleted certificate",
+		"arn", sdkCert.CertificateARN)
+	return nil
+}
+
+func (m *defaultCertificateManager) ListImportedCertificates(ctx context.Context, tagFilters map[string]string) ([]CertificateWithTags, error) {
+	// only imported certificates carry the stack tags, so certificates can be looked up by tags without listing them all.
+	req := &rgtsdk.GetResourcesInput{
+		TagFilters:          buildRGTTagFilters(tagFilters),
+		ResourceTypeFilters: []string{services.ResourceTypeACMCertificate},
+	}
+	resources, err := m.rgt.GetResourcesAsList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	sdkCerts := make([]CertificateWithTags, 0, len(resources))
+	for _, resource := range resources {
+		sdkCerts = append(sdkCerts, CertificateWithTags{
+			CertificateARN: awssdk.ToString(resource.ResourceARN),
+			Tags:           services.ParseRGTTags(resource.Tags),
+		})
+	}
+	return sdkCerts, nil
+}
+
+func (m *defaultCertificateManager) desiredTags(resCert *acmmodel.Certificate) map[string]string {
+	digestTags := map[string]string{
+		CertificateDigestTagKey: resCert.Spec.Digest,
+	}
+	return m.trackingProvider.ResourceTags(resCert.Stack(), resCert, algorithm.MergeStringMap(digestTags, resCert.Spec.Tags))
+}
+
+func buildRGTTagFilters(tagFilters map[string]string) []rgttypes.TagFilter {
+	rgtTagFilters := make([]rgttypes.TagFilter, 0, len(tagFilters))
+	for _, key := range sets.StringKeySet(tagFilters).List() {
+		rgtTagFilters = append(rgtTagFilters, rgttypes.TagFilter{
+			Key:    awssdk.String(key),
+			Values: []string{tagFilters[key]},
+		})
+	}
+	return rgtTagFilters
+}
+
+func buildSDKImportCertificateInput(certSpec acmmodel.CertificateSpec) *acmsdk.ImportCertificateInput {
+	req := &acmsdk.ImportCertificateInput{
+		Certificate: certSpec.Certificate,
+		PrivateKey:  certSpec.PrivateKey,
+	}
+	if len(certSpec.CertificateChain) != 0 {
+		req.CertificateChain = certSpec.CertificateChain
+	}
+	return req
+}
+
+func buildSDKTags(tags map[string]string) []acmtypes.Tag {
+	sdkTags := make([]acmtypes.Tag, 0, len(tags))
+	for _, key := range sets.StringKeySet(tags).List() {
+		sdkTags = append(sdkTags, acmtypes.Tag{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(tags[key]),
+		})
+	}
+	return sdkTags
+}
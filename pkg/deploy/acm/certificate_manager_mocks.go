@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/acm (interfaces: CertificateManager)
+
+// Package acm is a generated GoMock package.
+package acm
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	acm0 "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+)
+
+// MockCertificateManager is a mock of CertificateManager interface.
+type MockCertificateManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockCertificateManagerMockRecorder
+}
+
+// MockCertificateManagerMockRecorder is the mock recorder for MockCertificateManager.
+type MockCertificateManagerMockRecorder struct {
+	mock *MockCertificateManager
+}
+
+// NewMockCertificateManager creates a new mock instance.
+func NewMockCertificateManager(ctrl *gomock.Controller) *MockCertificateManager {
+	mock := &MockCertificateManager{ctrl: ctrl}
+	mock.recorder = &MockCertificateManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCertificateManager) EXPECT() *MockCertificateManagerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockCertificateManager) Delete(arg0 context.Context, arg1 CertificateWithTags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCertificateManagerMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCertificateManager)(nil).Delete), arg0, arg1)
+}
+
+// Import mocks base method.
+func (m *MockCertificateManager) Import(arg0 context.Context, arg1 *acm0.Certificate) (acm0.CertificateStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", arg0, arg1)
+	ret0, _ := ret[0].(acm0.CertificateStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockCertificateManagerMockRecorder) Import(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockCertificateManager)(nil).Import), arg0, arg1)
+}
+
+// ListImportedCertificates mocks base method.
+func (m *MockCertificateManager) ListImportedCertificates(arg0 context.Context, arg1 map[string]string) ([]CertificateWithTags, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImportedCertificates", arg0, arg1)
+	ret0, _ := ret[0].([]CertificateWithTags)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImportedCertificates indicates an expected call of ListImportedCertificates.
+func (mr *MockCertificateManagerMockRecorder) ListImportedCertificates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImportedCertificates", reflect.TypeOf((*MockCertificateManager)(nil).ListImportedCertificates), arg0, arg1)
+}
+
+// Reimport mocks base method.
+func (m *MockCertificateManager) Reimport(arg0 context.Context, arg1 *acm0.Certificate, arg2 CertificateWithTags) (acm0.CertificateStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reimport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(acm0.CertificateStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reimport indicates an expected call of Reimport.
+func (mr *MockCertificateManagerMockRecorder) Reimport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reimport", reflect.TypeOf((*MockCertificateManager)(nil).Reimport), arg0, arg1, arg2)
+}
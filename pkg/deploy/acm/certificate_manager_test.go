@@ -0,0 +1,149 @@
+package acm
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	acmsdk "github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	rgtsdk "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	rgttypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultCertificateManager_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "gw"})
+	resCert := acmmodel.NewCertificate(stack, "ns/foo-tls", acmmodel.CertificateSpec{
+		Certificate: []byte("cert"),
+		PrivateKey:  []byte("key"),
+		Digest:      "digest",
+		Tags:        map[string]string{"team": "a"},
+	})
+	acmClient := services.NewMockACM(ctrl)
+	acmClient.EXPECT().ImportCertificateWithContext(gomock.Any(), &acmsdk.ImportCertificateInput{
+		Certificate: []byte("cert"),
+		PrivateKey:  []byte("key"),
+		Tags: []acmtypes.Tag{
+			{Key: awssdk.String("elbv2.k8s.aws/certificate-digest"), Value: awssdk.String("digest")},
+			{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("cluster")},
+			{Key: awssdk.String("gateway.k8s.aws.alb/resource"), Value: awssdk.String("ns/foo-tls")},
+			{Key: awssdk.String("gateway.k8s.aws.alb/stack"), Value: awssdk.String("ns/gw")},
+			{Key: awssdk.String("team"), Value: awssdk.String("a")},
+		},
+	}).Return(&acmsdk.ImportCertificateOutput{CertificateArn: awssdk.String("arn-foo")}, nil)
+
+	m := NewDefaultCertificateManager(acmClient, nil, tracking.NewDefaultProvider("gateway.k8s.aws.alb", "cluster"), log.Log)
+	got, err := m.Import(context.Background(), resCert)
+	assert.NoError(t, err)
+	assert.Equal(t, acmmodel.CertificateStatus{CertificateARN: "arn-foo"}, got)
+}
+
+func Test_defaultCertificateManager_Reimport(t *testing.T) {
+	currentTags := map[string]string{
+		"elbv2.k8s.aws/certificate-digest": "digest",
+		"elbv2.k8s.aws/cluster":            "cluster",
+		"gateway.k8s.aws.alb/resource":     "ns/foo-tls",
+		"gateway.k8s.aws.alb/stack":        "ns/gw",
+	}
+	tests := []struct {
+		name         string
+		digest       string
+		wantReimport bool
+		wantTags     []acmtypes.Tag
+	}{
+		{
+			name:   "certificate is unchanged",
+			digest: "digest",
+		},
+		{
+			name:         "certificate is rotated",
+			digest:       "new-digest",
+			wantReimport: true,
+			wantTags: []acmtypes.Tag{
+				{Key: awssdk.String("elbv2.k8s.aws/certificate-digest"), Value: awssdk.String("new-digest")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "gw"})
+			resCert := acmmodel.NewCertificate(stack, "ns/foo-tls", acmmodel.CertificateSpec{
+				Certificate:      []byte("cert"),
+				CertificateChain: []byte("chain"),
+				PrivateKey:       []byte("key"),
+				Digest:           tt.digest,
+			})
+			acmClient := services.NewMockACM(ctrl)
+			if tt.wantReimport {
+				acmClient.EXPECT().ImportCertificateWithContext(gomock.Any(), &acmsdk.ImportCertificateInput{
+					CertificateArn:   awssdk.String("arn-foo"),
+					Certificate:      []byte("cert"),
+					CertificateChain: []byte("chain"),
+					PrivateKey:       []byte("key"),
+				}).Return(&acmsdk.ImportCertificateOutput{CertificateArn: awssdk.String("arn-foo")}, nil)
+			}
+			if tt.wantTags != nil {
+				acmClient.EXPECT().AddTagsToCertificateWithContext(gomock.Any(), &acmsdk.AddTagsToCertificateInput{
+					CertificateArn: awssdk.String("arn-foo"),
+					Tags:           tt.wantTags,
+				}).Return(&acmsdk.AddTagsToCertificateOutput{}, nil)
+			}
+
+			m := NewDefaultCertificateManager(acmClient, nil, tracking.NewDefaultProvider("gateway.k8s.aws.alb", "cluster"), log.Log)
+			got, err := m.Reimport(context.Background(), resCert, CertificateWithTags{CertificateARN: "arn-foo", Tags: currentTags})
+			assert.NoError(t, err)
+			assert.Equal(t, acmmodel.CertificateStatus{CertificateARN: "arn-foo"}, got)
+		})
+	}
+}
+
+func Test_defaultCertificateManager_ListImportedCertificates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rgt := services.NewMockRGT(ctrl)
+	rgt.EXPECT().GetResourcesAsList(gomock.Any(), &rgtsdk.GetResourcesInput{
+		TagFilters: []rgttypes.TagFilter{
+			{Key: awssdk.String("elbv2.k8s.aws/cluster"), Values: []string{"cluster"}},
+			{Key: awssdk.String("gateway.k8s.aws.alb/stack"), Values: []string{"ns/gw"}},
+		},
+		ResourceTypeFilters: []string{"acm:certificate"},
+	}).Return([]rgttypes.ResourceTagMapping{
+		{
+			ResourceARN: awssdk.String("arn-owned"),
+			Tags: []rgttypes.Tag{
+				{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("cluster")},
+				{Key: awssdk.String("gateway.k8s.aws.alb/stack"), Value: awssdk.String("ns/gw")},
+			},
+		},
+	}, nil)
+
+	m := NewDefaultCertificateManager(nil, rgt, tracking.NewDefaultProvider("gateway.k8s.aws.alb", "cluster"), log.Log)
+	got, err := m.ListImportedCertificates(context.Background(), map[string]string{
+		"elbv2.k8s.aws/cluster":     "cluster",
+		"gateway.k8s.aws.alb/stack": "ns/gw",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []CertificateWithTags{
+		{
+			CertificateARN: "arn-owned",
+			Tags: map[string]string{
+				"elbv2.k8s.aws/cluster":     "cluster",
+				"gateway.k8s.aws.alb/stack": "ns/gw",
+			},
+		},
+	}, got)
+}
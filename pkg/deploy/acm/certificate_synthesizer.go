@@ -0,0 +1,130 @@
+package acm
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+// NewCertificateSynthesizer constructs new certificateSynthesizer.
+func NewCertificateSynthesizer(trackingProvider tracking.Provider, certManager CertificateManager, logger logr.Logger, stack core.Stack) *certificateSynthesizer {
+	return &certificateSynthesizer{
+		trackingProvider:  trackingProvider,
+		certManager:       certManager,
+		logger:            logger,
+		stack:             stack,
+		unmatchedSDKCerts: nil,
+	}
+}
+
+type certificateSynthesizer struct {
+	trackingProvider tracking.Provider
+	certManager      CertificateManager
+	logger           logr.Logger
+
+	stack             core.Stack
+	unmatchedSDKCerts []CertificateWithTags
+}
+
+func (s *certificateSynthesizer) Synthesize(ctx context.Context) error {
+	var resCerts []*acmmodel.Certificate
+	s.stack.ListResources(&resCerts)
+	sdkCerts, err := s.certManager.ListImportedCertificates(ctx, s.trackingProvider.StackTags(s.stack))
+	if err != nil {
+		return err
+	}
+	matchedResAndSDKCerts, unmatchedResCerts, unmatchedSDKCerts, err := matchResAndSDKCertificates(resCerts, sdkCerts, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return err
+	}
+
+	// For Certificate, we delete unmatched ones during post synthesize, once listeners no longer use them.
+	s.unmatchedSDKCerts = unmatchedSDKCerts
+
+	for _, resCert := range unmatchedResCerts {
+		certStatus, err := s.certManager.Import(ctx, resCert)
+		if err != nil {
+			return errors.Wrapf(err, "failed to import certificate %v", resCert.ID())
+		}
+		resCert.SetStatus(certStatus)
+	}
+	for _, resAndSDKCert := range matchedResAndSDKCerts {
+		certStatus, err := s.certManager.Reimport(ctx, resAndSDKCert.resCert, resAndSDKCert.sdkCert)
+		if err != nil {
+			return errors.Wrapf(err, "failed to re-import certificate %v", resAndSDKCert.resCert.ID())
+		}
+		resAndSDKCert.resCert.SetStatus(certStatus)
+	}
+	return nil
+}
+
+func (s *certificateSynthesizer) PostSynthesize(ctx context.Context) error {
+	for _, sdkCert := range s.unmatchedSDKCerts {
+		if err := s.certManager.Delete(ctx, sdkCert); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type resAndSDKCertificatePair struct {
+	resCert *acmmodel.Certificate
+	sdkCert CertificateWithTags
+}
+
+func matchResAndSDKCertificates(resCerts []*acmmodel.Certificate, sdkCerts []CertificateWithTags,
+	resourceIDTagKey string) ([]resAndSDKCertificatePair, []*acmmodel.Certificate, []CertificateWithTags, error) {
+	var matchedResAndSDKCerts []resAndSDKCertificatePair
+	var unmatchedResCerts []*acmmodel.Certificate
+	var unmatchedSDKCerts []CertificateWithTags
+
+	resCertsByID := mapResCertificateByResourceID(resCerts)
+	sdkCertsByID, err := mapSDKCertificateByResourceID(sdkCerts, resourceIDTagKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resCertIDs := sets.StringKeySet(resCertsByID)
+	sdkCertIDs := sets.StringKeySet(sdkCertsByID)
+	for _, resID := range resCertIDs.Intersection(sdkCertIDs).List() {
+		resCert := resCertsByID[resID]
+		sdkCerts := sdkCertsByID[resID]
+		matchedResAndSDKCerts = append(matchedResAndSDKCerts, resAndSDKCertificatePair{
+			resCert: resCert,
+			sdkCert: sdkCerts[0],
+		})
+		unmatchedSDKCerts = append(unmatchedSDKCerts, sdkCerts[1:]...)
+	}
+	for _, resID := range resCertIDs.Difference(sdkCertIDs).List() {
+		unmatchedResCerts = append(unmatchedResCerts, resCertsByID[resID])
+	}
+	for _, resID := range sdkCertIDs.Difference(resCertIDs).List() {
+		unmatchedSDKCerts = append(unmatchedSDKCerts, sdkCertsByID[resID]...)
+	}
+	return matchedResAndSDKCerts, unmatchedResCerts, unmatchedSDKCerts, nil
+}
+
+func mapResCertificateByResourceID(resCerts []*acmmodel.Certificate) map[string]*acmmodel.Certificate {
+	resCertsByID := make(map[string]*acmmodel.Certificate, len(resCerts))
+	for _, resCert := range resCerts {
+		resCertsByID[resCert.ID()] = resCert
+	}
+	return resCertsByID
+}
+
+func mapSDKCertificateByResourceID(sdkCerts []CertificateWithTags, resourceIDTagKey string) (map[string][]CertificateWithTags, error) {
+	sdkCertsByID := make(map[string][]CertificateWithTags, len(sdkCerts))
+	for _, sdkCert := range sdkCerts {
+		resourceID, ok := sdkCert.Tags[resourceIDTagKey]
+		if !ok {
+			return nil, errors.Errorf("unexpected certificate with no resourceID: %v", sdkCert.CertificateARN)
+		}
+		sdkCertsByID[resourceID] = append(sdkCertsByID[resourceID], sdkCert)
+	}
+	return sdkCertsByID, nil
+}
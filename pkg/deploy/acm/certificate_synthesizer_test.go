@@ -0,0 +1,129 @@
+package acm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_certificateSynthesizer_Synthesize(t *testing.T) {
+	stackID := core.StackID{Namespace: "ns", Name: "gw"}
+	stackTags := map[string]string{
+		"elbv2.k8s.aws/cluster":     "cluster",
+		"gateway.k8s.aws.alb/stack": "ns/gw",
+	}
+	sdkCert := func(arn string, resID string) CertificateWithTags {
+		return CertificateWithTags{
+			CertificateARN: arn,
+			Tags: map[string]string{
+				"elbv2.k8s.aws/cluster":        "cluster",
+				"gateway.k8s.aws.alb/stack":    "ns/gw",
+				"gateway.k8s.aws.alb/resource": resID,
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		resCertIDs      []string
+		sdkCerts        []CertificateWithTags
+		listErr         error
+		wantImported    []string
+		wantReimported  map[string]string
+		wantDeletedARNs []string
+		wantStatusARNs  map[string]string
+		wantErr         string
+	}{
+		{
+			name:       "certificate is imported",
+			resCertIDs: []string{"ns/foo-tls"},
+			wantImported: []string{
+				"ns/foo-tls",
+			},
+			wantStatusARNs: map[string]string{"ns/foo-tls": "imported-ns/foo-tls"},
+		},
+		{
+			name:       "existing certificate is re-imported",
+			resCertIDs: []string{"ns/foo-tls"},
+			sdkCerts:   []CertificateWithTags{sdkCert("arn-foo", "ns/foo-tls")},
+			wantReimported: map[string]string{
+				"ns/foo-tls": "arn-foo",
+			},
+			wantStatusARNs: map[string]string{"ns/foo-tls": "arn-foo"},
+		},
+		{
+			name:            "unreferenced certificates are deleted",
+			resCertIDs:      []string{"ns/foo-tls"},
+			sdkCerts:        []CertificateWithTags{sdkCert("arn-foo", "ns/foo-tls"), sdkCert("arn-foo-dup", "ns/foo-tls"), sdkCert("arn-bar", "ns/bar-tls")},
+			wantReimported:  map[string]string{"ns/foo-tls": "arn-foo"},
+			wantDeletedARNs: []string{"arn-foo-dup", "arn-bar"},
+			wantStatusARNs:  map[string]string{"ns/foo-tls": "arn-foo"},
+		},
+		{
+			name:            "all certificates are deleted without certificates in stack",
+			sdkCerts:        []CertificateWithTags{sdkCert("arn-bar", "ns/bar-tls")},
+			wantDeletedARNs: []string{"arn-bar"},
+		},
+		{
+			name:       "list certificates fails",
+			resCertIDs: []string{"ns/foo-tls"},
+			listErr:    errors.New("access denied"),
+			wantErr:    "access denied",
+		},
+		{
+			name:       "certificate without resource ID",
+			resCertIDs: []string{"ns/foo-tls"},
+			sdkCerts:   []CertificateWithTags{{CertificateARN: "arn-foo", Tags: stackTags}},
+			wantErr:    "unexpected certificate with no resourceID: arn-foo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stack := core.NewDefaultStack(stackID)
+			resCerts := make(map[string]*acmmodel.Certificate)
+			for _, id := range tt.resCertIDs {
+				resCerts[id] = acmmodel.NewCertificate(stack, id, acmmodel.CertificateSpec{Digest: "digest"})
+			}
+
+			certManager := NewMockCertificateManager(ctrl)
+			certManager.EXPECT().ListImportedCertificates(gomock.Any(), stackTags).Return(tt.sdkCerts, tt.listErr)
+			for _, id := range tt.wantImported {
+				certManager.EXPECT().Import(gomock.Any(), resCerts[id]).Return(acmmodel.CertificateStatus{CertificateARN: "imported-" + id}, nil)
+			}
+			for id, arn := range tt.wantReimported {
+				certManager.EXPECT().Reimport(gomock.Any(), resCerts[id], sdkCert(arn, id)).Return(acmmodel.CertificateStatus{CertificateARN: arn}, nil)
+			}
+			for _, arn := range tt.wantDeletedARNs {
+				for _, cert := range tt.sdkCerts {
+					if cert.CertificateARN == arn {
+						certManager.EXPECT().Delete(gomock.Any(), cert).Return(nil)
+					}
+				}
+			}
+
+			synthesizer := NewCertificateSynthesizer(tracking.NewDefaultProvider("gateway.k8s.aws.alb", "cluster"), certManager, log.Log, stack)
+			err := synthesizer.Synthesize(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			for id, arn := range tt.wantStatusARNs {
+				certARN, err := resCerts[id].CertificateARN().Resolve(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, arn, certARN)
+			}
+			assert.NoError(t, synthesizer.PostSynthesize(context.Background()))
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Target is a deployed resource stack that's audited for drift.
+type Target struct {
+	// Stack is the resource stack that was last deployed.
+	Stack core.Stack
+	// Objects are the Kubernetes objects the stack was built from, drift is reported on them.
+	Objects []client.Object
+	// FrontendNlbTargetGroupDesiredState is the frontend NLB targets state the stack was deployed with, if any.
+	FrontendNlbTargetGroupDesiredState *core.FrontendNlbTargetGroupDesiredState
+	// HealRequest is the reconcile request that deploys the stack, if it's not the request the stack is tracked for.
+	// e.g. the stacks of the shards of an IngressGroup are deployed by reconciling the IngressGroup.
+	HealRequest *reconcile.Request
+}
+
+// Auditor periodically compares the live AWS resources against the resource stacks last deployed by a controller.
+// Drift is detected by planning the deployment of the stack again: any change the deployment would make is drift,
+// as the stack was fully deployed before.
+type Auditor interface {
+	// Track starts auditing the stack deployed for the reconcile request, replacing the stack deployed before.
+	// Auditing sets the status of the stack resources, the stack must no longer be resolved by the caller once tracked.
+	Track(req reconcile.Request, target Target)
+
+	// Forget stops auditing the stack deployed for the reconcile request.
+	Forget(req reconcile.Request)
+
+	// Source is the source of reconcile requests for objects whose drift is healed automatically.
+	Source() source.Source
+
+	// Start audits the tracked stacks periodically, until ctx is done.
+	Start(ctx context.Context) error
+}
+
+// NewDefaultAuditor constructs new defaultAuditor.
+func NewDefaultAuditor(stackDeployer deploy.StackDeployer, eventRecorder record.EventRecorder, metricsCollector lbcmetrics.MetricCollector,
+	controllerName string, interval time.Duration, policy config.DriftPolicy, logger logr.Logger) *defaultAuditor {
+	return &defaultAuditor{
+		stackDeployer:    stackDeployer,
+		eventRecorder:    eventRecorder,
+		metricsCollector: metricsCollector,
+		controllerName:   controllerName,
+		interval:         interval,
+		policy:           policy,
+		logger:           logger,
+		requeueChan:      make(chan event.TypedGenericEvent[reconcile.Request]),
+		targets:          make(map[reconcile.Request]*Target),
+	}
+}
+
+var _ Auditor = &defaultAuditor{}
+
+type defaultAuditor struct {
+	stackDeployer    deploy.StackDeployer
+	eventRecorder    record.EventRecorder
+	metricsCollector lbcmetrics.MetricCollector
+	controllerName   string
+	interval         time.Duration
+	policy           config.DriftPolicy
+	logger           logr.Logger
+	requeueChan      chan event.TypedGenericEvent[reconcile.Request]
+
+	targetsMutex sync.Mutex
+	targets      map[reconcile.Request]*Target
+}
+
+func (a *defaultAuditor) Track(req reconcile.Request, target Target) {
+	if a.interval <= 0 {
+		return
+	}
+	a.targetsMutex.Lock()
+	defer a.targetsMutex.Unlock()
+	a.targets[req] = &target
+}
+
+func (a *defaultAuditor) Forget(req reconcile.Request) {
+	if a.interval <= 0 {
+		return
+	}
+	a.targetsMutex.Lock()
+	defer a.targetsMutex.Unlock()
+	if _, ok := a.targets[req]; ok {
+		delete(a.targets, req)
+		a.metricsCollector.ObserveLoadBalancerDrift(a.controllerName, req.Namespace, req.Name, 0)
+	}
+}
+
+func (a *defaultAuditor) Source() source.Source {
+	return source.Channel(a.requeueChan, handler.TypedFuncs[reconcile.Request, reconcile.Request]{
+		GenericFunc: func(_ context.Context, e event.TypedGenericEvent[reconcile.Request], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			queue.Add(e.Object)
+		},
+	})
+}
+
+func (a *defaultAuditor) Start(ctx context.Context) error {
+	if a.interval <= 0 {
+		return nil
+	}
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			a.auditAll(ctx)
+		}
+	}
+}
+
+// NeedLeaderElection makes the auditor run on the leader only, as only the leader reconciles.
+func (a *defaultAuditor) NeedLeaderElection() bool {
+	return true
+}
+
+func (a *defaultAuditor) auditAll(ctx context.Context) {
+	a.targetsMutex.Lock()
+	targets := make(map[reconcile.Request]*Target, len(a.targets))
+	for req, target := range a.targets {
+		targets[req] = target
+	}
+	a.targetsMutex.Unlock()
+
+	for req, target := range targets {
+		if ctx.Err() != nil {
+			return
+		}
+		a.audit(ctx, req, target)
+	}
+}
+
+func (a *defaultAuditor) audit(ctx context.Context, req reconcile.Request, target *Target) {
+	plan, err := a.stackDeployer.Plan(ctx, target.Stack, a.metricsCollector, a.controllerName, target.FrontendNlbTargetGroupDesiredState)
+	if err != nil {
+		a.logger.Error(err, "failed to audit drift", "request", req.NamespacedName)
+		return
+	}
+	// the stack is rebuilt and deployed whenever the object is reconciled, the audit of a stack that was replaced meanwhile is stale.
+	if !a.isTracked(req, target) {
+		return
+	}
+	a.metricsCollector.ObserveLoadBalancerDrift(a.controllerName, req.Namespace, req.Name, len(plan.Changes))
+	if len(plan.Changes) == 0 {
+		return
+	}
+
+	a.logger.Info("detected drift", "request", req.NamespacedName, "policy", a.policy, "changes", plan.Changes)
+	message := fmt.Sprintf("Detected drift of load balancer resources: %v", plan.Describe())
+	if a.policy == config.DriftPolicyAutoHeal {
+		message = fmt.Sprintf("Detected drift of load balancer resources, reverting it: %v", plan.Describe())
+	}
+	for _, obj := range target.Objects {
+		a.eventRecorder.Event(obj, corev1.EventTypeWarning, k8s.DriftEventReasonDriftDetected, message)
+	}
+	if a.policy == config.DriftPolicyAutoHeal {
+		healReq := req
+		if target.HealRequest != nil {
+			healReq = *target.HealRequest
+		}
+		select {
+		case a.requeueChan <- event.TypedGenericEvent[reconcile.Request]{Object: healReq}:
+		case <-ctx.Done():
+		}
+	}
+}
+
+func (a *defaultAuditor) isTracked(req reconcile.Request, target *Target) bool {
+	a.targetsMutex.Lock()
+	defer a.targetsMutex.Unlock()
+	return a.targets[req] == target
+}
@@ -0,0 +1,183 @@
+package drift
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/dryrun"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type driftObservation struct {
+	namespace string
+	name      string
+	changes   int
+}
+
+// driftMetricsCollector records the drift observations, other observations are dropped.
+type driftMetricsCollector struct {
+	lbcmetrics.MetricCollector
+	observations []driftObservation
+}
+
+func (c *driftMetricsCollector) ObserveLoadBalancerDrift(_ string, namespace string, name string, changes int) {
+	c.observations = append(c.observations, driftObservation{namespace: namespace, name: name, changes: changes})
+}
+
+func Test_defaultAuditor_audit(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-svc",
+		},
+	}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-svc"}}
+	driftedPlan := &dryrun.Plan{
+		Changes: []dryrun.Change{
+			{
+				Type:      dryrun.ChangeTypeUpdate,
+				Operation: "elasticloadbalancing:ModifyListener",
+				Resource:  "arn:listener",
+			},
+		},
+	}
+	tests := []struct {
+		name             string
+		policy           config.DriftPolicy
+		plan             *dryrun.Plan
+		healRequest      *reconcile.Request
+		retrackedMidPlan bool
+		wantObservations []driftObservation
+		wantEvents       []string
+		wantRequeue      bool
+	}{
+		{
+			name:             "no drift",
+			policy:           config.DriftPolicyReportOnly,
+			plan:             &dryrun.Plan{},
+			wantObservations: []driftObservation{{namespace: "awesome-ns", name: "awesome-svc", changes: 0}},
+		},
+		{
+			name:             "drift reported",
+			policy:           config.DriftPolicyReportOnly,
+			plan:             driftedPlan,
+			wantObservations: []driftObservation{{namespace: "awesome-ns", name: "awesome-svc", changes: 1}},
+			wantEvents: []string{"Warning DriftDetected Detected drift of load balancer resources: " +
+				"0 to create, 1 to update, 0 to delete\nUpdate elasticloadbalancing:ModifyListener arn:listener"},
+		},
+		{
+			name:             "drift healed",
+			policy:           config.DriftPolicyAutoHeal,
+			plan:             driftedPlan,
+			wantObservations: []driftObservation{{namespace: "awesome-ns", name: "awesome-svc", changes: 1}},
+			wantEvents: []string{"Warning DriftDetected Detected drift of load balancer resources, reverting it: " +
+				"0 to create, 1 to update, 0 to delete\nUpdate elasticloadbalancing:ModifyListener arn:listener"},
+			wantRequeue: true,
+		},
+		{
+			name:             "drift healed by reconciling the heal request",
+			policy:           config.DriftPolicyAutoHeal,
+			plan:             driftedPlan,
+			healRequest:      &reconcile.Request{NamespacedName: types.NamespacedName{Name: "awesome-group"}},
+			wantObservations: []driftObservation{{namespace: "awesome-ns", name: "awesome-svc", changes: 1}},
+			wantEvents: []string{"Warning DriftDetected Detected drift of load balancer resources, reverting it: " +
+				"0 to create, 1 to update, 0 to delete\nUpdate elasticloadbalancing:ModifyListener arn:listener"},
+			wantRequeue: true,
+		},
+		{
+			name:             "stack deployed again while auditing",
+			policy:           config.DriftPolicyAutoHeal,
+			plan:             driftedPlan,
+			retrackedMidPlan: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stack := core.NewDefaultStack(core.StackID(req.NamespacedName))
+			stackDeployer := deploy.NewMockStackDeployer(ctrl)
+			eventRecorder := record.NewFakeRecorder(10)
+			metricsCollector := &driftMetricsCollector{}
+			auditor := NewDefaultAuditor(stackDeployer, eventRecorder, metricsCollector, "service", time.Minute, tt.policy, logr.Discard())
+			auditor.Track(req, Target{Stack: stack, Objects: []client.Object{svc}, HealRequest: tt.healRequest})
+
+			stackDeployer.EXPECT().Plan(gomock.Any(), stack, metricsCollector, "service", nil).DoAndReturn(
+				func(_ context.Context, _ core.Stack, _ lbcmetrics.MetricCollector, _ string, _ *core.FrontendNlbTargetGroupDesiredState) (*dryrun.Plan, error) {
+					if tt.retrackedMidPlan {
+						auditor.Track(req, Target{Stack: stack, Objects: []client.Object{svc}})
+					}
+					return tt.plan, nil
+				})
+
+			var gotRequeues []reconcile.Request
+			requeueDone := make(chan struct{})
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				defer close(requeueDone)
+				for {
+					select {
+					case e := <-auditor.requeueChan:
+						gotRequeues = append(gotRequeues, e.Object)
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+			auditor.auditAll(ctx)
+			cancel()
+			<-requeueDone
+
+			assert.Equal(t, tt.wantObservations, metricsCollector.observations)
+			var gotEvents []string
+			close(eventRecorder.Events)
+			for e := range eventRecorder.Events {
+				gotEvents = append(gotEvents, e)
+			}
+			assert.Equal(t, tt.wantEvents, gotEvents)
+			if tt.wantRequeue {
+				wantRequeue := req
+				if tt.healRequest != nil {
+					wantRequeue = *tt.healRequest
+				}
+				assert.Equal(t, []reconcile.Request{wantRequeue}, gotRequeues)
+			} else {
+				assert.Empty(t, gotRequeues)
+			}
+		})
+	}
+}
+
+func Test_defaultAuditor_Track(t *testing.T) {
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-svc"}}
+	stack := core.NewDefaultStack(core.StackID(req.NamespacedName))
+
+	t.Run("disabled", func(t *testing.T) {
+		auditor := NewDefaultAuditor(nil, nil, &driftMetricsCollector{}, "service", 0, config.DriftPolicyReportOnly, logr.Discard())
+		auditor.Track(req, Target{Stack: stack})
+		assert.Empty(t, auditor.targets)
+	})
+	t.Run("forget", func(t *testing.T) {
+		metricsCollector := &driftMetricsCollector{}
+		auditor := NewDefaultAuditor(nil, nil, metricsCollector, "service", time.Minute, config.DriftPolicyReportOnly, logr.Discard())
+		auditor.Track(req, Target{Stack: stack})
+		assert.Len(t, auditor.targets, 1)
+		auditor.Forget(req)
+		assert.Empty(t, auditor.targets)
+		assert.Equal(t, []driftObservation{{namespace: "awesome-ns", name: "awesome-svc", changes: 0}}, metricsCollector.observations)
+	})
+}
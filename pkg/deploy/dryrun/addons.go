@@ -0,0 +1,149 @@
+package dryrun
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	acmsdk "github.com/aws/aws-sdk-go-v2/service/acm"
+	shieldsdk "github.com/aws/aws-sdk-go-v2/service/shield"
+	shieldtypes "github.com/aws/aws-sdk-go-v2/service/shield/types"
+	wafregionalsdk "github.com/aws/aws-sdk-go-v2/service/wafregional"
+	wafv2sdk "github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+// NewACM constructs an ACM client that records mutating calls instead of making them.
+func NewACM(acmClient services.ACM, region string, recorder *Recorder) services.ACM {
+	return &dryRunACM{
+		ACM:      acmClient,
+		region:   region,
+		recorder: recorder,
+	}
+}
+
+var _ services.ACM = &dryRunACM{}
+
+type dryRunACM struct {
+	services.ACM
+	region   string
+	recorder *Recorder
+}
+
+func (c *dryRunACM) ImportCertificateWithContext(_ context.Context, input *acmsdk.ImportCertificateInput) (*acmsdk.ImportCertificateOutput, error) {
+	redactedInput := *input
+	redactedInput.PrivateKey = []byte(redactedValue)
+	if input.CertificateArn != nil {
+		c.recorder.Record(ChangeTypeUpdate, "acm:ImportCertificate", awssdk.ToString(input.CertificateArn), &redactedInput)
+		return &acmsdk.ImportCertificateOutput{CertificateArn: input.CertificateArn}, nil
+	}
+	c.recorder.Record(ChangeTypeCreate, "acm:ImportCertificate", "", &redactedInput)
+	certARN := fmt.Sprintf("arn:aws:acm:%s:000000000000:certificate/%s", c.region, c.recorder.nextPlaceholderID())
+	return &acmsdk.ImportCertificateOutput{CertificateArn: awssdk.String(certARN)}, nil
+}
+
+func (c *dryRunACM) DeleteCertificateWithContext(_ context.Context, input *acmsdk.DeleteCertificateInput) (*acmsdk.DeleteCertificateOutput, error) {
+	c.recorder.Record(ChangeTypeDelete, "acm:DeleteCertificate", awssdk.ToString(input.CertificateArn), input)
+	return &acmsdk.DeleteCertificateOutput{}, nil
+}
+
+func (c *dryRunACM) AddTagsToCertificateWithContext(_ context.Context, input *acmsdk.AddTagsToCertificateInput) (*acmsdk.AddTagsToCertificateOutput, error) {
+	c.recorder.Record(ChangeTypeUpdate, "acm:AddTagsToCertificate", awssdk.ToString(input.CertificateArn), input)
+	return &acmsdk.AddTagsToCertificateOutput{}, nil
+}
+
+// NewWAFv2 constructs a WAFv2 client that records mutating calls instead of making them.
+func NewWAFv2(wafv2Client services.WAFv2, recorder *Recorder) services.WAFv2 {
+	return &dryRunWAFv2{
+		WAFv2:    wafv2Client,
+		recorder: recorder,
+	}
+}
+
+var _ services.WAFv2 = &dryRunWAFv2{}
+
+type dryRunWAFv2 struct {
+	services.WAFv2
+	recorder *Recorder
+}
+
+func (c *dryRunWAFv2) AssociateWebACLWithContext(_ context.Context, input *wafv2sdk.AssociateWebACLInput) (*wafv2sdk.AssociateWebACLOutput, error) {
+	c.recorder.Record(ChangeTypeUpdate, "wafv2:AssociateWebACL", awssdk.ToString(input.ResourceArn), input)
+	return &wafv2sdk.AssociateWebACLOutput{}, nil
+}
+
+func (c *dryRunWAFv2) DisassociateWebACLWithContext(_ context.Context, input *wafv2sdk.DisassociateWebACLInput) (*wafv2sdk.DisassociateWebACLOutput, error) {
+	c.recorder.Record(ChangeTypeUpdate, "wafv2:DisassociateWebACL", awssdk.ToString(input.ResourceArn), input)
+	return &wafv2sdk.DisassociateWebACLOutput{}, nil
+}
+
+func (c *dryRunWAFv2) GetWebACLForResourceWithContext(ctx context.Context, input *wafv2sdk.GetWebACLForResourceInput) (*wafv2sdk.GetWebACLForResourceOutput, error) {
+	if IsPlaceholderID(awssdk.ToString(input.ResourceArn)) {
+		return &wafv2sdk.GetWebACLForResourceOutput{}, nil
+	}
+	return c.WAFv2.GetWebACLForResourceWithContext(ctx, input)
+}
+
+// NewWAFRegional constructs a WAFRegional client that records mutating calls instead of making them.
+func NewWAFRegional(wafRegionalClient services.WAFRegional, recorder *Recorder) services.WAFRegional {
+	return &dryRunWAFRegional{
+		WAFRegional: wafRegionalClient,
+		recorder:    recorder,
+	}
+}
+
+var _ services.WAFRegional = &dryRunWAFRegional{}
+
+type dryRunWAFRegional struct {
+	services.WAFRegional
+	recorder *Recorder
+}
+
+func (c *dryRunWAFRegional) AssociateWebACLWithContext(_ context.Context, input *wafregionalsdk.AssociateWebACLInput) (*wafregionalsdk.AssociateWebACLOutput, error) {
+	c.recorder.Record(ChangeTypeUpdate, "waf-regional:AssociateWebACL", awssdk.ToString(input.ResourceArn), input)
+	return &wafregionalsdk.AssociateWebACLOutput{}, nil
+}
+
+func (c *dryRunWAFRegional) DisassociateWebACLWithContext(_ context.Context, input *wafregionalsdk.DisassociateWebACLInput) (*wafregionalsdk.DisassociateWebACLOutput, error) {
+	c.recorder.Record(ChangeTypeUpdate, "waf-regional:DisassociateWebACL", awssdk.ToString(input.ResourceArn), input)
+	return &wafregionalsdk.DisassociateWebACLOutput{}, nil
+}
+
+func (c *dryRunWAFRegional) GetWebACLForResourceWithContext(ctx context.Context, input *wafregionalsdk.GetWebACLForResourceInput) (*wafregionalsdk.GetWebACLForResourceOutput, error) {
+	if IsPlaceholderID(awssdk.ToString(input.ResourceArn)) {
+		return &wafregionalsdk.GetWebACLForResourceOutput{}, nil
+	}
+	return c.WAFRegional.GetWebACLForResourceWithContext(ctx, input)
+}
+
+// NewShield constructs a Shield client that records mutating calls instead of making them.
+func NewShield(shieldClient services.Shield, recorder *Recorder) services.Shield {
+	return &dryRunShield{
+		Shield:   shieldClient,
+		recorder: recorder,
+	}
+}
+
+var _ services.Shield = &dryRunShield{}
+
+type dryRunShield struct {
+	services.Shield
+	recorder *Recorder
+}
+
+func (c *dryRunShield) CreateProtectionWithContext(_ context.Context, input *shieldsdk.CreateProtectionInput) (*shieldsdk.CreateProtectionOutput, error) {
+	c.recorder.Record(ChangeTypeCreate, "shield:CreateProtection", awssdk.ToString(input.ResourceArn), input)
+	return &shieldsdk.CreateProtectionOutput{ProtectionId: awssdk.String(c.recorder.nextPlaceholderID())}, nil
+}
+
+func (c *dryRunShield) DeleteProtectionWithContext(_ context.Context, input *shieldsdk.DeleteProtectionInput) (*shieldsdk.DeleteProtectionOutput, error) {
+	c.recorder.Record(ChangeTypeDelete, "shield:DeleteProtection", awssdk.ToString(input.ProtectionId), input)
+	return &shieldsdk.DeleteProtectionOutput{}, nil
+}
+
+func (c *dryRunShield) DescribeProtectionWithContext(ctx context.Context, input *shieldsdk.DescribeProtectionInput) (*shieldsdk.DescribeProtectionOutput, error) {
+	if IsPlaceholderID(awssdk.ToString(input.ResourceArn)) {
+		return nil, &shieldtypes.ResourceNotFoundException{Message: awssdk.String("resource would be created")}
+	}
+	return c.Shield.DescribeProtectionWithContext(ctx, input)
+}
@@ -0,0 +1,66 @@
+package dryrun
+
+import (
+	"context"
+
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+// NewCloud constructs a Cloud whose clients record mutating calls instead of making them.
+func NewCloud(cloud services.Cloud, recorder *Recorder) services.Cloud {
+	return &dryRunCloud{
+		Cloud:       cloud,
+		recorder:    recorder,
+		ec2:         NewEC2(cloud.EC2(), recorder),
+		elbv2:       NewELBV2(cloud.ELBV2(), cloud.Region(), recorder),
+		acm:         NewACM(cloud.ACM(), cloud.Region(), recorder),
+		wafv2:       NewWAFv2(cloud.WAFv2(), recorder),
+		wafRegional: NewWAFRegional(cloud.WAFRegional(), recorder),
+		shield:      NewShield(cloud.Shield(), recorder),
+	}
+}
+
+var _ services.Cloud = &dryRunCloud{}
+
+type dryRunCloud struct {
+	services.Cloud
+	recorder    *Recorder
+	ec2         services.EC2
+	elbv2       services.ELBV2
+	acm         services.ACM
+	wafv2       services.WAFv2
+	wafRegional services.WAFRegional
+	shield      services.Shield
+}
+
+func (c *dryRunCloud) EC2() services.EC2 {
+	return c.ec2
+}
+
+func (c *dryRunCloud) ELBV2() services.ELBV2 {
+	return c.elbv2
+}
+
+func (c *dryRunCloud) ACM() services.ACM {
+	return c.acm
+}
+
+func (c *dryRunCloud) WAFv2() services.WAFv2 {
+	return c.wafv2
+}
+
+func (c *dryRunCloud) WAFRegional() services.WAFRegional {
+	return c.wafRegional
+}
+
+func (c *dryRunCloud) Shield() services.Shield {
+	return c.shield
+}
+
+func (c *dryRunCloud) GetAssumedRoleELBV2(ctx context.Context, assumeRoleArn string, externalId string) (services.ELBV2, error) {
+	assumedRoleClient, err := c.Cloud.GetAssumedRoleELBV2(ctx, assumeRoleArn, externalId)
+	if err != nil {
+		return nil, err
+	}
+	return NewELBV2(assumedRoleClient, c.Cloud.Region(), c.recorder), nil
+}
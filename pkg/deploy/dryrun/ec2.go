@@ -0,0 +1,104 @@
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const ec2Service = "ec2"
+
+// NewEC2 constructs an EC2 client that records mutating calls instead of making them.
+// Read calls are made against AWS, except for security groups that would be created.
+func NewEC2(ec2Client services.EC2, recorder *Recorder) services.EC2 {
+	return &dryRunEC2{
+		EC2:      ec2Client,
+		recorder: recorder,
+		sgByID:   make(map[string]ec2types.SecurityGroup),
+	}
+}
+
+var _ services.EC2 = &dryRunEC2{}
+
+type dryRunEC2 struct {
+	services.EC2
+	recorder *Recorder
+
+	sgByIDMutex sync.Mutex
+	// sgByID holds the security groups that would be created.
+	sgByID map[string]ec2types.SecurityGroup
+}
+
+func (c *dryRunEC2) record(changeType ChangeType, operation string, resource string, input interface{}) {
+	c.recorder.Record(changeType, fmt.Sprintf("%s:%s", ec2Service, operation), resource, input)
+}
+
+func (c *dryRunEC2) CreateTagsWithContext(_ context.Context, input *ec2sdk.CreateTagsInput) (*ec2sdk.CreateTagsOutput, error) {
+	c.record(ChangeTypeUpdate, "CreateTags", strings.Join(input.Resources, ","), input)
+	return &ec2sdk.CreateTagsOutput{}, nil
+}
+
+func (c *dryRunEC2) DeleteTagsWithContext(_ context.Context, input *ec2sdk.DeleteTagsInput) (*ec2sdk.DeleteTagsOutput, error) {
+	c.record(ChangeTypeUpdate, "DeleteTags", strings.Join(input.Resources, ","), input)
+	return &ec2sdk.DeleteTagsOutput{}, nil
+}
+
+func (c *dryRunEC2) CreateSecurityGroupWithContext(_ context.Context, input *ec2sdk.CreateSecurityGroupInput) (*ec2sdk.CreateSecurityGroupOutput, error) {
+	c.record(ChangeTypeCreate, "CreateSecurityGroup", awssdk.ToString(input.GroupName), input)
+	sgID := fmt.Sprintf("sg-%s", c.recorder.nextPlaceholderID())
+	c.sgByIDMutex.Lock()
+	c.sgByID[sgID] = ec2types.SecurityGroup{
+		GroupId:     awssdk.String(sgID),
+		GroupName:   input.GroupName,
+		Description: input.Description,
+		VpcId:       input.VpcId,
+	}
+	c.sgByIDMutex.Unlock()
+	return &ec2sdk.CreateSecurityGroupOutput{GroupId: awssdk.String(sgID)}, nil
+}
+
+func (c *dryRunEC2) DeleteSecurityGroupWithContext(_ context.Context, input *ec2sdk.DeleteSecurityGroupInput) (*ec2sdk.DeleteSecurityGroupOutput, error) {
+	c.record(ChangeTypeDelete, "DeleteSecurityGroup", awssdk.ToString(input.GroupId), input)
+	return &ec2sdk.DeleteSecurityGroupOutput{}, nil
+}
+
+func (c *dryRunEC2) AuthorizeSecurityGroupIngressWithContext(_ context.Context, input *ec2sdk.AuthorizeSecurityGroupIngressInput) (*ec2sdk.AuthorizeSecurityGroupIngressOutput, error) {
+	c.record(ChangeTypeUpdate, "AuthorizeSecurityGroupIngress", awssdk.ToString(input.GroupId), input)
+	return &ec2sdk.AuthorizeSecurityGroupIngressOutput{}, nil
+}
+
+func (c *dryRunEC2) RevokeSecurityGroupIngressWithContext(_ context.Context, input *ec2sdk.RevokeSecurityGroupIngressInput) (*ec2sdk.RevokeSecurityGroupIngressOutput, error) {
+	c.record(ChangeTypeUpdate, "RevokeSecurityGroupIngress", awssdk.ToString(input.GroupId), input)
+	return &ec2sdk.RevokeSecurityGroupIngressOutput{}, nil
+}
+
+func (c *dryRunEC2) DescribeSecurityGroupsAsList(ctx context.Context, input *ec2sdk.DescribeSecurityGroupsInput) ([]ec2types.SecurityGroup, error) {
+	realIDs, hasPlaceholder := filterPlaceholderIDs(input.GroupIds)
+	if !hasPlaceholder {
+		return c.EC2.DescribeSecurityGroupsAsList(ctx, input)
+	}
+	var sgs []ec2types.SecurityGroup
+	if len(realIDs) != 0 {
+		realInput := *input
+		realInput.GroupIds = realIDs
+		realSGs, err := c.EC2.DescribeSecurityGroupsAsList(ctx, &realInput)
+		if err != nil {
+			return nil, err
+		}
+		sgs = append(sgs, realSGs...)
+	}
+	c.sgByIDMutex.Lock()
+	defer c.sgByIDMutex.Unlock()
+	for _, sgID := range input.GroupIds {
+		if sg, exists := c.sgByID[sgID]; exists {
+			sgs = append(sgs, sg)
+		}
+	}
+	return sgs, nil
+}
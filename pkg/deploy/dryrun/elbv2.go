@@ -0,0 +1,412 @@
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	elbv2Service = "elasticloadbalancing"
+
+	redactedValue = "<redacted>"
+)
+
+// NewELBV2 constructs an ELBV2 client that records mutating calls instead of making them.
+// Read calls are made against AWS, except for resources that would be created.
+func NewELBV2(elbv2Client services.ELBV2, region string, recorder *Recorder) services.ELBV2 {
+	return &dryRunELBV2{
+		ELBV2:    elbv2Client,
+		region:   region,
+		recorder: recorder,
+		lbByARN:  make(map[string]elbv2types.LoadBalancer),
+	}
+}
+
+var _ services.ELBV2 = &dryRunELBV2{}
+
+type dryRunELBV2 struct {
+	services.ELBV2
+	region   string
+	recorder *Recorder
+
+	lbByARNMutex sync.Mutex
+	// lbByARN holds the load balancers that would be created.
+	lbByARN map[string]elbv2types.LoadBalancer
+}
+
+func (c *dryRunELBV2) placeholderARN(resourceType string, name string) string {
+	return fmt.Sprintf("arn:aws:%s:%s:000000000000:%s/%s/%s", elbv2Service, c.region, resourceType, name, c.recorder.nextPlaceholderID())
+}
+
+func (c *dryRunELBV2) record(changeType ChangeType, operation string, resource string, input interface{}) {
+	c.recorder.Record(changeType, fmt.Sprintf("%s:%s", elbv2Service, operation), resource, input)
+}
+
+func (c *dryRunELBV2) AddTagsWithContext(_ context.Context, input *elbv2sdk.AddTagsInput) (*elbv2sdk.AddTagsOutput, error) {
+	c.record(ChangeTypeUpdate, "AddTags", strings.Join(input.ResourceArns, ","), input)
+	return &elbv2sdk.AddTagsOutput{}, nil
+}
+
+func (c *dryRunELBV2) RemoveTagsWithContext(_ context.Context, input *elbv2sdk.RemoveTagsInput) (*elbv2sdk.RemoveTagsOutput, error) {
+	c.record(ChangeTypeUpdate, "RemoveTags", strings.Join(input.ResourceArns, ","), input)
+	return &elbv2sdk.RemoveTagsOutput{}, nil
+}
+
+func (c *dryRunELBV2) CreateLoadBalancerWithContext(_ context.Context, input *elbv2sdk.CreateLoadBalancerInput) (*elbv2sdk.CreateLoadBalancerOutput, error) {
+	c.record(ChangeTypeCreate, "CreateLoadBalancer", awssdk.ToString(input.Name), input)
+	lbARN := c.placeholderARN("loadbalancer", awssdk.ToString(input.Name))
+	lb := elbv2types.LoadBalancer{
+		LoadBalancerArn:  awssdk.String(lbARN),
+		LoadBalancerName: input.Name,
+		DNSName:          awssdk.String(fmt.Sprintf("%s.%s.invalid", awssdk.ToString(input.Name), placeholderMarker)),
+		Scheme:           input.Scheme,
+		Type:             input.Type,
+		IpAddressType:    input.IpAddressType,
+		SecurityGroups:   input.SecurityGroups,
+		State:            &elbv2types.LoadBalancerState{Code: elbv2types.LoadBalancerStateEnumActive},
+	}
+	for _, subnetID := range input.Subnets {
+		lb.AvailabilityZones = append(lb.AvailabilityZones, elbv2types.AvailabilityZone{SubnetId: awssdk.String(subnetID)})
+	}
+	for _, subnetMapping := range input.SubnetMappings {
+		lb.AvailabilityZones = append(lb.AvailabilityZones, elbv2types.AvailabilityZone{SubnetId: subnetMapping.SubnetId})
+	}
+	c.lbByARNMutex.Lock()
+	c.lbByARN[lbARN] = lb
+	c.lbByARNMutex.Unlock()
+	return &elbv2sdk.CreateLoadBalancerOutput{LoadBalancers: []elbv2types.LoadBalancer{lb}}, nil
+}
+
+func (c *dryRunELBV2) DeleteLoadBalancerWithContext(_ context.Context, input *elbv2sdk.DeleteLoadBalancerInput) (*elbv2sdk.DeleteLoadBalancerOutput, error) {
+	c.record(ChangeTypeDelete, "DeleteLoadBalancer", awssdk.ToString(input.LoadBalancerArn), input)
+	return &elbv2sdk.DeleteLoadBalancerOutput{}, nil
+}
+
+func (c *dryRunELBV2) ModifyLoadBalancerAttributesWithContext(_ context.Context, input *elbv2sdk.ModifyLoadBalancerAttributesInput) (*elbv2sdk.ModifyLoadBalancerAttributesOutput, error) {
+	c.record(ChangeTypeUpdate, "ModifyLoadBalancerAttributes", awssdk.ToString(input.LoadBalancerArn), input)
+	return &elbv2sdk.ModifyLoadBalancerAttributesOutput{Attributes: input.Attributes}, nil
+}
+
+func (c *dryRunELBV2) SetIpAddressTypeWithContext(_ context.Context, input *elbv2sdk.SetIpAddressTypeInput) (*elbv2sdk.SetIpAddressTypeOutput, error) {
+	c.record(ChangeTypeUpdate, "SetIpAddressType", awssdk.ToString(input.LoadBalancerArn), input)
+	return &elbv2sdk.SetIpAddressTypeOutput{IpAddressType: input.IpAddressType}, nil
+}
+
+func (c *dryRunELBV2) SetSubnetsWithContext(_ context.Context, input *elbv2sdk.SetSubnetsInput) (*elbv2sdk.SetSubnetsOutput, error) {
+	c.record(ChangeTypeUpdate, "SetSubnets", awssdk.ToString(input.LoadBalancerArn), input)
+	return &elbv2sdk.SetSubnetsOutput{}, nil
+}
+
+func (c *dryRunELBV2) SetSecurityGroupsWithContext(_ context.Context, input *elbv2sdk.SetSecurityGroupsInput) (*elbv2sdk.SetSecurityGroupsOutput, error) {
+	c.record(ChangeTypeUpdate, "SetSecurityGroups", awssdk.ToString(input.LoadBalancerArn), input)
+	return &elbv2sdk.SetSecurityGroupsOutput{SecurityGroupIds: input.SecurityGroups}, nil
+}
+
+func (c *dryRunELBV2) ModifyCapacityReservationWithContext(_ context.Context, input *elbv2sdk.ModifyCapacityReservationInput) (*elbv2sdk.ModifyCapacityReservationOutput, error) {
+	c.record(ChangeTypeUpdate, "ModifyCapacityReservation", awssdk.ToString(input.LoadBalancerArn), input)
+	return &elbv2sdk.ModifyCapacityReservationOutput{}, nil
+}
+
+func (c *dryRunELBV2) ModifyIPPoolsWithContext(_ context.Context, input *elbv2sdk.ModifyIpPoolsInput) (*elbv2sdk.ModifyIpPoolsOutput, error) {
+	c.record(ChangeTypeUpdate, "ModifyIpPools", awssdk.ToString(input.LoadBalancerArn), input)
+	return &elbv2sdk.ModifyIpPoolsOutput{}, nil
+}
+
+func (c *dryRunELBV2) CreateTargetGroupWithContext(_ context.Context, input *elbv2sdk.CreateTargetGroupInput) (*elbv2sdk.CreateTargetGroupOutput, error) {
+	c.record(ChangeTypeCreate, "CreateTargetGroup", awssdk.ToString(input.Name), input)
+	return &elbv2sdk.CreateTargetGroupOutput{
+		TargetGroups: []elbv2types.TargetGroup{
+			{
+				TargetGroupArn:  awssdk.String(c.placeholderARN("targetgroup", awssdk.ToString(input.Name))),
+				TargetGroupName: input.Name,
+				Port:            input.Port,
+				Protocol:        input.Protocol,
+				ProtocolVersion: input.ProtocolVersion,
+				TargetType:      input.TargetType,
+				IpAddressType:   input.IpAddressType,
+				VpcId:           input.VpcId,
+			},
+		},
+	}, nil
+}
+
+func (c *dryRunELBV2) ModifyTargetGroupWithContext(_ context.Context, input *elbv2sdk.ModifyTargetGroupInput) (*elbv2sdk.ModifyTargetGroupOutput, error) {
+	c.record(ChangeTypeUpdate, "ModifyTargetGroup", awssdk.ToString(input.TargetGroupArn), input)
+	return &elbv2sdk.ModifyTargetGroupOutput{}, nil
+}
+
+func (c *dryRunELBV2) ModifyTargetGroupAttributesWithContext(_ context.Context, input *elbv2sdk.ModifyTargetGroupAttributesInput) (*elbv2sdk.ModifyTargetGroupAttributesOutput, error) {
+	c.record(ChangeTypeUpdate, "ModifyTargetGroupAttributes", awssdk.ToString(input.TargetGroupArn), input)
+	return &elbv2sdk.ModifyTargetGroupAttributesOutput{Attributes: input.Attributes}, nil
+}
+
+func (c *dryRunELBV2) DeleteTargetGroupWithContext(_ context.Context, input *elbv2sdk.DeleteTargetGroupInput) (*elbv2sdk.DeleteTargetGroupOutput, error) {
+	c.record(ChangeTypeDelete, "DeleteTargetGroup", awssdk.ToString(input.TargetGroupArn), input)
+	return &elbv2sdk.DeleteTargetGroupOutput{}, nil
+}
+
+func (c *dryRunELBV2) RegisterTargetsWithContext(_ context.Context, input *elbv2sdk.RegisterTargetsInput) (*elbv2sdk.RegisterTargetsOutput, error) {
+	c.record(ChangeTypeUpdate, "RegisterTargets", awssdk.ToString(input.TargetGroupArn), input)
+	return &elbv2sdk.RegisterTargetsOutput{}, nil
+}
+
+func (c *dryRunELBV2) DeregisterTargetsWithContext(_ context.Context, input *elbv2sdk.DeregisterTargetsInput) (*elbv2sdk.DeregisterTargetsOutput, error) {
+	c.record(ChangeTypeUpdate, "DeregisterTargets", awssdk.ToString(input.TargetGroupArn), input)
+	return &elbv2sdk.DeregisterTargetsOutput{}, nil
+}
+
+func (c *dryRunELBV2) CreateListenerWithContext(_ context.Context, input *elbv2sdk.CreateListenerInput) (*elbv2sdk.CreateListenerOutput, error) {
+	resource := fmt.Sprintf("%s:%d", awssdk.ToString(input.LoadBalancerArn), awssdk.ToInt32(input.Port))
+	redactedInput := *input
+	redactedInput.DefaultActions = redactActions(input.DefaultActions)
+	c.record(ChangeTypeCreate, "CreateListener", resource, &redactedInput)
+	return &elbv2sdk.CreateListenerOutput{
+		Listeners: []elbv2types.Listener{
+			{
+				ListenerArn:     awssdk.String(c.placeholderARN("listener", fmt.Sprintf("%d", awssdk.ToInt32(input.Port)))),
+				LoadBalancerArn: input.LoadBalancerArn,
+				Port:            input.Port,
+				Protocol:        input.Protocol,
+				Certificates:    input.Certificates,
+				SslPolicy:       input.SslPolicy,
+				AlpnPolicy:      input.AlpnPolicy,
+				DefaultActions:  input.DefaultActions,
+			},
+		},
+	}, nil
+}
+
+func (c *dryRunELBV2) ModifyListenerWithContext(_ context.Context, input *elbv2sdk.ModifyListenerInput) (*elbv2sdk.ModifyListenerOutput, error) {
+	redactedInput := *input
+	redactedInput.DefaultActions = redactActions(input.DefaultActions)
+	c.record(ChangeTypeUpdate, "ModifyListener", awssdk.ToString(input.ListenerArn), &redactedInput)
+	return &elbv2sdk.ModifyListenerOutput{}, nil
+}
+
+func (c *dryRunELBV2) DeleteListenerWithContext(_ context.Context, input *elbv2sdk.DeleteListenerInput) (*elbv2sdk.DeleteListenerOutput, error) {
+	c.record(ChangeTypeDelete, "DeleteListener", awssdk.ToString(input.ListenerArn), input)
+	return &elbv2sdk.DeleteListenerOutput{}, nil
+}
+
+func (c *dryRunELBV2) ModifyListenerAttributesWithContext(_ context.Context, input *elbv2sdk.ModifyListenerAttributesInput) (*elbv2sdk.ModifyListenerAttributesOutput, error) {
+	c.record(ChangeTypeUpdate, "ModifyListenerAttributes", awssdk.ToString(input.ListenerArn), input)
+	return &elbv2sdk.ModifyListenerAttributesOutput{Attributes: input.Attributes}, nil
+}
+
+func (c *dryRunELBV2) AddListenerCertificatesWithContext(_ context.Context, input *elbv2sdk.AddListenerCertificatesInput) (*elbv2sdk.AddListenerCertificatesOutput, error) {
+	c.record(ChangeTypeUpdate, "AddListenerCertificates", awssdk.ToString(input.ListenerArn), input)
+	return &elbv2sdk.AddListenerCertificatesOutput{Certificates: input.Certificates}, nil
+}
+
+func (c *dryRunELBV2) RemoveListenerCertificatesWithContext(_ context.Context, input *elbv2sdk.RemoveListenerCertificatesInput) (*elbv2sdk.RemoveListenerCertificatesOutput, error) {
+	c.record(ChangeTypeUpdate, "RemoveListenerCertificates", awssdk.ToString(input.ListenerArn), input)
+	return &elbv2sdk.RemoveListenerCertificatesOutput{}, nil
+}
+
+func (c *dryRunELBV2) CreateRuleWithContext(_ context.Context, input *elbv2sdk.CreateRuleInput) (*elbv2sdk.CreateRuleOutput, error) {
+	resource := fmt.Sprintf("%s:%d", awssdk.ToString(input.ListenerArn), awssdk.ToInt32(input.Priority))
+	redactedInput := *input
+	redactedInput.Actions = redactActions(input.Actions)
+	c.record(ChangeTypeCreate, "CreateRule", resource, &redactedInput)
+	return &elbv2sdk.CreateRuleOutput{
+		Rules: []elbv2types.Rule{
+			{
+				RuleArn:    awssdk.String(c.placeholderARN("listener-rule", fmt.Sprintf("%d", awssdk.ToInt32(input.Priority)))),
+				Priority:   awssdk.String(fmt.Sprintf("%d", awssdk.ToInt32(input.Priority))),
+				Conditions: input.Conditions,
+				Actions:    input.Actions,
+			},
+		},
+	}, nil
+}
+
+func (c *dryRunELBV2) ModifyRuleWithContext(_ context.Context, input *elbv2sdk.ModifyRuleInput) (*elbv2sdk.ModifyRuleOutput, error) {
+	redactedInput := *input
+	redactedInput.Actions = redactActions(input.Actions)
+	c.record(ChangeTypeUpdate, "ModifyRule", awssdk.ToString(input.RuleArn), &redactedInput)
+	return &elbv2sdk.ModifyRuleOutput{}, nil
+}
+
+func (c *dryRunELBV2) SetRulePrioritiesWithContext(_ context.Context, input *elbv2sdk.SetRulePrioritiesInput) (*elbv2sdk.SetRulePrioritiesOutput, error) {
+	var ruleARNs []string
+	for _, rulePriority := range input.RulePriorities {
+		ruleARNs = append(ruleARNs, awssdk.ToString(rulePriority.RuleArn))
+	}
+	c.record(ChangeTypeUpdate, "SetRulePriorities", strings.Join(ruleARNs, ","), input)
+	return &elbv2sdk.SetRulePrioritiesOutput{}, nil
+}
+
+func (c *dryRunELBV2) DeleteRuleWithContext(_ context.Context, input *elbv2sdk.DeleteRuleInput) (*elbv2sdk.DeleteRuleOutput, error) {
+	c.record(ChangeTypeDelete, "DeleteRule", awssdk.ToString(input.RuleArn), input)
+	return &elbv2sdk.DeleteRuleOutput{}, nil
+}
+
+func (c *dryRunELBV2) DescribeLoadBalancersAsList(ctx context.Context, input *elbv2sdk.DescribeLoadBalancersInput) ([]elbv2types.LoadBalancer, error) {
+	realARNs, hasPlaceholder := filterPlaceholderIDs(input.LoadBalancerArns)
+	if !hasPlaceholder {
+		return c.ELBV2.DescribeLoadBalancersAsList(ctx, input)
+	}
+	var lbs []elbv2types.LoadBalancer
+	if len(realARNs) != 0 {
+		realInput := *input
+		realInput.LoadBalancerArns = realARNs
+		realLBs, err := c.ELBV2.DescribeLoadBalancersAsList(ctx, &realInput)
+		if err != nil {
+			return nil, err
+		}
+		lbs = append(lbs, realLBs...)
+	}
+	c.lbByARNMutex.Lock()
+	defer c.lbByARNMutex.Unlock()
+	for _, lbARN := range input.LoadBalancerArns {
+		if lb, exists := c.lbByARN[lbARN]; exists {
+			lbs = append(lbs, lb)
+		}
+	}
+	return lbs, nil
+}
+
+func (c *dryRunELBV2) WaitUntilLoadBalancerAvailableWithContext(ctx context.Context, input *elbv2sdk.DescribeLoadBalancersInput) error {
+	realARNs, hasPlaceholder := filterPlaceholderIDs(input.LoadBalancerArns)
+	if hasPlaceholder && len(realARNs) == 0 {
+		return nil
+	}
+	realInput := *input
+	realInput.LoadBalancerArns = realARNs
+	return c.ELBV2.WaitUntilLoadBalancerAvailableWithContext(ctx, &realInput)
+}
+
+func (c *dryRunELBV2) DescribeLoadBalancerAttributesWithContext(ctx context.Context, input *elbv2sdk.DescribeLoadBalancerAttributesInput) (*elbv2sdk.DescribeLoadBalancerAttributesOutput, error) {
+	if IsPlaceholderID(awssdk.ToString(input.LoadBalancerArn)) {
+		return &elbv2sdk.DescribeLoadBalancerAttributesOutput{}, nil
+	}
+	return c.ELBV2.DescribeLoadBalancerAttributesWithContext(ctx, input)
+}
+
+func (c *dryRunELBV2) DescribeCapacityReservationWithContext(ctx context.Context, input *elbv2sdk.DescribeCapacityReservationInput) (*elbv2sdk.DescribeCapacityReservationOutput, error) {
+	if IsPlaceholderID(awssdk.ToString(input.LoadBalancerArn)) {
+		return &elbv2sdk.DescribeCapacityReservationOutput{}, nil
+	}
+	return c.ELBV2.DescribeCapacityReservationWithContext(ctx, input)
+}
+
+func (c *dryRunELBV2) DescribeTargetGroupsAsList(ctx context.Context, input *elbv2sdk.DescribeTargetGroupsInput) ([]elbv2types.TargetGroup, error) {
+	if IsPlaceholderID(awssdk.ToString(input.LoadBalancerArn)) {
+		return nil, nil
+	}
+	realARNs, hasPlaceholder := filterPlaceholderIDs(input.TargetGroupArns)
+	if !hasPlaceholder {
+		return c.ELBV2.DescribeTargetGroupsAsList(ctx, input)
+	}
+	if len(realARNs) == 0 {
+		return nil, nil
+	}
+	realInput := *input
+	realInput.TargetGroupArns = realARNs
+	return c.ELBV2.DescribeTargetGroupsAsList(ctx, &realInput)
+}
+
+func (c *dryRunELBV2) DescribeTargetGroupAttributesWithContext(ctx context.Context, input *elbv2sdk.DescribeTargetGroupAttributesInput) (*elbv2sdk.DescribeTargetGroupAttributesOutput, error) {
+	if IsPlaceholderID(awssdk.ToString(input.TargetGroupArn)) {
+		return &elbv2sdk.DescribeTargetGroupAttributesOutput{}, nil
+	}
+	return c.ELBV2.DescribeTargetGroupAttributesWithContext(ctx, input)
+}
+
+func (c *dryRunELBV2) DescribeTargetHealthWithContext(ctx context.Context, input *elbv2sdk.DescribeTargetHealthInput) (*elbv2sdk.DescribeTargetHealthOutput, error) {
+	if IsPlaceholderID(awssdk.ToString(input.TargetGroupArn)) {
+		return &elbv2sdk.DescribeTargetHealthOutput{}, nil
+	}
+	return c.ELBV2.DescribeTargetHealthWithContext(ctx, input)
+}
+
+func (c *dryRunELBV2) DescribeListenersAsList(ctx context.Context, input *elbv2sdk.DescribeListenersInput) ([]elbv2types.Listener, error) {
+	if IsPlaceholderID(awssdk.ToString(input.LoadBalancerArn)) {
+		return nil, nil
+	}
+	realARNs, hasPlaceholder := filterPlaceholderIDs(input.ListenerArns)
+	if !hasPlaceholder {
+		return c.ELBV2.DescribeListenersAsList(ctx, input)
+	}
+	if len(realARNs) == 0 {
+		return nil, nil
+	}
+	realInput := *input
+	realInput.ListenerArns = realARNs
+	return c.ELBV2.DescribeListenersAsList(ctx, &realInput)
+}
+
+func (c *dryRunELBV2) DescribeListenerAttributesWithContext(ctx context.Context, input *elbv2sdk.DescribeListenerAttributesInput) (*elbv2sdk.DescribeListenerAttributesOutput, error) {
+	if IsPlaceholderID(awssdk.ToString(input.ListenerArn)) {
+		return &elbv2sdk.DescribeListenerAttributesOutput{}, nil
+	}
+	return c.ELBV2.DescribeListenerAttributesWithContext(ctx, input)
+}
+
+func (c *dryRunELBV2) DescribeListenerCertificatesAsList(ctx context.Context, input *elbv2sdk.DescribeListenerCertificatesInput) ([]elbv2types.Certificate, error) {
+	if IsPlaceholderID(awssdk.ToString(input.ListenerArn)) {
+		return nil, nil
+	}
+	return c.ELBV2.DescribeListenerCertificatesAsList(ctx, input)
+}
+
+func (c *dryRunELBV2) DescribeRulesAsList(ctx context.Context, input *elbv2sdk.DescribeRulesInput) ([]elbv2types.Rule, error) {
+	if IsPlaceholderID(awssdk.ToString(input.ListenerArn)) {
+		return nil, nil
+	}
+	realARNs, hasPlaceholder := filterPlaceholderIDs(input.RuleArns)
+	if !hasPlaceholder {
+		return c.ELBV2.DescribeRulesAsList(ctx, input)
+	}
+	if len(realARNs) == 0 {
+		return nil, nil
+	}
+	realInput := *input
+	realInput.RuleArns = realARNs
+	return c.ELBV2.DescribeRulesAsList(ctx, &realInput)
+}
+
+func (c *dryRunELBV2) DescribeTagsWithContext(ctx context.Context, input *elbv2sdk.DescribeTagsInput) (*elbv2sdk.DescribeTagsOutput, error) {
+	realARNs, hasPlaceholder := filterPlaceholderIDs(input.ResourceArns)
+	if !hasPlaceholder {
+		return c.ELBV2.DescribeTagsWithContext(ctx, input)
+	}
+	if len(realARNs) == 0 {
+		return &elbv2sdk.DescribeTagsOutput{}, nil
+	}
+	realInput := *input
+	realInput.ResourceArns = realARNs
+	return c.ELBV2.DescribeTagsWithContext(ctx, &realInput)
+}
+
+func (c *dryRunELBV2) AssumeRole(ctx context.Context, assumeRoleArn string, externalId string) (services.ELBV2, error) {
+	assumedRoleClient, err := c.ELBV2.AssumeRole(ctx, assumeRoleArn, externalId)
+	if err != nil {
+		return nil, err
+	}
+	return NewELBV2(assumedRoleClient, c.region, c.recorder), nil
+}
+
+// redactActions returns a copy of the actions without the OIDC client secrets.
+func redactActions(actions []elbv2types.Action) []elbv2types.Action {
+	if actions == nil {
+		return nil
+	}
+	redactedActions := make([]elbv2types.Action, 0, len(actions))
+	for _, action := range actions {
+		if action.AuthenticateOidcConfig != nil && action.AuthenticateOidcConfig.ClientSecret != nil {
+			oidcConfig := *action.AuthenticateOidcConfig
+			oidcConfig.ClientSecret = awssdk.String(redactedValue)
+			action.AuthenticateOidcConfig = &oidcConfig
+		}
+		redactedActions = append(redactedActions, action)
+	}
+	return redactedActions
+}
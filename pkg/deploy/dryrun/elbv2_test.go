@@ -0,0 +1,134 @@
+package dryrun
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+func Test_dryRunELBV2_CreateLoadBalancer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	realLB := elbv2types.LoadBalancer{
+		LoadBalancerArn: awssdk.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/existing/1234"),
+	}
+	elbv2Client := services.NewMockELBV2(ctrl)
+	elbv2Client.EXPECT().DescribeLoadBalancersAsList(gomock.Any(), &elbv2sdk.DescribeLoadBalancersInput{
+		LoadBalancerArns: []string{awssdk.ToString(realLB.LoadBalancerArn)},
+	}).Return([]elbv2types.LoadBalancer{realLB}, nil)
+
+	recorder := NewRecorder()
+	recorder.SetSynthesizer("*elbv2.loadBalancerSynthesizer")
+	client := NewELBV2(elbv2Client, "us-west-2", recorder)
+
+	createOutput, err := client.CreateLoadBalancerWithContext(context.Background(), &elbv2sdk.CreateLoadBalancerInput{
+		Name:    awssdk.String("my-lb"),
+		Type:    elbv2types.LoadBalancerTypeEnumApplication,
+		Subnets: []string{"subnet-a", "subnet-b"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, createOutput.LoadBalancers, 1)
+	lbARN := awssdk.ToString(createOutput.LoadBalancers[0].LoadBalancerArn)
+	assert.True(t, IsPlaceholderID(lbARN))
+
+	attrsOutput, err := client.DescribeLoadBalancerAttributesWithContext(context.Background(), &elbv2sdk.DescribeLoadBalancerAttributesInput{
+		LoadBalancerArn: awssdk.String(lbARN),
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, attrsOutput.Attributes)
+
+	assert.NoError(t, client.WaitUntilLoadBalancerAvailableWithContext(context.Background(), &elbv2sdk.DescribeLoadBalancersInput{
+		LoadBalancerArns: []string{lbARN},
+	}))
+
+	lbs, err := client.DescribeLoadBalancersAsList(context.Background(), &elbv2sdk.DescribeLoadBalancersInput{
+		LoadBalancerArns: []string{awssdk.ToString(realLB.LoadBalancerArn), lbARN},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []elbv2types.LoadBalancer{realLB, createOutput.LoadBalancers[0]}, lbs)
+
+	plan := recorder.Plan()
+	assert.Len(t, plan.Changes, 1)
+	assert.Equal(t, Change{
+		Synthesizer: "*elbv2.loadBalancerSynthesizer",
+		Type:        ChangeTypeCreate,
+		Operation:   "elasticloadbalancing:CreateLoadBalancer",
+		Resource:    "my-lb",
+		Input: &elbv2sdk.CreateLoadBalancerInput{
+			Name:    awssdk.String("my-lb"),
+			Type:    elbv2types.LoadBalancerTypeEnumApplication,
+			Subnets: []string{"subnet-a", "subnet-b"},
+		},
+	}, plan.Changes[0])
+}
+
+func Test_dryRunELBV2_CreateRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	recorder := NewRecorder()
+	client := NewELBV2(services.NewMockELBV2(ctrl), "us-west-2", recorder)
+	actions := []elbv2types.Action{
+		{
+			Type: elbv2types.ActionTypeEnumAuthenticateOidc,
+			AuthenticateOidcConfig: &elbv2types.AuthenticateOidcActionConfig{
+				ClientId:     awssdk.String("client-id"),
+				ClientSecret: awssdk.String("client-secret"),
+			},
+		},
+	}
+	_, err := client.CreateRuleWithContext(context.Background(), &elbv2sdk.CreateRuleInput{
+		ListenerArn: awssdk.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/existing/1234/5678"),
+		Priority:    awssdk.Int32(1),
+		Actions:     actions,
+	})
+	assert.NoError(t, err)
+
+	plan := recorder.Plan()
+	assert.Len(t, plan.Changes, 1)
+	recordedInput := plan.Changes[0].Input.(*elbv2sdk.CreateRuleInput)
+	assert.Equal(t, redactedValue, awssdk.ToString(recordedInput.Actions[0].AuthenticateOidcConfig.ClientSecret))
+	assert.Equal(t, "client-secret", awssdk.ToString(actions[0].AuthenticateOidcConfig.ClientSecret))
+}
+
+func Test_filterPlaceholderIDs(t *testing.T) {
+	tests := []struct {
+		name               string
+		ids                []string
+		wantIDs            []string
+		wantHasPlaceholder bool
+	}{
+		{
+			name:               "no placeholder",
+			ids:                []string{"sg-a", "sg-b"},
+			wantIDs:            []string{"sg-a", "sg-b"},
+			wantHasPlaceholder: false,
+		},
+		{
+			name:               "mixed",
+			ids:                []string{"sg-a", "sg-lbc-dry-run-placeholder-1"},
+			wantIDs:            []string{"sg-a"},
+			wantHasPlaceholder: true,
+		},
+		{
+			name:               "only placeholder",
+			ids:                []string{"sg-lbc-dry-run-placeholder-1"},
+			wantIDs:            nil,
+			wantHasPlaceholder: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotIDs, gotHasPlaceholder := filterPlaceholderIDs(tt.ids)
+			assert.Equal(t, tt.wantIDs, gotIDs)
+			assert.Equal(t, tt.wantHasPlaceholder, gotHasPlaceholder)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package dryrun
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewK8sClient constructs a k8s client that records writes instead of persisting them.
+// Writes are still sent to the API server in dry-run mode, so that invalid objects fail the plan.
+func NewK8sClient(k8sClient client.Client, recorder *Recorder) client.Client {
+	return &dryRunK8sClient{
+		Client:   client.NewDryRunClient(k8sClient),
+		recorder: recorder,
+	}
+}
+
+var _ client.Client = &dryRunK8sClient{}
+
+type dryRunK8sClient struct {
+	client.Client
+	recorder *Recorder
+}
+
+func (c *dryRunK8sClient) record(changeType ChangeType, verb string, obj client.Object) {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if gvk, err := c.Client.GroupVersionKindFor(obj); err == nil {
+		kind = gvk.Kind
+	}
+	c.recorder.Record(changeType, fmt.Sprintf("kubernetes:%s%s", verb, kind), k8s.NamespacedName(obj).String(), obj)
+}
+
+func (c *dryRunK8sClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	c.record(ChangeTypeCreate, "Create", obj)
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *dryRunK8sClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	c.record(ChangeTypeUpdate, "Update", obj)
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func (c *dryRunK8sClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	c.record(ChangeTypeUpdate, "Patch", obj)
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *dryRunK8sClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	c.record(ChangeTypeDelete, "Delete", obj)
+	return c.Client.Delete(ctx, obj, opts...)
+}
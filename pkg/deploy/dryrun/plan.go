@@ -0,0 +1,144 @@
+package dryrun
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// placeholderMarker is part of every identifier generated for resources that would be created.
+// Reads of such resources are answered locally, as they don't exist in AWS.
+const placeholderMarker = "lbc-dry-run-placeholder"
+
+// ChangeType is the type of change an API call makes to a resource.
+type ChangeType string
+
+const (
+	ChangeTypeCreate ChangeType = "Create"
+	ChangeTypeUpdate ChangeType = "Update"
+	ChangeTypeDelete ChangeType = "Delete"
+)
+
+// Change is a mutating API call that deploying a stack would make.
+type Change struct {
+	// Synthesizer is the synthesizer that would make the change.
+	Synthesizer string `json:"synthesizer"`
+	// Type of the change.
+	Type ChangeType `json:"type"`
+	// Operation is the API operation, e.g. elasticloadbalancing:ModifyTargetGroupAttributes.
+	Operation string `json:"operation"`
+	// Resource identifies the changed resource, resources that would be created are identified by their name if any.
+	Resource string `json:"resource,omitempty"`
+	// Input is the request of the operation, with secrets redacted.
+	Input interface{} `json:"input,omitempty"`
+}
+
+// Plan is the set of changes deploying a stack would make.
+type Plan struct {
+	Changes []Change `json:"changes"`
+}
+
+// Summary returns a short human-readable summary of the plan.
+func (p *Plan) Summary() string {
+	countByType := p.countByType()
+	return fmt.Sprintf("%d to create, %d to update, %d to delete",
+		countByType[ChangeTypeCreate], countByType[ChangeTypeUpdate], countByType[ChangeTypeDelete])
+}
+
+// PlannedChanges returns the summary of the plan stored in the reconcile status of the planned objects.
+func (p *Plan) PlannedChanges() k8s.PlannedChanges {
+	countByType := p.countByType()
+	return k8s.PlannedChanges{
+		Create:      countByType[ChangeTypeCreate],
+		Update:      countByType[ChangeTypeUpdate],
+		Delete:      countByType[ChangeTypeDelete],
+		Description: p.Describe(),
+	}
+}
+
+func (p *Plan) countByType() map[ChangeType]int {
+	countByType := make(map[ChangeType]int)
+	for _, change := range p.Changes {
+		countByType[change.Type]++
+	}
+	return countByType
+}
+
+// Describe returns a human-readable description of every change in the plan.
+func (p *Plan) Describe() string {
+	var sb strings.Builder
+	sb.WriteString(p.Summary())
+	for _, change := range p.Changes {
+		sb.WriteString(fmt.Sprintf("\n%s %s %s", change.Type, change.Operation, change.Resource))
+	}
+	return sb.String()
+}
+
+// Recorder records the changes made through the dry-run clients.
+type Recorder struct {
+	mutex       sync.Mutex
+	synthesizer string
+	changes     []Change
+	lastID      int
+}
+
+// NewRecorder constructs new Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// SetSynthesizer sets the synthesizer that subsequently recorded changes are attributed to.
+func (r *Recorder) SetSynthesizer(synthesizer string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.synthesizer = synthesizer
+}
+
+// Record records a change.
+func (r *Recorder) Record(changeType ChangeType, operation string, resource string, input interface{}) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.changes = append(r.changes, Change{
+		Synthesizer: r.synthesizer,
+		Type:        changeType,
+		Operation:   operation,
+		Resource:    resource,
+		Input:       input,
+	})
+}
+
+// Plan returns the plan of all changes recorded so far.
+func (r *Recorder) Plan() *Plan {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return &Plan{Changes: append([]Change(nil), r.changes...)}
+}
+
+// nextPlaceholderID generates a unique identifier for a resource that would be created.
+func (r *Recorder) nextPlaceholderID() string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.lastID++
+	return fmt.Sprintf("%s-%d", placeholderMarker, r.lastID)
+}
+
+// IsPlaceholderID checks whether the identifier belongs to a resource that would be created.
+func IsPlaceholderID(id string) bool {
+	return strings.Contains(id, placeholderMarker)
+}
+
+// filterPlaceholderIDs splits the identifiers into real identifiers and whether any placeholder identifier was present.
+func filterPlaceholderIDs(ids []string) ([]string, bool) {
+	var realIDs []string
+	hasPlaceholder := false
+	for _, id := range ids {
+		if IsPlaceholderID(id) {
+			hasPlaceholder = true
+			continue
+		}
+		realIDs = append(realIDs, id)
+	}
+	return realIDs, hasPlaceholder
+}
@@ -0,0 +1,95 @@
+package dryrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+func TestPlan_Describe(t *testing.T) {
+	tests := []struct {
+		name string
+		plan Plan
+		want string
+	}{
+		{
+			name: "empty plan",
+			plan: Plan{},
+			want: "0 to create, 0 to update, 0 to delete",
+		},
+		{
+			name: "plan with changes",
+			plan: Plan{
+				Changes: []Change{
+					{
+						Type:      ChangeTypeCreate,
+						Operation: "elasticloadbalancing:CreateTargetGroup",
+						Resource:  "k8s-default-svc-1234",
+					},
+					{
+						Type:      ChangeTypeUpdate,
+						Operation: "ec2:AuthorizeSecurityGroupIngress",
+						Resource:  "sg-a",
+					},
+					{
+						Type:      ChangeTypeUpdate,
+						Operation: "elasticloadbalancing:AddTags",
+						Resource:  "arn:lb",
+					},
+				},
+			},
+			want: "1 to create, 2 to update, 0 to delete\n" +
+				"Create elasticloadbalancing:CreateTargetGroup k8s-default-svc-1234\n" +
+				"Update ec2:AuthorizeSecurityGroupIngress sg-a\n" +
+				"Update elasticloadbalancing:AddTags arn:lb",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.plan.Describe())
+		})
+	}
+}
+
+func TestRecorder_Record(t *testing.T) {
+	recorder := NewRecorder()
+	recorder.Record(ChangeTypeCreate, "ec2:CreateSecurityGroup", "my-sg", nil)
+	recorder.SetSynthesizer("*ec2.securityGroupSynthesizer")
+	recorder.Record(ChangeTypeDelete, "ec2:DeleteSecurityGroup", "sg-a", nil)
+
+	assert.Equal(t, &Plan{
+		Changes: []Change{
+			{
+				Type:      ChangeTypeCreate,
+				Operation: "ec2:CreateSecurityGroup",
+				Resource:  "my-sg",
+			},
+			{
+				Synthesizer: "*ec2.securityGroupSynthesizer",
+				Type:        ChangeTypeDelete,
+				Operation:   "ec2:DeleteSecurityGroup",
+				Resource:    "sg-a",
+			},
+		},
+	}, recorder.Plan())
+	assert.NotEqual(t, recorder.nextPlaceholderID(), recorder.nextPlaceholderID())
+}
+
+func TestPlan_PlannedChanges(t *testing.T) {
+	plan := Plan{
+		Changes: []Change{
+			{Type: ChangeTypeCreate, Operation: "elasticloadbalancing:CreateTargetGroup", Resource: "k8s-default-svc-1234"},
+			{Type: ChangeTypeDelete, Operation: "elasticloadbalancing:DeleteListener", Resource: "arn:listener"},
+			{Type: ChangeTypeDelete, Operation: "elasticloadbalancing:DeleteTargetGroup", Resource: "arn:tg"},
+		},
+	}
+	assert.Equal(t, k8s.PlannedChanges{
+		Create: 1,
+		Delete: 2,
+		Description: "1 to create, 0 to update, 2 to delete\n" +
+			"Create elasticloadbalancing:CreateTargetGroup k8s-default-svc-1234\n" +
+			"Delete elasticloadbalancing:DeleteListener arn:listener\n" +
+			"Delete elasticloadbalancing:DeleteTargetGroup arn:tg",
+	}, plan.PlannedChanges())
+}
@@ -158,7 +158,10 @@ func (m *defaultListenerManager) updateSDKListenerWithSettings(ctx context.Conte
 	if err != nil {
 		return err
 	}
-	desiredDefaultCerts, _ := buildSDKCertificates(resLS.Spec.Certificates)
+	desiredDefaultCerts, _, err := buildSDKCertificates(resLS.Spec.Certificates)
+	if err != nil {
+		return err
+	}
 	desiredDefaultMutualAuthentication := buildSDKMutualAuthenticationConfig(resLS.Spec.MutualAuthentication)
 	if !isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts, desiredDefaultMutualAuthentication) {
 		return nil
@@ -190,7 +193,10 @@ func (m *defaultListenerManager) updateSDKListenerWithExtraCertificates(ctx cont
 	}
 
 	desiredExtraCertARNs := sets.NewString()
-	_, desiredExtraCerts := buildSDKCertificates(resLS.Spec.Certificates)
+	_, desiredExtraCerts, err := buildSDKCertificates(resLS.Spec.Certificates)
+	if err != nil {
+		return err
+	}
 	for _, cert := range desiredExtraCerts {
 		desiredExtraCertARNs.Insert(awssdk.ToString(cert.CertificateArn))
 	}
@@ -313,7 +319,10 @@ func buildSDKCreateListenerInput(lsSpec elbv2model.ListenerSpec, featureGates co
 		return nil, err
 	}
 	sdkObj.DefaultActions = defaultActions
-	sdkObj.Certificates, _ = buildSDKCertificates(lsSpec.Certificates)
+	sdkObj.Certificates, _, err = buildSDKCertificates(lsSpec.Certificates)
+	if err != nil {
+		return nil, err
+	}
 	sdkObj.SslPolicy = lsSpec.SSLPolicy
 	if len(lsSpec.ALPNPolicy) != 0 {
 		sdkObj.AlpnPolicy = lsSpec.ALPNPolicy
@@ -340,24 +349,41 @@ func buildSDKModifyListenerInput(lsSpec elbv2model.ListenerSpec, desiredDefaultA
 
 // buildSDKCertificates builds the certificate list for listener.
 // returns the default certificates and extra certificates.
-func buildSDKCertificates(modelCerts []elbv2model.Certificate) ([]elbv2types.Certificate, []elbv2types.Certificate) {
+func buildSDKCertificates(modelCerts []elbv2model.Certificate) ([]elbv2types.Certificate, []elbv2types.Certificate, error) {
 	if len(modelCerts) == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	var defaultSDKCerts []elbv2types.Certificate
 	var extraSDKCerts []elbv2types.Certificate
-	defaultSDKCerts = append(defaultSDKCerts, buildSDKCertificate(modelCerts[0]))
+	defaultSDKCert, err := buildSDKCertificate(modelCerts[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	defaultSDKCerts = append(defaultSDKCerts, defaultSDKCert)
 	for _, cert := range modelCerts[1:] {
-		extraSDKCerts = append(extraSDKCerts, buildSDKCertificate(cert))
+		extraSDKCert, err := buildSDKCertificate(cert)
+		if err != nil {
+			return nil, nil, err
+		}
+		extraSDKCerts = append(extraSDKCerts, extraSDKCert)
 	}
-	return defaultSDKCerts, extraSDKCerts
+	return defaultSDKCerts, extraSDKCerts, nil
 }
 
-func buildSDKCertificate(modelCert elbv2model.Certificate) elbv2types.Certificate {
+func buildSDKCertificate(modelCert elbv2model.Certificate) (elbv2types.Certificate, error) {
+	if modelCert.ImportedCertificateARN != nil {
+		certARN, err := modelCert.ImportedCertificateARN.Resolve(context.Background())
+		if err != nil {
+			return elbv2types.Certificate{}, err
+		}
+		return elbv2types.Certificate{
+			CertificateArn: awssdk.String(certARN),
+		}, nil
+	}
 	return elbv2types.Certificate{
 		CertificateArn: modelCert.CertificateARN,
-	}
+	}, nil
 }
 
 // buildSDKMutualAuthenticationConfig builds the mutual TLS authentication config for listener
@@ -29,6 +29,8 @@ type ListenerRuleManager interface {
 	Delete(ctx context.Context, sdkLR ListenerRuleWithTags) error
 
 	SetRulePriorities(ctx context.Context, matchedResAndSDKLRsBySettings []resAndSDKListenerRulePair, unmatchedSDKLRs []ListenerRuleWithTags) error
+
+	MoveRules(ctx context.Context, lrsToMove []resAndSDKListenerRulePair, plannedPriorities map[*elbv2model.ListenerRule]int32) error
 }
 
 // NewDefaultListenerRuleManager constructs new defaultListenerRuleManager.
@@ -137,6 +139,18 @@ func (m *defaultListenerRuleManager) SetRulePriorities(ctx context.Context, matc
 	return nil
 }
 
+func (m *defaultListenerRuleManager) MoveRules(ctx context.Context, lrsToMove []resAndSDKListenerRulePair, plannedPriorities map[*elbv2model.ListenerRule]int32) error {
+	req := buildSDKMoveRulesInput(lrsToMove, plannedPriorities)
+	m.logger.Info("moving listener rules",
+		"rule priority pairs", req.RulePriorities)
+	if _, err := m.elbv2Client.SetRulePrioritiesWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("moved listener rules",
+		"rule priority pairs", req.RulePriorities)
+	return nil
+}
+
 func (m *defaultListenerRuleManager) updateSDKListenerRuleWithTags(ctx context.Context, resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) error {
 	desiredTags := m.trackingProvider.ResourceTags(resLR.Stack(), resLR, resLR.Spec.Tags)
 	return m.taggingManager.ReconcileTags(ctx, awssdk.ToString(sdkLR.ListenerRule.RuleArn), desiredTags,
@@ -173,6 +187,9 @@ func buildSDKCreateListenerRuleInput(lrSpec elbv2model.ListenerRuleSpec, desired
 	sdkObj := &elbv2sdk.CreateRuleInput{}
 	sdkObj.ListenerArn = awssdk.String(lsARN)
 	sdkObj.Priority = awssdk.Int32(lrSpec.Priority)
+	if desiredActionsAndConditions != nil && desiredActionsAndConditions.desiredPriority != 0 {
+		sdkObj.Priority = awssdk.Int32(desiredActionsAndConditions.desiredPriority)
+	}
 	if desiredActionsAndConditions != nil && desiredActionsAndConditions.desiredActions != nil {
 		sdkObj.Actions = desiredActionsAndConditions.desiredActions
 	} else {
@@ -232,6 +249,21 @@ func buildSDKSetRulePrioritiesInput(matchedResAndSDKLRsBySettings []resAndSDKLis
 	}
 	return sdkObj
 }
+
+// buildSDKMoveRulesInput builds the input to move the listener rules to their planned priorities in a single call.
+func buildSDKMoveRulesInput(lrsToMove []resAndSDKListenerRulePair, plannedPriorities map[*elbv2model.ListenerRule]int32) *elbv2sdk.SetRulePrioritiesInput {
+	rulePriorities := make([]elbv2types.RulePriorityPair, 0, len(lrsToMove))
+	for _, resAndSDKLR := range lrsToMove {
+		rulePriorities = append(rulePriorities, elbv2types.RulePriorityPair{
+			RuleArn:  resAndSDKLR.sdkLR.ListenerRule.RuleArn,
+			Priority: awssdk.Int32(plannedPriorities[resAndSDKLR.resLR]),
+		})
+	}
+	return &elbv2sdk.SetRulePrioritiesInput{
+		RulePriorities: rulePriorities,
+	}
+}
+
 func buildResListenerRuleStatus(sdkLR ListenerRuleWithTags) elbv2model.ListenerRuleStatus {
 	return elbv2model.ListenerRuleStatus{
 		RuleARN: awssdk.ToString(sdkLR.ListenerRule.RuleArn),
@@ -0,0 +1,341 @@
+package elbv2
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2equality "sigs.k8s.io/aws-load-balancer-controller/pkg/equality/elbv2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	// maxListenerRulePriority is the highest priority of a listener rule.
+	maxListenerRulePriority int32 = 50000
+	// listenerRulePrioritySpacing is the spacing between the priorities of rules appended to a listener,
+	// so that later rules can be inserted between them without moving them.
+	listenerRulePrioritySpacing int32 = 10
+)
+
+// listenerRulePlan is the make-before-break plan to converge the rules of a listener into the desired rules.
+// Existing rules are moved in a single call, new rules are created before any existing rule is modified or deleted,
+// so that requests are routed either as before or as desired throughout.
+type listenerRulePlan struct {
+	// plannedPriorities are the priorities planned for the desired rules, in the order of the desired rules.
+	plannedPriorities map[*elbv2model.ListenerRule]int32
+	// matchedLRs are desired rules reusing existing rules with the same conditions.
+	matchedLRs []resAndSDKListenerRulePair
+	// lrsToMove are existing rules to move to their planned priority.
+	lrsToMove []resAndSDKListenerRulePair
+	// lrsToModify are existing rules whose actions change.
+	lrsToModify []resAndSDKListenerRulePair
+	// lrsToCreate are desired rules without existing rule with the same conditions.
+	lrsToCreate []*elbv2model.ListenerRule
+	// lrsToDelete are existing rules which aren't desired anymore.
+	lrsToDelete []ListenerRuleWithTags
+	// lrsToDeleteFirst are existing rules which aren't desired anymore, deleted before new rules are created
+	// to free capacity of the load balancer. None of them overlaps a new rule.
+	lrsToDeleteFirst []ListenerRuleWithTags
+	// lrsToReplace are existing rules which aren't desired anymore, modified in place into desired rules
+	// because the load balancer lacks the capacity to create the desired rules before deleting them.
+	lrsToReplace []resAndSDKListenerRulePair
+}
+
+// planListenerRules plans the convergence of the existing rules of a listener into the desired rules.
+// Existing rules are reused by matching their conditions rather than their priority. The longest sequence of reused rules
+// that are already in the desired order keep their priority, other rules are planned evenly in the free priorities between them.
+func planListenerRules(resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags,
+	resLRDesiredActionsAndConditionsPairs map[*elbv2model.ListenerRule]*resLRDesiredActionsAndConditionsPair) (listenerRulePlan, error) {
+	resLRs = append([]*elbv2model.ListenerRule(nil), resLRs...)
+	sort.SliceStable(resLRs, func(i, j int) bool {
+		return resLRs[i].Spec.Priority < resLRs[j].Spec.Priority
+	})
+
+	matchedSDKLRs := matchSDKListenerRulesByConditions(resLRs, sdkLRs, resLRDesiredActionsAndConditionsPairs)
+	plannedPriorities, err := planListenerRulePriorities(matchedSDKLRs, sdkLRs)
+	if err != nil {
+		return listenerRulePlan{}, err
+	}
+
+	plan := listenerRulePlan{
+		plannedPriorities: make(map[*elbv2model.ListenerRule]int32, len(resLRs)),
+	}
+	matchedSDKLRIndexes := sets.New[int]()
+	for i, resLR := range resLRs {
+		plan.plannedPriorities[resLR] = plannedPriorities[i]
+		sdkLRIndex := matchedSDKLRs[i]
+		if sdkLRIndex < 0 {
+			plan.lrsToCreate = append(plan.lrsToCreate, resLR)
+			continue
+		}
+		matchedSDKLRIndexes.Insert(sdkLRIndex)
+		pair := resAndSDKListenerRulePair{resLR: resLR, sdkLR: sdkLRs[sdkLRIndex]}
+		plan.matchedLRs = append(plan.matchedLRs, pair)
+		if sdkListenerRulePriority(pair.sdkLR) != plannedPriorities[i] {
+			plan.lrsToMove = append(plan.lrsToMove, pair)
+		}
+		if !cmp.Equal(resLRDesiredActionsAndConditionsPairs[resLR].desiredActions, pair.sdkLR.ListenerRule.Actions, elbv2equality.CompareOptionForActions()) {
+			plan.lrsToModify = append(plan.lrsToModify, pair)
+		}
+	}
+	for i, sdkLR := range sdkLRs {
+		if !matchedSDKLRIndexes.Has(i) {
+			plan.lrsToDelete = append(plan.lrsToDelete, sdkLR)
+		}
+	}
+	return plan, nil
+}
+
+// fitListenerRulePlanIntoCapacity fits the plan into the capacity of the load balancer, that is the number of rules
+// that can be created before any rule is deleted. Since the rule quota is per load balancer, creating every new rule before
+// deleting obsolete ones would fail for good once the quota is reached. Obsolete rules that don't overlap any new rule are
+// deleted first, then obsolete rules are modified in place into new rules, preferably the ones they overlap.
+// If there still isn't enough capacity, the desired rules exceed the quota and the remaining new rules are created regardless.
+func fitListenerRulePlanIntoCapacity(plan *listenerRulePlan, capacity int,
+	resLRDesiredActionsAndConditionsPairs map[*elbv2model.ListenerRule]*resLRDesiredActionsAndConditionsPair) {
+	missingCapacity := len(plan.lrsToCreate) - capacity
+	if missingCapacity <= 0 {
+		return
+	}
+
+	var lrsToDelete []ListenerRuleWithTags
+	for _, sdkLR := range plan.lrsToDelete {
+		overlapsNewRule := false
+		for _, resLR := range plan.lrsToCreate {
+			if listenerRuleConditionsOverlap(sdkLR.ListenerRule.Conditions, resLRDesiredActionsAndConditionsPairs[resLR].desiredConditions) {
+				overlapsNewRule = true
+				break
+			}
+		}
+		if missingCapacity > 0 && !overlapsNewRule {
+			plan.lrsToDeleteFirst = append(plan.lrsToDeleteFirst, sdkLR)
+			missingCapacity--
+			continue
+		}
+		lrsToDelete = append(lrsToDelete, sdkLR)
+	}
+
+	var lrsToCreate []*elbv2model.ListenerRule
+	for _, resLR := range plan.lrsToCreate {
+		if missingCapacity <= 0 || len(lrsToDelete) == 0 {
+			lrsToCreate = append(lrsToCreate, resLR)
+			continue
+		}
+		replacedIndex := 0
+		for i, sdkLR := range lrsToDelete {
+			if listenerRuleConditionsOverlap(sdkLR.ListenerRule.Conditions, resLRDesiredActionsAndConditionsPairs[resLR].desiredConditions) {
+				replacedIndex = i
+				break
+			}
+		}
+		plan.lrsToReplace = append(plan.lrsToReplace, resAndSDKListenerRulePair{resLR: resLR, sdkLR: lrsToDelete[replacedIndex]})
+		lrsToDelete = append(lrsToDelete[:replacedIndex:replacedIndex], lrsToDelete[replacedIndex+1:]...)
+		missingCapacity--
+	}
+	plan.lrsToCreate = lrsToCreate
+	plan.lrsToDelete = lrsToDelete
+}
+
+// listenerRuleConditionsOverlap checks whether a request may match the conditions of both rules.
+// Rules don't overlap only if they have a host-header, path-pattern or http-request-method condition without common value,
+// values with wildcards are assumed to have values in common with any value.
+func listenerRuleConditionsOverlap(conditions []elbv2types.RuleCondition, otherConditions []elbv2types.RuleCondition) bool {
+	for _, field := range []string{"host-header", "path-pattern", "http-request-method"} {
+		values, exists := listenerRuleConditionValues(conditions, field)
+		otherValues, otherExists := listenerRuleConditionValues(otherConditions, field)
+		if !exists || !otherExists {
+			continue
+		}
+		if !listenerRuleConditionValuesOverlap(field, values, otherValues) {
+			return false
+		}
+	}
+	return true
+}
+
+// listenerRuleConditionValues returns the values of the condition of the rule on field, if the rule has one.
+func listenerRuleConditionValues(conditions []elbv2types.RuleCondition, field string) ([]string, bool) {
+	for _, condition := range conditions {
+		if awssdk.ToString(condition.Field) != field {
+			continue
+		}
+		switch {
+		case condition.HostHeaderConfig != nil:
+			return condition.HostHeaderConfig.Values, true
+		case condition.PathPatternConfig != nil:
+			return condition.PathPatternConfig.Values, true
+		case condition.HttpRequestMethodConfig != nil:
+			return condition.HttpRequestMethodConfig.Values, true
+		default:
+			return condition.Values, true
+		}
+	}
+	return nil, false
+}
+
+func listenerRuleConditionValuesOverlap(field string, values []string, otherValues []string) bool {
+	for _, value := range values {
+		for _, otherValue := range otherValues {
+			if strings.ContainsAny(value, "*?") || strings.ContainsAny(otherValue, "*?") {
+				return true
+			}
+			// host names are case-insensitive, paths and methods are case-sensitive.
+			if value == otherValue || (field == "host-header" && strings.EqualFold(value, otherValue)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchSDKListenerRulesByConditions matches the desired rules with existing rules with the same conditions, preferring
+// existing rules with the same actions. It returns the index of the matched existing rule for each desired rule, or -1.
+func matchSDKListenerRulesByConditions(resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags,
+	resLRDesiredActionsAndConditionsPairs map[*elbv2model.ListenerRule]*resLRDesiredActionsAndConditionsPair) []int {
+	matchedSDKLRs := make([]int, len(resLRs))
+	for i := range matchedSDKLRs {
+		matchedSDKLRs[i] = -1
+	}
+	unmatchedSDKLRIndexes := sets.New[int]()
+	for i := range sdkLRs {
+		unmatchedSDKLRIndexes.Insert(i)
+	}
+	for _, matchActions := range []bool{true, false} {
+		for i, resLR := range resLRs {
+			if matchedSDKLRs[i] >= 0 {
+				continue
+			}
+			desired := resLRDesiredActionsAndConditionsPairs[resLR]
+			for _, j := range sets.List(unmatchedSDKLRIndexes) {
+				sdkLR := sdkLRs[j].ListenerRule
+				if !cmp.Equal(desired.desiredConditions, sdkLR.Conditions, elbv2equality.CompareOptionForRuleConditions()) {
+					continue
+				}
+				if matchActions && !cmp.Equal(desired.desiredActions, sdkLR.Actions, elbv2equality.CompareOptionForActions()) {
+					continue
+				}
+				matchedSDKLRs[i] = j
+				unmatchedSDKLRIndexes.Delete(j)
+				break
+			}
+		}
+	}
+	return matchedSDKLRs
+}
+
+// planListenerRulePriorities plans the priorities of the desired rules, given the existing rules they're matched with.
+// Planned priorities never collide with the priorities of existing rules, unless the rule keeps its priority,
+// so that rules can be moved and created while all existing rules are still in place.
+func planListenerRulePriorities(matchedSDKLRs []int, sdkLRs []ListenerRuleWithTags) ([]int32, error) {
+	occupiedPriorities := sets.New[int32]()
+	for _, sdkLR := range sdkLRs {
+		occupiedPriorities.Insert(sdkListenerRulePriority(sdkLR))
+	}
+	currentPriorities := make([]int32, len(matchedSDKLRs))
+	for i, sdkLRIndex := range matchedSDKLRs {
+		if sdkLRIndex >= 0 {
+			currentPriorities[i] = sdkListenerRulePriority(sdkLRs[sdkLRIndex])
+		}
+	}
+
+	anchors := findLongestIncreasingPriorities(currentPriorities)
+	for {
+		plannedPriorities, infeasibleAnchor := planListenerRulePrioritiesAroundAnchors(currentPriorities, anchors, occupiedPriorities)
+		if plannedPriorities != nil {
+			return plannedPriorities, nil
+		}
+		if infeasibleAnchor < 0 {
+			return nil, errors.Errorf("not enough free priorities for %d listener rules besides %d existing rules", len(matchedSDKLRs), len(sdkLRs))
+		}
+		// there aren't enough free priorities between the anchors, the anchor is moved as well.
+		anchors.Delete(infeasibleAnchor)
+	}
+}
+
+// planListenerRulePrioritiesAroundAnchors plans the priorities of the desired rules between the anchor rules keeping their priority.
+// If there aren't enough free priorities between two anchors, it returns the anchor to drop instead, or -1 if there's none.
+func planListenerRulePrioritiesAroundAnchors(currentPriorities []int32, anchors sets.Set[int], occupiedPriorities sets.Set[int32]) ([]int32, int) {
+	plannedPriorities := make([]int32, len(currentPriorities))
+	lowerBound := int32(0)
+	runStart := 0
+	for i := 0; i <= len(currentPriorities); i++ {
+		if i < len(currentPriorities) && !anchors.Has(i) {
+			continue
+		}
+		upperBound := maxListenerRulePriority + 1
+		runLength := i - runStart
+		if i < len(currentPriorities) {
+			upperBound = currentPriorities[i]
+		} else if spacedUpperBound := lowerBound + listenerRulePrioritySpacing*int32(runLength+1); spacedUpperBound < upperBound &&
+			len(freeListenerRulePriorities(lowerBound, spacedUpperBound, occupiedPriorities)) >= runLength {
+			// rules appended after the last anchor are spaced, rather than spread up to the highest priority.
+			upperBound = spacedUpperBound
+		}
+		freePriorities := freeListenerRulePriorities(lowerBound, upperBound, occupiedPriorities)
+		if len(freePriorities) < runLength {
+			if i < len(currentPriorities) {
+				return nil, i
+			}
+			return nil, runStart - 1
+		}
+		for j := 0; j < runLength; j++ {
+			plannedPriorities[runStart+j] = freePriorities[(j+1)*len(freePriorities)/(runLength+1)]
+		}
+		if i < len(currentPriorities) {
+			plannedPriorities[i] = currentPriorities[i]
+			lowerBound = currentPriorities[i]
+		}
+		runStart = i + 1
+	}
+	return plannedPriorities, -1
+}
+
+// freeListenerRulePriorities returns the priorities between lowerBound and upperBound exclusively, that aren't occupied by existing rules.
+func freeListenerRulePriorities(lowerBound int32, upperBound int32, occupiedPriorities sets.Set[int32]) []int32 {
+	var freePriorities []int32
+	for priority := lowerBound + 1; priority < upperBound; priority++ {
+		if !occupiedPriorities.Has(priority) {
+			freePriorities = append(freePriorities, priority)
+		}
+	}
+	return freePriorities
+}
+
+// findLongestIncreasingPriorities finds the longest sequence of desired rules matched with existing rules whose priorities are already increasing.
+// Unmatched rules have a zero priority.
+func findLongestIncreasingPriorities(priorities []int32) sets.Set[int] {
+	lengths := make([]int, len(priorities))
+	previous := make([]int, len(priorities))
+	last := -1
+	for i, priority := range priorities {
+		previous[i] = -1
+		if priority == 0 {
+			continue
+		}
+		lengths[i] = 1
+		for j := 0; j < i; j++ {
+			if priorities[j] != 0 && priorities[j] < priority && lengths[j]+1 > lengths[i] {
+				lengths[i] = lengths[j] + 1
+				previous[i] = j
+			}
+		}
+		if last < 0 || lengths[i] > lengths[last] {
+			last = i
+		}
+	}
+	anchors := sets.New[int]()
+	for i := last; i >= 0; i = previous[i] {
+		anchors.Insert(i)
+	}
+	return anchors
+}
+
+func sdkListenerRulePriority(sdkLR ListenerRuleWithTags) int32 {
+	priority, _ := strconv.ParseInt(awssdk.ToString(sdkLR.ListenerRule.Priority), 10, 32)
+	return int32(priority)
+}
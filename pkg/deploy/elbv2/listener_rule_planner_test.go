@@ -0,0 +1,276 @@
+package elbv2
+
+import (
+	"strconv"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/stretchr/testify/assert"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_planListenerRulePriorities(t *testing.T) {
+	tests := []struct {
+		name          string
+		matchedSDKLRs []int
+		sdkPriorities []int32
+		want          []int32
+		wantErr       bool
+	}{
+		{
+			name:          "rules on an empty listener are spaced",
+			matchedSDKLRs: []int{-1, -1, -1},
+			want:          []int32{10, 20, 30},
+		},
+		{
+			name:          "rule inserted in the middle doesn't move other rules",
+			matchedSDKLRs: []int{0, 1, -1, 2},
+			sdkPriorities: []int32{10, 20, 30},
+			want:          []int32{10, 20, 25, 30},
+		},
+		{
+			name:          "only the rule out of order is moved",
+			matchedSDKLRs: []int{2, 0, 1},
+			sdkPriorities: []int32{10, 20, 30},
+			want:          []int32{5, 10, 20},
+		},
+		{
+			name:          "priorities of rules to delete aren't reused",
+			matchedSDKLRs: []int{-1},
+			sdkPriorities: []int32{10},
+			want:          []int32{11},
+		},
+		{
+			name:          "rules are moved when there's no free priority between them",
+			matchedSDKLRs: []int{0, -1, 1},
+			sdkPriorities: []int32{1, 2},
+			want:          []int32{1, 12, 21},
+		},
+		{
+			name:          "not enough free priorities",
+			matchedSDKLRs: []int{-1},
+			sdkPriorities: buildSequentialPriorities(maxListenerRulePriority),
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sdkLRs []ListenerRuleWithTags
+			for _, priority := range tt.sdkPriorities {
+				sdkLRs = append(sdkLRs, buildPlannerSDKListenerRule("", "", priority))
+			}
+			got, err := planListenerRulePriorities(tt.matchedSDKLRs, sdkLRs)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_planListenerRules(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resLRA := &elbv2model.ListenerRule{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::ListenerRule", "a"),
+		Spec:         elbv2model.ListenerRuleSpec{Priority: 1},
+	}
+	resLRNew := &elbv2model.ListenerRule{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::ListenerRule", "new"),
+		Spec:         elbv2model.ListenerRuleSpec{Priority: 2},
+	}
+	resLRB := &elbv2model.ListenerRule{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::ListenerRule", "b"),
+		Spec:         elbv2model.ListenerRuleSpec{Priority: 3},
+	}
+	sdkLRA := buildPlannerSDKListenerRule("/a", "tg-a", 1)
+	sdkLRB := buildPlannerSDKListenerRule("/b", "tg-b", 2)
+	sdkLROld := buildPlannerSDKListenerRule("/old", "tg-old", 3)
+	resLRDesiredActionsAndConditionsPairs := map[*elbv2model.ListenerRule]*resLRDesiredActionsAndConditionsPair{
+		resLRA:   buildPlannerDesiredActionsAndConditions("/a", "tg-a"),
+		resLRNew: buildPlannerDesiredActionsAndConditions("/new", "tg-new"),
+		resLRB:   buildPlannerDesiredActionsAndConditions("/b", "tg-b2"),
+	}
+
+	got, err := planListenerRules([]*elbv2model.ListenerRule{resLRB, resLRNew, resLRA},
+		[]ListenerRuleWithTags{sdkLROld, sdkLRB, sdkLRA}, resLRDesiredActionsAndConditionsPairs)
+	assert.NoError(t, err)
+	assert.Equal(t, listenerRulePlan{
+		plannedPriorities: map[*elbv2model.ListenerRule]int32{
+			resLRA:   1,
+			resLRNew: 13,
+			resLRB:   22,
+		},
+		matchedLRs: []resAndSDKListenerRulePair{
+			{resLR: resLRA, sdkLR: sdkLRA},
+			{resLR: resLRB, sdkLR: sdkLRB},
+		},
+		lrsToMove: []resAndSDKListenerRulePair{
+			{resLR: resLRB, sdkLR: sdkLRB},
+		},
+		lrsToModify: []resAndSDKListenerRulePair{
+			{resLR: resLRB, sdkLR: sdkLRB},
+		},
+		lrsToCreate: []*elbv2model.ListenerRule{resLRNew},
+		lrsToDelete: []ListenerRuleWithTags{sdkLROld},
+	}, got)
+}
+
+func Test_fitListenerRulePlanIntoCapacity(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resLRV2 := &elbv2model.ListenerRule{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::ListenerRule", "v2"),
+		Spec:         elbv2model.ListenerRuleSpec{Priority: 2},
+	}
+	resLRV3 := &elbv2model.ListenerRule{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::ListenerRule", "v3"),
+		Spec:         elbv2model.ListenerRuleSpec{Priority: 3},
+	}
+	sdkLRAPI := buildPlannerSDKListenerRule("/api/*", "tg-api", 2)
+	sdkLRLegacy := buildPlannerSDKListenerRule("/legacy", "tg-legacy", 3)
+	resLRDesiredActionsAndConditionsPairs := map[*elbv2model.ListenerRule]*resLRDesiredActionsAndConditionsPair{
+		resLRV2: buildPlannerDesiredActionsAndConditions("/api/v2", "tg-v2"),
+		resLRV3: buildPlannerDesiredActionsAndConditions("/api/v3", "tg-v3"),
+	}
+	tests := []struct {
+		name     string
+		capacity int
+		want     listenerRulePlan
+	}{
+		{
+			name:     "enough capacity",
+			capacity: 2,
+			want: listenerRulePlan{
+				lrsToCreate: []*elbv2model.ListenerRule{resLRV2, resLRV3},
+				lrsToDelete: []ListenerRuleWithTags{sdkLRAPI, sdkLRLegacy},
+			},
+		},
+		{
+			name:     "load balancer at the quota",
+			capacity: 0,
+			want: listenerRulePlan{
+				lrsToCreate:      []*elbv2model.ListenerRule{resLRV3},
+				lrsToDelete:      []ListenerRuleWithTags{},
+				lrsToDeleteFirst: []ListenerRuleWithTags{sdkLRLegacy},
+				lrsToReplace: []resAndSDKListenerRulePair{
+					{resLR: resLRV2, sdkLR: sdkLRAPI},
+				},
+			},
+		},
+		{
+			name:     "load balancer one rule below the quota",
+			capacity: 1,
+			want: listenerRulePlan{
+				lrsToCreate:      []*elbv2model.ListenerRule{resLRV2, resLRV3},
+				lrsToDelete:      []ListenerRuleWithTags{sdkLRAPI},
+				lrsToDeleteFirst: []ListenerRuleWithTags{sdkLRLegacy},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := listenerRulePlan{
+				lrsToCreate: []*elbv2model.ListenerRule{resLRV2, resLRV3},
+				lrsToDelete: []ListenerRuleWithTags{sdkLRAPI, sdkLRLegacy},
+			}
+			fitListenerRulePlanIntoCapacity(&plan, tt.capacity, resLRDesiredActionsAndConditionsPairs)
+			assert.Equal(t, tt.want, plan)
+		})
+	}
+}
+
+func Test_listenerRuleConditionsOverlap(t *testing.T) {
+	tests := []struct {
+		name            string
+		conditions      []elbv2types.RuleCondition
+		otherConditions []elbv2types.RuleCondition
+		want            bool
+	}{
+		{
+			name:            "different paths",
+			conditions:      buildPlannerConditions("/a"),
+			otherConditions: buildPlannerConditions("/b"),
+			want:            false,
+		},
+		{
+			name:            "path with wildcard",
+			conditions:      buildPlannerConditions("/a/*"),
+			otherConditions: buildPlannerConditions("/a/b"),
+			want:            true,
+		},
+		{
+			name:       "different hosts in different case",
+			conditions: []elbv2types.RuleCondition{{Field: awssdk.String("host-header"), HostHeaderConfig: &elbv2types.HostHeaderConditionConfig{Values: []string{"Example.com"}}}},
+			otherConditions: []elbv2types.RuleCondition{
+				{Field: awssdk.String("host-header"), HostHeaderConfig: &elbv2types.HostHeaderConditionConfig{Values: []string{"example.com"}}},
+			},
+			want: true,
+		},
+		{
+			name:            "condition on different fields",
+			conditions:      []elbv2types.RuleCondition{{Field: awssdk.String("host-header"), HostHeaderConfig: &elbv2types.HostHeaderConditionConfig{Values: []string{"example.com"}}}},
+			otherConditions: buildPlannerConditions("/a"),
+			want:            true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, listenerRuleConditionsOverlap(tt.conditions, tt.otherConditions))
+		})
+	}
+}
+
+func buildPlannerDesiredActionsAndConditions(path string, tgARN string) *resLRDesiredActionsAndConditionsPair {
+	return &resLRDesiredActionsAndConditionsPair{
+		desiredActions:    buildPlannerActions(tgARN),
+		desiredConditions: buildPlannerConditions(path),
+	}
+}
+
+func buildPlannerSDKListenerRule(path string, tgARN string, priority int32) ListenerRuleWithTags {
+	return ListenerRuleWithTags{
+		ListenerRule: &elbv2types.Rule{
+			RuleArn:    awssdk.String("arn-" + strconv.Itoa(int(priority))),
+			Priority:   awssdk.String(strconv.Itoa(int(priority))),
+			Actions:    buildPlannerActions(tgARN),
+			Conditions: buildPlannerConditions(path),
+		},
+	}
+}
+
+func buildPlannerActions(tgARN string) []elbv2types.Action {
+	return []elbv2types.Action{
+		{
+			Type: elbv2types.ActionTypeEnumForward,
+			ForwardConfig: &elbv2types.ForwardActionConfig{
+				TargetGroups: []elbv2types.TargetGroupTuple{
+					{
+						TargetGroupArn: awssdk.String(tgARN),
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildPlannerConditions(path string) []elbv2types.RuleCondition {
+	return []elbv2types.RuleCondition{
+		{
+			Field: awssdk.String("path-pattern"),
+			PathPatternConfig: &elbv2types.PathPatternConditionConfig{
+				Values: []string{path},
+			},
+		},
+	}
+}
+
+func buildSequentialPriorities(count int32) []int32 {
+	priorities := make([]int32, 0, count)
+	for priority := int32(1); priority <= count; priority++ {
+		priorities = append(priorities, priority)
+	}
+	return priorities
+}
@@ -3,10 +3,12 @@ package elbv2
 import (
 	"context"
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	elbv2equality "sigs.k8s.io/aws-load-balancer-controller/pkg/equality/elbv2"
@@ -15,9 +17,16 @@ import (
 	"strconv"
 )
 
+const (
+	// accountLimitRulesPerLoadBalancer is the name of the account limit on the number of rules per Application Load Balancer, excluding default rules.
+	accountLimitRulesPerLoadBalancer = "rules-per-application-load-balancer"
+	// defaultMaxRulesPerLoadBalancer is the default quota of rules per Application Load Balancer.
+	defaultMaxRulesPerLoadBalancer int64 = 100
+)
+
 // NewListenerRuleSynthesizer constructs new listenerRuleSynthesizer.
 func NewListenerRuleSynthesizer(elbv2Client services.ELBV2, taggingManager TaggingManager,
-	lrManager ListenerRuleManager, logger logr.Logger, featureGates config.FeatureGates, stack core.Stack) *listenerRuleSynthesizer {
+	lrManager ListenerRuleManager, logger logr.Logger, featureGates config.FeatureGates, stack core.Stack, maxConcurrency int) *listenerRuleSynthesizer {
 	return &listenerRuleSynthesizer{
 		elbv2Client:    elbv2Client,
 		lrManager:      lrManager,
@@ -25,6 +34,7 @@ func NewListenerRuleSynthesizer(elbv2Client services.ELBV2, taggingManager Taggi
 		featureGates:   featureGates,
 		taggingManager: taggingManager,
 		stack:          stack,
+		maxConcurrency: maxConcurrency,
 	}
 }
 
@@ -35,7 +45,11 @@ type listenerRuleSynthesizer struct {
 	logger         logr.Logger
 	taggingManager TaggingManager
 
-	stack core.Stack
+	stack          core.Stack
+	maxConcurrency int
+
+	// maxRulesPerLoadBalancer is the quota of rules per Application Load Balancer, looked up lazily.
+	maxRulesPerLoadBalancer int64
 }
 
 func (s *listenerRuleSynthesizer) Synthesize(ctx context.Context) error {
@@ -48,15 +62,51 @@ func (s *listenerRuleSynthesizer) Synthesize(ctx context.Context) error {
 
 	var resLSs []*elbv2model.Listener
 	s.stack.ListResources(&resLSs)
+	lsARNsByLBARN := make(map[string][]string)
+	var lbARNs []string
 	for _, resLS := range resLSs {
 		lsARN, err := resLS.ListenerARN().Resolve(ctx)
 		if err != nil {
 			return err
 		}
+		lbARN, err := resLS.Spec.LoadBalancerARN.Resolve(ctx)
+		if err != nil {
+			return err
+		}
+		if _, exists := lsARNsByLBARN[lbARN]; !exists {
+			lbARNs = append(lbARNs, lbARN)
+		}
+		lsARNsByLBARN[lbARN] = append(lsARNsByLBARN[lbARN], lsARN)
+	}
+	for _, lbARN := range lbARNs {
+		if err := s.synthesizeListenerRulesOnLB(ctx, lsARNsByLBARN[lbARN], resLRsByLSARN); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// synthesizeListenerRulesOnLB synthesizes the listener rules on the listeners of a load balancer,
+// keeping track of the number of rules on the load balancer since the rule quota is per load balancer.
+func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnLB(ctx context.Context, lsARNs []string, resLRsByLSARN map[string][]*elbv2model.ListenerRule) error {
+	sdkLRsByLSARN := make(map[string][]ListenerRuleWithTags, len(lsARNs))
+	lbRuleCount := 0
+	for _, lsARN := range lsARNs {
+		// Find existing listener rules on the load balancer
+		sdkLRs, err := s.findSDKListenersRulesOnLS(ctx, lsARN)
+		if err != nil {
+			return err
+		}
+		sdkLRsByLSARN[lsARN] = sdkLRs
+		lbRuleCount += len(sdkLRs)
+	}
+	for _, lsARN := range lsARNs {
 		resLRs := resLRsByLSARN[lsARN]
-		if err := s.synthesizeListenerRulesOnListener(ctx, lsARN, resLRs); err != nil {
+		sdkLRs := sdkLRsByLSARN[lsARN]
+		if err := s.synthesizeListenerRulesOnListener(ctx, resLRs, sdkLRs, lbRuleCount); err != nil {
 			return err
 		}
+		lbRuleCount += len(resLRs) - len(sdkLRs)
 	}
 	return nil
 }
@@ -66,12 +116,7 @@ func (s *listenerRuleSynthesizer) PostSynthesize(ctx context.Context) error {
 	return nil
 }
 
-func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.Context, lsARN string, resLRs []*elbv2model.ListenerRule) error {
-	// Find existing listener rules on the load balancer
-	sdkLRs, err := s.findSDKListenersRulesOnLS(ctx, lsARN)
-	if err != nil {
-		return err
-	}
+func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.Context, resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags, lbRuleCount int) error {
 	// Build desired actions and conditions pairs for resource listener rules.
 	resLRDesiredActionsAndConditionsPairs := make(map[*elbv2model.ListenerRule]*resLRDesiredActionsAndConditionsPair, len(resLRs))
 	for _, resLR := range resLRs {
@@ -81,6 +126,9 @@ func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.
 		}
 		resLRDesiredActionsAndConditionsPairs[resLR] = resLRDesiredActionsAndConditionsPair
 	}
+	if s.featureGates.Enabled(config.ListenerRulesMakeBeforeBreak) {
+		return s.synthesizeListenerRulesOnListenerMakeBeforeBreak(ctx, resLRs, sdkLRs, resLRDesiredActionsAndConditionsPairs, lbRuleCount)
+	}
 	// matchedResAndSDKLRsBySettings : A slice of matched resLR and SDKLR rule pairs that have matching settings like actions and conditions. These needs to be only reprioratized to their corresponding priorities
 	// matchedResAndSDKLRsByPriority :  A slice of matched resLR and SDKLR rule pairs that have matching priorities but not settings like actions and conditions. These needs to be modified in place to avoid any 503 errors
 	// unmatchedResLRs : A slice of resLR that do not have a corresponding match in the sdkLRs. These rules need to be created on the load balancer.
@@ -104,13 +152,16 @@ func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.
 		}
 		resAndSDKLR.resLR.SetStatus(lsStatus)
 	}
-	// Create all the new rules on the LB
-	for _, resLR := range unmatchedResLRs {
+	// Create all the new rules on the LB, concurrently as each new rule has its own priority
+	if err := algorithm.ParallelForEach(unmatchedResLRs, s.maxConcurrency, func(resLR *elbv2model.ListenerRule) error {
 		lrStatus, err := s.lrManager.Create(ctx, resLR, resLRDesiredActionsAndConditionsPairs[resLR])
 		if err != nil {
 			return err
 		}
 		resLR.SetStatus(lrStatus)
+		return nil
+	}); err != nil {
+		return err
 	}
 	// Delete all unmatched sdk LRs which were pushed down as new rules are either modified or created at higher priority
 	for _, sdkLR := range unmatchedSDKLRs {
@@ -129,6 +180,99 @@ func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.
 	return nil
 }
 
+// synthesizeListenerRulesOnListenerMakeBeforeBreak converges the listener rules without transient misrouting.
+// Existing rules are reused by conditions and moved into the free priorities around the rules already in order in a single call,
+// then new rules are created, then rules with changed actions are modified, and rules no longer desired are deleted last.
+// Since a request matches at most one rule per set of conditions, the listener routes every request either as before or as desired.
+// When the load balancer lacks the capacity to hold the new rules along with the obsolete ones, obsolete rules that don't overlap
+// new rules are deleted before creating new rules, and the remaining ones are modified in place into new rules then moved.
+func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListenerMakeBeforeBreak(ctx context.Context, resLRs []*elbv2model.ListenerRule,
+	sdkLRs []ListenerRuleWithTags, resLRDesiredActionsAndConditionsPairs map[*elbv2model.ListenerRule]*resLRDesiredActionsAndConditionsPair, lbRuleCount int) error {
+	plan, err := planListenerRules(resLRs, sdkLRs, resLRDesiredActionsAndConditionsPairs)
+	if err != nil {
+		return err
+	}
+	if len(plan.lrsToCreate) > 0 && len(plan.lrsToDelete) > 0 {
+		maxRules, err := s.findMaxRulesPerLoadBalancer(ctx)
+		if err != nil {
+			return err
+		}
+		fitListenerRulePlanIntoCapacity(&plan, int(maxRules)-lbRuleCount, resLRDesiredActionsAndConditionsPairs)
+	}
+	if len(plan.lrsToMove) > 0 {
+		if err := s.lrManager.MoveRules(ctx, plan.lrsToMove, plan.plannedPriorities); err != nil {
+			return err
+		}
+	}
+	for _, sdkLR := range plan.lrsToDeleteFirst {
+		if err := s.lrManager.Delete(ctx, sdkLR); err != nil {
+			return err
+		}
+	}
+	// Create all the new rules on the LB, concurrently as each new rule has its own free priority
+	if err := algorithm.ParallelForEach(plan.lrsToCreate, s.maxConcurrency, func(resLR *elbv2model.ListenerRule) error {
+		resLRDesiredActionsAndConditionsPair := *resLRDesiredActionsAndConditionsPairs[resLR]
+		resLRDesiredActionsAndConditionsPair.desiredPriority = plan.plannedPriorities[resLR]
+		lrStatus, err := s.lrManager.Create(ctx, resLR, &resLRDesiredActionsAndConditionsPair)
+		if err != nil {
+			return err
+		}
+		resLR.SetStatus(lrStatus)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, resAndSDKLR := range plan.lrsToModify {
+		if _, err := s.lrManager.UpdateRules(ctx, resAndSDKLR.resLR, resAndSDKLR.sdkLR, resLRDesiredActionsAndConditionsPairs[resAndSDKLR.resLR]); err != nil {
+			return err
+		}
+	}
+	for _, resAndSDKLR := range plan.lrsToReplace {
+		if _, err := s.lrManager.UpdateRules(ctx, resAndSDKLR.resLR, resAndSDKLR.sdkLR, resLRDesiredActionsAndConditionsPairs[resAndSDKLR.resLR]); err != nil {
+			return err
+		}
+	}
+	if len(plan.lrsToReplace) > 0 {
+		if err := s.lrManager.MoveRules(ctx, plan.lrsToReplace, plan.plannedPriorities); err != nil {
+			return err
+		}
+	}
+	for _, sdkLR := range plan.lrsToDelete {
+		if err := s.lrManager.Delete(ctx, sdkLR); err != nil {
+			return err
+		}
+	}
+	for _, resAndSDKLR := range append(plan.matchedLRs, plan.lrsToReplace...) {
+		lrStatus, err := s.lrManager.UpdateRulesTags(ctx, resAndSDKLR.resLR, resAndSDKLR.sdkLR)
+		if err != nil {
+			return err
+		}
+		resAndSDKLR.resLR.SetStatus(lrStatus)
+	}
+	return nil
+}
+
+// findMaxRulesPerLoadBalancer returns the quota of rules per Application Load Balancer of the account, looked up once per synthesis.
+func (s *listenerRuleSynthesizer) findMaxRulesPerLoadBalancer(ctx context.Context) (int64, error) {
+	if s.maxRulesPerLoadBalancer > 0 {
+		return s.maxRulesPerLoadBalancer, nil
+	}
+	resp, err := s.elbv2Client.DescribeAccountLimitsWithContext(ctx, &elbv2sdk.DescribeAccountLimitsInput{})
+	if err != nil {
+		return 0, err
+	}
+	s.maxRulesPerLoadBalancer = defaultMaxRulesPerLoadBalancer
+	for _, limit := range resp.Limits {
+		if awssdk.ToString(limit.Name) != accountLimitRulesPerLoadBalancer {
+			continue
+		}
+		if maxRules, err := strconv.ParseInt(awssdk.ToString(limit.Max), 10, 64); err == nil {
+			s.maxRulesPerLoadBalancer = maxRules
+		}
+	}
+	return s.maxRulesPerLoadBalancer, nil
+}
+
 // findSDKListenersRulesOnLS returns the listenerRules configured on Listener.
 func (s *listenerRuleSynthesizer) findSDKListenersRulesOnLS(ctx context.Context, lsARN string) ([]ListenerRuleWithTags, error) {
 	sdkLRs, err := s.taggingManager.ListListenerRules(ctx, lsARN)
@@ -153,6 +297,8 @@ type resAndSDKListenerRulePair struct {
 type resLRDesiredActionsAndConditionsPair struct {
 	desiredActions    []types.Action
 	desiredConditions []types.RuleCondition
+	// desiredPriority overrides the priority of the rule spec when it's created, if set.
+	desiredPriority int32
 }
 
 func (s *listenerRuleSynthesizer) matchResAndSDKListenerRules(resLRs []*elbv2model.ListenerRule, unmatchedSDKLRs []ListenerRuleWithTags, resLRDesiredActionsAndConditionsPairs map[*elbv2model.ListenerRule]*resLRDesiredActionsAndConditionsPair) ([]resAndSDKListenerRulePair, []resAndSDKListenerRulePair, []*elbv2model.ListenerRule, []ListenerRuleWithTags, error) {
@@ -48,6 +48,14 @@ func NewDefaultTargetGroupBindingManager(k8sClient client.Client, trackingProvid
 	}
 }
 
+// NewDryRunTargetGroupBindingManager constructs new defaultTargetGroupBindingManager for a k8sClient that doesn't persist changes,
+// it doesn't wait for changes to be observed, as they never will be.
+func NewDryRunTargetGroupBindingManager(k8sClient client.Client, trackingProvider tracking.Provider, logger logr.Logger) *defaultTargetGroupBindingManager {
+	m := NewDefaultTargetGroupBindingManager(k8sClient, trackingProvider, logger)
+	m.skipWaits = true
+	return m
+}
+
 var _ TargetGroupBindingManager = &defaultTargetGroupBindingManager{}
 
 // default implementation for TargetGroupBindingManager.
@@ -60,6 +68,7 @@ type defaultTargetGroupBindingManager struct {
 	waitTGBObservedTimout       time.Duration
 	waitTGBDeletionPollInterval time.Duration
 	waitTGBDeletionTimeout      time.Duration
+	skipWaits                   bool
 }
 
 func (m *defaultTargetGroupBindingManager) Create(ctx context.Context, resTGB *elbv2model.TargetGroupBindingResource) (elbv2model.TargetGroupBindingResourceStatus, error) {
@@ -134,6 +143,9 @@ func (m *defaultTargetGroupBindingManager) Delete(ctx context.Context, tgb *elbv
 }
 
 func (m *defaultTargetGroupBindingManager) waitUntilTargetGroupBindingObserved(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	if m.skipWaits {
+		return nil
+	}
 	ctx, cancel := context.WithTimeout(ctx, m.waitTGBObservedTimout)
 	defer cancel()
 
@@ -151,6 +163,9 @@ func (m *defaultTargetGroupBindingManager) waitUntilTargetGroupBindingObserved(c
 }
 
 func (m *defaultTargetGroupBindingManager) waitUntilTargetGroupBindingDeleted(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	if m.skipWaits {
+		return nil
+	}
 	ctx, cancel := context.WithTimeout(ctx, m.waitTGBDeletionTimeout)
 	defer cancel()
 
@@ -7,6 +7,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
@@ -16,7 +17,7 @@ import (
 
 // NewTargetGroupSynthesizer constructs targetGroupSynthesizer
 func NewTargetGroupSynthesizer(elbv2Client services.ELBV2, trackingProvider tracking.Provider, taggingManager TaggingManager,
-	tgManager TargetGroupManager, logger logr.Logger, featureGates config.FeatureGates, stack core.Stack) *targetGroupSynthesizer {
+	tgManager TargetGroupManager, logger logr.Logger, featureGates config.FeatureGates, stack core.Stack, maxConcurrency int) *targetGroupSynthesizer {
 	return &targetGroupSynthesizer{
 		elbv2Client:      elbv2Client,
 		trackingProvider: trackingProvider,
@@ -25,6 +26,7 @@ func NewTargetGroupSynthesizer(elbv2Client services.ELBV2, trackingProvider trac
 		featureGates:     featureGates,
 		logger:           logger,
 		stack:            stack,
+		maxConcurrency:   maxConcurrency,
 		unmatchedSDKTGs:  nil,
 	}
 }
@@ -39,6 +41,7 @@ type targetGroupSynthesizer struct {
 	logger           logr.Logger
 
 	stack           core.Stack
+	maxConcurrency  int
 	unmatchedSDKTGs []TargetGroupWithTags
 }
 
@@ -59,21 +62,25 @@ func (s *targetGroupSynthesizer) Synthesize(ctx context.Context) error {
 	// * unmatched targetGroups might still be use by a listener rule.
 	s.unmatchedSDKTGs = unmatchedSDKTGs
 
-	for _, resTG := range unmatchedResTGs {
+	// TargetGroups are independent of each other, so they are created and updated concurrently.
+	if err := algorithm.ParallelForEach(unmatchedResTGs, s.maxConcurrency, func(resTG *elbv2model.TargetGroup) error {
 		tgStatus, err := s.tgManager.Create(ctx, resTG)
 		if err != nil {
 			return err
 		}
 		resTG.SetStatus(tgStatus)
+		return nil
+	}); err != nil {
+		return err
 	}
-	for _, resAndSDKTG := range matchedResAndSDKTGs {
+	return algorithm.ParallelForEach(matchedResAndSDKTGs, s.maxConcurrency, func(resAndSDKTG resAndSDKTargetGroupPair) error {
 		tgStatus, err := s.tgManager.Update(ctx, resAndSDKTG.resTG, resAndSDKTG.sdkTG)
 		if err != nil {
 			return err
 		}
 		resAndSDKTG.resTG.SetStatus(tgStatus)
-	}
-	return nil
+		return nil
+	})
 }
 
 func (s *targetGroupSynthesizer) PostSynthesize(ctx context.Context) error {
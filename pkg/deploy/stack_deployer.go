@@ -3,10 +3,13 @@ package deploy
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/acm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/dryrun"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/shield"
@@ -14,8 +17,10 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/wafregional"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/wafv2"
 	errmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/error"
+	gatewayconstants "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/constants"
 	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core/graph"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -28,6 +33,9 @@ const (
 type StackDeployer interface {
 	// Deploy a resource stack.
 	Deploy(ctx context.Context, stack core.Stack, metricsCollector lbcmetrics.MetricCollector, controllerName string, frontendNlbTargetGroupDesiredState *core.FrontendNlbTargetGroupDesiredState) error
+
+	// Plan computes the changes deploying a resource stack would make, without making them.
+	Plan(ctx context.Context, stack core.Stack, metricsCollector lbcmetrics.MetricCollector, controllerName string, frontendNlbTargetGroupDesiredState *core.FrontendNlbTargetGroupDesiredState) (*dryrun.Plan, error)
 }
 
 // NewDefaultStackDeployer constructs new defaultStackDeployer.
@@ -37,6 +45,16 @@ func NewDefaultStackDeployer(cloud services.Cloud, k8sClient client.Client,
 	config config.ControllerConfig, tagPrefix string, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, controllerName string) *defaultStackDeployer {
 
 	trackingProvider := tracking.NewDefaultProvider(tagPrefix, config.ClusterName)
+	elbv2TGBManager := elbv2.NewDefaultTargetGroupBindingManager(k8sClient, trackingProvider, logger)
+	return newDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, elbv2TaggingManager, elbv2TGBManager,
+		config, trackingProvider, logger, metricsCollector, controllerName)
+}
+
+func newDefaultStackDeployer(cloud services.Cloud, k8sClient client.Client,
+	networkingSGManager networking.SecurityGroupManager, networkingSGReconciler networking.SecurityGroupReconciler,
+	elbv2TaggingManager elbv2.TaggingManager, elbv2TGBManager elbv2.TargetGroupBindingManager,
+	config config.ControllerConfig, trackingProvider tracking.Provider, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, controllerName string) *defaultStackDeployer {
+
 	ec2TaggingManager := ec2.NewDefaultTaggingManager(cloud.EC2(), networkingSGManager, cloud.VpcID(), logger)
 
 	return &defaultStackDeployer{
@@ -52,8 +70,9 @@ func NewDefaultStackDeployer(cloud services.Cloud, k8sClient client.Client,
 		elbv2LSManager:                      elbv2.NewDefaultListenerManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, logger),
 		elbv2LRManager:                      elbv2.NewDefaultListenerRuleManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, logger),
 		elbv2TGManager:                      elbv2.NewDefaultTargetGroupManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, cloud.VpcID(), config.ExternalManagedTags, logger),
-		elbv2TGBManager:                     elbv2.NewDefaultTargetGroupBindingManager(k8sClient, trackingProvider, logger),
+		elbv2TGBManager:                     elbv2TGBManager,
 		elbv2FrontendNlbTargetsManager:      elbv2.NewFrontendNlbTargetsManager(cloud.ELBV2(), logger),
+		acmCertificateManager:               acm.NewDefaultCertificateManager(cloud.ACM(), cloud.RGT(), trackingProvider, logger),
 		wafv2WebACLAssociationManager:       wafv2.NewDefaultWebACLAssociationManager(cloud.WAFv2(), logger),
 		wafRegionalWebACLAssociationManager: wafregional.NewDefaultWebACLAssociationManager(cloud.WAFRegional(), logger),
 		shieldProtectionManager:             shield.NewDefaultProtectionManager(cloud.Shield(), logger),
@@ -62,6 +81,7 @@ func NewDefaultStackDeployer(cloud services.Cloud, k8sClient client.Client,
 		logger:                              logger,
 		metricsCollector:                    metricsCollector,
 		controllerName:                      controllerName,
+		concurrency:                         config.StackDeployConcurrency,
 	}
 }
 
@@ -83,6 +103,7 @@ type defaultStackDeployer struct {
 	elbv2TGManager                      elbv2.TargetGroupManager
 	elbv2TGBManager                     elbv2.TargetGroupBindingManager
 	elbv2FrontendNlbTargetsManager      elbv2.FrontendNlbTargetsManager
+	acmCertificateManager               acm.CertificateManager
 	wafv2WebACLAssociationManager       wafv2.WebACLAssociationManager
 	wafRegionalWebACLAssociationManager wafregional.WebACLAssociationManager
 	shieldProtectionManager             shield.ProtectionManager
@@ -90,6 +111,10 @@ type defaultStackDeployer struct {
 	vpcID                               string
 	metricsCollector                    lbcmetrics.MetricCollector
 	controllerName                      string
+	// concurrency is the max number of synthesizers, and of operations within each synthesizer, that run concurrently.
+	concurrency int
+	// recorder is set when the deployer only plans changes.
+	recorder *dryrun.Recorder
 
 	logger logr.Logger
 }
@@ -100,41 +125,60 @@ type ResourceSynthesizer interface {
 }
 
 // Deploy a resource stack.
+// Synthesizers run concurrently as soon as the synthesizers they depend on completed, and post synthesize in the reverse order.
 func (d *defaultStackDeployer) Deploy(ctx context.Context, stack core.Stack, metricsCollector lbcmetrics.MetricCollector, controllerName string, frontendNlbTargetGroupDesiredState *core.FrontendNlbTargetGroupDesiredState) error {
-	synthesizers := []ResourceSynthesizer{
-		ec2.NewSecurityGroupSynthesizer(d.cloud.EC2(), d.trackingProvider, d.ec2TaggingManager, d.ec2SGManager, d.vpcID, d.logger, stack),
-	}
+	synthesizers := newSynthesizerGraph()
+	sgSynthesizer := ec2.NewSecurityGroupSynthesizer(d.cloud.EC2(), d.trackingProvider, d.ec2TaggingManager, d.ec2SGManager, d.vpcID, d.logger, stack)
+	synthesizers.add(sgSynthesizer)
 
+	// Targets of frontend NLBs are deregistered before target groups are deleted, and registered once the load balancer is deployed.
+	var frontendNlbTargetSynthesizer ResourceSynthesizer
 	if controllerName == ingressController {
-		synthesizers = append(synthesizers, elbv2.NewFrontendNlbTargetSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TaggingManager, d.elbv2FrontendNlbTargetsManager, d.logger, d.featureGates, stack, frontendNlbTargetGroupDesiredState))
+		frontendNlbTargetSynthesizer = elbv2.NewFrontendNlbTargetSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TaggingManager, d.elbv2FrontendNlbTargetsManager, d.logger, d.featureGates, stack, frontendNlbTargetGroupDesiredState)
+		synthesizers.add(frontendNlbTargetSynthesizer, sgSynthesizer)
 	}
 
-	synthesizers = append(synthesizers,
-		elbv2.NewTargetGroupSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2TGManager, d.logger, d.featureGates, stack),
-		elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.logger, d.featureGates, d.controllerConfig, stack),
-		elbv2.NewListenerSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LSManager, d.logger, stack),
-		elbv2.NewListenerRuleSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LRManager, d.logger, d.featureGates, stack),
-		elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TGBManager, d.logger, stack))
+	tgSynthesizer := elbv2.NewTargetGroupSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2TGManager, d.logger, d.featureGates, stack, d.concurrency)
+	synthesizers.add(tgSynthesizer, frontendNlbTargetSynthesizer)
+	// Target groups are only deleted after load balancers that might still use them.
+	lbSynthesizer := elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.logger, d.featureGates, d.controllerConfig, stack)
+	synthesizers.add(lbSynthesizer, sgSynthesizer, tgSynthesizer)
+
+	// Certificates are imported before listeners reference them, and only deleted after listeners stopped referencing them.
+	var certSynthesizer ResourceSynthesizer
+	if (controllerName == gatewayconstants.ALBGatewayController || controllerName == gatewayconstants.NLBGatewayController) && d.featureGates.Enabled(config.GatewayCertificateImport) {
+		certSynthesizer = acm.NewCertificateSynthesizer(d.trackingProvider, d.acmCertificateManager, d.logger, stack)
+		synthesizers.add(certSynthesizer)
+	}
+
+	lsSynthesizer := elbv2.NewListenerSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LSManager, d.logger, stack)
+	synthesizers.add(lsSynthesizer, lbSynthesizer, tgSynthesizer, certSynthesizer)
+	synthesizers.add(elbv2.NewListenerRuleSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LRManager, d.logger, d.featureGates, stack, d.concurrency),
+		lsSynthesizer, tgSynthesizer)
+	synthesizers.add(elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TGBManager, d.logger, stack),
+		sgSynthesizer, tgSynthesizer)
 
 	if d.addonsConfig.WAFV2Enabled {
-		synthesizers = append(synthesizers, wafv2.NewWebACLAssociationSynthesizer(d.wafv2WebACLAssociationManager, d.logger, stack))
+		synthesizers.add(wafv2.NewWebACLAssociationSynthesizer(d.wafv2WebACLAssociationManager, d.logger, stack), lbSynthesizer)
 	}
 	if d.addonsConfig.WAFEnabled && d.cloud.WAFRegional().Available() {
-		synthesizers = append(synthesizers, wafregional.NewWebACLAssociationSynthesizer(d.wafRegionalWebACLAssociationManager, d.logger, stack))
+		synthesizers.add(wafregional.NewWebACLAssociationSynthesizer(d.wafRegionalWebACLAssociationManager, d.logger, stack), lbSynthesizer)
 	}
 	if d.addonsConfig.ShieldEnabled {
 		shieldSubscribed, err := d.shieldProtectionManager.IsSubscribed(ctx)
 		if err != nil {
 			d.logger.Error(err, "unable to determine AWS Shield subscription state, skipping AWS shield reconciliation")
 		} else if shieldSubscribed {
-			synthesizers = append(synthesizers, shield.NewProtectionSynthesizer(d.shieldProtectionManager, d.logger, stack))
+			synthesizers.add(shield.NewProtectionSynthesizer(d.shieldProtectionManager, d.logger, stack), lbSynthesizer)
 		}
 	}
 
-	for _, synthesizer := range synthesizers {
+	if err := graph.ParallelTopologicalTraversal(synthesizers.dependencies, d.concurrency, func(uid graph.ResourceUID) error {
 		var err error
+		synthesizer := synthesizers.synthesizerByUID[uid]
 		// Get synthesizer type name for better context
 		synthesizerType := fmt.Sprintf("%T", synthesizer)
+		d.recordChangesFor(synthesizerType)
 		synthesizeFn := func() {
 			err = synthesizer.Synthesize(ctx)
 		}
@@ -142,12 +186,81 @@ func (d *defaultStackDeployer) Deploy(ctx context.Context, stack core.Stack, met
 		if err != nil {
 			return errmetrics.NewErrorWithMetrics(controllerName, synthesizerType, err, d.metricsCollector)
 		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return graph.ParallelTopologicalTraversal(synthesizers.dependents, d.concurrency, func(uid graph.ResourceUID) error {
+		synthesizer := synthesizers.synthesizerByUID[uid]
+		d.recordChangesFor(fmt.Sprintf("%T", synthesizer))
+		return synthesizer.PostSynthesize(ctx)
+	})
+}
+
+// Plan computes the changes deploying a resource stack would make, without making them.
+// The stack is deployed with AWS and k8s clients that record the changes instead of making them, so the plan
+// contains exactly the calls Deploy would make given the current state of the resources.
+func (d *defaultStackDeployer) Plan(ctx context.Context, stack core.Stack, metricsCollector lbcmetrics.MetricCollector, controllerName string, frontendNlbTargetGroupDesiredState *core.FrontendNlbTargetGroupDesiredState) (*dryrun.Plan, error) {
+	recorder := dryrun.NewRecorder()
+	cloud := dryrun.NewCloud(d.cloud, recorder)
+	k8sClient := dryrun.NewK8sClient(d.k8sClient, recorder)
+	// the managers are built from scratch, so that their caches never hold changes that weren't made.
+	networkingSGManager := networking.NewDefaultSecurityGroupManager(cloud.EC2(), d.logger)
+	networkingSGReconciler := networking.NewDefaultSecurityGroupReconciler(networkingSGManager, d.logger)
+	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), d.featureGates, cloud.RGT(), d.logger)
+	elbv2TGBManager := elbv2.NewDryRunTargetGroupBindingManager(k8sClient, d.trackingProvider, d.logger)
+	planner := newDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, elbv2TaggingManager, elbv2TGBManager,
+		d.controllerConfig, d.trackingProvider, d.logger, d.metricsCollector, d.controllerName)
+	planner.recorder = recorder
+	// changes are attributed to the synthesizer that runs, so the synthesizers run one at a time.
+	planner.concurrency = 1
+
+	if err := planner.Deploy(ctx, stack, metricsCollector, controllerName, frontendNlbTargetGroupDesiredState); err != nil {
+		return nil, err
+	}
+	return recorder.Plan(), nil
+}
+
+// recordChangesFor attributes the changes recorded afterward to the synthesizer.
+func (d *defaultStackDeployer) recordChangesFor(synthesizerType string) {
+	if d.recorder != nil {
+		d.recorder.SetSynthesizer(synthesizerType)
+	}
+}
+
+// synthesizerGraph is the dependency graph of the synthesizers of a stack.
+type synthesizerGraph struct {
+	synthesizerByUID map[graph.ResourceUID]ResourceSynthesizer
+	// dependencies has edges from synthesizers to the ones depending on them.
+	dependencies graph.ResourceGraph
+	// dependents has edges from synthesizers to the ones they depend on.
+	dependents graph.ResourceGraph
+}
+
+func newSynthesizerGraph() *synthesizerGraph {
+	return &synthesizerGraph{
+		synthesizerByUID: make(map[graph.ResourceUID]ResourceSynthesizer),
+		dependencies:     graph.NewDefaultResourceGraph(),
+		dependents:       graph.NewDefaultResourceGraph(),
 	}
-	for i := len(synthesizers) - 1; i >= 0; i-- {
-		if err := synthesizers[i].PostSynthesize(ctx); err != nil {
-			return err
+}
+
+// add adds a synthesizer that runs after the synthesizers it depends on, nil dependencies are ignored.
+func (g *synthesizerGraph) add(synthesizer ResourceSynthesizer, dependencies ...ResourceSynthesizer) {
+	uid := synthesizerUID(synthesizer)
+	g.synthesizerByUID[uid] = synthesizer
+	g.dependencies.AddNode(uid)
+	g.dependents.AddNode(uid)
+	for _, dependency := range dependencies {
+		if dependency == nil {
+			continue
 		}
+		dependencyUID := synthesizerUID(dependency)
+		g.dependencies.AddEdge(dependencyUID, uid)
+		g.dependents.AddEdge(uid, dependencyUID)
 	}
+}
 
-	return nil
+func synthesizerUID(synthesizer ResourceSynthesizer) graph.ResourceUID {
+	return graph.ResourceUID{ResType: reflect.TypeOf(synthesizer)}
 }
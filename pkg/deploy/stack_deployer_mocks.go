@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/aws-load-balancer-controller/pkg/deploy (interfaces: StackDeployer)
+
+// Package deploy is a generated GoMock package.
+package deploy
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	dryrun "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/dryrun"
+	lbc "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	core "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+// MockStackDeployer is a mock of StackDeployer interface.
+type MockStackDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockStackDeployerMockRecorder
+}
+
+// MockStackDeployerMockRecorder is the mock recorder for MockStackDeployer.
+type MockStackDeployerMockRecorder struct {
+	mock *MockStackDeployer
+}
+
+// NewMockStackDeployer creates a new mock instance.
+func NewMockStackDeployer(ctrl *gomock.Controller) *MockStackDeployer {
+	mock := &MockStackDeployer{ctrl: ctrl}
+	mock.recorder = &MockStackDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStackDeployer) EXPECT() *MockStackDeployerMockRecorder {
+	return m.recorder
+}
+
+// Deploy mocks base method.
+func (m *MockStackDeployer) Deploy(arg0 context.Context, arg1 core.Stack, arg2 lbc.MetricCollector, arg3 string, arg4 *core.FrontendNlbTargetGroupDesiredState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deploy", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deploy indicates an expected call of Deploy.
+func (mr *MockStackDeployerMockRecorder) Deploy(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockStackDeployer)(nil).Deploy), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Plan mocks base method.
+func (m *MockStackDeployer) Plan(arg0 context.Context, arg1 core.Stack, arg2 lbc.MetricCollector, arg3 string, arg4 *core.FrontendNlbTargetGroupDesiredState) (*dryrun.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*dryrun.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockStackDeployerMockRecorder) Plan(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockStackDeployer)(nil).Plan), arg0, arg1, arg2, arg3, arg4)
+}
@@ -221,4 +221,22 @@ func (merger *configMergerImpl) performTakeOneMerges(merged *elbv2gw.LoadBalance
 	} else {
 		merged.ManageBackendSecurityGroupRules = lowPriority.Spec.ManageBackendSecurityGroupRules
 	}
+
+	if highPriority.Spec.WAFv2 != nil {
+		merged.WAFv2 = highPriority.Spec.WAFv2
+	} else {
+		merged.WAFv2 = lowPriority.Spec.WAFv2
+	}
+
+	if highPriority.Spec.WAFRegional != nil {
+		merged.WAFRegional = highPriority.Spec.WAFRegional
+	} else {
+		merged.WAFRegional = lowPriority.Spec.WAFRegional
+	}
+
+	if highPriority.Spec.ShieldAdvanced != nil {
+		merged.ShieldAdvanced = highPriority.Spec.ShieldAdvanced
+	} else {
+		merged.ShieldAdvanced = lowPriority.Spec.ShieldAdvanced
+	}
 }
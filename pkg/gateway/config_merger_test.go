@@ -64,6 +64,15 @@ func Test_Merge(t *testing.T) {
 					},
 					EnableICMP:                      awssdk.Bool(false),
 					ManageBackendSecurityGroupRules: awssdk.Bool(false),
+					WAFv2: &elbv2gw.WAFv2Configuration{
+						WebACL: "web-acl-arn",
+					},
+					WAFRegional: &elbv2gw.WAFRegionalConfiguration{
+						WebACLID: "web-acl-id",
+					},
+					ShieldAdvanced: &elbv2gw.ShieldAdvancedConfiguration{
+						Enabled: true,
+					},
 				},
 			},
 			gwLbConfig: elbv2gw.LoadBalancerConfiguration{},
@@ -105,6 +114,15 @@ func Test_Merge(t *testing.T) {
 					},
 					EnableICMP:                      awssdk.Bool(false),
 					ManageBackendSecurityGroupRules: awssdk.Bool(false),
+					WAFv2: &elbv2gw.WAFv2Configuration{
+						WebACL: "web-acl-arn",
+					},
+					WAFRegional: &elbv2gw.WAFRegionalConfiguration{
+						WebACLID: "web-acl-id",
+					},
+					ShieldAdvanced: &elbv2gw.ShieldAdvancedConfiguration{
+						Enabled: true,
+					},
 				},
 			},
 		},
@@ -148,6 +166,15 @@ func Test_Merge(t *testing.T) {
 					},
 					EnableICMP:                      awssdk.Bool(false),
 					ManageBackendSecurityGroupRules: awssdk.Bool(false),
+					WAFv2: &elbv2gw.WAFv2Configuration{
+						WebACL: "web-acl-arn",
+					},
+					WAFRegional: &elbv2gw.WAFRegionalConfiguration{
+						WebACLID: "web-acl-id",
+					},
+					ShieldAdvanced: &elbv2gw.ShieldAdvancedConfiguration{
+						Enabled: true,
+					},
 				},
 			},
 			gwClassLbConfig: elbv2gw.LoadBalancerConfiguration{},
@@ -189,6 +216,15 @@ func Test_Merge(t *testing.T) {
 					},
 					EnableICMP:                      awssdk.Bool(false),
 					ManageBackendSecurityGroupRules: awssdk.Bool(false),
+					WAFv2: &elbv2gw.WAFv2Configuration{
+						WebACL: "web-acl-arn",
+					},
+					WAFRegional: &elbv2gw.WAFRegionalConfiguration{
+						WebACLID: "web-acl-id",
+					},
+					ShieldAdvanced: &elbv2gw.ShieldAdvancedConfiguration{
+						Enabled: true,
+					},
 				},
 			},
 		},
@@ -10,6 +10,9 @@ const (
 
 	// LoadBalancerConfiguration the CRD name of LoadBalancerConfiguration
 	LoadBalancerConfiguration = "LoadBalancerConfiguration"
+
+	// GatewayDryRunAnnotation the annotation on a Gateway to only plan the changes to its load balancer resources.
+	GatewayDryRunAnnotation = "gateway.k8s.aws/dry-run"
 )
 
 /*
@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
@@ -26,7 +27,7 @@ import (
 // Builder builds the model stack for a Gateway resource.
 type Builder interface {
 	// Build model stack for a gateway
-	Build(ctx context.Context, gw *gwv1.Gateway, lbConf elbv2gw.LoadBalancerConfiguration, routes map[int32][]routeutils.RouteDescriptor) (core.Stack, *elbv2model.LoadBalancer, bool, error)
+	Build(ctx context.Context, gw *gwv1.Gateway, lbConf elbv2gw.LoadBalancerConfiguration, routes map[int32][]routeutils.RouteDescriptor, listenerCertificates map[gwv1.SectionName]routeutils.ListenerCertificates) (core.Stack, *elbv2model.LoadBalancer, bool, error)
 }
 
 // NewModelBuilder construct a new baseModelBuilder
@@ -49,6 +50,7 @@ func NewModelBuilder(subnetsResolver networking.SubnetsResolver,
 		backendSGProvider:        backendSGProvider,
 		sgResolver:               sgResolver,
 		vpcInfoProvider:          vpcInfoProvider,
+		trackingProvider:         trackingProvider,
 		elbv2TaggingManager:      elbv2TaggingManager,
 		featureGates:             featureGates,
 		ec2Client:                ec2Client,
@@ -82,6 +84,7 @@ type baseModelBuilder struct {
 	vpcInfoProvider            networking.VPCInfoProvider
 	backendSGProvider          networking.BackendSGProvider
 	sgResolver                 networking.SecurityGroupResolver
+	trackingProvider           tracking.Provider
 	elbv2TaggingManager        elbv2deploy.TaggingManager
 	featureGates               config.FeatureGates
 	enableIPTargetType         bool
@@ -107,10 +110,10 @@ type baseModelBuilder struct {
 	defaultIPType             elbv2model.IPAddressType
 }
 
-func (baseBuilder *baseModelBuilder) Build(ctx context.Context, gw *gwv1.Gateway, lbConf elbv2gw.LoadBalancerConfiguration, routes map[int32][]routeutils.RouteDescriptor) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
+func (baseBuilder *baseModelBuilder) Build(ctx context.Context, gw *gwv1.Gateway, lbConf elbv2gw.LoadBalancerConfiguration, routes map[int32][]routeutils.RouteDescriptor, listenerCertificates map[gwv1.SectionName]routeutils.ListenerCertificates) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
 	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(gw)))
 	tgBuilder := newTargetGroupBuilder(baseBuilder.clusterName, baseBuilder.vpcID, baseBuilder.gwTagHelper, baseBuilder.loadBalancerType, baseBuilder.disableRestrictedSGRules, baseBuilder.defaultTargetType)
-	listenerBuilder := newListenerBuilder(ctx, baseBuilder.loadBalancerType, tgBuilder, baseBuilder.gwTagHelper, baseBuilder.clusterName, baseBuilder.defaultSSLPolicy, baseBuilder.acmClient, baseBuilder.allowedCAARNs, baseBuilder.logger)
+	listenerBuilder := newListenerBuilder(ctx, baseBuilder.loadBalancerType, tgBuilder, baseBuilder.gwTagHelper, baseBuilder.clusterName, baseBuilder.defaultSSLPolicy, baseBuilder.acmClient, baseBuilder.allowedCAARNs, baseBuilder.featureGates, baseBuilder.logger)
 	if gw.DeletionTimestamp != nil && !gw.DeletionTimestamp.IsZero() {
 		if baseBuilder.isDeleteProtected(lbConf) {
 			return nil, nil, false, errors.Errorf("Unable to delete gateway %+v because deletion protection is enabled.", k8s.NamespacedName(gw))
@@ -154,15 +157,47 @@ func (baseBuilder *baseModelBuilder) Build(ctx context.Context, gw *gwv1.Gateway
 		return nil, nil, false, err
 	}
 
+	/* WAF and Shield */
+	currentLBTags, err := baseBuilder.fetchCurrentLoadBalancerAddOnTags(ctx, stack, lbConf)
+
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	spec.Tags = algorithm.MergeStringMap(buildLoadBalancerAddOnTags(baseBuilder.loadBalancerType, lbConf), spec.Tags)
 	lb := elbv2model.NewLoadBalancer(stack, resourceIDLoadBalancer, spec)
 
-	if err := listenerBuilder.buildListeners(ctx, stack, lb, securityGroups, gw, routes, lbConf); err != nil {
+	if err := buildLoadBalancerAddOns(stack, baseBuilder.loadBalancerType, lb.LoadBalancerARN(), lbConf, currentLBTags); err != nil {
+		return nil, nil, false, err
+	}
+
+	if err := listenerBuilder.buildListeners(ctx, stack, lb, securityGroups, gw, routes, lbConf, listenerCertificates); err != nil {
 		return nil, nil, false, err
 	}
 
 	return stack, lb, securityGroups.backendSecurityGroupAllocated, nil
 }
 
+// fetchCurrentLoadBalancerAddOnTags returns the tags of the LB deployed for the stack, when add-ons cleared from the
+// LoadBalancerConfiguration might still be managed by the controller.
+func (baseBuilder *baseModelBuilder) fetchCurrentLoadBalancerAddOnTags(ctx context.Context, stack core.Stack, lbConf elbv2gw.LoadBalancerConfiguration) (map[string]string, error) {
+	if baseBuilder.loadBalancerType != elbv2model.LoadBalancerTypeApplication {
+		return nil, nil
+	}
+	if lbConf.Spec.WAFv2 != nil && lbConf.Spec.WAFRegional != nil && lbConf.Spec.ShieldAdvanced != nil {
+		return nil, nil
+	}
+	stackTags := baseBuilder.trackingProvider.StackTags(stack)
+	sdkLBs, err := baseBuilder.elbv2TaggingManager.ListLoadBalancers(ctx, tracking.TagsAsTagFilter(stackTags))
+	if err != nil {
+		return nil, err
+	}
+	if len(sdkLBs) == 0 {
+		return nil, nil
+	}
+	return sdkLBs[0].Tags, nil
+}
+
 func (baseBuilder *baseModelBuilder) isDeleteProtected(lbConf elbv2gw.LoadBalancerConfiguration) bool {
 	for _, attr := range lbConf.Spec.LoadBalancerAttributes {
 		if attr.Key == shared_constants.LBAttributeDeletionProtection {
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// ParseProtocolPort parses the protocolPort of a listener configuration, of the form PROTOCOL:PORT.
+func ParseProtocolPort(protocolPort elbv2gw.ProtocolPort) (elbv2model.Protocol, int32, error) {
+	protocol, rawPort, found := strings.Cut(string(protocolPort), ":")
+	if !found || protocol == "" || rawPort == "" {
+		return "", 0, errors.Errorf("protocolPort %v must be of the form PROTOCOL:PORT", protocolPort)
+	}
+	port, err := strconv.ParseInt(rawPort, 10, 32)
+	if err != nil || port < 1 || port > 65535 {
+		return "", 0, errors.Errorf("protocolPort %v has an invalid port", protocolPort)
+	}
+	return elbv2model.Protocol(protocol), int32(port), nil
+}
+
+// ValidateSubnetConfigurations validates the subnet configurations of a LoadBalancer regardless of its type.
+// Checks depending on the scheme or the IP address type are skipped when they're empty, i.e. not known yet.
+func ValidateSubnetConfigurations(subnetsConfig []elbv2gw.SubnetConfiguration, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType) error {
+	if len(subnetsConfig) == 0 {
+		return nil
+	}
+
+	identifierSpecified := subnetsConfig[0].Identifier != ""
+	eipAllocationSpecified := subnetsConfig[0].EIPAllocation != nil
+	ipv6AllocationSpecified := subnetsConfig[0].IPv6Allocation != nil
+	privateIPv4AllocationSpecified := subnetsConfig[0].PrivateIPv4Allocation != nil
+	sourceNATSpecified := subnetsConfig[0].SourceNatIPv6Prefix != nil
+
+	if eipAllocationSpecified && scheme != "" && scheme != elbv2model.LoadBalancerSchemeInternetFacing {
+		return errors.Errorf("EIPAllocation can only be set for internet facing load balancers")
+	}
+
+	if ipv6AllocationSpecified && ipAddressType != "" && ipAddressType != elbv2model.IPAddressTypeDualStack {
+		return errors.Errorf("IPv6Allocation can only be set for dualstack load balancers")
+	}
+
+	if privateIPv4AllocationSpecified && scheme != "" && scheme != elbv2model.LoadBalancerSchemeInternal {
+		return errors.Errorf("PrivateIPv4Allocation can only be set for internal load balancers")
+	}
+
+	for _, subnetConfig := range subnetsConfig {
+		if (subnetConfig.Identifier != "") != identifierSpecified {
+			return errors.Errorf("Either specify all subnet identifiers or none.")
+		}
+
+		if (subnetConfig.EIPAllocation != nil) != eipAllocationSpecified {
+			return errors.Errorf("Either specify all eip allocations or none.")
+		}
+
+		if (subnetConfig.IPv6Allocation != nil) != ipv6AllocationSpecified {
+			return errors.Errorf("Either specify all ipv6 allocations or none.")
+		}
+
+		if (subnetConfig.PrivateIPv4Allocation != nil) != privateIPv4AllocationSpecified {
+			return errors.Errorf("Either specify all private ipv4 allocations or none.")
+		}
+
+		if (subnetConfig.SourceNatIPv6Prefix != nil) != sourceNATSpecified {
+			return errors.Errorf("Either specify all source nat prefixes or none.")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_ParseProtocolPort(t *testing.T) {
+	tests := []struct {
+		name         string
+		protocolPort elbv2gw.ProtocolPort
+		wantProtocol elbv2model.Protocol
+		wantPort     int32
+		wantErr      string
+	}{
+		{
+			name:         "protocol and port",
+			protocolPort: "HTTPS:443",
+			wantProtocol: elbv2model.ProtocolHTTPS,
+			wantPort:     443,
+		},
+		{
+			name:         "missing protocol",
+			protocolPort: ":443",
+			wantErr:      "protocolPort :443 must be of the form PROTOCOL:PORT",
+		},
+		{
+			name:         "missing port",
+			protocolPort: "TCP:",
+			wantErr:      "protocolPort TCP: must be of the form PROTOCOL:PORT",
+		},
+		{
+			name:         "missing separator",
+			protocolPort: "TCP",
+			wantErr:      "protocolPort TCP must be of the form PROTOCOL:PORT",
+		},
+		{
+			name:         "port out of range",
+			protocolPort: "TCP:65536",
+			wantErr:      "protocolPort TCP:65536 has an invalid port",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			protocol, port, err := ParseProtocolPort(tt.protocolPort)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantProtocol, protocol)
+			assert.Equal(t, tt.wantPort, port)
+		})
+	}
+}
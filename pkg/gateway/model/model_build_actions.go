@@ -0,0 +1,254 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/routeutils"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	// ALB and NLB support target group weights between 0 and 999.
+	maxTargetGroupWeight = 999
+
+	// defaults of the authenticate actions, matching the defaults of the Ingress auth annotations.
+	defaultAuthScope                    = "openid"
+	defaultAuthSessionCookieName        = "AWSELBAuthSessionCookie"
+	defaultAuthSessionTimeout           = int64(604800)
+	defaultAuthOnUnauthenticatedRequest = elbv2gw.OnUnauthenticatedRequestAuthenticate
+)
+
+var wellKnownPortByScheme = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
+
+// buildL7RuleActions builds the listener rule actions for a route rule.
+// Rules referencing a ListenerRuleConfiguration with authentication authenticate users before any other action.
+// All backends of the rule are forwarded to within a single forward action, weighted by the backendRef weight.
+// Rules with a RequestRedirect filter redirect the request instead, as backends are ignored by such rules.
+func (l listenerBuilderImpl) buildL7RuleActions(stack core.Stack, gw *gwv1.Gateway, lbCfg elbv2gw.LoadBalancerConfiguration, lb *elbv2model.LoadBalancer, securityGroups securityGroupOutput, listenerProtocol elbv2model.Protocol, descriptor routeutils.RouteDescriptor, rule routeutils.RouteRule) ([]elbv2model.Action, error) {
+	var actions []elbv2model.Action
+	if ruleConfig := rule.GetListenerRuleConfig(); ruleConfig != nil {
+		// Rules must never be programmed without their configuration, as that would skip the authentication.
+		if ruleConfig.Configuration == nil {
+			return []elbv2model.Action{buildInternalServerErrorAction()}, nil
+		}
+		if auth := ruleConfig.Configuration.Spec.Authentication; auth != nil {
+			// ALB only supports authenticate actions on HTTPS listeners.
+			if listenerProtocol != elbv2model.ProtocolHTTPS {
+				return []elbv2model.Action{buildInternalServerErrorAction()}, nil
+			}
+			authAction, err := buildAuthenticateAction(*auth, *ruleConfig)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to build authenticate action for route %v", descriptor.GetRouteNamespacedName())
+			}
+			actions = append(actions, authAction)
+		}
+	}
+
+	if httpRule, ok := rule.GetRawRouteRule().(*gwv1.HTTPRouteRule); ok {
+		for _, filter := range httpRule.Filters {
+			if filter.Type == gwv1.HTTPRouteFilterRequestRedirect && filter.RequestRedirect != nil {
+				return append(actions, buildRedirectAction(*filter.RequestRedirect)), nil
+			}
+		}
+	}
+
+	backends := rule.GetBackends()
+	// Per Gateway API, requests matching a rule without any valid backend must receive a 500 status code.
+	if len(backends) == 0 {
+		return append(actions, buildInternalServerErrorAction()), nil
+	}
+
+	var targetGroups []*elbv2model.TargetGroup
+	var weights []int
+	for _, backend := range backends {
+		targetGroup, err := l.tgBuilder.buildTargetGroup(stack, gw, lbCfg, lb.Spec.IPAddressType, descriptor, backend, securityGroups.backendSecurityGroupToken)
+		if err != nil {
+			return nil, err
+		}
+		targetGroups = append(targetGroups, targetGroup)
+		weights = append(weights, backend.Weight)
+	}
+	forwardActions, err := l.buildForwardActions(targetGroups, weights, descriptor)
+	if err != nil {
+		return nil, err
+	}
+	return append(actions, forwardActions...), nil
+}
+
+// buildForwardActions builds a forward action to the target groups, multiple backendRefs resolving to the same target group have their weights combined.
+func (l listenerBuilderImpl) buildForwardActions(targetGroups []*elbv2model.TargetGroup, weights []int, descriptor routeutils.RouteDescriptor) ([]elbv2model.Action, error) {
+	var uniqueTargetGroups []*elbv2model.TargetGroup
+	weightByTGResID := make(map[string]int)
+	for i, targetGroup := range targetGroups {
+		if _, exists := weightByTGResID[targetGroup.ID()]; !exists {
+			uniqueTargetGroups = append(uniqueTargetGroups, targetGroup)
+		}
+		weightByTGResID[targetGroup.ID()] += weights[i]
+	}
+
+	if len(uniqueTargetGroups) == 1 {
+		return buildL4ListenerDefaultActions(uniqueTargetGroups[0]), nil
+	}
+	if l.featureGates != nil && !l.featureGates.Enabled(config.WeightedTargetGroups) {
+		return nil, errors.Errorf("route %v forwards to multiple backends, which requires the %v feature gate", descriptor.GetRouteNamespacedName(), config.WeightedTargetGroups)
+	}
+
+	uniqueWeights := make([]int, 0, len(uniqueTargetGroups))
+	for _, targetGroup := range uniqueTargetGroups {
+		uniqueWeights = append(uniqueWeights, weightByTGResID[targetGroup.ID()])
+	}
+	normalizedWeights := normalizeTargetGroupWeights(uniqueWeights)
+	tgTuples := make([]elbv2model.TargetGroupTuple, 0, len(uniqueTargetGroups))
+	for i, targetGroup := range uniqueTargetGroups {
+		tgTuples = append(tgTuples, elbv2model.TargetGroupTuple{
+			TargetGroupARN: targetGroup.TargetGroupARN(),
+			Weight:         awssdk.Int32(normalizedWeights[i]),
+		})
+	}
+	return []elbv2model.Action{
+		{
+			Type: elbv2model.ActionTypeForward,
+			ForwardConfig: &elbv2model.ForwardActionConfig{
+				TargetGroups: tgTuples,
+			},
+		},
+	}, nil
+}
+
+// normalizeTargetGroupWeights scales Gateway API weights (up to 1,000,000) down to the weight range supported by ALB and NLB.
+// The proportion between weights is preserved as closely as possible, and a non-zero weight never becomes zero.
+func normalizeTargetGroupWeights(weights []int) []int32 {
+	maxWeight := 0
+	for _, weight := range weights {
+		maxWeight = max(maxWeight, weight)
+	}
+	normalizedWeights := make([]int32, 0, len(weights))
+	for _, weight := range weights {
+		if maxWeight <= maxTargetGroupWeight {
+			normalizedWeights = append(normalizedWeights, int32(weight))
+			continue
+		}
+		normalizedWeight := (weight*maxTargetGroupWeight + maxWeight/2) / maxWeight
+		if weight > 0 && normalizedWeight == 0 {
+			normalizedWeight = 1
+		}
+		normalizedWeights = append(normalizedWeights, int32(normalizedWeight))
+	}
+	return normalizedWeights
+}
+
+// buildRedirectAction builds the action for a RequestRedirect filter.
+// Unset redirect fields are left empty, which makes ALB keep the corresponding value of the original request.
+func buildRedirectAction(redirect gwv1.HTTPRequestRedirectFilter) elbv2model.Action {
+	redirectConfig := &elbv2model.RedirectActionConfig{
+		StatusCode: "HTTP_302",
+	}
+	if redirect.StatusCode != nil {
+		redirectConfig.StatusCode = fmt.Sprintf("HTTP_%d", *redirect.StatusCode)
+	}
+	if redirect.Scheme != nil {
+		redirectConfig.Protocol = awssdk.String(strings.ToUpper(*redirect.Scheme))
+		// Per Gateway API, changing the scheme without specifying a port redirects to the well known port of the scheme.
+		if redirect.Port == nil {
+			redirectConfig.Port = awssdk.String(wellKnownPortByScheme[strings.ToLower(*redirect.Scheme)])
+		}
+	}
+	if redirect.Port != nil {
+		redirectConfig.Port = awssdk.String(strconv.Itoa(int(*redirect.Port)))
+	}
+	if redirect.Hostname != nil {
+		redirectConfig.Host = awssdk.String(string(*redirect.Hostname))
+	}
+	if redirect.Path != nil && redirect.Path.ReplaceFullPath != nil {
+		redirectConfig.Path = redirect.Path.ReplaceFullPath
+	}
+	return elbv2model.Action{
+		Type:           elbv2model.ActionTypeRedirect,
+		RedirectConfig: redirectConfig,
+	}
+}
+
+// buildAuthenticateAction builds the authenticate-cognito or authenticate-oidc action of a ListenerRuleConfiguration.
+// Fields left empty are defaulted the same way as the Ingress auth annotations.
+func buildAuthenticateAction(auth elbv2gw.AuthenticationConfiguration, ruleConfig routeutils.ListenerRuleConfig) (elbv2model.Action, error) {
+	onUnauthenticatedRequest := defaultAuthOnUnauthenticatedRequest
+	if auth.OnUnauthenticatedRequest != nil {
+		onUnauthenticatedRequest = *auth.OnUnauthenticatedRequest
+	}
+	scope := defaultAuthScope
+	if auth.Scope != nil {
+		scope = *auth.Scope
+	}
+	sessionCookieName := defaultAuthSessionCookieName
+	if auth.SessionCookieName != nil {
+		sessionCookieName = *auth.SessionCookieName
+	}
+	sessionTimeout := defaultAuthSessionTimeout
+	if auth.SessionTimeout != nil {
+		sessionTimeout = *auth.SessionTimeout
+	}
+
+	switch auth.Type {
+	case elbv2gw.AuthenticationTypeCognito:
+		if auth.Cognito == nil {
+			return elbv2model.Action{}, errors.New("missing cognito configuration")
+		}
+		return elbv2model.Action{
+			Type: elbv2model.ActionTypeAuthenticateCognito,
+			AuthenticateCognitoConfig: &elbv2model.AuthenticateCognitoActionConfig{
+				UserPoolARN:                      auth.Cognito.UserPoolARN,
+				UserPoolClientID:                 auth.Cognito.UserPoolClientID,
+				UserPoolDomain:                   auth.Cognito.UserPoolDomain,
+				AuthenticationRequestExtraParams: auth.AuthenticationRequestExtraParams,
+				OnUnauthenticatedRequest:         elbv2model.AuthenticateCognitoActionConditionalBehavior(onUnauthenticatedRequest),
+				Scope:                            awssdk.String(scope),
+				SessionCookieName:                awssdk.String(sessionCookieName),
+				SessionTimeout:                   awssdk.Int64(sessionTimeout),
+			},
+		}, nil
+	case elbv2gw.AuthenticationTypeOIDC:
+		if auth.OIDC == nil {
+			return elbv2model.Action{}, errors.New("missing oidc configuration")
+		}
+		return elbv2model.Action{
+			Type: elbv2model.ActionTypeAuthenticateOIDC,
+			AuthenticateOIDCConfig: &elbv2model.AuthenticateOIDCActionConfig{
+				Issuer:                           auth.OIDC.Issuer,
+				AuthorizationEndpoint:            auth.OIDC.AuthorizationEndpoint,
+				TokenEndpoint:                    auth.OIDC.TokenEndpoint,
+				UserInfoEndpoint:                 auth.OIDC.UserInfoEndpoint,
+				ClientID:                         ruleConfig.OIDCClientID,
+				ClientSecret:                     ruleConfig.OIDCClientSecret,
+				AuthenticationRequestExtraParams: auth.AuthenticationRequestExtraParams,
+				OnUnauthenticatedRequest:         elbv2model.AuthenticateOIDCActionConditionalBehavior(onUnauthenticatedRequest),
+				Scope:                            awssdk.String(scope),
+				SessionCookieName:                awssdk.String(sessionCookieName),
+				SessionTimeout:                   awssdk.Int64(sessionTimeout),
+			},
+		}, nil
+	default:
+		return elbv2model.Action{}, errors.Errorf("unknown authentication type: %v", auth.Type)
+	}
+}
+
+// buildInternalServerErrorAction builds the action for rules that can't be programmed safely, e.g. rules without any valid backend.
+func buildInternalServerErrorAction() elbv2model.Action {
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			ContentType: awssdk.String("text/plain"),
+			StatusCode:  "500",
+		},
+	}
+}
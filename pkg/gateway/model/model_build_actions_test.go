@@ -0,0 +1,435 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/routeutils"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_buildForwardActions(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+	tg1 := elbv2model.NewTargetGroup(stack, "tg-1", elbv2model.TargetGroupSpec{})
+	tg2 := elbv2model.NewTargetGroup(stack, "tg-2", elbv2model.TargetGroupSpec{})
+
+	tests := []struct {
+		name               string
+		targetGroups       []*elbv2model.TargetGroup
+		weights            []int
+		disableWeightedTGs bool
+		want               []elbv2model.Action
+		wantErr            bool
+	}{
+		{
+			name:         "single backend",
+			targetGroups: []*elbv2model.TargetGroup{tg1},
+			weights:      []int{1},
+			want: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeForward,
+					ForwardConfig: &elbv2model.ForwardActionConfig{
+						TargetGroups: []elbv2model.TargetGroupTuple{
+							{
+								TargetGroupARN: tg1.TargetGroupARN(),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:         "weighted backends",
+			targetGroups: []*elbv2model.TargetGroup{tg1, tg2},
+			weights:      []int{90, 10},
+			want: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeForward,
+					ForwardConfig: &elbv2model.ForwardActionConfig{
+						TargetGroups: []elbv2model.TargetGroupTuple{
+							{
+								TargetGroupARN: tg1.TargetGroupARN(),
+								Weight:         awssdk.Int32(90),
+							},
+							{
+								TargetGroupARN: tg2.TargetGroupARN(),
+								Weight:         awssdk.Int32(10),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:         "backends resolving to the same target group are combined",
+			targetGroups: []*elbv2model.TargetGroup{tg1, tg2, tg1},
+			weights:      []int{1, 2, 3},
+			want: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeForward,
+					ForwardConfig: &elbv2model.ForwardActionConfig{
+						TargetGroups: []elbv2model.TargetGroupTuple{
+							{
+								TargetGroupARN: tg1.TargetGroupARN(),
+								Weight:         awssdk.Int32(4),
+							},
+							{
+								TargetGroupARN: tg2.TargetGroupARN(),
+								Weight:         awssdk.Int32(2),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:               "weighted backends with feature gate disabled",
+			targetGroups:       []*elbv2model.TargetGroup{tg1, tg2},
+			weights:            []int{90, 10},
+			disableWeightedTGs: true,
+			wantErr:            true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureGates := config.NewFeatureGates()
+			if tt.disableWeightedTGs {
+				featureGates.Disable(config.WeightedTargetGroups)
+			}
+			builder := listenerBuilderImpl{
+				featureGates: featureGates,
+			}
+			got, err := builder.buildForwardActions(tt.targetGroups, tt.weights, &routeutils.MockRoute{Namespace: "ns", Name: "route"})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			// target group ARN tokens hold resolver funcs, compare their JSON representation instead.
+			wantJSON, _ := json.Marshal(tt.want)
+			gotJSON, _ := json.Marshal(got)
+			assert.JSONEq(t, string(wantJSON), string(gotJSON))
+		})
+	}
+}
+
+func Test_buildL7RuleActions_NoBackends(t *testing.T) {
+	builder := listenerBuilderImpl{
+		featureGates: config.NewFeatureGates(),
+	}
+	stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+	got, err := builder.buildL7RuleActions(stack, nil, elbv2gw.LoadBalancerConfiguration{}, nil, securityGroupOutput{}, elbv2model.ProtocolHTTP, &routeutils.MockRoute{}, &routeutils.MockRule{})
+	assert.NoError(t, err)
+	assert.Equal(t, []elbv2model.Action{
+		{
+			Type: elbv2model.ActionTypeFixedResponse,
+			FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+				ContentType: awssdk.String("text/plain"),
+				StatusCode:  "500",
+			},
+		},
+	}, got)
+}
+
+func Test_buildL7RuleActions_RequestRedirect(t *testing.T) {
+	builder := listenerBuilderImpl{
+		featureGates: config.NewFeatureGates(),
+	}
+	httpsScheme := "https"
+	rule := &gwv1.HTTPRouteRule{
+		Filters: []gwv1.HTTPRouteFilter{
+			{
+				Type: gwv1.HTTPRouteFilterRequestRedirect,
+				RequestRedirect: &gwv1.HTTPRequestRedirectFilter{
+					Scheme: &httpsScheme,
+				},
+			},
+		},
+	}
+	stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+	got, err := builder.buildL7RuleActions(stack, nil, elbv2gw.LoadBalancerConfiguration{}, nil, securityGroupOutput{}, elbv2model.ProtocolHTTP, &routeutils.MockRoute{}, &routeutils.MockRule{RawRule: rule})
+	assert.NoError(t, err)
+	assert.Equal(t, []elbv2model.Action{
+		{
+			Type: elbv2model.ActionTypeRedirect,
+			RedirectConfig: &elbv2model.RedirectActionConfig{
+				Port:       awssdk.String("443"),
+				Protocol:   awssdk.String("HTTPS"),
+				StatusCode: "HTTP_302",
+			},
+		},
+	}, got)
+}
+
+func Test_buildRedirectAction(t *testing.T) {
+	httpScheme := "http"
+	httpsScheme := "https"
+	hostname := gwv1.PreciseHostname("example.com")
+	port := gwv1.PortNumber(8443)
+	path := "/new"
+	movedPermanently := 301
+	tests := []struct {
+		name     string
+		redirect gwv1.HTTPRequestRedirectFilter
+		want     elbv2model.Action
+	}{
+		{
+			name:     "empty redirect",
+			redirect: gwv1.HTTPRequestRedirectFilter{},
+			want: elbv2model.Action{
+				Type: elbv2model.ActionTypeRedirect,
+				RedirectConfig: &elbv2model.RedirectActionConfig{
+					StatusCode: "HTTP_302",
+				},
+			},
+		},
+		{
+			name: "scheme without port uses the well known port",
+			redirect: gwv1.HTTPRequestRedirectFilter{
+				Scheme:     &httpScheme,
+				StatusCode: &movedPermanently,
+			},
+			want: elbv2model.Action{
+				Type: elbv2model.ActionTypeRedirect,
+				RedirectConfig: &elbv2model.RedirectActionConfig{
+					Port:       awssdk.String("80"),
+					Protocol:   awssdk.String("HTTP"),
+					StatusCode: "HTTP_301",
+				},
+			},
+		},
+		{
+			name: "all fields",
+			redirect: gwv1.HTTPRequestRedirectFilter{
+				Scheme:   &httpsScheme,
+				Hostname: &hostname,
+				Path: &gwv1.HTTPPathModifier{
+					Type:            gwv1.FullPathHTTPPathModifier,
+					ReplaceFullPath: &path,
+				},
+				Port: &port,
+			},
+			want: elbv2model.Action{
+				Type: elbv2model.ActionTypeRedirect,
+				RedirectConfig: &elbv2model.RedirectActionConfig{
+					Host:       awssdk.String("example.com"),
+					Path:       awssdk.String("/new"),
+					Port:       awssdk.String("8443"),
+					Protocol:   awssdk.String("HTTPS"),
+					StatusCode: "HTTP_302",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildRedirectAction(tt.redirect))
+		})
+	}
+}
+
+func Test_normalizeTargetGroupWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []int
+		want    []int32
+	}{
+		{
+			name:    "weights within ALB range are kept",
+			weights: []int{0, 1, 999},
+			want:    []int32{0, 1, 999},
+		},
+		{
+			name:    "weights exceeding ALB range are scaled",
+			weights: []int{1000000, 500000},
+			want:    []int32{999, 500},
+		},
+		{
+			name:    "non-zero weights are never scaled to zero",
+			weights: []int{1000000, 1, 0},
+			want:    []int32{999, 1, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeTargetGroupWeights(tt.weights))
+		})
+	}
+}
+
+func Test_buildL7RuleActions_Authentication(t *testing.T) {
+	builder := listenerBuilderImpl{
+		featureGates: config.NewFeatureGates(),
+	}
+	httpsScheme := "https"
+	redirectRule := &gwv1.HTTPRouteRule{
+		Filters: []gwv1.HTTPRouteFilter{
+			{
+				Type: gwv1.HTTPRouteFilterRequestRedirect,
+				RequestRedirect: &gwv1.HTTPRequestRedirectFilter{
+					Scheme: &httpsScheme,
+				},
+			},
+		},
+	}
+	cognitoRuleConfig := &routeutils.ListenerRuleConfig{
+		Configuration: &elbv2gw.ListenerRuleConfiguration{
+			Spec: elbv2gw.ListenerRuleConfigurationSpec{
+				Authentication: &elbv2gw.AuthenticationConfiguration{
+					Type: elbv2gw.AuthenticationTypeCognito,
+					Cognito: &elbv2gw.AuthenticationCognitoConfig{
+						UserPoolARN:      "pool-arn",
+						UserPoolClientID: "client",
+						UserPoolDomain:   "domain",
+					},
+				},
+			},
+		},
+	}
+	cognitoAction := elbv2model.Action{
+		Type: elbv2model.ActionTypeAuthenticateCognito,
+		AuthenticateCognitoConfig: &elbv2model.AuthenticateCognitoActionConfig{
+			UserPoolARN:              "pool-arn",
+			UserPoolClientID:         "client",
+			UserPoolDomain:           "domain",
+			OnUnauthenticatedRequest: elbv2model.AuthenticateCognitoActionConditionalBehaviorAuthenticate,
+			Scope:                    awssdk.String("openid"),
+			SessionCookieName:        awssdk.String("AWSELBAuthSessionCookie"),
+			SessionTimeout:           awssdk.Int64(604800),
+		},
+	}
+	internalServerErrorAction := elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			ContentType: awssdk.String("text/plain"),
+			StatusCode:  "500",
+		},
+	}
+
+	tests := []struct {
+		name             string
+		rule             *routeutils.MockRule
+		listenerProtocol elbv2model.Protocol
+		want             []elbv2model.Action
+	}{
+		{
+			name:             "authenticate before redirect",
+			rule:             &routeutils.MockRule{RawRule: redirectRule, RuleConfig: cognitoRuleConfig},
+			listenerProtocol: elbv2model.ProtocolHTTPS,
+			want: []elbv2model.Action{
+				cognitoAction,
+				{
+					Type: elbv2model.ActionTypeRedirect,
+					RedirectConfig: &elbv2model.RedirectActionConfig{
+						Port:       awssdk.String("443"),
+						Protocol:   awssdk.String("HTTPS"),
+						StatusCode: "HTTP_302",
+					},
+				},
+			},
+		},
+		{
+			name:             "authenticate rule without backends",
+			rule:             &routeutils.MockRule{RuleConfig: cognitoRuleConfig},
+			listenerProtocol: elbv2model.ProtocolHTTPS,
+			want:             []elbv2model.Action{cognitoAction, internalServerErrorAction},
+		},
+		{
+			name:             "authentication isn't supported on http listeners",
+			rule:             &routeutils.MockRule{RawRule: redirectRule, RuleConfig: cognitoRuleConfig},
+			listenerProtocol: elbv2model.ProtocolHTTP,
+			want:             []elbv2model.Action{internalServerErrorAction},
+		},
+		{
+			name:             "unresolved listener rule configuration",
+			rule:             &routeutils.MockRule{RawRule: redirectRule, RuleConfig: &routeutils.ListenerRuleConfig{}},
+			listenerProtocol: elbv2model.ProtocolHTTPS,
+			want:             []elbv2model.Action{internalServerErrorAction},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+			got, err := builder.buildL7RuleActions(stack, nil, elbv2gw.LoadBalancerConfiguration{}, nil, securityGroupOutput{}, tt.listenerProtocol, &routeutils.MockRoute{}, tt.rule)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_buildAuthenticateAction(t *testing.T) {
+	deny := elbv2gw.OnUnauthenticatedRequestDeny
+	tests := []struct {
+		name       string
+		auth       elbv2gw.AuthenticationConfiguration
+		ruleConfig routeutils.ListenerRuleConfig
+		want       elbv2model.Action
+		wantErr    bool
+	}{
+		{
+			name: "oidc with custom settings",
+			auth: elbv2gw.AuthenticationConfiguration{
+				Type: elbv2gw.AuthenticationTypeOIDC,
+				OIDC: &elbv2gw.AuthenticationOIDCConfig{
+					Issuer:                "https://idp.example.com",
+					AuthorizationEndpoint: "https://idp.example.com/authorize",
+					TokenEndpoint:         "https://idp.example.com/token",
+					UserInfoEndpoint:      "https://idp.example.com/userinfo",
+					SecretName:            "oidc-secret",
+				},
+				OnUnauthenticatedRequest:         &deny,
+				Scope:                            awssdk.String("openid email"),
+				SessionCookieName:                awssdk.String("my-cookie"),
+				SessionTimeout:                   awssdk.Int64(3600),
+				AuthenticationRequestExtraParams: map[string]string{"prompt": "login"},
+			},
+			ruleConfig: routeutils.ListenerRuleConfig{
+				OIDCClientID:     "client-id",
+				OIDCClientSecret: "client-secret",
+			},
+			want: elbv2model.Action{
+				Type: elbv2model.ActionTypeAuthenticateOIDC,
+				AuthenticateOIDCConfig: &elbv2model.AuthenticateOIDCActionConfig{
+					Issuer:                           "https://idp.example.com",
+					AuthorizationEndpoint:            "https://idp.example.com/authorize",
+					TokenEndpoint:                    "https://idp.example.com/token",
+					UserInfoEndpoint:                 "https://idp.example.com/userinfo",
+					ClientID:                         "client-id",
+					ClientSecret:                     "client-secret",
+					AuthenticationRequestExtraParams: map[string]string{"prompt": "login"},
+					OnUnauthenticatedRequest:         elbv2model.AuthenticateOIDCActionConditionalBehaviorDeny,
+					Scope:                            awssdk.String("openid email"),
+					SessionCookieName:                awssdk.String("my-cookie"),
+					SessionTimeout:                   awssdk.Int64(3600),
+				},
+			},
+		},
+		{
+			name: "oidc type without oidc configuration",
+			auth: elbv2gw.AuthenticationConfiguration{
+				Type: elbv2gw.AuthenticationTypeOIDC,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildAuthenticateAction(tt.auth, tt.ruleConfig)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
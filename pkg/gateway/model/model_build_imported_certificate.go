@@ -0,0 +1,76 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+// buildImportedCertificate builds the ACM certificate imported from a kubernetes.io/tls secret.
+// A secret referenced by multiple listeners is only imported once.
+func buildImportedCertificate(stack core.Stack, secret *corev1.Secret, tags map[string]string) (*acmmodel.Certificate, error) {
+	certResID := k8s.NamespacedName(secret).String()
+	var existingCerts []*acmmodel.Certificate
+	if err := stack.ListResources(&existingCerts); err != nil {
+		return nil, err
+	}
+	for _, cert := range existingCerts {
+		if cert.ID() == certResID {
+			return cert, nil
+		}
+	}
+
+	spec, err := buildImportedCertificateSpec(secret, tags)
+	if err != nil {
+		return nil, err
+	}
+	return acmmodel.NewCertificate(stack, certResID, spec), nil
+}
+
+// buildImportedCertificateSpec splits the tls.crt of the secret into the leaf certificate and its chain, as expected by ACM.
+func buildImportedCertificateSpec(secret *corev1.Secret, tags map[string]string) (acmmodel.CertificateSpec, error) {
+	rawCert := secret.Data[corev1.TLSCertKey]
+	privateKey := secret.Data[corev1.TLSPrivateKeyKey]
+	if len(rawCert) == 0 || len(privateKey) == 0 {
+		return acmmodel.CertificateSpec{}, errors.Errorf("secret %v must contain the %v and %v keys", k8s.NamespacedName(secret), corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+	}
+
+	var certBlocks [][]byte
+	for rest := rawCert; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			certBlocks = append(certBlocks, pem.EncodeToMemory(block))
+		}
+	}
+	if len(certBlocks) == 0 {
+		return acmmodel.CertificateSpec{}, errors.Errorf("secret %v doesn't contain any PEM encoded certificate", k8s.NamespacedName(secret))
+	}
+
+	certificate := certBlocks[0]
+	var certificateChain []byte
+	for _, block := range certBlocks[1:] {
+		certificateChain = append(certificateChain, block...)
+	}
+
+	digest := sha256.New()
+	digest.Write(certificate)
+	digest.Write(certificateChain)
+	digest.Write(privateKey)
+	return acmmodel.CertificateSpec{
+		Certificate:      certificate,
+		CertificateChain: certificateChain,
+		PrivateKey:       privateKey,
+		Digest:           hex.EncodeToString(digest.Sum(nil)),
+		Tags:             tags,
+	}, nil
+}
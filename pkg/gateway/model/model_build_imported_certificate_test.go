@@ -0,0 +1,218 @@
+package model
+
+import (
+	"context"
+	"encoding/pem"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func newTestTLSSecret(name string, certs ...string) *corev1.Secret {
+	var rawCert []byte
+	for _, cert := range certs {
+		rawCert = append(rawCert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte(cert)})...)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       rawCert,
+			corev1.TLSPrivateKeyKey: []byte("key-" + name),
+		},
+	}
+}
+
+func Test_buildImportedCertificateSpec(t *testing.T) {
+	tests := []struct {
+		name          string
+		secret        *corev1.Secret
+		wantCert      []byte
+		wantCertChain []byte
+		wantErr       string
+	}{
+		{
+			name:     "leaf certificate only",
+			secret:   newTestTLSSecret("tls", "leaf"),
+			wantCert: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("leaf")}),
+		},
+		{
+			name:     "leaf certificate with chain",
+			secret:   newTestTLSSecret("tls", "leaf", "intermediate", "root"),
+			wantCert: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("leaf")}),
+			wantCertChain: append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("intermediate")}),
+				pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("root")})...),
+		},
+		{
+			name: "missing private key",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "tls"},
+				Data: map[string][]byte{
+					corev1.TLSCertKey: []byte("cert"),
+				},
+			},
+			wantErr: "secret ns/tls must contain the tls.crt and tls.key keys",
+		},
+		{
+			name: "no PEM certificate",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "tls"},
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       []byte("cert"),
+					corev1.TLSPrivateKeyKey: []byte("key"),
+				},
+			},
+			wantErr: "secret ns/tls doesn't contain any PEM encoded certificate",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildImportedCertificateSpec(tt.secret, map[string]string{"k": "v"})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCert, got.Certificate)
+			assert.Equal(t, tt.wantCertChain, got.CertificateChain)
+			assert.Equal(t, tt.secret.Data[corev1.TLSPrivateKeyKey], got.PrivateKey)
+			assert.Equal(t, map[string]string{"k": "v"}, got.Tags)
+			assert.Len(t, got.Digest, 64)
+		})
+	}
+
+	t.Run("digest changes with the certificate", func(t *testing.T) {
+		spec1, err := buildImportedCertificateSpec(newTestTLSSecret("tls", "leaf-1"), nil)
+		assert.NoError(t, err)
+		spec2, err := buildImportedCertificateSpec(newTestTLSSecret("tls", "leaf-2"), nil)
+		assert.NoError(t, err)
+		assert.NotEqual(t, spec1.Digest, spec2.Digest)
+	})
+}
+
+func Test_buildCertificates_ImportedCertificates(t *testing.T) {
+	fooSecret := newTestTLSSecret("foo-tls", "foo")
+	barSecret := newTestTLSSecret("bar-tls", "bar")
+	tests := []struct {
+		name             string
+		importEnabled    bool
+		gwLsCfg          *gwListenerConfig
+		lbLsCfg          *elbv2gw.ListenerConfiguration
+		setupMocks       func(mockCertDiscovery *certs.MockCertDiscovery)
+		wantImportedIDs  []string
+		wantCertificates []elbv2model.Certificate
+	}{
+		{
+			name:          "certificate secrets are imported",
+			importEnabled: true,
+			gwLsCfg: &gwListenerConfig{
+				protocol:           elbv2model.ProtocolHTTPS,
+				certificateSecrets: []*corev1.Secret{fooSecret, barSecret},
+			},
+			wantImportedIDs: []string{"ns/foo-tls", "ns/bar-tls"},
+		},
+		{
+			name:          "explicit certificates take precedence",
+			importEnabled: true,
+			gwLsCfg: &gwListenerConfig{
+				protocol:           elbv2model.ProtocolHTTPS,
+				certificateSecrets: []*corev1.Secret{fooSecret},
+			},
+			lbLsCfg: &elbv2gw.ListenerConfiguration{
+				ProtocolPort:       "HTTPS:443",
+				DefaultCertificate: aws.String("arn:aws:acm:region:123456789012:certificate/cert-1"),
+			},
+			wantCertificates: []elbv2model.Certificate{
+				{
+					CertificateARN: aws.String("arn:aws:acm:region:123456789012:certificate/cert-1"),
+				},
+			},
+		},
+		{
+			name: "certificate secrets are ignored when import is disabled",
+			gwLsCfg: &gwListenerConfig{
+				protocol:           elbv2model.ProtocolHTTPS,
+				hostnames:          []string{"foo.example.com"},
+				certificateSecrets: []*corev1.Secret{fooSecret},
+			},
+			lbLsCfg: &elbv2gw.ListenerConfiguration{
+				ProtocolPort: "HTTPS:443",
+			},
+			setupMocks: func(mockCertDiscovery *certs.MockCertDiscovery) {
+				mockCertDiscovery.EXPECT().
+					Discover(gomock.Any(), []string{"foo.example.com"}).
+					Return([]string{"arn:aws:acm:region:123456789012:certificate/cert-1"}, nil)
+			},
+			wantCertificates: []elbv2model.Certificate{
+				{
+					CertificateARN: aws.String("arn:aws:acm:region:123456789012:certificate/cert-1"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCertDiscovery := certs.NewMockCertDiscovery(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockCertDiscovery)
+			}
+			featureGates := config.NewFeatureGates()
+			if tt.importEnabled {
+				featureGates.Enable(config.GatewayCertificateImport)
+			}
+			builder := &listenerBuilderImpl{
+				certDiscovery: mockCertDiscovery,
+				featureGates:  featureGates,
+				tagHelper:     newTagHelper(sets.New[string](), map[string]string{"default": "tag"}),
+			}
+			stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "gw"})
+
+			got, err := builder.buildCertificates(context.Background(), stack, elbv2gw.LoadBalancerConfiguration{}, tt.gwLsCfg, tt.lbLsCfg)
+			assert.NoError(t, err)
+
+			var importedCerts []*acmmodel.Certificate
+			assert.NoError(t, stack.ListResources(&importedCerts))
+			if tt.wantImportedIDs == nil {
+				assert.Empty(t, importedCerts)
+				assert.Equal(t, tt.wantCertificates, got)
+				return
+			}
+			assert.Len(t, got, len(tt.wantImportedIDs))
+			assert.Len(t, importedCerts, len(tt.wantImportedIDs))
+			for i, wantID := range tt.wantImportedIDs {
+				assert.Nil(t, got[i].CertificateARN)
+				assert.Equal(t, []core.Resource{findCertificate(importedCerts, wantID)}, got[i].ImportedCertificateARN.Dependencies())
+				assert.Equal(t, map[string]string{"default": "tag"}, findCertificate(importedCerts, wantID).Spec.Tags)
+			}
+
+			// a secret referenced again, e.g. from another port, is only imported once.
+			_, err = builder.buildCertificates(context.Background(), stack, elbv2gw.LoadBalancerConfiguration{}, tt.gwLsCfg, tt.lbLsCfg)
+			assert.NoError(t, err)
+			importedCerts = nil
+			assert.NoError(t, stack.ListResources(&importedCerts))
+			assert.Len(t, importedCerts, len(tt.wantImportedIDs))
+		})
+	}
+}
+
+func findCertificate(certs []*acmmodel.Certificate, id string) *acmmodel.Certificate {
+	for _, cert := range certs {
+		if cert.ID() == id {
+			return cert
+		}
+	}
+	return nil
+}
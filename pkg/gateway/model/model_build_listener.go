@@ -3,16 +3,16 @@ package model
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	certs "sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/routeutils"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
@@ -25,10 +25,12 @@ import (
 type gwListenerConfig struct {
 	protocol  elbv2model.Protocol
 	hostnames []string
+	// certificateSecrets are the TLS secrets resolved from the tls.certificateRefs of the listeners.
+	certificateSecrets []*corev1.Secret
 }
 
 type listenerBuilder interface {
-	buildListeners(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, securityGroups securityGroupOutput, gw *gwv1.Gateway, routes map[int32][]routeutils.RouteDescriptor, lbConf elbv2gw.LoadBalancerConfiguration) error
+	buildListeners(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, securityGroups securityGroupOutput, gw *gwv1.Gateway, routes map[int32][]routeutils.RouteDescriptor, lbConf elbv2gw.LoadBalancerConfiguration, listenerCertificates map[gwv1.SectionName]routeutils.ListenerCertificates) error
 	buildListenerSpec(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, securityGroups securityGroupOutput, gw *gwv1.Gateway, port int32, routes []routeutils.RouteDescriptor, lbCfg elbv2gw.LoadBalancerConfiguration, gwLsCfg *gwListenerConfig, lbLsCfg *elbv2gw.ListenerConfiguration) (*elbv2model.ListenerSpec, error)
 	buildL7ListenerSpec(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, securityGroups securityGroupOutput, gw *gwv1.Gateway, lbCfg elbv2gw.LoadBalancerConfiguration, port int32, routes []routeutils.RouteDescriptor, gwLsCfg *gwListenerConfig, lbLsCfg *elbv2gw.ListenerConfiguration) (*elbv2model.ListenerSpec, error)
 	buildL4ListenerSpec(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, securityGroups securityGroupOutput, gw *gwv1.Gateway, lbCfg elbv2gw.LoadBalancerConfiguration, port int32, routes []routeutils.RouteDescriptor, gwLsCfg *gwListenerConfig, lbLsCfg *elbv2gw.ListenerConfiguration) (*elbv2model.ListenerSpec, error)
@@ -41,11 +43,12 @@ type listenerBuilderImpl struct {
 	tgBuilder        targetGroupBuilder
 	defaultSSLPolicy string
 	certDiscovery    certs.CertDiscovery
+	featureGates     config.FeatureGates
 	logger           logr.Logger
 }
 
-func (l listenerBuilderImpl) buildListeners(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, securityGroups securityGroupOutput, gw *gwv1.Gateway, routes map[int32][]routeutils.RouteDescriptor, lbCfg elbv2gw.LoadBalancerConfiguration) error {
-	gwLsCfgs, err := mapGatewayListenerConfigsByPort(gw)
+func (l listenerBuilderImpl) buildListeners(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, securityGroups securityGroupOutput, gw *gwv1.Gateway, routes map[int32][]routeutils.RouteDescriptor, lbCfg elbv2gw.LoadBalancerConfiguration, listenerCertificates map[gwv1.SectionName]routeutils.ListenerCertificates) error {
+	gwLsCfgs, err := mapGatewayListenerConfigsByPort(gw, listenerCertificates)
 	if err != nil {
 		return err
 	}
@@ -61,7 +64,7 @@ func (l listenerBuilderImpl) buildListeners(ctx context.Context, stack core.Stac
 			}
 			// build rules only for L7 gateways
 			if l.loadBalancerType == elbv2model.LoadBalancerTypeApplication {
-				if err := l.buildListenerRules(stack, ls, lb, securityGroups, gw, port, lbCfg, routes); err != nil {
+				if err := l.buildListenerRules(stack, ls, lb, securityGroups, gw, port, lbCfg, routes[port]); err != nil {
 					return err
 				}
 			}
@@ -103,7 +106,7 @@ func (l listenerBuilderImpl) buildListenerSpec(ctx context.Context, stack core.S
 	if sslPolicyErr != nil {
 		return &elbv2model.ListenerSpec{}, sslPolicyErr
 	}
-	certificates, certsErr := l.buildCertificates(ctx, gwLsCfg, lbLsCfg)
+	certificates, certsErr := l.buildCertificates(ctx, stack, lbCfg, gwLsCfg, lbLsCfg)
 	if certsErr != nil {
 		return &elbv2model.ListenerSpec{}, certsErr
 	}
@@ -134,6 +137,10 @@ func (l listenerBuilderImpl) buildL7ListenerSpec(ctx context.Context, stack core
 }
 
 func (l listenerBuilderImpl) buildL4ListenerSpec(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, securityGroups securityGroupOutput, gw *gwv1.Gateway, lbCfg elbv2gw.LoadBalancerConfiguration, port int32, routes []routeutils.RouteDescriptor, gwLsCfg *gwListenerConfig, lbLsCfg *elbv2gw.ListenerConfiguration) (*elbv2model.ListenerSpec, error) {
+	// TLS routes are distinguished by the certificate served for their hostnames, so certificates are discovered for them as well.
+	if gwLsCfg.protocol == elbv2model.ProtocolTLS {
+		gwLsCfg = withL4RouteHostnames(gwLsCfg, routes)
+	}
 	listenerSpec, err := l.buildListenerSpec(ctx, stack, lb, securityGroups, gw, port, routes, lbCfg, gwLsCfg, lbLsCfg)
 	if err != nil {
 		return &elbv2model.ListenerSpec{}, err
@@ -144,59 +151,81 @@ func (l listenerBuilderImpl) buildL4ListenerSpec(ctx context.Context, stack core
 	}
 	listenerSpec.ALPNPolicy = alpnPolicy
 
-	// For L4 Gateways we will assume that each L4 gateway Listener will have a single L4 route and each route will only have a single backendRef as weighted tgs are not supported for NLBs.
-	if len(routes) > 1 {
-		return &elbv2model.ListenerSpec{}, errors.Errorf("multiple routes %v are not supported for listener on port:protocol %v:%v for gateway %v", routes, port, listenerSpec.Protocol, k8s.NamespacedName(gw))
+	routeDescriptor, backends, err := selectL4RouteBackends(gw, routes)
+	if err != nil {
+		return &elbv2model.ListenerSpec{}, err
+	}
+	var targetGroups []*elbv2model.TargetGroup
+	var weights []int
+	for _, backend := range backends {
+		targetGroup, tgErr := l.tgBuilder.buildTargetGroup(stack, gw, lbCfg, lb.Spec.IPAddressType, routeDescriptor, backend, securityGroups.backendSecurityGroupToken)
+		if tgErr != nil {
+			return &elbv2model.ListenerSpec{}, tgErr
+		}
+		targetGroups = append(targetGroups, targetGroup)
+		weights = append(weights, backend.Weight)
 	}
+	defaultActions, err := l.buildForwardActions(targetGroups, weights, routeDescriptor)
+	if err != nil {
+		return &elbv2model.ListenerSpec{}, err
+	}
+	listenerSpec.DefaultActions = defaultActions
+	return listenerSpec, nil
+}
+
+// selectL4RouteBackends selects the route and the backends forwarded to by the default action of an L4 listener.
+// NLB listeners don't have rules, the routes loader only keeps multiple TLS routes on a listener when they forward to the same backends.
+// Backends of the oldest route are used, so that target groups stay stable when routes are added.
+func selectL4RouteBackends(gw *gwv1.Gateway, routes []routeutils.RouteDescriptor) (routeutils.RouteDescriptor, []routeutils.Backend, error) {
 	routeDescriptor := routes[0]
-	if routeDescriptor.GetAttachedRules()[0].GetBackends() == nil || len(routeDescriptor.GetAttachedRules()[0].GetBackends()) == 0 {
-		return &elbv2model.ListenerSpec{}, errors.Errorf("no backend refs found for route %v for gateway %v, one backend ref must be specified", routeDescriptor.GetRouteNamespacedName(), k8s.NamespacedName(gw))
+	for _, route := range routes[1:] {
+		rTime, oldestTime := route.GetRouteCreateTimestamp(), routeDescriptor.GetRouteCreateTimestamp()
+		if rTime.Before(oldestTime) || (rTime.Equal(oldestTime) && route.GetRouteNamespacedName().String() < routeDescriptor.GetRouteNamespacedName().String()) {
+			routeDescriptor = route
+		}
 	}
-	if len(routeDescriptor.GetAttachedRules()[0].GetBackends()) > 1 {
-		return &elbv2model.ListenerSpec{}, errors.Errorf("multiple backend refs found for route %v for listener on port:protocol %v:%v for gateway %v , only one must be specified", routeDescriptor.GetRouteNamespacedName(), port, listenerSpec.Protocol, k8s.NamespacedName(gw))
+	backends := routeutils.GetL4RouteBackends(routeDescriptor)
+	if len(backends) == 0 {
+		return nil, nil, errors.Errorf("no backend refs found for route %v for gateway %v, one backend ref must be specified", routeDescriptor.GetRouteNamespacedName(), k8s.NamespacedName(gw))
 	}
-	backend := routeDescriptor.GetAttachedRules()[0].GetBackends()[0]
-	targetGroup, tgErr := l.tgBuilder.buildTargetGroup(stack, gw, lbCfg, lb.Spec.IPAddressType, routeDescriptor, backend, securityGroups.backendSecurityGroupToken)
-	if tgErr != nil {
-		return &elbv2model.ListenerSpec{}, tgErr
+	return routeDescriptor, backends, nil
+}
+
+// withL4RouteHostnames returns a copy of the listener config including the hostnames of the routes.
+func withL4RouteHostnames(gwLsCfg *gwListenerConfig, routes []routeutils.RouteDescriptor) *gwListenerConfig {
+	lsCfg := *gwLsCfg
+	lsCfg.hostnames = append([]string(nil), gwLsCfg.hostnames...)
+	for _, route := range routes {
+		for _, hostname := range route.GetHostnames() {
+			lsCfg.hostnames = append(lsCfg.hostnames, string(hostname))
+		}
 	}
-	listenerSpec.DefaultActions = buildL4ListenerDefaultActions(targetGroup)
-	return listenerSpec, nil
+	return &lsCfg
 }
 
-func (l listenerBuilderImpl) buildListenerRules(stack core.Stack, ls *elbv2model.Listener, lb *elbv2model.LoadBalancer, securityGroups securityGroupOutput, gw *gwv1.Gateway, port int32, lbCfg elbv2gw.LoadBalancerConfiguration, routes map[int32][]routeutils.RouteDescriptor) error {
-	// TODO for L7 Gateway Implementation
-	// This is throw away code
-	// This is temporary implementation for supporting basic multiple HTTPRoute for simple backend refs. We will create default forward action for all the backend refs for all HTTPRoutes for this listener
+func (l listenerBuilderImpl) buildListenerRules(stack core.Stack, ls *elbv2model.Listener, lb *elbv2model.LoadBalancer, securityGroups securityGroupOutput, gw *gwv1.Gateway, port int32, lbCfg elbv2gw.LoadBalancerConfiguration, routes []routeutils.RouteDescriptor) error {
+	// Rules are ordered by Gateway API precedence, so that priorities stay stable across reconciles.
 	var rules []ingress.Rule
-	for _, descriptors := range routes {
-		for _, descriptor := range descriptors {
-			for _, rule := range descriptor.GetAttachedRules() {
-				for _, backend := range rule.GetBackends() {
-					targetGroup, tgErr := l.tgBuilder.buildTargetGroup(stack, gw, lbCfg, lb.Spec.IPAddressType, descriptor, backend, securityGroups.backendSecurityGroupToken)
-					if tgErr != nil {
-						return tgErr
-					}
-					// Basic condition
-					conditions := []elbv2model.RuleCondition{{
-						Field: elbv2model.RuleConditionFieldPathPattern,
-						PathPatternConfig: &elbv2model.PathPatternConditionConfig{
-							Values: []string{"/*"},
-						},
-					},
-					}
-					actions := buildL4ListenerDefaultActions(targetGroup)
-					tags, tagsErr := l.tagHelper.getGatewayTags(lbCfg)
-					if tagsErr != nil {
-						return tagsErr
-					}
-					rules = append(rules, ingress.Rule{
-						Conditions: conditions,
-						Actions:    actions,
-						Tags:       tags,
-					})
-				}
-			}
+	for _, precedence := range routeutils.SortAllRulesByPrecedence(routes) {
+		descriptor := precedence.RouteDescriptor
+		conditionsList, err := buildRuleConditions(precedence)
+		if err != nil {
+			return errors.Wrapf(err, "failed to build conditions for route %v", descriptor.GetRouteNamespacedName())
+		}
+		actions, err := l.buildL7RuleActions(stack, gw, lbCfg, lb, securityGroups, ls.Spec.Protocol, descriptor, precedence.Rule)
+		if err != nil {
+			return err
+		}
+		tags, err := l.tagHelper.getGatewayTags(lbCfg)
+		if err != nil {
+			return err
+		}
+		for _, conditions := range conditionsList {
+			rules = append(rules, ingress.Rule{
+				Conditions: conditions,
+				Actions:    actions,
+				Tags:       tags,
+			})
 		}
 	}
 
@@ -234,9 +263,7 @@ func buildListenerAttributes(lsCfg *elbv2gw.ListenerConfiguration) ([]elbv2model
 	return attributes, nil
 }
 
-func (l listenerBuilderImpl) buildCertificates(ctx context.Context, gwLsCfg *gwListenerConfig, lbLsCfg *elbv2gw.ListenerConfiguration) ([]elbv2model.Certificate, error) {
-	// TODO for cert discovery and secure listeners during L7 and L4 gateways implementations
-
+func (l listenerBuilderImpl) buildCertificates(ctx context.Context, stack core.Stack, lbCfg elbv2gw.LoadBalancerConfiguration, gwLsCfg *gwListenerConfig, lbLsCfg *elbv2gw.ListenerConfiguration) ([]elbv2model.Certificate, error) {
 	certs := make([]elbv2model.Certificate, 0)
 
 	// Build explict certs
@@ -244,6 +271,23 @@ func (l listenerBuilderImpl) buildCertificates(ctx context.Context, gwLsCfg *gwL
 		certs = append(certs, l.buildExplicitTLSCertARNs(ctx, *lbLsCfg)...)
 	}
 
+	// Build certs imported from the listener certificateRefs
+	if len(certs) == 0 && len(gwLsCfg.certificateSecrets) != 0 && l.featureGates.Enabled(config.GatewayCertificateImport) {
+		tags, err := l.tagHelper.getGatewayTags(lbCfg)
+		if err != nil {
+			return []elbv2model.Certificate{}, err
+		}
+		for _, secret := range gwLsCfg.certificateSecrets {
+			importedCert, err := buildImportedCertificate(stack, secret, tags)
+			if err != nil {
+				return []elbv2model.Certificate{}, err
+			}
+			certs = append(certs, elbv2model.Certificate{
+				ImportedCertificateARN: importedCert.CertificateARN(),
+			})
+		}
+	}
+
 	// Build inferred certs
 	if len(certs) == 0 && lbLsCfg != nil {
 		discoveredCerts, err := l.buildInferredTLSCertARNs(ctx, lbLsCfg.ProtocolPort, gwLsCfg.hostnames)
@@ -360,7 +404,8 @@ func buildListenerALPNPolicy(listenerProtocol elbv2model.Protocol, lbLsCfg *elbv
 }
 
 // mapGatewayListenerConfigsByPort creates a mapping of ports to listener configurations from the Gateway listeners.
-func mapGatewayListenerConfigsByPort(gw *gwv1.Gateway) (map[int32]*gwListenerConfig, error) {
+// The certificate secrets of listeners sharing a port are combined in the listener order.
+func mapGatewayListenerConfigsByPort(gw *gwv1.Gateway, listenerCertificates map[gwv1.SectionName]routeutils.ListenerCertificates) (map[int32]*gwListenerConfig, error) {
 	gwListenerConfigs := make(map[int32]*gwListenerConfig)
 	for _, listener := range gw.Spec.Listeners {
 		port := int32(listener.Port)
@@ -379,6 +424,7 @@ func mapGatewayListenerConfigsByPort(gw *gwv1.Gateway) (map[int32]*gwListenerCon
 			hostnames = append(hostnames, string(*listener.Hostname))
 			gwListenerConfigs[port].hostnames = hostnames
 		}
+		gwListenerConfigs[port].certificateSecrets = append(gwListenerConfigs[port].certificateSecrets, listenerCertificates[listener.Name].Secrets...)
 	}
 	return gwListenerConfigs, nil
 }
@@ -391,13 +437,16 @@ func mapLoadBalancerListenerConfigsByPort(lbCfg elbv2gw.LoadBalancerConfiguratio
 		return lbLsCfgs
 	}
 	for _, lsCfg := range *lbCfg.Spec.ListenerConfigurations {
-		port, _ := strconv.ParseInt(strings.Split(string(lsCfg.ProtocolPort), ":")[1], 10, 64)
-		lbLsCfgs[int32(port)] = &lsCfg
+		_, port, err := ParseProtocolPort(lsCfg.ProtocolPort)
+		if err != nil {
+			continue
+		}
+		lbLsCfgs[port] = &lsCfg
 	}
 	return lbLsCfgs
 }
 
-func newListenerBuilder(ctx context.Context, loadBalancerType elbv2model.LoadBalancerType, tgBuilder targetGroupBuilder, tagHelper tagHelper, clusterName string, defaultSSLPolicy string, acmClient services.ACM, allowedCAARNs []string, logger logr.Logger) listenerBuilder {
+func newListenerBuilder(ctx context.Context, loadBalancerType elbv2model.LoadBalancerType, tgBuilder targetGroupBuilder, tagHelper tagHelper, clusterName string, defaultSSLPolicy string, acmClient services.ACM, allowedCAARNs []string, featureGates config.FeatureGates, logger logr.Logger) listenerBuilder {
 	certDiscovery := certs.NewACMCertDiscovery(acmClient, allowedCAARNs, logger)
 	return &listenerBuilderImpl{
 		loadBalancerType: loadBalancerType,
@@ -406,6 +455,7 @@ func newListenerBuilder(ctx context.Context, loadBalancerType elbv2model.LoadBal
 		tagHelper:        tagHelper,
 		defaultSSLPolicy: defaultSSLPolicy,
 		certDiscovery:    certDiscovery,
+		featureGates:     featureGates,
 		logger:           logger,
 	}
 }
@@ -4,13 +4,18 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
 	certs "sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/routeutils"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
@@ -18,11 +23,14 @@ import (
 func Test_mapGatewayListenerConfigsByPort(t *testing.T) {
 	fooHostname := gwv1.Hostname("foo.example.com")
 	barHostname := gwv1.Hostname("bar.example.com")
+	tlsSecret1 := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "foo-tls"}}
+	tlsSecret2 := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "bar-tls"}}
 	tests := []struct {
-		name    string
-		gateway *gwv1.Gateway
-		want    map[int32]*gwListenerConfig
-		wantErr bool
+		name                 string
+		gateway              *gwv1.Gateway
+		listenerCertificates map[gwv1.SectionName]routeutils.ListenerCertificates
+		want                 map[int32]*gwListenerConfig
+		wantErr              bool
 	}{
 		{
 			name: "single HTTP listener",
@@ -186,11 +194,44 @@ func Test_mapGatewayListenerConfigsByPort(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "certificate secrets of listeners on the same port",
+			gateway: &gwv1.Gateway{
+				Spec: gwv1.GatewaySpec{
+					Listeners: []gwv1.Listener{
+						{
+							Name:     "https-foo",
+							Port:     443,
+							Protocol: gwv1.HTTPSProtocolType,
+							Hostname: &fooHostname,
+						},
+						{
+							Name:     "https-bar",
+							Port:     443,
+							Protocol: gwv1.HTTPSProtocolType,
+							Hostname: &barHostname,
+						},
+					},
+				},
+			},
+			listenerCertificates: map[gwv1.SectionName]routeutils.ListenerCertificates{
+				"https-foo": {Secrets: []*corev1.Secret{tlsSecret1}, ResolvedRefs: true},
+				"https-bar": {Secrets: []*corev1.Secret{tlsSecret2}, ResolvedRefs: true},
+			},
+			want: map[int32]*gwListenerConfig{
+				443: {
+					protocol:           elbv2model.ProtocolHTTPS,
+					hostnames:          []string{"foo.example.com", "bar.example.com"},
+					certificateSecrets: []*corev1.Secret{tlsSecret1, tlsSecret2},
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := mapGatewayListenerConfigsByPort(tt.gateway)
+			got, err := mapGatewayListenerConfigsByPort(tt.gateway, tt.listenerCertificates)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -510,7 +551,7 @@ func TestBuildCertificates(t *testing.T) {
 				certDiscovery: mockCertDiscovery,
 			}
 
-			got, err := builder.buildCertificates(context.Background(), tt.gwLsCfg, tt.lbLsCfg)
+			got, err := builder.buildCertificates(context.Background(), coremodel.NewDefaultStack(coremodel.StackID{Name: "gw"}), elbv2gw.LoadBalancerConfiguration{}, tt.gwLsCfg, tt.lbLsCfg)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("buildCertificates() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -521,3 +562,96 @@ func TestBuildCertificates(t *testing.T) {
 		})
 	}
 }
+
+func Test_selectL4RouteBackends(t *testing.T) {
+	gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw"}}
+	newBackend := func(svcName string, port int32, weight int) routeutils.Backend {
+		return routeutils.Backend{
+			Service:     &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: svcName}},
+			ServicePort: &corev1.ServicePort{Port: port},
+			Weight:      weight,
+		}
+	}
+	newRoute := func(name string, creationTime time.Time, backends ...routeutils.Backend) *routeutils.MockRoute {
+		return &routeutils.MockRoute{
+			Namespace:    "ns",
+			Name:         name,
+			CreationTime: creationTime,
+			Rules:        []routeutils.RouteRule{&routeutils.MockRule{BackendRefs: backends}},
+		}
+	}
+	now := time.Now()
+	blue := newBackend("blue", 80, 90)
+	green := newBackend("green", 80, 10)
+
+	tests := []struct {
+		name         string
+		routes       []routeutils.RouteDescriptor
+		wantRoute    string
+		wantBackends []routeutils.Backend
+		wantErr      string
+	}{
+		{
+			name:         "single route with weighted backends",
+			routes:       []routeutils.RouteDescriptor{newRoute("route", now, blue, green)},
+			wantRoute:    "route",
+			wantBackends: []routeutils.Backend{blue, green},
+		},
+		{
+			name: "backends of all rules are combined",
+			routes: []routeutils.RouteDescriptor{
+				&routeutils.MockRoute{
+					Namespace: "ns",
+					Name:      "route",
+					Rules: []routeutils.RouteRule{
+						&routeutils.MockRule{BackendRefs: []routeutils.Backend{blue}},
+						&routeutils.MockRule{BackendRefs: []routeutils.Backend{green}},
+					},
+				},
+			},
+			wantRoute:    "route",
+			wantBackends: []routeutils.Backend{blue, green},
+		},
+		{
+			name:    "route without backends",
+			routes:  []routeutils.RouteDescriptor{newRoute("route", now)},
+			wantErr: "no backend refs found for route ns/route for gateway ns/gw, one backend ref must be specified",
+		},
+		{
+			name: "oldest of multiple routes on TLS listener",
+			routes: []routeutils.RouteDescriptor{
+				newRoute("route-new", now.Add(time.Minute), green, blue),
+				newRoute("route-old", now, blue, green),
+			},
+			wantRoute:    "route-old",
+			wantBackends: []routeutils.Backend{blue, green},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route, backends, err := selectL4RouteBackends(gw, tt.routes)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRoute, route.GetRouteNamespacedName().Name)
+			assert.Equal(t, tt.wantBackends, backends)
+		})
+	}
+}
+
+func Test_withL4RouteHostnames(t *testing.T) {
+	gwLsCfg := &gwListenerConfig{
+		protocol:  elbv2model.ProtocolTLS,
+		hostnames: []string{"*.example.com"},
+	}
+	routes := []routeutils.RouteDescriptor{
+		&routeutils.MockRoute{Hostnames: []gwv1.Hostname{"foo.example.com"}},
+		&routeutils.MockRoute{Hostnames: []gwv1.Hostname{"bar.example.com"}},
+	}
+	got := withL4RouteHostnames(gwLsCfg, routes)
+	assert.Equal(t, []string{"*.example.com", "foo.example.com", "bar.example.com"}, got.hostnames)
+	assert.Equal(t, elbv2model.ProtocolTLS, got.protocol)
+	assert.Equal(t, []string{"*.example.com"}, gwLsCfg.hostnames)
+}
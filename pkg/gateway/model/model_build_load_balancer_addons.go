@@ -0,0 +1,100 @@
+package model
+
+import (
+	"github.com/pkg/errors"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	shieldmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/shield"
+	wafregionalmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafregional"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+)
+
+const (
+	// sentinel value to remove the wafv2 web ACL associated with the LB.
+	wafv2ACLARNNone = "none"
+	// sentinel value to remove the wafRegional web ACL associated with the LB.
+	webACLIDNone = "none"
+
+	// tags recording the add-ons managed by the controller on the LB, so they're removed once cleared from the LoadBalancerConfiguration.
+	wafv2ManagedTagKey          = "elbv2.k8s.aws/wafv2-managed"
+	wafRegionalManagedTagKey    = "elbv2.k8s.aws/waf-regional-managed"
+	shieldAdvancedManagedTagKey = "elbv2.k8s.aws/shield-advanced-managed"
+	addOnManagedTagValue        = "true"
+)
+
+// buildLoadBalancerAddOnTags builds the tags recording the add-ons specified in the LoadBalancerConfiguration.
+func buildLoadBalancerAddOnTags(lbType elbv2model.LoadBalancerType, lbConf elbv2gw.LoadBalancerConfiguration) map[string]string {
+	tags := make(map[string]string)
+	if lbType != elbv2model.LoadBalancerTypeApplication {
+		return tags
+	}
+	if lbConf.Spec.WAFv2 != nil {
+		tags[wafv2ManagedTagKey] = addOnManagedTagValue
+	}
+	if lbConf.Spec.WAFRegional != nil {
+		tags[wafRegionalManagedTagKey] = addOnManagedTagValue
+	}
+	if lbConf.Spec.ShieldAdvanced != nil {
+		tags[shieldAdvancedManagedTagKey] = addOnManagedTagValue
+	}
+	return tags
+}
+
+// buildLoadBalancerAddOns builds the WAFv2, WAF Regional and Shield Advanced resources of an ALB.
+// Like the Ingress annotations, add-ons are only managed when they're specified in the LoadBalancerConfiguration,
+// so that web ACLs and protections attached outside of the controller, e.g. by Firewall Manager, are left untouched.
+// Add-ons cleared from the LoadBalancerConfiguration are removed when the current LB tags record they were managed by the controller.
+func buildLoadBalancerAddOns(stack core.Stack, lbType elbv2model.LoadBalancerType, lbARN core.StringToken, lbConf elbv2gw.LoadBalancerConfiguration, currentLBTags map[string]string) error {
+	if lbType != elbv2model.LoadBalancerTypeApplication {
+		if lbConf.Spec.WAFv2 != nil || lbConf.Spec.WAFRegional != nil || lbConf.Spec.ShieldAdvanced != nil {
+			return errors.New("wafV2, wafRegional and shieldAdvanced are only supported for Application LoadBalancers")
+		}
+		return nil
+	}
+
+	if lbConf.Spec.WAFv2 != nil {
+		webACLARN := lbConf.Spec.WAFv2.WebACL
+		if webACLARN == wafv2ACLARNNone {
+			webACLARN = ""
+		}
+		_ = wafv2model.NewWebACLAssociation(stack, resourceIDLoadBalancer, wafv2model.WebACLAssociationSpec{
+			WebACLARN:   webACLARN,
+			ResourceARN: lbARN,
+		})
+	} else if currentLBTags[wafv2ManagedTagKey] == addOnManagedTagValue {
+		_ = wafv2model.NewWebACLAssociation(stack, resourceIDLoadBalancer, wafv2model.WebACLAssociationSpec{
+			WebACLARN:   "",
+			ResourceARN: lbARN,
+		})
+	}
+
+	if lbConf.Spec.WAFRegional != nil {
+		webACLID := lbConf.Spec.WAFRegional.WebACLID
+		if webACLID == webACLIDNone {
+			webACLID = ""
+		}
+		_ = wafregionalmodel.NewWebACLAssociation(stack, resourceIDLoadBalancer, wafregionalmodel.WebACLAssociationSpec{
+			WebACLID:    webACLID,
+			ResourceARN: lbARN,
+		})
+	} else if currentLBTags[wafRegionalManagedTagKey] == addOnManagedTagValue {
+		_ = wafregionalmodel.NewWebACLAssociation(stack, resourceIDLoadBalancer, wafregionalmodel.WebACLAssociationSpec{
+			WebACLID:    "",
+			ResourceARN: lbARN,
+		})
+	}
+
+	if lbConf.Spec.ShieldAdvanced != nil {
+		_ = shieldmodel.NewProtection(stack, resourceIDLoadBalancer, shieldmodel.ProtectionSpec{
+			Enabled:     lbConf.Spec.ShieldAdvanced.Enabled,
+			ResourceARN: lbARN,
+		})
+	} else if currentLBTags[shieldAdvancedManagedTagKey] == addOnManagedTagValue {
+		_ = shieldmodel.NewProtection(stack, resourceIDLoadBalancer, shieldmodel.ProtectionSpec{
+			Enabled:     false,
+			ResourceARN: lbARN,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	shieldmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/shield"
+	wafregionalmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafregional"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+)
+
+func Test_buildLoadBalancerAddOns(t *testing.T) {
+	lbARN := core.LiteralStringToken("lb-arn")
+	tests := []struct {
+		name                  string
+		lbType                elbv2model.LoadBalancerType
+		lbConf                elbv2gw.LoadBalancerConfiguration
+		currentLBTags         map[string]string
+		wantWebACLARN         string
+		wantWebACLID          string
+		wantShieldEnabled     bool
+		wantNoAddOnsResources bool
+		wantErr               bool
+	}{
+		{
+			name:   "all add-ons configured",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			lbConf: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					WAFv2:          &elbv2gw.WAFv2Configuration{WebACL: "web-acl-arn"},
+					WAFRegional:    &elbv2gw.WAFRegionalConfiguration{WebACLID: "web-acl-id"},
+					ShieldAdvanced: &elbv2gw.ShieldAdvancedConfiguration{Enabled: true},
+				},
+			},
+			wantWebACLARN:     "web-acl-arn",
+			wantWebACLID:      "web-acl-id",
+			wantShieldEnabled: true,
+		},
+		{
+			name:   "add-ons explicitly removed",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			lbConf: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					WAFv2:          &elbv2gw.WAFv2Configuration{WebACL: "none"},
+					WAFRegional:    &elbv2gw.WAFRegionalConfiguration{WebACLID: "none"},
+					ShieldAdvanced: &elbv2gw.ShieldAdvancedConfiguration{Enabled: false},
+				},
+			},
+		},
+		{
+			name:                  "unspecified add-ons are left untouched",
+			lbType:                elbv2model.LoadBalancerTypeApplication,
+			lbConf:                elbv2gw.LoadBalancerConfiguration{},
+			wantNoAddOnsResources: true,
+		},
+		{
+			name:   "add-ons managed by the controller are removed once unspecified",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			lbConf: elbv2gw.LoadBalancerConfiguration{},
+			currentLBTags: map[string]string{
+				"elbv2.k8s.aws/wafv2-managed":           "true",
+				"elbv2.k8s.aws/waf-regional-managed":    "true",
+				"elbv2.k8s.aws/shield-advanced-managed": "true",
+			},
+		},
+		{
+			name:   "unspecified add-ons not managed by the controller are left untouched",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			lbConf: elbv2gw.LoadBalancerConfiguration{},
+			currentLBTags: map[string]string{
+				"elbv2.k8s.aws/cluster": "cluster",
+			},
+			wantNoAddOnsResources: true,
+		},
+		{
+			name:                  "network load balancer without add-ons",
+			lbType:                elbv2model.LoadBalancerTypeNetwork,
+			lbConf:                elbv2gw.LoadBalancerConfiguration{},
+			wantNoAddOnsResources: true,
+		},
+		{
+			name:   "network load balancer with add-ons",
+			lbType: elbv2model.LoadBalancerTypeNetwork,
+			lbConf: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					ShieldAdvanced: &elbv2gw.ShieldAdvancedConfiguration{Enabled: true},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+			err := buildLoadBalancerAddOns(stack, tt.lbType, lbARN, tt.lbConf, tt.currentLBTags)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			var wafv2Associations []*wafv2model.WebACLAssociation
+			var wafRegionalAssociations []*wafregionalmodel.WebACLAssociation
+			var protections []*shieldmodel.Protection
+			assert.NoError(t, stack.ListResources(&wafv2Associations))
+			assert.NoError(t, stack.ListResources(&wafRegionalAssociations))
+			assert.NoError(t, stack.ListResources(&protections))
+			if tt.wantNoAddOnsResources {
+				assert.Empty(t, wafv2Associations)
+				assert.Empty(t, wafRegionalAssociations)
+				assert.Empty(t, protections)
+				return
+			}
+			assert.Len(t, wafv2Associations, 1)
+			assert.Equal(t, tt.wantWebACLARN, wafv2Associations[0].Spec.WebACLARN)
+			assert.Len(t, wafRegionalAssociations, 1)
+			assert.Equal(t, tt.wantWebACLID, wafRegionalAssociations[0].Spec.WebACLID)
+			assert.Len(t, protections, 1)
+			assert.Equal(t, tt.wantShieldEnabled, protections[0].Spec.Enabled)
+		})
+	}
+}
+
+func Test_buildLoadBalancerAddOnTags(t *testing.T) {
+	tests := []struct {
+		name   string
+		lbType elbv2model.LoadBalancerType
+		lbConf elbv2gw.LoadBalancerConfiguration
+		want   map[string]string
+	}{
+		{
+			name:   "specified add-ons are recorded",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			lbConf: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					WAFv2:          &elbv2gw.WAFv2Configuration{WebACL: "none"},
+					ShieldAdvanced: &elbv2gw.ShieldAdvancedConfiguration{Enabled: true},
+				},
+			},
+			want: map[string]string{
+				"elbv2.k8s.aws/wafv2-managed":           "true",
+				"elbv2.k8s.aws/shield-advanced-managed": "true",
+			},
+		},
+		{
+			name:   "no add-ons specified",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			lbConf: elbv2gw.LoadBalancerConfiguration{},
+			want:   map[string]string{},
+		},
+		{
+			name:   "network load balancer",
+			lbType: elbv2model.LoadBalancerTypeNetwork,
+			lbConf: elbv2gw.LoadBalancerConfiguration{},
+			want:   map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildLoadBalancerAddOnTags(tt.lbType, tt.lbConf))
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/routeutils"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	// ALB allows up to three match evaluations per condition.
+	maxMatchEvaluationsPerCondition = 3
+	// ALB allows up to five match evaluations per rule.
+	maxMatchEvaluationsPerRule = 5
+)
+
+// matchConditions holds the ALB conditions generated from a single route match.
+// hosts and paths are each rendered as one condition whose values are ORed, every condition within others is ANDed.
+type matchConditions struct {
+	hosts  []string
+	paths  []string
+	others []elbv2model.RuleCondition
+}
+
+// buildRuleConditions builds the ALB listener rule conditions for a single route rule match and hostname.
+// A match usually results in one ALB listener rule, but can be split into multiple listener rules when it exceeds the
+// ALB condition limits. The route rule matches a request if any of these listener rules matches.
+func buildRuleConditions(precedence routeutils.RulePrecedence) ([][]elbv2model.RuleCondition, error) {
+	var hosts []string
+	if precedence.Hostname != "" {
+		hosts = []string{string(precedence.Hostname)}
+	}
+
+	var match matchConditions
+	var err error
+	switch {
+	case precedence.HTTPMatch != nil:
+		match, err = buildHTTPRouteMatchConditions(hosts, *precedence.HTTPMatch)
+	case precedence.GRPCMatch != nil:
+		match, err = buildGRPCRouteMatchConditions(hosts, *precedence.GRPCMatch)
+	default:
+		err = errors.Errorf("unsupported route rule type %T", precedence.Rule.GetRawRouteRule())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return splitMatchConditions(match)
+}
+
+// buildHTTPRouteMatchConditions builds conditions for an HTTPRoute match.
+func buildHTTPRouteMatchConditions(hosts []string, match gwv1.HTTPRouteMatch) (matchConditions, error) {
+	paths, err := buildHTTPPathPatterns(match.Path)
+	if err != nil {
+		return matchConditions{}, err
+	}
+	var others []elbv2model.RuleCondition
+	for _, header := range match.Headers {
+		if header.Type != nil && *header.Type != gwv1.HeaderMatchExact {
+			return matchConditions{}, errors.Errorf("unsupported header match type %v for header %v", *header.Type, header.Name)
+		}
+		others = append(others, buildHTTPHeaderCondition(string(header.Name), header.Value))
+	}
+	for _, queryParam := range match.QueryParams {
+		if queryParam.Type != nil && *queryParam.Type != gwv1.QueryParamMatchExact {
+			return matchConditions{}, errors.Errorf("unsupported query param match type %v for query param %v", *queryParam.Type, queryParam.Name)
+		}
+		others = append(others, buildQueryStringCondition(string(queryParam.Name), queryParam.Value))
+	}
+	if match.Method != nil {
+		others = append(others, buildHTTPRequestMethodCondition(string(*match.Method)))
+	}
+	return matchConditions{
+		hosts:  hosts,
+		paths:  paths,
+		others: others,
+	}, nil
+}
+
+// buildHTTPPathPatterns builds ALB path patterns for an HTTPRoute path match.
+// a nil path match defaults to a PathPrefix match on "/".
+func buildHTTPPathPatterns(pathMatch *gwv1.HTTPPathMatch) ([]string, error) {
+	matchType := gwv1.PathMatchPathPrefix
+	path := "/"
+	if pathMatch != nil {
+		if pathMatch.Type != nil {
+			matchType = *pathMatch.Type
+		}
+		if pathMatch.Value != nil {
+			path = *pathMatch.Value
+		}
+	}
+	switch matchType {
+	case gwv1.PathMatchExact:
+		return buildPathPatternsForExactPath(path)
+	case gwv1.PathMatchPathPrefix:
+		return buildPathPatternsForPrefixPath(path)
+	default:
+		return nil, errors.Errorf("unsupported path match type %v for path %v", matchType, path)
+	}
+}
+
+// buildPathPatternsForExactPath builds path patterns for an exact path match.
+// exact path shouldn't contain any wildcards, as ALB would interpret them.
+func buildPathPatternsForExactPath(path string) ([]string, error) {
+	if strings.ContainsAny(path, "*?") {
+		return nil, errors.Errorf("exact path shouldn't contain wildcards: %v", path)
+	}
+	return []string{path}, nil
+}
+
+// buildPathPatternsForPrefixPath builds path patterns for a prefix path match.
+// with prefix match, "/foo" should match paths like "/foo", "/foo/" or "/foo/bar" but not "/foobar".
+// for above case, we'll generate two path patterns: "/foo" and "/foo/*".
+// an special case is "/", which matches all paths, thus we generate the path pattern as "/*"
+func buildPathPatternsForPrefixPath(path string) ([]string, error) {
+	if path == "/" {
+		return []string{"/*"}, nil
+	}
+	if strings.ContainsAny(path, "*?") {
+		return nil, errors.Errorf("prefix path shouldn't contain wildcards: %v", path)
+	}
+	normalizedPath := strings.TrimSuffix(path, "/")
+	return []string{normalizedPath, normalizedPath + "/*"}, nil
+}
+
+// buildGRPCRouteMatchConditions builds conditions for a GRPCRoute match.
+func buildGRPCRouteMatchConditions(hosts []string, match gwv1.GRPCRouteMatch) (matchConditions, error) {
+	path, err := buildGRPCMethodPathPattern(match.Method)
+	if err != nil {
+		return matchConditions{}, err
+	}
+	var others []elbv2model.RuleCondition
+	for _, header := range match.Headers {
+		if header.Type != nil && *header.Type != gwv1.GRPCHeaderMatchExact {
+			return matchConditions{}, errors.Errorf("unsupported header match type %v for header %v", *header.Type, header.Name)
+		}
+		others = append(others, buildHTTPHeaderCondition(string(header.Name), header.Value))
+	}
+	return matchConditions{
+		hosts:  hosts,
+		paths:  []string{path},
+		others: others,
+	}, nil
+}
+
+// buildGRPCMethodPathPattern builds the ALB path pattern for a gRPC method match.
+// gRPC requests are sent to the path "/<service>/<method>", an unspecified service or method matches any value.
+func buildGRPCMethodPathPattern(methodMatch *gwv1.GRPCMethodMatch) (string, error) {
+	if methodMatch == nil {
+		return "/*", nil
+	}
+	if methodMatch.Type != nil && *methodMatch.Type != gwv1.GRPCMethodMatchExact {
+		return "", errors.Errorf("unsupported method match type %v", *methodMatch.Type)
+	}
+	service := "*"
+	if methodMatch.Service != nil && *methodMatch.Service != "" {
+		if strings.ContainsAny(*methodMatch.Service, "/*?") {
+			return "", errors.Errorf("invalid gRPC service: %v", *methodMatch.Service)
+		}
+		service = *methodMatch.Service
+	}
+	method := "*"
+	if methodMatch.Method != nil && *methodMatch.Method != "" {
+		if strings.ContainsAny(*methodMatch.Method, "/*?") {
+			return "", errors.Errorf("invalid gRPC method: %v", *methodMatch.Method)
+		}
+		method = *methodMatch.Method
+	}
+	if service == "*" && method == "*" {
+		return "/*", nil
+	}
+	return "/" + service + "/" + method, nil
+}
+
+// splitMatchConditions renders the conditions for a single route match into one or more sets of ALB conditions.
+// Host and path values are ORed within their condition, so when they exceed ALB's match evaluation limits,
+// the values are split across multiple rules that together are equivalent to the original match.
+func splitMatchConditions(match matchConditions) ([][]elbv2model.RuleCondition, error) {
+	otherEvaluations := 0
+	for _, condition := range match.others {
+		otherEvaluations += countMatchEvaluations(condition)
+	}
+	budget := maxMatchEvaluationsPerRule - otherEvaluations
+	pathChunkSize := min(len(match.paths), maxMatchEvaluationsPerCondition)
+	hostChunkSize := min(len(match.hosts), maxMatchEvaluationsPerCondition)
+	if len(match.hosts) != 0 && len(match.paths) != 0 {
+		pathChunkSize = min(pathChunkSize, budget-1)
+		hostChunkSize = min(hostChunkSize, budget-pathChunkSize)
+	} else {
+		pathChunkSize = min(pathChunkSize, budget)
+		hostChunkSize = min(hostChunkSize, budget)
+	}
+	if budget < 0 || (len(match.paths) != 0 && pathChunkSize < 1) || (len(match.hosts) != 0 && hostChunkSize < 1) {
+		return nil, errors.Errorf("route match requires more than %v condition values, which exceeds the ALB limit", maxMatchEvaluationsPerRule)
+	}
+
+	hostChunks := chunkConditionValues(match.hosts, hostChunkSize)
+	pathChunks := chunkConditionValues(match.paths, pathChunkSize)
+	var conditionsList [][]elbv2model.RuleCondition
+	for _, hostChunk := range hostChunks {
+		for _, pathChunk := range pathChunks {
+			conditions := make([]elbv2model.RuleCondition, 0, len(match.others)+2)
+			conditions = append(conditions, match.others...)
+			if len(hostChunk) != 0 {
+				conditions = append(conditions, buildHostHeaderCondition(hostChunk))
+			}
+			if len(pathChunk) != 0 {
+				conditions = append(conditions, buildPathPatternCondition(pathChunk))
+			}
+			if len(conditions) == 0 {
+				conditions = append(conditions, buildPathPatternCondition([]string{"/*"}))
+			}
+			conditionsList = append(conditionsList, conditions)
+		}
+	}
+	return conditionsList, nil
+}
+
+// chunkConditionValues splits values into chunks of at most chunkSize values.
+// empty values produce a single empty chunk so that callers can iterate them uniformly.
+func chunkConditionValues(values []string, chunkSize int) [][]string {
+	if len(values) == 0 {
+		return [][]string{nil}
+	}
+	var chunks [][]string
+	for start := 0; start < len(values); start += chunkSize {
+		end := min(start+chunkSize, len(values))
+		chunks = append(chunks, values[start:end])
+	}
+	return chunks
+}
+
+func countMatchEvaluations(condition elbv2model.RuleCondition) int {
+	switch condition.Field {
+	case elbv2model.RuleConditionFieldHostHeader:
+		return len(condition.HostHeaderConfig.Values)
+	case elbv2model.RuleConditionFieldPathPattern:
+		return len(condition.PathPatternConfig.Values)
+	case elbv2model.RuleConditionFieldHTTPHeader:
+		return len(condition.HTTPHeaderConfig.Values)
+	case elbv2model.RuleConditionFieldHTTPRequestMethod:
+		return len(condition.HTTPRequestMethodConfig.Values)
+	case elbv2model.RuleConditionFieldQueryString:
+		return len(condition.QueryStringConfig.Values)
+	case elbv2model.RuleConditionFieldSourceIP:
+		return len(condition.SourceIPConfig.Values)
+	}
+	return 0
+}
+
+func buildHostHeaderCondition(hosts []string) elbv2model.RuleCondition {
+	return elbv2model.RuleCondition{
+		Field: elbv2model.RuleConditionFieldHostHeader,
+		HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+			Values: hosts,
+		},
+	}
+}
+
+func buildPathPatternCondition(paths []string) elbv2model.RuleCondition {
+	return elbv2model.RuleCondition{
+		Field: elbv2model.RuleConditionFieldPathPattern,
+		PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+			Values: paths,
+		},
+	}
+}
+
+func buildHTTPHeaderCondition(name string, value string) elbv2model.RuleCondition {
+	return elbv2model.RuleCondition{
+		Field: elbv2model.RuleConditionFieldHTTPHeader,
+		HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+			HTTPHeaderName: name,
+			Values:         []string{value},
+		},
+	}
+}
+
+func buildQueryStringCondition(key string, value string) elbv2model.RuleCondition {
+	return elbv2model.RuleCondition{
+		Field: elbv2model.RuleConditionFieldQueryString,
+		QueryStringConfig: &elbv2model.QueryStringConditionConfig{
+			Values: []elbv2model.QueryStringKeyValuePair{
+				{
+					Key:   &key,
+					Value: value,
+				},
+			},
+		},
+	}
+}
+
+func buildHTTPRequestMethodCondition(method string) elbv2model.RuleCondition {
+	return elbv2model.RuleCondition{
+		Field: elbv2model.RuleConditionFieldHTTPRequestMethod,
+		HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+			Values: []string{method},
+		},
+	}
+}
@@ -0,0 +1,288 @@
+package model
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/routeutils"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_buildRuleConditions(t *testing.T) {
+	exactPathType := gwv1.PathMatchExact
+	prefixPathType := gwv1.PathMatchPathPrefix
+	regexPathType := gwv1.PathMatchRegularExpression
+	regexHeaderType := gwv1.HeaderMatchRegularExpression
+	regexMethodType := gwv1.GRPCMethodMatchRegularExpression
+	getMethod := gwv1.HTTPMethodGet
+	tests := []struct {
+		name      string
+		hostname  gwv1.Hostname
+		httpMatch *gwv1.HTTPRouteMatch
+		grpcMatch *gwv1.GRPCRouteMatch
+		want      [][]elbv2model.RuleCondition
+		wantErr   bool
+	}{
+		{
+			name:      "empty http match",
+			httpMatch: &gwv1.HTTPRouteMatch{},
+			want: [][]elbv2model.RuleCondition{
+				{
+					buildPathPatternCondition([]string{"/*"}),
+				},
+			},
+		},
+		{
+			name:      "empty http match with hostname",
+			hostname:  "*.example.org",
+			httpMatch: &gwv1.HTTPRouteMatch{},
+			want: [][]elbv2model.RuleCondition{
+				{
+					buildHostHeaderCondition([]string{"*.example.org"}),
+					buildPathPatternCondition([]string{"/*"}),
+				},
+			},
+		},
+		{
+			name: "exact path match",
+			httpMatch: &gwv1.HTTPRouteMatch{
+				Path: &gwv1.HTTPPathMatch{
+					Type:  &exactPathType,
+					Value: awssdk.String("/login"),
+				},
+			},
+			want: [][]elbv2model.RuleCondition{
+				{
+					buildPathPatternCondition([]string{"/login"}),
+				},
+			},
+		},
+		{
+			name: "prefix path match",
+			httpMatch: &gwv1.HTTPRouteMatch{
+				Path: &gwv1.HTTPPathMatch{
+					Type:  &prefixPathType,
+					Value: awssdk.String("/api/"),
+				},
+			},
+			want: [][]elbv2model.RuleCondition{
+				{
+					buildPathPatternCondition([]string{"/api", "/api/*"}),
+				},
+			},
+		},
+		{
+			name:     "http match with headers, query params and method",
+			hostname: "example.com",
+			httpMatch: &gwv1.HTTPRouteMatch{
+				Path: &gwv1.HTTPPathMatch{
+					Type:  &exactPathType,
+					Value: awssdk.String("/search"),
+				},
+				Headers: []gwv1.HTTPHeaderMatch{
+					{
+						Name:  "x-env",
+						Value: "canary",
+					},
+				},
+				QueryParams: []gwv1.HTTPQueryParamMatch{
+					{
+						Name:  "q",
+						Value: "lbc",
+					},
+				},
+				Method: &getMethod,
+			},
+			want: [][]elbv2model.RuleCondition{
+				{
+					buildHTTPHeaderCondition("x-env", "canary"),
+					buildQueryStringCondition("q", "lbc"),
+					buildHTTPRequestMethodCondition("GET"),
+					buildHostHeaderCondition([]string{"example.com"}),
+					buildPathPatternCondition([]string{"/search"}),
+				},
+			},
+		},
+		{
+			name:     "paths exceeding the per rule limit are split across rules",
+			hostname: "a.com",
+			httpMatch: &gwv1.HTTPRouteMatch{
+				Path: &gwv1.HTTPPathMatch{
+					Type:  &prefixPathType,
+					Value: awssdk.String("/api"),
+				},
+				Headers: []gwv1.HTTPHeaderMatch{
+					{
+						Name:  "x-env",
+						Value: "canary",
+					},
+					{
+						Name:  "x-team",
+						Value: "lbc",
+					},
+					{
+						Name:  "x-app",
+						Value: "web",
+					},
+				},
+			},
+			want: [][]elbv2model.RuleCondition{
+				{
+					buildHTTPHeaderCondition("x-env", "canary"),
+					buildHTTPHeaderCondition("x-team", "lbc"),
+					buildHTTPHeaderCondition("x-app", "web"),
+					buildHostHeaderCondition([]string{"a.com"}),
+					buildPathPatternCondition([]string{"/api"}),
+				},
+				{
+					buildHTTPHeaderCondition("x-env", "canary"),
+					buildHTTPHeaderCondition("x-team", "lbc"),
+					buildHTTPHeaderCondition("x-app", "web"),
+					buildHostHeaderCondition([]string{"a.com"}),
+					buildPathPatternCondition([]string{"/api/*"}),
+				},
+			},
+		},
+		{
+			name:     "match exceeding the per rule limit",
+			hostname: "a.com",
+			httpMatch: &gwv1.HTTPRouteMatch{
+				Headers: []gwv1.HTTPHeaderMatch{
+					{Name: "h1", Value: "v"},
+					{Name: "h2", Value: "v"},
+					{Name: "h3", Value: "v"},
+					{Name: "h4", Value: "v"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "regular expression path match",
+			httpMatch: &gwv1.HTTPRouteMatch{
+				Path: &gwv1.HTTPPathMatch{
+					Type:  &regexPathType,
+					Value: awssdk.String("/api/v[0-9]+"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "regular expression header match",
+			httpMatch: &gwv1.HTTPRouteMatch{
+				Headers: []gwv1.HTTPHeaderMatch{
+					{
+						Type:  &regexHeaderType,
+						Name:  "x-env",
+						Value: "canary.*",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "exact path with wildcard",
+			httpMatch: &gwv1.HTTPRouteMatch{
+				Path: &gwv1.HTTPPathMatch{
+					Type:  &exactPathType,
+					Value: awssdk.String("/api/*"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:      "empty grpc match",
+			grpcMatch: &gwv1.GRPCRouteMatch{},
+			want: [][]elbv2model.RuleCondition{
+				{
+					buildPathPatternCondition([]string{"/*"}),
+				},
+			},
+		},
+		{
+			name:     "grpc match with service, method and header",
+			hostname: "grpc.example.com",
+			grpcMatch: &gwv1.GRPCRouteMatch{
+				Method: &gwv1.GRPCMethodMatch{
+					Service: awssdk.String("helloworld.Greeter"),
+					Method:  awssdk.String("SayHello"),
+				},
+				Headers: []gwv1.GRPCHeaderMatch{
+					{
+						Name:  "x-env",
+						Value: "canary",
+					},
+				},
+			},
+			want: [][]elbv2model.RuleCondition{
+				{
+					buildHTTPHeaderCondition("x-env", "canary"),
+					buildHostHeaderCondition([]string{"grpc.example.com"}),
+					buildPathPatternCondition([]string{"/helloworld.Greeter/SayHello"}),
+				},
+			},
+		},
+		{
+			name: "grpc match with service only",
+			grpcMatch: &gwv1.GRPCRouteMatch{
+				Method: &gwv1.GRPCMethodMatch{
+					Service: awssdk.String("helloworld.Greeter"),
+				},
+			},
+			want: [][]elbv2model.RuleCondition{
+				{
+					buildPathPatternCondition([]string{"/helloworld.Greeter/*"}),
+				},
+			},
+		},
+		{
+			name: "grpc match with method only",
+			grpcMatch: &gwv1.GRPCRouteMatch{
+				Method: &gwv1.GRPCMethodMatch{
+					Method: awssdk.String("Check"),
+				},
+			},
+			want: [][]elbv2model.RuleCondition{
+				{
+					buildPathPatternCondition([]string{"/*/Check"}),
+				},
+			},
+		},
+		{
+			name: "grpc match with regular expression method match",
+			grpcMatch: &gwv1.GRPCRouteMatch{
+				Method: &gwv1.GRPCMethodMatch{
+					Type:    &regexMethodType,
+					Service: awssdk.String("helloworld.*"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported rule type",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precedence := routeutils.RulePrecedence{
+				RouteDescriptor: &routeutils.MockRoute{
+					Kind: routeutils.HTTPRouteKind,
+				},
+				Rule:      &routeutils.MockRule{},
+				Hostname:  tt.hostname,
+				HTTPMatch: tt.httpMatch,
+				GRPCMatch: tt.grpcMatch,
+			}
+			got, err := buildRuleConditions(precedence)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
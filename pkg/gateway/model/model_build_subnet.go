@@ -107,70 +107,32 @@ func (subnetBuilder *subnetModelBuilderImpl) validateSubnetsInput(subnetConfigsP
 	}
 
 	subnetsConfig := *subnetConfigsPtr
-	identifierSpecified := subnetsConfig[0].Identifier != ""
 	eipAllocationSpecified := subnetsConfig[0].EIPAllocation != nil
 	ipv6AllocationSpecified := subnetsConfig[0].IPv6Allocation != nil
 	privateIPv4AllocationSpecified := subnetsConfig[0].PrivateIPv4Allocation != nil
 	sourceNATSpecified := subnetsConfig[0].SourceNatIPv6Prefix != nil
 
-	if eipAllocationSpecified {
-		if subnetBuilder.loadBalancerType != elbv2model.LoadBalancerTypeNetwork {
+	if subnetBuilder.loadBalancerType != elbv2model.LoadBalancerTypeNetwork {
+		if eipAllocationSpecified {
 			return false, errors.Errorf("EIP Allocation is only allowed for Network LoadBalancers")
 		}
 
-		if scheme != elbv2model.LoadBalancerSchemeInternetFacing {
-			return false, errors.Errorf("EIPAllocation can only be set for internet facing load balancers")
-		}
-	}
-
-	if ipv6AllocationSpecified {
-		if subnetBuilder.loadBalancerType != elbv2model.LoadBalancerTypeNetwork {
+		if ipv6AllocationSpecified {
 			return false, errors.Errorf("IPv6Allocation is only supported for Network LoadBalancers")
 		}
 
-		if ipAddressType != elbv2model.IPAddressTypeDualStack {
-			return false, errors.Errorf("IPv6Allocation can only be set for dualstack load balancers")
-		}
-	}
-
-	if privateIPv4AllocationSpecified {
-		if subnetBuilder.loadBalancerType != elbv2model.LoadBalancerTypeNetwork {
+		if privateIPv4AllocationSpecified {
 			return false, errors.Errorf("PrivateIPv4Allocation is only supported for Network LoadBalancers")
 		}
 
-		if scheme != elbv2model.LoadBalancerSchemeInternal {
-			return false, errors.Errorf("PrivateIPv4Allocation can only be set for internal load balancers")
-		}
-	}
-
-	if sourceNATSpecified {
-		if subnetBuilder.loadBalancerType != elbv2model.LoadBalancerTypeNetwork {
+		if sourceNATSpecified {
 			return false, errors.Errorf("SourceNatIPv6Prefix is only supported for Network LoadBalancers")
 		}
 	}
 
-	for _, subnetConfig := range subnetsConfig {
-		if (subnetConfig.Identifier != "") != identifierSpecified {
-			return false, errors.Errorf("Either specify all subnet identifiers or none.")
-		}
-
-		if (subnetConfig.EIPAllocation != nil) != eipAllocationSpecified {
-			return false, errors.Errorf("Either specify all eip allocations or none.")
-		}
-
-		if (subnetConfig.IPv6Allocation != nil) != ipv6AllocationSpecified {
-			return false, errors.Errorf("Either specify all ipv6 allocations or none.")
-		}
-
-		if (subnetConfig.PrivateIPv4Allocation != nil) != privateIPv4AllocationSpecified {
-			return false, errors.Errorf("Either specify all private ipv4 allocations or none.")
-		}
-
-		if (subnetConfig.SourceNatIPv6Prefix != nil) != sourceNATSpecified {
-			return false, errors.Errorf("Either specify all source nat prefixes or none.")
-		}
+	if err := ValidateSubnetConfigurations(subnetsConfig, scheme, ipAddressType); err != nil {
+		return false, err
 	}
-
 	return sourceNATSpecified, nil
 }
 
@@ -5,12 +5,12 @@ import (
 	"fmt"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
-	gwbeta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 const (
@@ -26,12 +26,43 @@ type Backend struct {
 	Weight                 int
 }
 
+// backendRefGroupKind formats the group and kind of backend reference, defaulting them the way the Gateway API does.
+func backendRefGroupKind(backendRef gwv1.BackendRef) string {
+	group := coreGroup
+	if backendRef.Group != nil {
+		group = string(*backendRef.Group)
+	}
+	kind := serviceKind
+	if backendRef.Kind != nil {
+		kind = string(*backendRef.Kind)
+	}
+	if group == coreGroup {
+		return kind
+	}
+	return fmt.Sprintf("%v.%v", kind, group)
+}
+
+// validateL4RuleBackendWeights returns a dropped rule error when all backend references of an L4 route rule have a weight of 0.
+// Such rules must not forward any connection, which NLB listeners can't do, so they are dropped from the route.
+func validateL4RuleBackendWeights(ruleIndex int, backendRefs []gwv1.BackendRef) *RouteValidationError {
+	if len(backendRefs) == 0 {
+		return nil
+	}
+	for _, backendRef := range backendRefs {
+		if backendRef.Weight == nil || *backendRef.Weight != 0 {
+			return nil
+		}
+	}
+	return newDroppedRuleError(ruleIndex, "all backend references have weight 0, which isn't supported by NLB listeners")
+}
+
 // commonBackendLoader this function will load the services and target group configurations associated with this gateway backend.
+// A RouteValidationError is returned when the backend reference can't be resolved, the route is still programmed without this backend.
 func commonBackendLoader(ctx context.Context, k8sClient client.Client, typeSpecificBackend interface{}, backendRef gwv1.BackendRef, routeIdentifier types.NamespacedName, routeKind RouteKind) (*Backend, error) {
 
 	// We only support references of type service.
-	if backendRef.Kind != nil && *backendRef.Kind != "Service" {
-		return nil, nil
+	if (backendRef.Kind != nil && *backendRef.Kind != serviceKind) || (backendRef.Group != nil && *backendRef.Group != coreGroup) {
+		return nil, newUnresolvedRefError(gwv1.RouteReasonInvalidKind, "Backend reference kind %v is not supported", backendRefGroupKind(backendRef))
 	}
 
 	if backendRef.Weight != nil && *backendRef.Weight == 0 {
@@ -61,17 +92,19 @@ func commonBackendLoader(ctx context.Context, k8sClient client.Client, typeSpeci
 			return nil, errors.Wrapf(err, "Unable to perform reference grant check")
 		}
 
-		// We should not give any hints about the existence of this resource, therefore, we return nil.
-		// That way, users can't infer if the route is missing because of a misconfigured service reference
-		// or the sentence grant is not allowing the connection.
+		// We should not give any hints about the existence of this resource, therefore, the grant is checked before
+		// looking up the service. That way, users can't infer if the service exists without being granted the reference.
 		if !allowed {
-			return nil, nil
+			return nil, newUnresolvedRefError(gwv1.RouteReasonRefNotPermitted, "Backend reference to %v is not permitted by any ReferenceGrant", svcIdentifier)
 		}
 	}
 
 	svc := &corev1.Service{}
 	err := k8sClient.Get(ctx, svcIdentifier, svc)
 	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, newUnresolvedRefError(gwv1.RouteReasonBackendNotFound, "Service %v not found", svcIdentifier)
+		}
 		return nil, errors.Wrap(err, fmt.Sprintf("Unable to fetch svc object %+v", svcIdentifier))
 	}
 
@@ -91,7 +124,7 @@ func commonBackendLoader(ctx context.Context, k8sClient client.Client, typeSpeci
 	}
 
 	if servicePort == nil {
-		return nil, errors.Errorf("Unable to find service port for port %d", *backendRef.Port)
+		return nil, newUnresolvedRefError(gwv1.RouteReasonBackendNotFound, "Service %v has no port %d", svcIdentifier, *backendRef.Port)
 	}
 
 	// Weight specifies the proportion of requests forwarded to the referenced
@@ -129,55 +162,32 @@ func lookUpTargetGroupConfiguration(ctx context.Context, k8sClient client.Client
 	}
 
 	for _, tgConfig := range tgConfigList.Items {
-		if tgConfig.Spec.TargetReference.Kind != nil && *tgConfig.Spec.TargetReference.Kind != serviceKind {
-			continue
-		}
-
-		// TODO - Add a webhook to validate that only one target group config references this service.
 		// TODO - Add an index for this
-		if tgConfig.Spec.TargetReference.Name == serviceMetadata.Name {
+		// the TargetGroupConfiguration webhook validates that only one target group config references this service.
+		if IsTargetGroupConfigurationForService(&tgConfig, serviceMetadata.Name) {
 			return &tgConfig, nil
 		}
 	}
 	return nil, nil
 }
 
-// Implements the reference grant API
-// https://gateway-api.sigs.k8s.io/api-types/referencegrant/
-func referenceGrantCheck(ctx context.Context, k8sClient client.Client, svcIdentifier types.NamespacedName, routeIdentifier types.NamespacedName, routeKind RouteKind) (bool, error) {
-	referenceGrantList := &gwbeta1.ReferenceGrantList{}
-	if err := k8sClient.List(ctx, referenceGrantList, client.InNamespace(svcIdentifier.Namespace)); err != nil {
-		return false, err
-	}
-
-	for _, grant := range referenceGrantList.Items {
-		var routeAllowed bool
-
-		for _, from := range grant.Spec.From {
-			// Kind check maybe?
-			if string(from.Kind) == string(routeKind) && string(from.Namespace) == routeIdentifier.Namespace {
-				routeAllowed = true
-				break
-			}
-		}
-
-		if routeAllowed {
-			for _, to := range grant.Spec.To {
-				// As this is a backend reference, we only care about the "Service" Kind.
-				if to.Kind != serviceKind {
-					continue
-				}
-
-				// If name is specified, we need to ensure that svc name matches the "to" name.
-				if to.Name != nil && string(*to.Name) != svcIdentifier.Name {
-					continue
-				}
-
-				return true, nil
-			}
-
-		}
+// IsTargetGroupConfigurationForService checks whether the target group configuration references the service with the given name.
+func IsTargetGroupConfigurationForService(tgConfig *elbv2gw.TargetGroupConfiguration, serviceName string) bool {
+	if tgConfig.Spec.TargetReference.Kind != nil && *tgConfig.Spec.TargetReference.Kind != serviceKind {
+		return false
 	}
+	return tgConfig.Spec.TargetReference.Name == serviceName
+}
 
-	return false, nil
+// referenceGrantCheck checks if the route is permitted to reference the service in another namespace.
+func referenceGrantCheck(ctx context.Context, k8sClient client.Client, svcIdentifier types.NamespacedName, routeIdentifier types.NamespacedName, routeKind RouteKind) (bool, error) {
+	return IsReferenceGranted(ctx, k8sClient, ReferenceFrom{
+		Group:     gwv1.GroupName,
+		Kind:      string(routeKind),
+		Namespace: routeIdentifier.Namespace,
+	}, ReferenceTo{
+		Group:          coreGroup,
+		Kind:           serviceKind,
+		NamespacedName: svcIdentifier,
+	})
 }
@@ -78,6 +78,7 @@ func TestCommonBackendLoader(t *testing.T) {
 		servicePort         int32
 		expectErr           bool
 		expectNoResult      bool
+		expectedUnresolved  gwv1.RouteConditionReason
 		expectedTargetGroup *elbv2gw.TargetGroupConfiguration
 	}{
 		{
@@ -185,7 +186,7 @@ func TestCommonBackendLoader(t *testing.T) {
 					Port:      portConverter(80),
 				},
 			},
-			expectNoResult: true,
+			expectedUnresolved: gwv1.RouteReasonRefNotPermitted,
 			storedService: &corev1.Service{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: namespaceToUse,
@@ -223,6 +224,7 @@ func TestCommonBackendLoader(t *testing.T) {
 					Spec: gwbeta1.ReferenceGrantSpec{
 						From: []gwbeta1.ReferenceGrantFrom{
 							{
+								Group:     gwv1.GroupName,
 								Kind:      gwbeta1.Kind(kind),
 								Namespace: "route-ns",
 							},
@@ -283,7 +285,65 @@ func TestCommonBackendLoader(t *testing.T) {
 					Port:      portConverter(80),
 				},
 			},
-			expectNoResult: true,
+			expectedUnresolved: gwv1.RouteReasonInvalidKind,
+		},
+		{
+			name: "backend of non-core group without kind should be reported as unresolved",
+			routeIdentifier: types.NamespacedName{
+				Name:      routeNameToUse,
+				Namespace: namespaceToUse,
+			},
+			backendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Name:      gwv1.ObjectName(svcNameToUse),
+					Namespace: (*gwv1.Namespace)(&namespaceToUse),
+					Group:     (*gwv1.Group)(awssdk.String("multicluster.x-k8s.io")),
+					Port:      portConverter(80),
+				},
+			},
+			expectedUnresolved: gwv1.RouteReasonInvalidKind,
+		},
+		{
+			name: "missing service should be reported as unresolved",
+			routeIdentifier: types.NamespacedName{
+				Name:      routeNameToUse,
+				Namespace: namespaceToUse,
+			},
+			backendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Name: gwv1.ObjectName(svcNameToUse),
+					Port: portConverter(80),
+				},
+			},
+			expectedUnresolved: gwv1.RouteReasonBackendNotFound,
+		},
+		{
+			name: "missing service port should be reported as unresolved",
+			routeIdentifier: types.NamespacedName{
+				Name:      routeNameToUse,
+				Namespace: namespaceToUse,
+			},
+			backendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Name: gwv1.ObjectName(svcNameToUse),
+					Port: portConverter(8080),
+				},
+			},
+			storedService: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespaceToUse,
+					Name:      svcNameToUse,
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{
+						{
+							Name: "port-80",
+							Port: 80,
+						},
+					},
+				},
+			},
+			expectedUnresolved: gwv1.RouteReasonBackendNotFound,
 		},
 		{
 			name: "missing port in backend ref should result in an error",
@@ -327,6 +387,14 @@ func TestCommonBackendLoader(t *testing.T) {
 				return
 			}
 
+			if tc.expectedUnresolved != "" {
+				var validationErr *RouteValidationError
+				assert.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tc.expectedUnresolved, validationErr.Reason)
+				assert.Nil(t, result)
+				return
+			}
+
 			assert.NoError(t, err)
 
 			if tc.expectNoResult {
@@ -572,6 +640,7 @@ func Test_referenceGrantCheck(t *testing.T) {
 					Spec: gwbeta1.ReferenceGrantSpec{
 						From: []gwbeta1.ReferenceGrantFrom{
 							{
+								Group:     gwv1.GroupName,
 								Kind:      gwbeta1.Kind(kind),
 								Namespace: "route-namespace",
 							},
@@ -606,6 +675,7 @@ func Test_referenceGrantCheck(t *testing.T) {
 					Spec: gwbeta1.ReferenceGrantSpec{
 						From: []gwbeta1.ReferenceGrantFrom{
 							{
+								Group:     gwv1.GroupName,
 								Kind:      gwbeta1.Kind(kind),
 								Namespace: "route-namespace",
 							},
@@ -651,6 +721,7 @@ func Test_referenceGrantCheck(t *testing.T) {
 					Spec: gwbeta1.ReferenceGrantSpec{
 						From: []gwbeta1.ReferenceGrantFrom{
 							{
+								Group:     gwv1.GroupName,
 								Kind:      gwbeta1.Kind(kind),
 								Namespace: "route-namespace",
 							},
@@ -685,6 +756,7 @@ func Test_referenceGrantCheck(t *testing.T) {
 					Spec: gwbeta1.ReferenceGrantSpec{
 						From: []gwbeta1.ReferenceGrantFrom{
 							{
+								Group:     gwv1.GroupName,
 								Kind:      gwbeta1.Kind("other kind"),
 								Namespace: "route-namespace",
 							},
@@ -699,6 +771,40 @@ func Test_referenceGrantCheck(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "from kind is allowed for a different group",
+			svcIdentifier: types.NamespacedName{
+				Namespace: "svc-namespace",
+				Name:      "svc-name",
+			},
+			routeIdentifier: types.NamespacedName{
+				Namespace: "route-namespace",
+				Name:      "route-name",
+			},
+			referenceGrants: []gwbeta1.ReferenceGrant{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "svc-namespace",
+						Name:      "grant1",
+					},
+					Spec: gwbeta1.ReferenceGrantSpec{
+						From: []gwbeta1.ReferenceGrantFrom{
+							{
+								Group:     "example.com",
+								Kind:      gwbeta1.Kind(kind),
+								Namespace: "route-namespace",
+							},
+						},
+						To: []gwbeta1.ReferenceGrantTo{
+							{
+								Kind: serviceKind,
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for _, tc := range testCases {
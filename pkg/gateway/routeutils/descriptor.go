@@ -2,6 +2,9 @@ package routeutils
 
 import (
 	"context"
+	"slices"
+	"time"
+
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -17,14 +20,17 @@ type routeMetadataDescriptor interface {
 	GetParentRefs() []gwv1.ParentReference
 	GetRawRoute() interface{}
 	GetBackendRefs() []gwv1.BackendRef
+	GetRouteCreateTimestamp() time.Time
 }
 
 // preLoadRouteDescriptor this object is used to represent a route description that has not loaded its child data (services, tg config)
 // generally use this interface to represent broad data, filter that data down to the absolutely required data, and the call
 // loadAttachedRules() to generate a full route description.
+// loadAttachedRules() also returns the backend references that couldn't be resolved, these backends are left out of the route rules,
+// and the rules that can't be programmed, which are dropped from the route.
 type preLoadRouteDescriptor interface {
 	routeMetadataDescriptor
-	loadAttachedRules(context context.Context, k8sClient client.Client) (RouteDescriptor, error)
+	loadAttachedRules(context context.Context, k8sClient client.Client) (RouteDescriptor, []RouteValidationError, error)
 }
 
 // RouteDescriptor is a type agnostic representation of a Gateway Route.
@@ -34,3 +40,22 @@ type RouteDescriptor interface {
 	routeMetadataDescriptor
 	GetAttachedRules() []RouteRule
 }
+
+// hostnameScopedRouteDescriptor is a route scoped to the hostnames it matches on the listeners of a port.
+type hostnameScopedRouteDescriptor struct {
+	RouteDescriptor
+	hostnames []gwv1.Hostname
+}
+
+func (r *hostnameScopedRouteDescriptor) GetHostnames() []gwv1.Hostname {
+	return r.hostnames
+}
+
+// withRouteHostnames scopes the route to the hostnames it matches on the listeners of a port, if they differ from its own hostnames.
+// No hostnames means the route matches any hostname, which only happens when the route has no hostnames either.
+func withRouteHostnames(route RouteDescriptor, hostnames []gwv1.Hostname) RouteDescriptor {
+	if len(hostnames) == 0 || slices.Equal(route.GetHostnames(), hostnames) {
+		return route
+	}
+	return &hostnameScopedRouteDescriptor{RouteDescriptor: route, hostnames: hostnames}
+}
@@ -2,6 +2,9 @@ package routeutils
 
 import (
 	"context"
+	"time"
+
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,6 +45,10 @@ func (t *convertedGRPCRouteRule) GetBackends() []Backend {
 	return t.backends
 }
 
+func (t *convertedGRPCRouteRule) GetListenerRuleConfig() *ListenerRuleConfig {
+	return nil
+}
+
 /* Route Description */
 
 type grpcRouteDescription struct {
@@ -50,14 +57,26 @@ type grpcRouteDescription struct {
 	backendLoader func(ctx context.Context, k8sClient client.Client, typeSpecificBackend interface{}, backendRef gwv1.BackendRef, routeIdentifier types.NamespacedName, routeKind RouteKind) (*Backend, error)
 }
 
-func (grpcRoute *grpcRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client) (RouteDescriptor, error) {
+func (grpcRoute *grpcRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client) (RouteDescriptor, []RouteValidationError, error) {
+	var unresolvedRefs []RouteValidationError
 	convertedRules := make([]RouteRule, 0)
 	for _, rule := range grpcRoute.route.Spec.Rules {
+		if err := validateGRPCRouteFilters(rule.Filters); err != nil {
+			return nil, nil, err
+		}
+		if err := validateGRPCRouteMatches(rule.Matches); err != nil {
+			return nil, nil, err
+		}
 		convertedBackends := make([]Backend, 0)
 		for _, backend := range rule.BackendRefs {
 			convertedBackend, err := grpcRoute.backendLoader(ctx, k8sClient, backend, backend.BackendRef, grpcRoute.GetRouteNamespacedName(), grpcRoute.GetRouteKind())
 			if err != nil {
-				return nil, err
+				var validationErr *RouteValidationError
+				if !errors.As(err, &validationErr) {
+					return nil, nil, err
+				}
+				unresolvedRefs = append(unresolvedRefs, *validationErr)
+				continue
 			}
 			if convertedBackend != nil {
 				convertedBackends = append(convertedBackends, *convertedBackend)
@@ -68,7 +87,7 @@ func (grpcRoute *grpcRouteDescription) loadAttachedRules(ctx context.Context, k8
 	}
 
 	grpcRoute.rules = convertedRules
-	return grpcRoute, nil
+	return grpcRoute, unresolvedRefs, nil
 }
 
 func (grpcRoute *grpcRouteDescription) GetHostnames() []gwv1.Hostname {
@@ -99,6 +118,10 @@ func (grpcRoute *grpcRouteDescription) GetRawRoute() interface{} {
 	return grpcRoute.route
 }
 
+func (grpcRoute *grpcRouteDescription) GetRouteCreateTimestamp() time.Time {
+	return grpcRoute.route.CreationTimestamp.Time
+}
+
 func (grpcRoute *grpcRouteDescription) GetBackendRefs() []gwv1.BackendRef {
 	backendRefs := make([]gwv1.BackendRef, 0)
 	if grpcRoute.route.Spec.Rules != nil {
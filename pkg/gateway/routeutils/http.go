@@ -2,6 +2,9 @@ package routeutils
 
 import (
 	"context"
+	"time"
+
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,14 +22,16 @@ HTTP specific features of the route.
 var _ RouteRule = &convertedHTTPRouteRule{}
 
 type convertedHTTPRouteRule struct {
-	rule     *gwv1.HTTPRouteRule
-	backends []Backend
+	rule               *gwv1.HTTPRouteRule
+	backends           []Backend
+	listenerRuleConfig *ListenerRuleConfig
 }
 
-func convertHTTPRouteRule(rule *gwv1.HTTPRouteRule, backends []Backend) RouteRule {
+func convertHTTPRouteRule(rule *gwv1.HTTPRouteRule, backends []Backend, listenerRuleConfig *ListenerRuleConfig) RouteRule {
 	return &convertedHTTPRouteRule{
-		rule:     rule,
-		backends: backends,
+		rule:               rule,
+		backends:           backends,
+		listenerRuleConfig: listenerRuleConfig,
 	}
 }
 
@@ -42,6 +47,10 @@ func (t *convertedHTTPRouteRule) GetBackends() []Backend {
 	return t.backends
 }
 
+func (t *convertedHTTPRouteRule) GetListenerRuleConfig() *ListenerRuleConfig {
+	return t.listenerRuleConfig
+}
+
 /* Route Description */
 
 type httpRouteDescription struct {
@@ -54,14 +63,34 @@ func (httpRoute *httpRouteDescription) GetAttachedRules() []RouteRule {
 	return httpRoute.rules
 }
 
-func (httpRoute *httpRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client) (RouteDescriptor, error) {
+func (httpRoute *httpRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client) (RouteDescriptor, []RouteValidationError, error) {
+	var unresolvedRefs []RouteValidationError
 	convertedRules := make([]RouteRule, 0)
 	for _, rule := range httpRoute.route.Spec.Rules {
+		if err := validateHTTPRouteFilters(rule.Filters); err != nil {
+			return nil, nil, err
+		}
+		if err := validateHTTPRouteMatches(rule.Matches); err != nil {
+			return nil, nil, err
+		}
+		listenerRuleConfig, err := loadListenerRuleConfig(ctx, k8sClient, rule.Filters, httpRoute.GetRouteNamespacedName().Namespace)
+		if err != nil {
+			var validationErr *RouteValidationError
+			if !errors.As(err, &validationErr) {
+				return nil, nil, err
+			}
+			unresolvedRefs = append(unresolvedRefs, *validationErr)
+		}
 		convertedBackends := make([]Backend, 0)
 		for _, backend := range rule.BackendRefs {
 			convertedBackend, err := httpRoute.backendLoader(ctx, k8sClient, backend, backend.BackendRef, httpRoute.GetRouteNamespacedName(), httpRoute.GetRouteKind())
 			if err != nil {
-				return nil, err
+				var validationErr *RouteValidationError
+				if !errors.As(err, &validationErr) {
+					return nil, nil, err
+				}
+				unresolvedRefs = append(unresolvedRefs, *validationErr)
+				continue
 			}
 
 			if convertedBackend != nil {
@@ -69,10 +98,10 @@ func (httpRoute *httpRouteDescription) loadAttachedRules(ctx context.Context, k8
 			}
 		}
 
-		convertedRules = append(convertedRules, convertHTTPRouteRule(&rule, convertedBackends))
+		convertedRules = append(convertedRules, convertHTTPRouteRule(&rule, convertedBackends, listenerRuleConfig))
 	}
 	httpRoute.rules = convertedRules
-	return httpRoute, nil
+	return httpRoute, unresolvedRefs, nil
 }
 
 func (httpRoute *httpRouteDescription) GetHostnames() []gwv1.Hostname {
@@ -111,6 +140,10 @@ func (httpRoute *httpRouteDescription) GetRawRoute() interface{} {
 	return httpRoute.route
 }
 
+func (httpRoute *httpRouteDescription) GetRouteCreateTimestamp() time.Time {
+	return httpRoute.route.CreationTimestamp.Time
+}
+
 var _ RouteDescriptor = &httpRouteDescription{}
 
 // Can we use an indexer here to query more efficiently?
@@ -26,9 +26,12 @@ func Test_ConvertHTTPRuleToRouteRule(t *testing.T) {
 		{}, {},
 	}
 
-	result := convertHTTPRouteRule(rule, backends)
+	listenerRuleConfig := &ListenerRuleConfig{}
+
+	result := convertHTTPRouteRule(rule, backends, listenerRuleConfig)
 
 	assert.Equal(t, backends, result.GetBackends())
+	assert.Equal(t, listenerRuleConfig, result.GetListenerRuleConfig())
 	assert.Equal(t, rule, result.GetRawRouteRule().(*gwv1.HTTPRouteRule))
 }
 
@@ -158,8 +161,9 @@ func Test_HTTP_LoadAttachedRules(t *testing.T) {
 		backendLoader: mockLoader,
 	}
 
-	result, err := routeDescription.loadAttachedRules(context.Background(), nil)
+	result, unresolvedRefs, err := routeDescription.loadAttachedRules(context.Background(), nil)
 	assert.NoError(t, err)
+	assert.Empty(t, unresolvedRefs)
 	convertedRules := result.GetAttachedRules()
 	assert.Equal(t, 3, len(convertedRules))
 
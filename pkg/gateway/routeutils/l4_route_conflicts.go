@@ -0,0 +1,112 @@
+package routeutils
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// l4RouteKinds are the route kinds programmed onto NLB listeners.
+var l4RouteKinds = sets.New(TCPRouteKind, UDPRouteKind, TLSRouteKind)
+
+// rejectConflictingL4Routes removes the L4 routes that can't share a listener with an older route attached to the same port.
+// NLB listeners don't have rules, their default action forwards to the backends of a single route. TLS routes are told apart by
+// SNI hostname through the listener certificates, so several TLS routes can share a listener as long as they forward to the same backends.
+// A route is reported as not accepted when it is rejected on every port it is attached to.
+func rejectConflictingL4Routes(result *LoaderResult, logger logr.Logger) {
+	ports := make([]int32, 0, len(result.Routes))
+	for port := range result.Routes {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+
+	keptRoutes := sets.New[routeKey]()
+	rejectionMessages := make(map[routeKey]string)
+	for _, port := range ports {
+		if len(result.Routes[port]) == 0 || !l4RouteKinds.Has(result.Routes[port][0].GetRouteKind()) {
+			continue
+		}
+		keptOnPort, rejectedOnPort := partitionL4Routes(port, result.Routes[port])
+		result.Routes[port] = keptOnPort
+		for _, route := range keptOnPort {
+			keptRoutes.Insert(routeKey{kind: route.GetRouteKind(), namespacedName: route.GetRouteNamespacedName()})
+		}
+		for key, message := range rejectedOnPort {
+			if _, ok := rejectionMessages[key]; !ok {
+				rejectionMessages[key] = message
+			}
+		}
+	}
+
+	for i := range result.RouteStatuses {
+		routeStatus := &result.RouteStatuses[i]
+		key := routeKey{kind: routeStatus.Kind, namespacedName: routeStatus.NamespacedName}
+		message, rejected := rejectionMessages[key]
+		if !rejected || keptRoutes.Has(key) || !routeStatus.Accepted {
+			continue
+		}
+		logger.Info("Rejecting route", "route", routeStatus.NamespacedName, "kind", routeStatus.Kind, "message", message)
+		routeStatus.Accepted = false
+		routeStatus.Reason = gwv1.RouteReasonUnsupportedValue
+		routeStatus.Message = message
+	}
+}
+
+// partitionL4Routes splits the routes attached to a port into the routes programmed onto the listener and the conflicting routes.
+// The oldest route is always kept, so that the listener keeps forwarding to the same backends when routes are added.
+func partitionL4Routes(port int32, routes []RouteDescriptor) ([]RouteDescriptor, map[routeKey]string) {
+	if len(routes) <= 1 {
+		return routes, nil
+	}
+	sortedRoutes := append([]RouteDescriptor(nil), routes...)
+	sort.SliceStable(sortedRoutes, func(i, j int) bool {
+		iTime, jTime := sortedRoutes[i].GetRouteCreateTimestamp(), sortedRoutes[j].GetRouteCreateTimestamp()
+		if !iTime.Equal(jTime) {
+			return iTime.Before(jTime)
+		}
+		return sortedRoutes[i].GetRouteNamespacedName().String() < sortedRoutes[j].GetRouteNamespacedName().String()
+	})
+
+	acceptedRoute := sortedRoutes[0]
+	acceptedWeights := mapL4BackendWeights(GetL4RouteBackends(acceptedRoute))
+	kept := []RouteDescriptor{acceptedRoute}
+	rejected := make(map[routeKey]string)
+	for _, route := range sortedRoutes[1:] {
+		key := routeKey{kind: route.GetRouteKind(), namespacedName: route.GetRouteNamespacedName()}
+		switch {
+		case route.GetRouteKind() != TLSRouteKind || acceptedRoute.GetRouteKind() != TLSRouteKind:
+			rejected[key] = fmt.Sprintf("Listener on port %v is already used by %v %v, NLB listeners only support multiple routes of kind %v",
+				port, acceptedRoute.GetRouteKind(), acceptedRoute.GetRouteNamespacedName(), TLSRouteKind)
+		case !maps.Equal(acceptedWeights, mapL4BackendWeights(GetL4RouteBackends(route))):
+			rejected[key] = fmt.Sprintf("Listener on port %v forwards to the backends of %v %v, routes sharing an NLB listener must forward to the same backends",
+				port, acceptedRoute.GetRouteKind(), acceptedRoute.GetRouteNamespacedName())
+		default:
+			kept = append(kept, route)
+		}
+	}
+	return kept, rejected
+}
+
+// GetL4RouteBackends returns the backends of all rules of an L4 route, as L4 route rules don't have any matches.
+func GetL4RouteBackends(route RouteDescriptor) []Backend {
+	var backends []Backend
+	for _, rule := range route.GetAttachedRules() {
+		backends = append(backends, rule.GetBackends()...)
+	}
+	return backends
+}
+
+// mapL4BackendWeights maps the service ports of the backends to their combined weight.
+func mapL4BackendWeights(backends []Backend) map[string]int {
+	weights := make(map[string]int, len(backends))
+	for _, backend := range backends {
+		key := fmt.Sprintf("%v:%v", k8s.NamespacedName(backend.Service), backend.ServicePort.Port)
+		weights[key] += backend.Weight
+	}
+	return weights
+}
@@ -0,0 +1,126 @@
+package routeutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_rejectConflictingL4Routes(t *testing.T) {
+	newBackend := func(svcName string, weight int) Backend {
+		return Backend{
+			Service:     &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: svcName}},
+			ServicePort: &corev1.ServicePort{Port: 80},
+			Weight:      weight,
+		}
+	}
+	newRoute := func(kind RouteKind, name string, creationTime time.Time, backends ...Backend) *MockRoute {
+		return &MockRoute{
+			Kind:         kind,
+			Namespace:    "ns",
+			Name:         name,
+			CreationTime: creationTime,
+			Rules:        []RouteRule{&MockRule{BackendRefs: backends}},
+		}
+	}
+	acceptedStatus := func(route *MockRoute) RouteStatusInfo {
+		return RouteStatusInfo{Kind: route.Kind, NamespacedName: route.GetRouteNamespacedName(), Accepted: true, ResolvedRefs: true}
+	}
+	rejectedStatus := func(route *MockRoute, message string) RouteStatusInfo {
+		return RouteStatusInfo{
+			Kind:           route.Kind,
+			NamespacedName: route.GetRouteNamespacedName(),
+			ResolvedRefs:   true,
+			Reason:         gwv1.RouteReasonUnsupportedValue,
+			Message:        message,
+		}
+	}
+	now := time.Now()
+	blue := newBackend("blue", 90)
+	green := newBackend("green", 10)
+
+	tlsOld := newRoute(TLSRouteKind, "tls-old", now, blue, green)
+	tlsSameBackends := newRoute(TLSRouteKind, "tls-same", now.Add(time.Minute), green, blue)
+	tlsOtherBackends := newRoute(TLSRouteKind, "tls-other", now.Add(time.Minute), blue)
+	tcpOld := newRoute(TCPRouteKind, "tcp-old", now, blue)
+	tcpNew := newRoute(TCPRouteKind, "tcp-new", now.Add(time.Minute), blue)
+	httpRoute1 := newRoute(HTTPRouteKind, "http-1", now, blue)
+	httpRoute2 := newRoute(HTTPRouteKind, "http-2", now.Add(time.Minute), green)
+
+	tests := []struct {
+		name          string
+		routes        map[int32][]RouteDescriptor
+		routeStatuses []RouteStatusInfo
+		wantRoutes    map[int32][]RouteDescriptor
+		wantStatuses  []RouteStatusInfo
+	}{
+		{
+			name:          "TLS routes forwarding to the same backends share the listener",
+			routes:        map[int32][]RouteDescriptor{443: {tlsSameBackends, tlsOld}},
+			routeStatuses: []RouteStatusInfo{acceptedStatus(tlsSameBackends), acceptedStatus(tlsOld)},
+			wantRoutes:    map[int32][]RouteDescriptor{443: {tlsOld, tlsSameBackends}},
+			wantStatuses:  []RouteStatusInfo{acceptedStatus(tlsSameBackends), acceptedStatus(tlsOld)},
+		},
+		{
+			name:          "newer TLS route forwarding to other backends is rejected",
+			routes:        map[int32][]RouteDescriptor{443: {tlsOtherBackends, tlsOld}},
+			routeStatuses: []RouteStatusInfo{acceptedStatus(tlsOtherBackends), acceptedStatus(tlsOld)},
+			wantRoutes:    map[int32][]RouteDescriptor{443: {tlsOld}},
+			wantStatuses: []RouteStatusInfo{
+				rejectedStatus(tlsOtherBackends, "Listener on port 443 forwards to the backends of TLSRoute ns/tls-old, routes sharing an NLB listener must forward to the same backends"),
+				acceptedStatus(tlsOld),
+			},
+		},
+		{
+			name:          "newer TCP route on the same listener is rejected",
+			routes:        map[int32][]RouteDescriptor{80: {tcpNew, tcpOld}},
+			routeStatuses: []RouteStatusInfo{acceptedStatus(tcpNew), acceptedStatus(tcpOld)},
+			wantRoutes:    map[int32][]RouteDescriptor{80: {tcpOld}},
+			wantStatuses: []RouteStatusInfo{
+				rejectedStatus(tcpNew, "Listener on port 80 is already used by TCPRoute ns/tcp-old, NLB listeners only support multiple routes of kind TLSRoute"),
+				acceptedStatus(tcpOld),
+			},
+		},
+		{
+			name:          "route rejected on one port stays accepted when it is programmed on another port",
+			routes:        map[int32][]RouteDescriptor{80: {tcpNew, tcpOld}, 81: {tcpNew}},
+			routeStatuses: []RouteStatusInfo{acceptedStatus(tcpNew), acceptedStatus(tcpOld)},
+			wantRoutes:    map[int32][]RouteDescriptor{80: {tcpOld}, 81: {tcpNew}},
+			wantStatuses:  []RouteStatusInfo{acceptedStatus(tcpNew), acceptedStatus(tcpOld)},
+		},
+		{
+			name:          "L7 routes are left alone",
+			routes:        map[int32][]RouteDescriptor{80: {httpRoute2, httpRoute1}},
+			routeStatuses: []RouteStatusInfo{acceptedStatus(httpRoute2), acceptedStatus(httpRoute1)},
+			wantRoutes:    map[int32][]RouteDescriptor{80: {httpRoute2, httpRoute1}},
+			wantStatuses:  []RouteStatusInfo{acceptedStatus(httpRoute2), acceptedStatus(httpRoute1)},
+		},
+		{
+			name:   "route that isn't accepted keeps its reason",
+			routes: map[int32][]RouteDescriptor{80: {tcpOld}},
+			routeStatuses: []RouteStatusInfo{
+				acceptedStatus(tcpOld),
+				{Kind: TCPRouteKind, NamespacedName: types.NamespacedName{Namespace: "ns", Name: "tcp-new"}, ResolvedRefs: true, Reason: gwv1.RouteReasonNotAllowedByListeners},
+			},
+			wantRoutes: map[int32][]RouteDescriptor{80: {tcpOld}},
+			wantStatuses: []RouteStatusInfo{
+				acceptedStatus(tcpOld),
+				{Kind: TCPRouteKind, NamespacedName: types.NamespacedName{Namespace: "ns", Name: "tcp-new"}, ResolvedRefs: true, Reason: gwv1.RouteReasonNotAllowedByListeners},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := &LoaderResult{Routes: tt.routes, RouteStatuses: tt.routeStatuses}
+			rejectConflictingL4Routes(result, logr.Discard())
+			assert.Equal(t, tt.wantRoutes, result.Routes)
+			assert.Equal(t, tt.wantStatuses, result.RouteStatuses)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package routeutils
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ListenerCertificates the certificates referenced by a listener through tls.certificateRefs.
+type ListenerCertificates struct {
+	// Secrets the TLS secrets that were resolved.
+	Secrets []*corev1.Secret
+	// ResolvedRefs is false when any of the certificate references can't be resolved, Reason and Message describe the first failure.
+	ResolvedRefs bool
+	Reason       gwv1.ListenerConditionReason
+	Message      string
+}
+
+// LoadListenerCertificates resolves the certificateRefs of every gateway listener.
+// A cross namespace certificate reference is only resolved when permitted by a ReferenceGrant.
+func LoadListenerCertificates(ctx context.Context, k8sClient client.Client, gw gwv1.Gateway) (map[gwv1.SectionName]ListenerCertificates, error) {
+	listenerCertificates := make(map[gwv1.SectionName]ListenerCertificates)
+	for _, listener := range gw.Spec.Listeners {
+		if listener.TLS == nil || len(listener.TLS.CertificateRefs) == 0 {
+			continue
+		}
+		certificates := ListenerCertificates{
+			ResolvedRefs: true,
+		}
+		for _, certRef := range listener.TLS.CertificateRefs {
+			secret, err := loadCertificateRef(ctx, k8sClient, gw, certRef)
+			if err != nil {
+				var validationErr *ListenerValidationError
+				if !errors.As(err, &validationErr) {
+					return nil, err
+				}
+				if certificates.ResolvedRefs {
+					certificates.ResolvedRefs = false
+					certificates.Reason = validationErr.Reason
+					certificates.Message = validationErr.Message
+				}
+				continue
+			}
+			certificates.Secrets = append(certificates.Secrets, secret)
+		}
+		listenerCertificates[listener.Name] = certificates
+	}
+	return listenerCertificates, nil
+}
+
+// ListenerValidationError indicates that a listener references a resource that can't be resolved.
+// Instead of failing the whole Gateway, the reason is reported on the listener status.
+type ListenerValidationError struct {
+	Reason  gwv1.ListenerConditionReason
+	Message string
+}
+
+func (e *ListenerValidationError) Error() string {
+	return e.Message
+}
+
+func newInvalidListenerRefError(reason gwv1.ListenerConditionReason, format string, args ...interface{}) error {
+	return &ListenerValidationError{
+		Reason:  reason,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// loadCertificateRef resolves a single certificate reference into a TLS secret.
+// A ListenerValidationError is returned when the reference can't be resolved.
+func loadCertificateRef(ctx context.Context, k8sClient client.Client, gw gwv1.Gateway, certRef gwv1.SecretObjectReference) (*corev1.Secret, error) {
+	if (certRef.Group != nil && *certRef.Group != coreGroup) || (certRef.Kind != nil && *certRef.Kind != secretKind) {
+		return nil, newInvalidListenerRefError(gwv1.ListenerReasonInvalidCertificateRef, "Certificate reference %v is not a Secret", certRef.Name)
+	}
+
+	secretIdentifier := types.NamespacedName{
+		Namespace: gw.Namespace,
+		Name:      string(certRef.Name),
+	}
+	if certRef.Namespace != nil {
+		secretIdentifier.Namespace = string(*certRef.Namespace)
+	}
+
+	// Just like for backends, the grant is checked before looking up the secret to not reveal if the secret exists.
+	if secretIdentifier.Namespace != gw.Namespace {
+		allowed, err := IsReferenceGranted(ctx, k8sClient, ReferenceFrom{
+			Group:     gwv1.GroupName,
+			Kind:      "Gateway",
+			Namespace: gw.Namespace,
+		}, ReferenceTo{
+			Group:          coreGroup,
+			Kind:           secretKind,
+			NamespacedName: secretIdentifier,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to perform reference grant check")
+		}
+		if !allowed {
+			return nil, newInvalidListenerRefError(gwv1.ListenerReasonRefNotPermitted, "Certificate reference to %v is not permitted by any ReferenceGrant", secretIdentifier)
+		}
+	}
+
+	secret := &corev1.Secret{}
+	if err := k8sClient.Get(ctx, secretIdentifier, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, newInvalidListenerRefError(gwv1.ListenerReasonInvalidCertificateRef, "Secret %v not found", secretIdentifier)
+		}
+		if apierrors.IsForbidden(err) {
+			return nil, newInvalidListenerRefError(gwv1.ListenerReasonInvalidCertificateRef, "Controller is not permitted to read secret %v", secretIdentifier)
+		}
+		return nil, errors.Wrapf(err, "Unable to fetch secret object %v", secretIdentifier)
+	}
+	if secret.Type != corev1.SecretTypeTLS {
+		return nil, newInvalidListenerRefError(gwv1.ListenerReasonInvalidCertificateRef, "Secret %v is not of type %v", secretIdentifier, corev1.SecretTypeTLS)
+	}
+	return secret, nil
+}
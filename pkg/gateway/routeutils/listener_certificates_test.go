@@ -0,0 +1,182 @@
+package routeutils
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/testutils"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwbeta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"testing"
+)
+
+func Test_LoadListenerCertificates(t *testing.T) {
+	otherNamespace := gwv1.Namespace("other-ns")
+	configMapKind := gwv1.Kind("ConfigMap")
+	tlsSecret := func(namespace string, name string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+			Type: corev1.SecretTypeTLS,
+		}
+	}
+	secretGrant := &gwbeta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "other-ns",
+			Name:      "grant",
+		},
+		Spec: gwbeta1.ReferenceGrantSpec{
+			From: []gwbeta1.ReferenceGrantFrom{
+				{
+					Group:     gwv1.GroupName,
+					Kind:      "Gateway",
+					Namespace: "gw-ns",
+				},
+			},
+			To: []gwbeta1.ReferenceGrantTo{
+				{
+					Kind: secretKind,
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		certificateRefs []gwv1.SecretObjectReference
+		secrets         []*corev1.Secret
+		referenceGrants []*gwbeta1.ReferenceGrant
+		expected        ListenerCertificates
+		expectedSecrets []string
+	}{
+		{
+			name: "secret in gateway namespace",
+			certificateRefs: []gwv1.SecretObjectReference{
+				{Name: "cert"},
+			},
+			secrets: []*corev1.Secret{tlsSecret("gw-ns", "cert")},
+			expected: ListenerCertificates{
+				ResolvedRefs: true,
+			},
+			expectedSecrets: []string{"gw-ns/cert"},
+		},
+		{
+			name: "cross namespace secret with reference grant",
+			certificateRefs: []gwv1.SecretObjectReference{
+				{Name: "cert", Namespace: &otherNamespace},
+			},
+			secrets:         []*corev1.Secret{tlsSecret("other-ns", "cert")},
+			referenceGrants: []*gwbeta1.ReferenceGrant{secretGrant},
+			expected: ListenerCertificates{
+				ResolvedRefs: true,
+			},
+			expectedSecrets: []string{"other-ns/cert"},
+		},
+		{
+			name: "cross namespace secret without reference grant",
+			certificateRefs: []gwv1.SecretObjectReference{
+				{Name: "cert", Namespace: &otherNamespace},
+			},
+			secrets: []*corev1.Secret{tlsSecret("other-ns", "cert")},
+			expected: ListenerCertificates{
+				Reason:  gwv1.ListenerReasonRefNotPermitted,
+				Message: "Certificate reference to other-ns/cert is not permitted by any ReferenceGrant",
+			},
+		},
+		{
+			name: "secret not found",
+			certificateRefs: []gwv1.SecretObjectReference{
+				{Name: "cert"},
+			},
+			expected: ListenerCertificates{
+				Reason:  gwv1.ListenerReasonInvalidCertificateRef,
+				Message: "Secret gw-ns/cert not found",
+			},
+		},
+		{
+			name: "secret isn't a tls secret",
+			certificateRefs: []gwv1.SecretObjectReference{
+				{Name: "cert"},
+			},
+			secrets: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "gw-ns",
+						Name:      "cert",
+					},
+					Type: corev1.SecretTypeOpaque,
+				},
+			},
+			expected: ListenerCertificates{
+				Reason:  gwv1.ListenerReasonInvalidCertificateRef,
+				Message: "Secret gw-ns/cert is not of type kubernetes.io/tls",
+			},
+		},
+		{
+			name: "unsupported kind, the first failure is reported",
+			certificateRefs: []gwv1.SecretObjectReference{
+				{Name: "cert"},
+				{Name: "config", Kind: &configMapKind},
+				{Name: "missing"},
+			},
+			secrets: []*corev1.Secret{tlsSecret("gw-ns", "cert")},
+			expected: ListenerCertificates{
+				Reason:  gwv1.ListenerReasonInvalidCertificateRef,
+				Message: "Certificate reference config is not a Secret",
+			},
+			expectedSecrets: []string{"gw-ns/cert"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := testutils.GenerateTestClient()
+			for _, secret := range tc.secrets {
+				assert.NoError(t, k8sClient.Create(context.Background(), secret))
+			}
+			for _, grant := range tc.referenceGrants {
+				assert.NoError(t, k8sClient.Create(context.Background(), grant))
+			}
+			gw := gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "gw-ns",
+					Name:      "gw",
+				},
+				Spec: gwv1.GatewaySpec{
+					Listeners: []gwv1.Listener{
+						{
+							Name:     "https",
+							Protocol: gwv1.HTTPSProtocolType,
+							Port:     443,
+							TLS: &gwv1.GatewayTLSConfig{
+								CertificateRefs: tc.certificateRefs,
+							},
+						},
+						{
+							Name:     "http",
+							Protocol: gwv1.HTTPProtocolType,
+							Port:     80,
+						},
+					},
+				},
+			}
+
+			result, err := LoadListenerCertificates(context.Background(), k8sClient, gw)
+			assert.NoError(t, err)
+			assert.Len(t, result, 1)
+
+			certificates := result["https"]
+			var secretNames []string
+			for _, secret := range certificates.Secrets {
+				secretNames = append(secretNames, secret.Namespace+"/"+secret.Name)
+			}
+			assert.Equal(t, tc.expectedSecrets, secretNames)
+			assert.Equal(t, tc.expected.ResolvedRefs, certificates.ResolvedRefs)
+			assert.Equal(t, tc.expected.Reason, certificates.Reason)
+			assert.Equal(t, tc.expected.Message, certificates.Message)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package routeutils
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	listenerRuleConfigurationKind = "ListenerRuleConfiguration"
+
+	oidcSecretClientIDKey       = "clientID"
+	oidcSecretClientIDLegacyKey = "clientId"
+	oidcSecretClientSecretKey   = "clientSecret"
+)
+
+// ListenerRuleConfig is the ListenerRuleConfiguration referenced by a route rule through an ExtensionRef filter.
+type ListenerRuleConfig struct {
+	// Configuration is nil when the reference can't be resolved, the rule must then reject all requests
+	// instead of being programmed without the configuration.
+	Configuration *elbv2gw.ListenerRuleConfiguration
+	// OIDCSecret is the secret holding the OIDC client credentials, it's set even when the secret can't be read,
+	// so that the secret is watched until it's fixed.
+	OIDCSecret *types.NamespacedName
+	// OIDCClientID and OIDCClientSecret are the client credentials read from the OIDC secret.
+	OIDCClientID     string
+	OIDCClientSecret string
+}
+
+// isListenerRuleConfigurationRef checks if an ExtensionRef filter references a ListenerRuleConfiguration.
+func isListenerRuleConfigurationRef(ref gwv1.LocalObjectReference) bool {
+	return string(ref.Group) == elbv2gw.GroupVersion.Group && string(ref.Kind) == listenerRuleConfigurationKind
+}
+
+// loadListenerRuleConfig loads the ListenerRuleConfiguration referenced by the filters of an HTTPRoute rule.
+// nil is returned when the rule doesn't reference any ListenerRuleConfiguration.
+// A RouteValidationError is returned alongside an unresolved ListenerRuleConfig when the reference can't be resolved.
+func loadListenerRuleConfig(ctx context.Context, k8sClient client.Client, filters []gwv1.HTTPRouteFilter, routeNamespace string) (*ListenerRuleConfig, error) {
+	var ref *gwv1.LocalObjectReference
+	for _, filter := range filters {
+		if filter.Type == gwv1.HTTPRouteFilterExtensionRef && filter.ExtensionRef != nil && isListenerRuleConfigurationRef(*filter.ExtensionRef) {
+			ref = filter.ExtensionRef
+			break
+		}
+	}
+	if ref == nil {
+		return nil, nil
+	}
+
+	cfgIdentifier := types.NamespacedName{
+		Namespace: routeNamespace,
+		Name:      string(ref.Name),
+	}
+	cfg := &elbv2gw.ListenerRuleConfiguration{}
+	if err := k8sClient.Get(ctx, cfgIdentifier, cfg); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &ListenerRuleConfig{}, newUnresolvedRefError(gwv1.RouteReasonBackendNotFound, "ListenerRuleConfiguration %v not found", cfgIdentifier)
+		}
+		return nil, errors.Wrapf(err, "Unable to fetch ListenerRuleConfiguration %v", cfgIdentifier)
+	}
+
+	ruleConfig := &ListenerRuleConfig{}
+	if auth := cfg.Spec.Authentication; auth != nil {
+		switch auth.Type {
+		case elbv2gw.AuthenticationTypeCognito:
+			if auth.Cognito == nil {
+				return ruleConfig, newUnresolvedRefError(gwv1.RouteReasonUnsupportedValue, "ListenerRuleConfiguration %v is missing the cognito configuration", cfgIdentifier)
+			}
+		case elbv2gw.AuthenticationTypeOIDC:
+			if auth.OIDC == nil {
+				return ruleConfig, newUnresolvedRefError(gwv1.RouteReasonUnsupportedValue, "ListenerRuleConfiguration %v is missing the oidc configuration", cfgIdentifier)
+			}
+			secretIdentifier := types.NamespacedName{
+				Namespace: cfg.Namespace,
+				Name:      auth.OIDC.SecretName,
+			}
+			ruleConfig.OIDCSecret = &secretIdentifier
+			clientID, clientSecret, err := loadOIDCClientCredentials(ctx, k8sClient, secretIdentifier)
+			if err != nil {
+				return ruleConfig, err
+			}
+			ruleConfig.OIDCClientID = clientID
+			ruleConfig.OIDCClientSecret = clientSecret
+		default:
+			return ruleConfig, newUnresolvedRefError(gwv1.RouteReasonUnsupportedValue, "ListenerRuleConfiguration %v authentication type %v is not supported", cfgIdentifier, auth.Type)
+		}
+	}
+	ruleConfig.Configuration = cfg
+	return ruleConfig, nil
+}
+
+// loadOIDCClientCredentials reads the OIDC client ID and secret, using the same secret format as the Ingress auth-idp-oidc annotation.
+func loadOIDCClientCredentials(ctx context.Context, k8sClient client.Client, secretIdentifier types.NamespacedName) (string, string, error) {
+	secret := &corev1.Secret{}
+	if err := k8sClient.Get(ctx, secretIdentifier, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", "", newUnresolvedRefError(gwv1.RouteReasonBackendNotFound, "Secret %v not found", secretIdentifier)
+		}
+		if apierrors.IsForbidden(err) {
+			return "", "", newUnresolvedRefError(gwv1.RouteReasonBackendNotFound, "Controller is not permitted to read secret %v", secretIdentifier)
+		}
+		return "", "", errors.Wrapf(err, "Unable to fetch secret %v", secretIdentifier)
+	}
+
+	rawClientID, ok := secret.Data[oidcSecretClientIDKey]
+	if !ok {
+		rawClientID, ok = secret.Data[oidcSecretClientIDLegacyKey]
+	}
+	rawClientSecret, hasClientSecret := secret.Data[oidcSecretClientSecretKey]
+	if !ok || !hasClientSecret {
+		return "", "", newUnresolvedRefError(gwv1.RouteReasonBackendNotFound, "Secret %v must contain the %v and %v keys", secretIdentifier, oidcSecretClientIDKey, oidcSecretClientSecretKey)
+	}
+	clientID := strings.TrimRightFunc(string(rawClientID), unicode.IsSpace)
+	clientSecret := strings.TrimRightFunc(string(rawClientSecret), unicode.IsControl)
+	return clientID, clientSecret, nil
+}
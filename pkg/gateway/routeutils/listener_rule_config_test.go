@@ -0,0 +1,178 @@
+package routeutils
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/testutils"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"testing"
+)
+
+func Test_loadListenerRuleConfig(t *testing.T) {
+	extensionRefFilters := []gwv1.HTTPRouteFilter{
+		{
+			Type: gwv1.HTTPRouteFilterExtensionRef,
+			ExtensionRef: &gwv1.LocalObjectReference{
+				Group: "gateway.k8s.aws",
+				Kind:  "ListenerRuleConfiguration",
+				Name:  "auth",
+			},
+		},
+	}
+	cognitoConfig := &elbv2gw.ListenerRuleConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "route-ns", Name: "auth"},
+		Spec: elbv2gw.ListenerRuleConfigurationSpec{
+			Authentication: &elbv2gw.AuthenticationConfiguration{
+				Type: elbv2gw.AuthenticationTypeCognito,
+				Cognito: &elbv2gw.AuthenticationCognitoConfig{
+					UserPoolARN:      "arn:aws:cognito-idp:us-west-2:123456789012:userpool/pool",
+					UserPoolClientID: "client",
+					UserPoolDomain:   "domain",
+				},
+			},
+		},
+	}
+	oidcConfig := &elbv2gw.ListenerRuleConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "route-ns", Name: "auth"},
+		Spec: elbv2gw.ListenerRuleConfigurationSpec{
+			Authentication: &elbv2gw.AuthenticationConfiguration{
+				Type: elbv2gw.AuthenticationTypeOIDC,
+				OIDC: &elbv2gw.AuthenticationOIDCConfig{
+					Issuer:                "https://idp.example.com",
+					AuthorizationEndpoint: "https://idp.example.com/authorize",
+					TokenEndpoint:         "https://idp.example.com/token",
+					UserInfoEndpoint:      "https://idp.example.com/userinfo",
+					SecretName:            "oidc-secret",
+				},
+			},
+		},
+	}
+	oidcSecretIdentifier := &types.NamespacedName{Namespace: "route-ns", Name: "oidc-secret"}
+	oidcSecret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "route-ns", Name: "oidc-secret"},
+			Data:       data,
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		filters          []gwv1.HTTPRouteFilter
+		config           *elbv2gw.ListenerRuleConfiguration
+		secret           *corev1.Secret
+		wantNil          bool
+		wantResolved     bool
+		wantClientID     string
+		wantClientSecret string
+		wantOIDCSecret   *types.NamespacedName
+		wantReason       gwv1.RouteConditionReason
+	}{
+		{
+			name:    "no extension ref",
+			wantNil: true,
+		},
+		{
+			name:         "cognito",
+			filters:      extensionRefFilters,
+			config:       cognitoConfig,
+			wantResolved: true,
+		},
+		{
+			name:    "oidc",
+			filters: extensionRefFilters,
+			config:  oidcConfig,
+			secret: oidcSecret(map[string][]byte{
+				"clientID":     []byte("client-id\n"),
+				"clientSecret": []byte("client-secret"),
+			}),
+			wantResolved:     true,
+			wantClientID:     "client-id",
+			wantClientSecret: "client-secret",
+			wantOIDCSecret:   oidcSecretIdentifier,
+		},
+		{
+			name:    "oidc with legacy client id key",
+			filters: extensionRefFilters,
+			config:  oidcConfig,
+			secret: oidcSecret(map[string][]byte{
+				"clientId":     []byte("client-id"),
+				"clientSecret": []byte("client-secret"),
+			}),
+			wantResolved:     true,
+			wantClientID:     "client-id",
+			wantClientSecret: "client-secret",
+			wantOIDCSecret:   oidcSecretIdentifier,
+		},
+		{
+			name:       "configuration not found",
+			filters:    extensionRefFilters,
+			wantReason: gwv1.RouteReasonBackendNotFound,
+		},
+		{
+			name:           "oidc secret not found",
+			filters:        extensionRefFilters,
+			config:         oidcConfig,
+			wantOIDCSecret: oidcSecretIdentifier,
+			wantReason:     gwv1.RouteReasonBackendNotFound,
+		},
+		{
+			name:    "oidc secret without client secret",
+			filters: extensionRefFilters,
+			config:  oidcConfig,
+			secret: oidcSecret(map[string][]byte{
+				"clientID": []byte("client-id"),
+			}),
+			wantOIDCSecret: oidcSecretIdentifier,
+			wantReason:     gwv1.RouteReasonBackendNotFound,
+		},
+		{
+			name:    "cognito type without cognito configuration",
+			filters: extensionRefFilters,
+			config: &elbv2gw.ListenerRuleConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "route-ns", Name: "auth"},
+				Spec: elbv2gw.ListenerRuleConfigurationSpec{
+					Authentication: &elbv2gw.AuthenticationConfiguration{
+						Type: elbv2gw.AuthenticationTypeCognito,
+					},
+				},
+			},
+			wantReason: gwv1.RouteReasonUnsupportedValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := testutils.GenerateTestClient()
+			if tc.config != nil {
+				assert.NoError(t, k8sClient.Create(context.Background(), tc.config.DeepCopy()))
+			}
+			if tc.secret != nil {
+				assert.NoError(t, k8sClient.Create(context.Background(), tc.secret))
+			}
+
+			result, err := loadListenerRuleConfig(context.Background(), k8sClient, tc.filters, "route-ns")
+			if tc.wantNil {
+				assert.NoError(t, err)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NotNil(t, result)
+			assert.Equal(t, tc.wantOIDCSecret, result.OIDCSecret)
+			if !tc.wantResolved {
+				var validationErr *RouteValidationError
+				assert.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tc.wantReason, validationErr.Reason)
+				assert.Nil(t, result.Configuration)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.config.Spec, result.Configuration.Spec)
+			assert.Equal(t, tc.wantClientID, result.OIDCClientID)
+			assert.Equal(t, tc.wantClientSecret, result.OIDCClientSecret)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -44,10 +45,20 @@ var L7RouteFilter LoadRouteFilter = &routeFilterImpl{
 	acceptedKinds: sets.New(HTTPRouteKind, GRPCRouteKind),
 }
 
+// LoaderResult holds the routes loaded for a gateway.
+type LoaderResult struct {
+	// Routes maps listener port to the routes attached to that port.
+	Routes map[int32][]RouteDescriptor
+	// RouteStatuses holds the status of every route that references the gateway, including the routes excluded from Routes.
+	RouteStatuses []RouteStatusInfo
+	// AttachedRoutes counts the routes attached to each listener of the gateway.
+	AttachedRoutes map[gwv1.SectionName]int32
+}
+
 // Loader will load all data Kubernetes that are pertinent to a gateway (Routes, Services, Target Group Configurations).
 // It will output the data using a map which maps listener port to the various routing rules for that port.
 type Loader interface {
-	LoadRoutesForGateway(ctx context.Context, gw gwv1.Gateway, filter LoadRouteFilter) (map[int32][]RouteDescriptor, error)
+	LoadRoutesForGateway(ctx context.Context, gw gwv1.Gateway, filter LoadRouteFilter) (*LoaderResult, error)
 }
 
 var _ Loader = &loaderImpl{}
@@ -69,7 +80,7 @@ func NewLoader(k8sClient client.Client, logger logr.Logger) Loader {
 }
 
 // LoadRoutesForGateway loads all relevant data for a single Gateway.
-func (l *loaderImpl) LoadRoutesForGateway(ctx context.Context, gw gwv1.Gateway, filter LoadRouteFilter) (map[int32][]RouteDescriptor, error) {
+func (l *loaderImpl) LoadRoutesForGateway(ctx context.Context, gw gwv1.Gateway, filter LoadRouteFilter) (*LoaderResult, error) {
 	// 1. Load all relevant routes according to the filter
 	loadedRoutes := make([]preLoadRouteDescriptor, 0)
 	for route, loader := range l.allRouteLoaders {
@@ -93,14 +104,26 @@ func (l *loaderImpl) LoadRoutesForGateway(ctx context.Context, gw gwv1.Gateway,
 	}
 
 	// 3. Load the underlying resource(s) for each route that is configured.
-	return l.loadChildResources(ctx, mappedRoutes)
+	result, err := l.loadChildResources(ctx, mappedRoutes.routesByPort, mappedRoutes.hostnamesByPort)
+	if err != nil {
+		return nil, err
+	}
+	// 4. Reject the L4 routes that can't be programmed onto the same listener as an older route.
+	rejectConflictingL4Routes(result, l.logger)
+	result.RouteStatuses = append(result.RouteStatuses, mappedRoutes.unattachedRoutes...)
+	result.AttachedRoutes = mappedRoutes.attachedRoutesByListener
+	return result, nil
 }
 
 // loadChildResources responsible for loading all resources that a route descriptor references.
-func (l *loaderImpl) loadChildResources(ctx context.Context, preloadedRoutes map[int][]preLoadRouteDescriptor) (map[int32][]RouteDescriptor, error) {
+// Routes with unsupported configuration are not returned as part of the loaded routes, they are reported as not accepted instead.
+// Routes are scoped to the hostnames they match on each port.
+func (l *loaderImpl) loadChildResources(ctx context.Context, preloadedRoutes map[int][]preLoadRouteDescriptor, hostnamesByPort map[int]map[routeKey][]gwv1.Hostname) (*LoaderResult, error) {
 	// Cache to reduce duplicate route look ups.
 	// Kind -> [NamespacedName:Previously Loaded Descriptor]
 	resourceCache := make(map[string]RouteDescriptor)
+	processedRouteKeys := sets.New[string]()
+	var routeStatuses []RouteStatusInfo
 
 	loadedRouteData := make(map[int32][]RouteDescriptor)
 
@@ -110,20 +133,55 @@ func (l *loaderImpl) loadChildResources(ctx context.Context, preloadedRoutes map
 			routeKind := preloadedRoute.GetRouteKind()
 			cacheKey := fmt.Sprintf("%s-%s-%s", routeKind, namespacedNameRoute.Name, namespacedNameRoute.Namespace)
 
+			hostnames := hostnamesByPort[port][newRouteKey(preloadedRoute)]
 			cachedRoute, ok := resourceCache[cacheKey]
 			if ok {
-				loadedRouteData[int32(port)] = append(loadedRouteData[int32(port)], cachedRoute)
+				loadedRouteData[int32(port)] = append(loadedRouteData[int32(port)], withRouteHostnames(cachedRoute, hostnames))
 				continue
 			}
+			if processedRouteKeys.Has(cacheKey) {
+				continue
+			}
+			processedRouteKeys.Insert(cacheKey)
 
-			generatedRoute, err := preloadedRoute.loadAttachedRules(ctx, l.k8sClient)
+			routeStatus := RouteStatusInfo{
+				Kind:           routeKind,
+				NamespacedName: namespacedNameRoute,
+				Accepted:       true,
+				ResolvedRefs:   true,
+			}
+			generatedRoute, unresolvedRefs, err := preloadedRoute.loadAttachedRules(ctx, l.k8sClient)
 			if err != nil {
-				return nil, err
+				var validationErr *RouteValidationError
+				if !errors.As(err, &validationErr) {
+					return nil, err
+				}
+				l.logger.Info("Rejecting route", "route", namespacedNameRoute, "kind", routeKind, "reason", validationErr.Reason, "message", validationErr.Message)
+				routeStatus.Accepted = false
+				routeStatus.Reason = validationErr.Reason
+				routeStatus.Message = validationErr.Message
+				routeStatuses = append(routeStatuses, routeStatus)
+				continue
+			}
+			for _, unresolvedRef := range unresolvedRefs {
+				if unresolvedRef.DroppedRule {
+					routeStatus.DroppedRules = append(routeStatus.DroppedRules, unresolvedRef.Message)
+					continue
+				}
+				if routeStatus.ResolvedRefs {
+					routeStatus.ResolvedRefs = false
+					routeStatus.Reason = unresolvedRef.Reason
+					routeStatus.Message = unresolvedRef.Message
+				}
 			}
-			loadedRouteData[int32(port)] = append(loadedRouteData[int32(port)], generatedRoute)
+			routeStatuses = append(routeStatuses, routeStatus)
+			loadedRouteData[int32(port)] = append(loadedRouteData[int32(port)], withRouteHostnames(generatedRoute, hostnames))
 			resourceCache[cacheKey] = generatedRoute
 		}
 	}
 
-	return loadedRouteData, nil
+	return &LoaderResult{
+		Routes:        loadedRouteData,
+		RouteStatuses: routeStatuses,
+	}, nil
 }
@@ -3,23 +3,32 @@ package routeutils
 import (
 	"context"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"testing"
+	"time"
 )
 
 type mockMapper struct {
 	t              *testing.T
 	expectedRoutes []preLoadRouteDescriptor
 	mapToReturn    map[int][]preLoadRouteDescriptor
+
+	attachedRoutesToReturn   map[gwv1.SectionName]int32
+	unattachedRoutesToReturn []RouteStatusInfo
 }
 
-func (m *mockMapper) mapGatewayAndRoutes(context context.Context, gw gwv1.Gateway, routes []preLoadRouteDescriptor) (map[int][]preLoadRouteDescriptor, error) {
+func (m *mockMapper) mapGatewayAndRoutes(context context.Context, gw gwv1.Gateway, routes []preLoadRouteDescriptor) (*routeMapping, error) {
 	assert.ElementsMatch(m.t, m.expectedRoutes, routes)
-	return m.mapToReturn, nil
+	return &routeMapping{
+		routesByPort:             m.mapToReturn,
+		attachedRoutesByListener: m.attachedRoutesToReturn,
+		unattachedRoutes:         m.unattachedRoutesToReturn,
+	}, nil
 }
 
 var _ RouteDescriptor = &mockRoute{}
@@ -27,10 +36,28 @@ var _ RouteDescriptor = &mockRoute{}
 type mockRoute struct {
 	namespacedName types.NamespacedName
 	routeKind      RouteKind
+	loadErr        error
+	unresolvedRefs []RouteValidationError
+}
+
+func (m *mockRoute) loadAttachedRules(context context.Context, k8sClient client.Client) (RouteDescriptor, []RouteValidationError, error) {
+	if m.loadErr != nil {
+		return nil, nil, m.loadErr
+	}
+	return m, m.unresolvedRefs, nil
 }
 
-func (m *mockRoute) loadAttachedRules(context context.Context, k8sClient client.Client) (RouteDescriptor, error) {
-	return m, nil
+func acceptedRouteStatuses(routes ...preLoadRouteDescriptor) []RouteStatusInfo {
+	var statuses []RouteStatusInfo
+	for _, route := range routes {
+		statuses = append(statuses, RouteStatusInfo{
+			Kind:           route.GetRouteKind(),
+			NamespacedName: route.GetRouteNamespacedName(),
+			Accepted:       true,
+			ResolvedRefs:   true,
+		})
+	}
+	return statuses
 }
 
 func (m *mockRoute) GetRouteNamespacedName() types.NamespacedName {
@@ -66,6 +93,11 @@ func (m *mockRoute) GetAttachedRules() []RouteRule {
 	panic("implement me")
 }
 
+func (m *mockRoute) GetRouteCreateTimestamp() time.Time {
+	//TODO implement me
+	panic("implement me")
+}
+
 func TestLoadRoutesForGateway(t *testing.T) {
 	preLoadHTTPRoutes := []preLoadRouteDescriptor{
 		&mockRoute{
@@ -93,7 +125,7 @@ func TestLoadRoutesForGateway(t *testing.T) {
 
 	loadedHTTPRoutes := make([]RouteDescriptor, 0)
 	for _, preload := range preLoadHTTPRoutes {
-		r, _ := preload.loadAttachedRules(nil, nil)
+		r, _, _ := preload.loadAttachedRules(nil, nil)
 		loadedHTTPRoutes = append(loadedHTTPRoutes, r)
 	}
 
@@ -123,10 +155,42 @@ func TestLoadRoutesForGateway(t *testing.T) {
 
 	loadedTCPRoutes := make([]RouteDescriptor, 0)
 	for _, preload := range preLoadTCPRoutes {
-		r, _ := preload.loadAttachedRules(nil, nil)
+		r, _, _ := preload.loadAttachedRules(nil, nil)
 		loadedTCPRoutes = append(loadedTCPRoutes, r)
 	}
 
+	rejectedHTTPRoute := &mockRoute{
+		namespacedName: types.NamespacedName{
+			Namespace: "http4-ns",
+			Name:      "http4",
+		},
+		routeKind: HTTPRouteKind,
+		loadErr:   newUnsupportedValueError("HTTPRoute filter type URLRewrite is not supported"),
+	}
+
+	unresolvedHTTPRoute := &mockRoute{
+		namespacedName: types.NamespacedName{
+			Namespace: "http6-ns",
+			Name:      "http6",
+		},
+		routeKind: HTTPRouteKind,
+		unresolvedRefs: []RouteValidationError{
+			{
+				Reason:  gwv1.RouteReasonBackendNotFound,
+				Message: "Service http6-ns/svc not found",
+			},
+		},
+	}
+
+	failedHTTPRoute := &mockRoute{
+		namespacedName: types.NamespacedName{
+			Namespace: "http5-ns",
+			Name:      "http5",
+		},
+		routeKind: HTTPRouteKind,
+		loadErr:   errors.New("unable to fetch svc object"),
+	}
+
 	allRouteLoaders := map[RouteKind]func(ctx context.Context, k8sClient client.Client) ([]preLoadRouteDescriptor, error){
 		HTTPRouteKind: func(ctx context.Context, k8sClient client.Client) ([]preLoadRouteDescriptor, error) {
 			return preLoadHTTPRoutes, nil
@@ -140,6 +204,9 @@ func TestLoadRoutesForGateway(t *testing.T) {
 		name                    string
 		acceptedKinds           sets.Set[RouteKind]
 		expectedMap             map[int32][]RouteDescriptor
+		expectedRouteStatuses   []RouteStatusInfo
+		attachedRoutes          map[gwv1.SectionName]int32
+		unattachedRoutes        []RouteStatusInfo
 		expectedPreloadMap      map[int][]preLoadRouteDescriptor
 		expectedPreMappedRoutes []preLoadRouteDescriptor
 		expectError             bool
@@ -160,6 +227,7 @@ func TestLoadRoutesForGateway(t *testing.T) {
 			expectedMap: map[int32][]RouteDescriptor{
 				80: loadedHTTPRoutes,
 			},
+			expectedRouteStatuses: acceptedRouteStatuses(preLoadHTTPRoutes...),
 		},
 		{
 			name:                    "filter only allows http route, multiple ports",
@@ -173,30 +241,119 @@ func TestLoadRoutesForGateway(t *testing.T) {
 				80:  loadedHTTPRoutes,
 				443: loadedHTTPRoutes,
 			},
+			expectedRouteStatuses: acceptedRouteStatuses(preLoadHTTPRoutes...),
 		},
 		{
 			name:                    "filter only allows tcp route",
 			acceptedKinds:           sets.New[RouteKind](TCPRouteKind),
 			expectedPreMappedRoutes: preLoadTCPRoutes,
 			expectedPreloadMap: map[int][]preLoadRouteDescriptor{
-				80: preLoadTCPRoutes,
+				80: preLoadTCPRoutes[:1],
+				81: preLoadTCPRoutes[1:2],
+				82: preLoadTCPRoutes[2:],
 			},
 			expectedMap: map[int32][]RouteDescriptor{
-				80: loadedTCPRoutes,
+				80: loadedTCPRoutes[:1],
+				81: loadedTCPRoutes[1:2],
+				82: loadedTCPRoutes[2:],
 			},
+			expectedRouteStatuses: acceptedRouteStatuses(preLoadTCPRoutes...),
 		},
 		{
 			name:                    "filter allows both route kinds",
 			acceptedKinds:           sets.New[RouteKind](TCPRouteKind, HTTPRouteKind),
 			expectedPreMappedRoutes: append(preLoadHTTPRoutes, preLoadTCPRoutes...),
 			expectedPreloadMap: map[int][]preLoadRouteDescriptor{
-				80:  preLoadTCPRoutes,
+				80:  preLoadTCPRoutes[:1],
+				81:  preLoadTCPRoutes[1:2],
+				82:  preLoadTCPRoutes[2:],
 				443: preLoadHTTPRoutes,
 			},
 			expectedMap: map[int32][]RouteDescriptor{
-				80:  loadedTCPRoutes,
+				80:  loadedTCPRoutes[:1],
+				81:  loadedTCPRoutes[1:2],
+				82:  loadedTCPRoutes[2:],
 				443: loadedHTTPRoutes,
 			},
+			expectedRouteStatuses: append(acceptedRouteStatuses(preLoadTCPRoutes...), acceptedRouteStatuses(preLoadHTTPRoutes...)...),
+		},
+		{
+			name:                    "route with unsupported configuration is rejected",
+			acceptedKinds:           sets.New[RouteKind](HTTPRouteKind),
+			expectedPreMappedRoutes: preLoadHTTPRoutes,
+			expectedPreloadMap: map[int][]preLoadRouteDescriptor{
+				80:  append([]preLoadRouteDescriptor{rejectedHTTPRoute}, preLoadHTTPRoutes...),
+				443: {rejectedHTTPRoute},
+			},
+			expectedMap: map[int32][]RouteDescriptor{
+				80: loadedHTTPRoutes,
+			},
+			expectedRouteStatuses: append(acceptedRouteStatuses(preLoadHTTPRoutes...), RouteStatusInfo{
+				Kind:           HTTPRouteKind,
+				NamespacedName: rejectedHTTPRoute.namespacedName,
+				Accepted:       false,
+				ResolvedRefs:   true,
+				Reason:         gwv1.RouteReasonUnsupportedValue,
+				Message:        "HTTPRoute filter type URLRewrite is not supported",
+			}),
+		},
+		{
+			name:                    "route with unresolved backend is accepted",
+			acceptedKinds:           sets.New[RouteKind](HTTPRouteKind),
+			expectedPreMappedRoutes: preLoadHTTPRoutes,
+			expectedPreloadMap: map[int][]preLoadRouteDescriptor{
+				80: {unresolvedHTTPRoute},
+			},
+			expectedMap: map[int32][]RouteDescriptor{
+				80: {unresolvedHTTPRoute},
+			},
+			expectedRouteStatuses: []RouteStatusInfo{
+				{
+					Kind:           HTTPRouteKind,
+					NamespacedName: unresolvedHTTPRoute.namespacedName,
+					Accepted:       true,
+					ResolvedRefs:   false,
+					Reason:         gwv1.RouteReasonBackendNotFound,
+					Message:        "Service http6-ns/svc not found",
+				},
+			},
+		},
+		{
+			name:                    "attached routes and unattached routes are reported",
+			acceptedKinds:           sets.New[RouteKind](HTTPRouteKind),
+			expectedPreMappedRoutes: preLoadHTTPRoutes,
+			expectedPreloadMap: map[int][]preLoadRouteDescriptor{
+				80: preLoadHTTPRoutes[:2],
+			},
+			attachedRoutes: map[gwv1.SectionName]int32{
+				"http": 2,
+			},
+			unattachedRoutes: []RouteStatusInfo{
+				{
+					Kind:           HTTPRouteKind,
+					NamespacedName: preLoadHTTPRoutes[2].GetRouteNamespacedName(),
+					ResolvedRefs:   true,
+					Reason:         gwv1.RouteReasonNotAllowedByListeners,
+				},
+			},
+			expectedMap: map[int32][]RouteDescriptor{
+				80: loadedHTTPRoutes[:2],
+			},
+			expectedRouteStatuses: append(acceptedRouteStatuses(preLoadHTTPRoutes[:2]...), RouteStatusInfo{
+				Kind:           HTTPRouteKind,
+				NamespacedName: preLoadHTTPRoutes[2].GetRouteNamespacedName(),
+				ResolvedRefs:   true,
+				Reason:         gwv1.RouteReasonNotAllowedByListeners,
+			}),
+		},
+		{
+			name:                    "route failing to load fails the gateway",
+			acceptedKinds:           sets.New[RouteKind](HTTPRouteKind),
+			expectedPreMappedRoutes: preLoadHTTPRoutes,
+			expectedPreloadMap: map[int][]preLoadRouteDescriptor{
+				80: {failedHTTPRoute},
+			},
+			expectError: true,
 		},
 	}
 
@@ -207,6 +364,9 @@ func TestLoadRoutesForGateway(t *testing.T) {
 					t:              t,
 					expectedRoutes: tc.expectedPreMappedRoutes,
 					mapToReturn:    tc.expectedPreloadMap,
+
+					attachedRoutesToReturn:   tc.attachedRoutes,
+					unattachedRoutesToReturn: tc.unattachedRoutes,
 				},
 				allRouteLoaders: allRouteLoaders,
 				logger:          logr.Discard(),
@@ -219,7 +379,9 @@ func TestLoadRoutesForGateway(t *testing.T) {
 				return
 			}
 			assert.NoError(t, err)
-			assert.Equal(t, tc.expectedMap, result)
+			assert.Equal(t, tc.expectedMap, result.Routes)
+			assert.ElementsMatch(t, tc.expectedRouteStatuses, result.RouteStatuses)
+			assert.Equal(t, tc.attachedRoutes, result.AttachedRoutes)
 		})
 	}
 }
@@ -1,14 +1,19 @@
 package routeutils
 
 import (
+	"time"
+
 	"k8s.io/apimachinery/pkg/types"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 type MockRoute struct {
-	Kind      RouteKind
-	Name      string
-	Namespace string
+	Kind         RouteKind
+	Name         string
+	Namespace    string
+	Hostnames    []gwv1.Hostname
+	CreationTime time.Time
+	Rules        []RouteRule
 }
 
 func (m *MockRoute) GetBackendRefs() []gwv1.BackendRef {
@@ -28,8 +33,7 @@ func (m *MockRoute) GetRouteKind() RouteKind {
 }
 
 func (m *MockRoute) GetHostnames() []gwv1.Hostname {
-	//TODO implement me
-	panic("implement me")
+	return m.Hostnames
 }
 
 func (m *MockRoute) GetParentRefs() []gwv1.ParentReference {
@@ -43,8 +47,36 @@ func (m *MockRoute) GetRawRoute() interface{} {
 }
 
 func (m *MockRoute) GetAttachedRules() []RouteRule {
-	//TODO implement me
-	panic("implement me")
+	return m.Rules
+}
+
+func (m *MockRoute) GetRouteCreateTimestamp() time.Time {
+	return m.CreationTime
 }
 
 var _ RouteDescriptor = &MockRoute{}
+
+type MockRule struct {
+	RawRule     interface{}
+	SectionName *gwv1.SectionName
+	BackendRefs []Backend
+	RuleConfig  *ListenerRuleConfig
+}
+
+func (m *MockRule) GetRawRouteRule() interface{} {
+	return m.RawRule
+}
+
+func (m *MockRule) GetSectionName() *gwv1.SectionName {
+	return m.SectionName
+}
+
+func (m *MockRule) GetBackends() []Backend {
+	return m.BackendRefs
+}
+
+func (m *MockRule) GetListenerRuleConfig() *ListenerRuleConfig {
+	return m.RuleConfig
+}
+
+var _ RouteRule = &MockRule{}
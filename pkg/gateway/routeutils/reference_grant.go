@@ -0,0 +1,69 @@
+package routeutils
+
+import (
+	"context"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwbeta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// coreGroup is the API group of core Kubernetes resources, e.g. Service and Secret.
+	coreGroup  = ""
+	secretKind = "Secret"
+)
+
+// ReferenceFrom identifies the kind and namespace of the resource holding a cross namespace reference.
+type ReferenceFrom struct {
+	Group     string
+	Kind      string
+	Namespace string
+}
+
+// ReferenceTo identifies the resource targeted by a cross namespace reference.
+type ReferenceTo struct {
+	Group          string
+	Kind           string
+	NamespacedName types.NamespacedName
+}
+
+// IsReferenceGranted checks if a ReferenceGrant in the namespace of the referent permits the reference.
+// Implements the reference grant API https://gateway-api.sigs.k8s.io/api-types/referencegrant/
+func IsReferenceGranted(ctx context.Context, k8sClient client.Client, from ReferenceFrom, to ReferenceTo) (bool, error) {
+	referenceGrantList := &gwbeta1.ReferenceGrantList{}
+	if err := k8sClient.List(ctx, referenceGrantList, client.InNamespace(to.NamespacedName.Namespace)); err != nil {
+		return false, err
+	}
+
+	for _, grant := range referenceGrantList.Items {
+		if isReferenceGrantedBy(grant, from, to) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func isReferenceGrantedBy(grant gwbeta1.ReferenceGrant, from ReferenceFrom, to ReferenceTo) bool {
+	fromAllowed := false
+	for _, grantFrom := range grant.Spec.From {
+		if string(grantFrom.Group) == from.Group && string(grantFrom.Kind) == from.Kind && string(grantFrom.Namespace) == from.Namespace {
+			fromAllowed = true
+			break
+		}
+	}
+	if !fromAllowed {
+		return false
+	}
+
+	for _, grantTo := range grant.Spec.To {
+		if string(grantTo.Group) != to.Group || string(grantTo.Kind) != to.Kind {
+			continue
+		}
+		// If name is specified, only the named resource is granted.
+		if grantTo.Name != nil && string(*grantTo.Name) != to.NamespacedName.Name {
+			continue
+		}
+		return true
+	}
+	return false
+}
@@ -3,14 +3,30 @@ package routeutils
 import (
 	"context"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"strings"
 )
 
 // listenerToRouteMapper is an internal utility that will map a list of routes to the listeners of a gateway
 // if the gateway and/or route are incompatible, then route is discarded.
 type listenerToRouteMapper interface {
-	mapGatewayAndRoutes(context context.Context, gw gwv1.Gateway, routes []preLoadRouteDescriptor) (map[int][]preLoadRouteDescriptor, error)
+	mapGatewayAndRoutes(context context.Context, gw gwv1.Gateway, routes []preLoadRouteDescriptor) (*routeMapping, error)
+}
+
+// routeMapping is the result of mapping routes to the listeners of a gateway.
+type routeMapping struct {
+	// routesByPort maps listener port to the routes attached to the listener(s) on that port, each route once per port.
+	routesByPort map[int][]preLoadRouteDescriptor
+	// hostnamesByPort maps listener port to the hostnames the routes match on that port, i.e. the hostnames of the route
+	// intersected with the hostnames of the listeners it's attached to on that port. Routes matching any hostname have no entry.
+	hostnamesByPort map[int]map[routeKey][]gwv1.Hostname
+	// attachedRoutesByListener counts the routes attached to each listener.
+	attachedRoutesByListener map[gwv1.SectionName]int32
+	// unattachedRoutes are routes that reference the gateway, but couldn't attach to any of its listeners.
+	unattachedRoutes []RouteStatusInfo
 }
 
 var _ listenerToRouteMapper = &listenerToRouteMapperImpl{}
@@ -30,8 +46,12 @@ func newListenerToRouteMapper(k8sClient client.Client, logger logr.Logger) liste
 }
 
 // mapGatewayAndRoutes will map route to the corresponding listener ports using the Gateway API spec rules.
-func (ltr *listenerToRouteMapperImpl) mapGatewayAndRoutes(ctx context.Context, gw gwv1.Gateway, routes []preLoadRouteDescriptor) (map[int][]preLoadRouteDescriptor, error) {
-	result := make(map[int][]preLoadRouteDescriptor)
+func (ltr *listenerToRouteMapperImpl) mapGatewayAndRoutes(ctx context.Context, gw gwv1.Gateway, routes []preLoadRouteDescriptor) (*routeMapping, error) {
+	result := &routeMapping{
+		routesByPort:             make(map[int][]preLoadRouteDescriptor),
+		hostnamesByPort:          make(map[int]map[routeKey][]gwv1.Hostname),
+		attachedRoutesByListener: make(map[gwv1.SectionName]int32),
+	}
 
 	// First filter out any routes that are not intended for this Gateway.
 	routesForGateway := make([]preLoadRouteDescriptor, 0)
@@ -44,13 +64,22 @@ func (ltr *listenerToRouteMapperImpl) mapGatewayAndRoutes(ctx context.Context, g
 	}
 
 	// Next, greedily looking for the route to attach to.
+	// The most specific reason is kept for routes that can't attach to any listener.
+	attachedRoutes := sets.New[routeKey]()
+	unattachedReasons := make(map[routeKey]gwv1.RouteConditionReason)
+	// the hostnames of the listeners each route is attached to, by port.
+	listenerHostnamesByPort := make(map[int]map[routeKey][]*gwv1.Hostname)
 	for _, listener := range gw.Spec.Listeners {
 		for _, route := range routesForGateway {
+			key := newRouteKey(route)
 
 			// We need to check both paths (route -> listener) and (listener -> route)
 			// for connection viability.
 			if !ltr.routeAttachmentHelper.routeAllowsAttachmentToListener(listener, route) {
 				ltr.logger.V(1).Info("Route doesnt allow attachment")
+				if _, ok := unattachedReasons[key]; !ok {
+					unattachedReasons[key] = gwv1.RouteReasonNoMatchingParent
+				}
 				continue
 			}
 
@@ -60,10 +89,157 @@ func (ltr *listenerToRouteMapperImpl) mapGatewayAndRoutes(ctx context.Context, g
 			}
 
 			ltr.logger.V(1).Info("lister allows attachment", "route", route.GetRouteNamespacedName(), "allowedAttachment", allowedAttachment)
-			if allowedAttachment {
-				result[int(listener.Port)] = append(result[int(listener.Port)], route)
+			if !allowedAttachment {
+				if reason := unattachedReasons[key]; reason != gwv1.RouteReasonNoMatchingListenerHostname {
+					unattachedReasons[key] = gwv1.RouteReasonNotAllowedByListeners
+				}
+				continue
+			}
+
+			if !listenerHostnameMatchesRoute(listener, route) {
+				unattachedReasons[key] = gwv1.RouteReasonNoMatchingListenerHostname
+				continue
+			}
+
+			attachedRoutes.Insert(key)
+			result.attachedRoutesByListener[listener.Name]++
+			port := int(listener.Port)
+			if listenerHostnamesByPort[port] == nil {
+				listenerHostnamesByPort[port] = make(map[routeKey][]*gwv1.Hostname)
+			}
+			if _, attachedOnPort := listenerHostnamesByPort[port][key]; !attachedOnPort {
+				result.routesByPort[port] = append(result.routesByPort[port], route)
+			}
+			listenerHostnamesByPort[port][key] = append(listenerHostnamesByPort[port][key], listener.Hostname)
+		}
+	}
+
+	for port, routes := range result.routesByPort {
+		for _, route := range routes {
+			key := newRouteKey(route)
+			hostnames := computeRouteHostnamesOnListeners(route.GetHostnames(), listenerHostnamesByPort[port][key])
+			if len(hostnames) == 0 {
+				continue
 			}
+			if result.hostnamesByPort[port] == nil {
+				result.hostnamesByPort[port] = make(map[routeKey][]gwv1.Hostname)
+			}
+			result.hostnamesByPort[port][key] = hostnames
+		}
+	}
+
+	for _, route := range routesForGateway {
+		key := newRouteKey(route)
+		if attachedRoutes.Has(key) {
+			continue
+		}
+		reason, ok := unattachedReasons[key]
+		if !ok {
+			reason = gwv1.RouteReasonNoMatchingParent
 		}
+		result.unattachedRoutes = append(result.unattachedRoutes, RouteStatusInfo{
+			Kind:           route.GetRouteKind(),
+			NamespacedName: route.GetRouteNamespacedName(),
+			Accepted:       false,
+			ResolvedRefs:   true,
+			Reason:         reason,
+			Message:        unattachedRouteMessages[reason],
+		})
 	}
 	return result, nil
 }
+
+// routeKey identifies a route across route kinds.
+type routeKey struct {
+	kind           RouteKind
+	namespacedName types.NamespacedName
+}
+
+func newRouteKey(route preLoadRouteDescriptor) routeKey {
+	return routeKey{
+		kind:           route.GetRouteKind(),
+		namespacedName: route.GetRouteNamespacedName(),
+	}
+}
+
+var unattachedRouteMessages = map[gwv1.RouteConditionReason]string{
+	gwv1.RouteReasonNoMatchingParent:           "No listener matches the parent reference of the route",
+	gwv1.RouteReasonNotAllowedByListeners:      "Route is not allowed by the listeners of the gateway",
+	gwv1.RouteReasonNoMatchingListenerHostname: "No listener hostname matches the hostnames of the route",
+}
+
+// listenerHostnameMatchesRoute checks if the hostname of the listener intersects with the hostnames of the route.
+// Listeners without hostname, or routes without hostnames, match any hostname.
+func listenerHostnameMatchesRoute(listener gwv1.Listener, route preLoadRouteDescriptor) bool {
+	routeHostnames := route.GetHostnames()
+	if listener.Hostname == nil || *listener.Hostname == "" || len(routeHostnames) == 0 {
+		return true
+	}
+	for _, routeHostname := range routeHostnames {
+		if hostnamesIntersect(string(*listener.Hostname), string(routeHostname)) {
+			return true
+		}
+	}
+	return false
+}
+
+// computeRouteHostnamesOnListeners computes the hostnames a route matches when attached to listeners with the given hostnames.
+// A listener without hostname matches the hostnames of the route, a listener with a hostname matches the intersection of its hostname
+// with the hostnames of the route, or its own hostname if the route has none. It returns no hostnames if the route matches any hostname.
+func computeRouteHostnamesOnListeners(routeHostnames []gwv1.Hostname, listenerHostnames []*gwv1.Hostname) []gwv1.Hostname {
+	var hostnames []gwv1.Hostname
+	seen := sets.New[gwv1.Hostname]()
+	add := func(hostname gwv1.Hostname) {
+		if !seen.Has(hostname) {
+			seen.Insert(hostname)
+			hostnames = append(hostnames, hostname)
+		}
+	}
+	for _, listenerHostname := range listenerHostnames {
+		if listenerHostname == nil || *listenerHostname == "" {
+			if len(routeHostnames) == 0 {
+				return nil
+			}
+			for _, routeHostname := range routeHostnames {
+				add(routeHostname)
+			}
+			continue
+		}
+		if len(routeHostnames) == 0 {
+			add(*listenerHostname)
+			continue
+		}
+		for _, routeHostname := range routeHostnames {
+			if hostname, ok := intersectHostnames(string(*listenerHostname), string(routeHostname)); ok {
+				add(gwv1.Hostname(hostname))
+			}
+		}
+	}
+	return hostnames
+}
+
+// intersectHostnames returns the most specific of two hostnames if they intersect.
+func intersectHostnames(a string, b string) (string, bool) {
+	if !hostnamesIntersect(a, b) {
+		return "", false
+	}
+	if strings.HasPrefix(a, "*.") && (!strings.HasPrefix(b, "*.") || len(b) > len(a)) {
+		return b, true
+	}
+	return a, true
+}
+
+// hostnamesIntersect checks if two hostnames, each of them possibly a wildcard hostname, can match the same request.
+// A wildcard hostname matches any hostname with the same suffix, e.g. `*.example.com` matches `foo.bar.example.com`.
+func hostnamesIntersect(a string, b string) bool {
+	if a == b {
+		return true
+	}
+	if strings.HasPrefix(a, "*.") && strings.HasSuffix(b, a[1:]) {
+		return true
+	}
+	if strings.HasPrefix(b, "*.") && strings.HasSuffix(a, b[1:]) {
+		return true
+	}
+	return false
+}
@@ -321,11 +321,234 @@ func Test_mapGatewayAndRoutes(t *testing.T) {
 			}
 
 			assert.NoError(t, err)
-			assert.Equal(t, len(tc.expected), len(result))
+			assert.Equal(t, len(tc.expected), len(result.routesByPort))
 
 			for k, v := range tc.expected {
-				assert.ElementsMatch(t, v, result[k])
+				assert.ElementsMatch(t, v, result.routesByPort[k])
 			}
 		})
 	}
 }
+
+func Test_mapGatewayAndRoutes_RouteStatus(t *testing.T) {
+	hostname := gwv1.Hostname("*.example.com")
+	otherSection := gwv1.SectionName("other")
+	gateway := gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw1",
+			Namespace: "ns-gw",
+		},
+		Spec: gwv1.GatewaySpec{
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "http",
+					Port:     gwv1.PortNumber(80),
+					Protocol: gwv1.HTTPProtocolType,
+					Hostname: &hostname,
+				},
+				{
+					Name:     "https",
+					Port:     gwv1.PortNumber(443),
+					Protocol: gwv1.HTTPSProtocolType,
+					Hostname: &hostname,
+				},
+			},
+		},
+	}
+
+	newRoute := func(name string, namespace string, sectionName *gwv1.SectionName, hostnames ...gwv1.Hostname) preLoadRouteDescriptor {
+		return convertHTTPRoute(gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: gwv1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1.CommonRouteSpec{
+					ParentRefs: []gwv1.ParentReference{
+						{
+							Name:        "gw1",
+							Namespace:   (*gwv1.Namespace)(&gateway.Namespace),
+							SectionName: sectionName,
+						},
+					},
+				},
+				Hostnames: hostnames,
+			},
+		})
+	}
+
+	attachedRoute := newRoute("attached", "ns-gw", nil, "foo.example.com")
+	routeWithoutHostname := newRoute("no-hostname", "ns-gw", nil)
+	otherHostnameRoute := newRoute("other-hostname", "ns-gw", nil, "foo.example.org")
+	otherNamespaceRoute := newRoute("other-namespace", "ns-route", nil)
+	otherSectionRoute := newRoute("other-section", "ns-gw", &otherSection)
+
+	mapper := listenerToRouteMapperImpl{
+		listenerAttachmentHelper: newListenerAttachmentHelper(nil, logr.Discard()),
+		routeAttachmentHelper:    newRouteAttachmentHelper(logr.Discard()),
+		logger:                   logr.Discard(),
+	}
+	result, err := mapper.mapGatewayAndRoutes(context.Background(), gateway, []preLoadRouteDescriptor{attachedRoute, routeWithoutHostname, otherHostnameRoute, otherNamespaceRoute, otherSectionRoute})
+	assert.NoError(t, err)
+
+	assert.ElementsMatch(t, []preLoadRouteDescriptor{attachedRoute, routeWithoutHostname}, result.routesByPort[80])
+	assert.ElementsMatch(t, []preLoadRouteDescriptor{attachedRoute, routeWithoutHostname}, result.routesByPort[443])
+	assert.Equal(t, map[gwv1.SectionName]int32{
+		"http":  2,
+		"https": 2,
+	}, result.attachedRoutesByListener)
+	assert.Equal(t, map[routeKey][]gwv1.Hostname{
+		newRouteKey(attachedRoute):        {"foo.example.com"},
+		newRouteKey(routeWithoutHostname): {"*.example.com"},
+	}, result.hostnamesByPort[80])
+	assert.ElementsMatch(t, []RouteStatusInfo{
+		{
+			Kind:           HTTPRouteKind,
+			NamespacedName: otherHostnameRoute.GetRouteNamespacedName(),
+			ResolvedRefs:   true,
+			Reason:         gwv1.RouteReasonNoMatchingListenerHostname,
+			Message:        unattachedRouteMessages[gwv1.RouteReasonNoMatchingListenerHostname],
+		},
+		{
+			Kind:           HTTPRouteKind,
+			NamespacedName: otherNamespaceRoute.GetRouteNamespacedName(),
+			ResolvedRefs:   true,
+			Reason:         gwv1.RouteReasonNotAllowedByListeners,
+			Message:        unattachedRouteMessages[gwv1.RouteReasonNotAllowedByListeners],
+		},
+		{
+			Kind:           HTTPRouteKind,
+			NamespacedName: otherSectionRoute.GetRouteNamespacedName(),
+			ResolvedRefs:   true,
+			Reason:         gwv1.RouteReasonNoMatchingParent,
+			Message:        unattachedRouteMessages[gwv1.RouteReasonNoMatchingParent],
+		},
+	}, result.unattachedRoutes)
+}
+
+func Test_mapGatewayAndRoutes_ListenersOnSamePort(t *testing.T) {
+	fooHostname := gwv1.Hostname("foo.example.com")
+	barHostname := gwv1.Hostname("bar.example.com")
+	gateway := gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw1",
+			Namespace: "ns-gw",
+		},
+		Spec: gwv1.GatewaySpec{
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "foo",
+					Port:     gwv1.PortNumber(443),
+					Protocol: gwv1.HTTPSProtocolType,
+					Hostname: &fooHostname,
+				},
+				{
+					Name:     "bar",
+					Port:     gwv1.PortNumber(443),
+					Protocol: gwv1.HTTPSProtocolType,
+					Hostname: &barHostname,
+				},
+			},
+		},
+	}
+	route := convertHTTPRoute(gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "ns-gw",
+		},
+		Spec: gwv1.HTTPRouteSpec{
+			CommonRouteSpec: gwv1.CommonRouteSpec{
+				ParentRefs: []gwv1.ParentReference{
+					{
+						Name: "gw1",
+					},
+				},
+			},
+		},
+	})
+
+	mapper := listenerToRouteMapperImpl{
+		listenerAttachmentHelper: newListenerAttachmentHelper(nil, logr.Discard()),
+		routeAttachmentHelper:    newRouteAttachmentHelper(logr.Discard()),
+		logger:                   logr.Discard(),
+	}
+	result, err := mapper.mapGatewayAndRoutes(context.Background(), gateway, []preLoadRouteDescriptor{route})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []preLoadRouteDescriptor{route}, result.routesByPort[443])
+	assert.Equal(t, map[gwv1.SectionName]int32{
+		"foo": 1,
+		"bar": 1,
+	}, result.attachedRoutesByListener)
+	assert.Equal(t, map[routeKey][]gwv1.Hostname{
+		newRouteKey(route): {"foo.example.com", "bar.example.com"},
+	}, result.hostnamesByPort[443])
+}
+
+func Test_computeRouteHostnamesOnListeners(t *testing.T) {
+	wildcardHostname := gwv1.Hostname("*.example.com")
+	fooHostname := gwv1.Hostname("foo.example.com")
+	testCases := []struct {
+		name              string
+		routeHostnames    []gwv1.Hostname
+		listenerHostnames []*gwv1.Hostname
+		expected          []gwv1.Hostname
+	}{
+		{
+			name:              "no hostnames match any hostname",
+			listenerHostnames: []*gwv1.Hostname{nil},
+		},
+		{
+			name:              "route without hostnames on a listener with a hostname",
+			listenerHostnames: []*gwv1.Hostname{&wildcardHostname},
+			expected:          []gwv1.Hostname{"*.example.com"},
+		},
+		{
+			name:              "route hostnames on a listener without hostname",
+			routeHostnames:    []gwv1.Hostname{"foo.example.org"},
+			listenerHostnames: []*gwv1.Hostname{nil},
+			expected:          []gwv1.Hostname{"foo.example.org"},
+		},
+		{
+			name:              "route hostnames intersected with the listener hostname",
+			routeHostnames:    []gwv1.Hostname{"foo.example.com", "foo.example.org", "*.com"},
+			listenerHostnames: []*gwv1.Hostname{&wildcardHostname},
+			expected:          []gwv1.Hostname{"foo.example.com", "*.example.com"},
+		},
+		{
+			name:              "listeners on the same port",
+			routeHostnames:    []gwv1.Hostname{"*.example.com"},
+			listenerHostnames: []*gwv1.Hostname{&fooHostname, &wildcardHostname},
+			expected:          []gwv1.Hostname{"foo.example.com", "*.example.com"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, computeRouteHostnamesOnListeners(tc.routeHostnames, tc.listenerHostnames))
+		})
+	}
+}
+
+func Test_hostnamesIntersect(t *testing.T) {
+	testCases := []struct {
+		a        string
+		b        string
+		expected bool
+	}{
+		{a: "example.com", b: "example.com", expected: true},
+		{a: "example.com", b: "example.org", expected: false},
+		{a: "*.example.com", b: "foo.example.com", expected: true},
+		{a: "*.example.com", b: "foo.bar.example.com", expected: true},
+		{a: "*.example.com", b: "example.com", expected: false},
+		{a: "foo.example.com", b: "*.example.com", expected: true},
+		{a: "*.example.com", b: "*.foo.example.com", expected: true},
+		{a: "*.example.com", b: "*.example.org", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s-%s", tc.a, tc.b), func(t *testing.T) {
+			assert.Equal(t, tc.expected, hostnamesIntersect(tc.a, tc.b))
+		})
+	}
+}
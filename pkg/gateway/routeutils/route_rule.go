@@ -9,4 +9,6 @@ type RouteRule interface {
 	GetRawRouteRule() interface{}
 	GetSectionName() *gwv1.SectionName
 	GetBackends() []Backend
+	// GetListenerRuleConfig returns the ListenerRuleConfiguration referenced by the rule, nil when there is none.
+	GetListenerRuleConfig() *ListenerRuleConfig
 }
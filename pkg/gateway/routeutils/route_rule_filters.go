@@ -0,0 +1,56 @@
+package routeutils
+
+import (
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// validateHTTPRouteFilters verifies that every filter of an HTTPRoute rule can be translated into an ALB action.
+// ALB listener rules can't modify requests or responses, so only RequestRedirect and
+// ExtensionRef filters referencing a ListenerRuleConfiguration are supported.
+func validateHTTPRouteFilters(filters []gwv1.HTTPRouteFilter) error {
+	hasListenerRuleConfig := false
+	for _, filter := range filters {
+		switch filter.Type {
+		case gwv1.HTTPRouteFilterRequestRedirect:
+			if err := validateHTTPRequestRedirectFilter(filter.RequestRedirect); err != nil {
+				return err
+			}
+		case gwv1.HTTPRouteFilterExtensionRef:
+			if filter.ExtensionRef == nil || !isListenerRuleConfigurationRef(*filter.ExtensionRef) {
+				return newUnsupportedValueError("HTTPRoute filter type %v only supports references to a %v", filter.Type, listenerRuleConfigurationKind)
+			}
+			if hasListenerRuleConfig {
+				return newUnsupportedValueError("HTTPRoute rule can reference at most one %v", listenerRuleConfigurationKind)
+			}
+			hasListenerRuleConfig = true
+		default:
+			return newUnsupportedValueError("HTTPRoute filter type %v is not supported", filter.Type)
+		}
+	}
+	return nil
+}
+
+func validateHTTPRequestRedirectFilter(redirect *gwv1.HTTPRequestRedirectFilter) error {
+	if redirect == nil {
+		return newUnsupportedValueError("HTTPRoute filter type %v is missing its configuration", gwv1.HTTPRouteFilterRequestRedirect)
+	}
+	if redirect.Scheme != nil && *redirect.Scheme != "http" && *redirect.Scheme != "https" {
+		return newUnsupportedValueError("redirect scheme %v is not supported", *redirect.Scheme)
+	}
+	if redirect.StatusCode != nil && *redirect.StatusCode != 301 && *redirect.StatusCode != 302 {
+		return newUnsupportedValueError("redirect status code %v is not supported", *redirect.StatusCode)
+	}
+	if redirect.Path != nil && redirect.Path.Type != gwv1.FullPathHTTPPathModifier {
+		return newUnsupportedValueError("redirect path modifier type %v is not supported", redirect.Path.Type)
+	}
+	return nil
+}
+
+// validateGRPCRouteFilters verifies that every filter of a GRPCRoute rule can be translated into an ALB action.
+// None of the GRPCRoute filters can be expressed using ALB listener rules.
+func validateGRPCRouteFilters(filters []gwv1.GRPCRouteFilter) error {
+	if len(filters) != 0 {
+		return newUnsupportedValueError("GRPCRoute filter type %v is not supported", filters[0].Type)
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package routeutils
+
+import (
+	"github.com/stretchr/testify/assert"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"testing"
+)
+
+func Test_validateHTTPRouteFilters(t *testing.T) {
+	httpsScheme := "https"
+	ftpScheme := "ftp"
+	movedPermanently := 301
+	permanentRedirect := 308
+	fullPath := "/new"
+	prefixPath := "/prefix"
+	testCases := []struct {
+		name    string
+		filters []gwv1.HTTPRouteFilter
+		wantErr bool
+	}{
+		{
+			name: "no filters",
+		},
+		{
+			name: "request redirect",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type: gwv1.HTTPRouteFilterRequestRedirect,
+					RequestRedirect: &gwv1.HTTPRequestRedirectFilter{
+						Scheme:     &httpsScheme,
+						StatusCode: &movedPermanently,
+						Path: &gwv1.HTTPPathModifier{
+							Type:            gwv1.FullPathHTTPPathModifier,
+							ReplaceFullPath: &fullPath,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "request redirect without configuration",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type: gwv1.HTTPRouteFilterRequestRedirect,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "request redirect with unsupported scheme",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type: gwv1.HTTPRouteFilterRequestRedirect,
+					RequestRedirect: &gwv1.HTTPRequestRedirectFilter{
+						Scheme: &ftpScheme,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "request redirect with unsupported status code",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type: gwv1.HTTPRouteFilterRequestRedirect,
+					RequestRedirect: &gwv1.HTTPRequestRedirectFilter{
+						StatusCode: &permanentRedirect,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "request redirect with prefix path replacement",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type: gwv1.HTTPRouteFilterRequestRedirect,
+					RequestRedirect: &gwv1.HTTPRequestRedirectFilter{
+						Path: &gwv1.HTTPPathModifier{
+							Type:               gwv1.PrefixMatchHTTPPathModifier,
+							ReplacePrefixMatch: &prefixPath,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "url rewrite",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type:       gwv1.HTTPRouteFilterURLRewrite,
+					URLRewrite: &gwv1.HTTPURLRewriteFilter{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "request header modifier",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type:                  gwv1.HTTPRouteFilterRequestHeaderModifier,
+					RequestHeaderModifier: &gwv1.HTTPHeaderFilter{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "response header modifier",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type:                   gwv1.HTTPRouteFilterResponseHeaderModifier,
+					ResponseHeaderModifier: &gwv1.HTTPHeaderFilter{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "listener rule configuration extension ref",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type: gwv1.HTTPRouteFilterExtensionRef,
+					ExtensionRef: &gwv1.LocalObjectReference{
+						Group: "gateway.k8s.aws",
+						Kind:  "ListenerRuleConfiguration",
+						Name:  "auth",
+					},
+				},
+			},
+		},
+		{
+			name: "multiple listener rule configuration extension refs",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type: gwv1.HTTPRouteFilterExtensionRef,
+					ExtensionRef: &gwv1.LocalObjectReference{
+						Group: "gateway.k8s.aws",
+						Kind:  "ListenerRuleConfiguration",
+						Name:  "auth",
+					},
+				},
+				{
+					Type: gwv1.HTTPRouteFilterExtensionRef,
+					ExtensionRef: &gwv1.LocalObjectReference{
+						Group: "gateway.k8s.aws",
+						Kind:  "ListenerRuleConfiguration",
+						Name:  "other-auth",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "extension ref of another kind",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type: gwv1.HTTPRouteFilterExtensionRef,
+					ExtensionRef: &gwv1.LocalObjectReference{
+						Group: "example.com",
+						Kind:  "Filter",
+						Name:  "filter",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateHTTPRouteFilters(tc.filters)
+			if !tc.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *RouteValidationError
+			assert.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, gwv1.RouteReasonUnsupportedValue, validationErr.Reason)
+		})
+	}
+}
+
+func Test_validateGRPCRouteFilters(t *testing.T) {
+	assert.NoError(t, validateGRPCRouteFilters(nil))
+
+	err := validateGRPCRouteFilters([]gwv1.GRPCRouteFilter{
+		{
+			Type:                  gwv1.GRPCRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: &gwv1.HTTPHeaderFilter{},
+		},
+	})
+	var validationErr *RouteValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, gwv1.RouteReasonUnsupportedValue, validationErr.Reason)
+}
@@ -0,0 +1,57 @@
+package routeutils
+
+import (
+	"strings"
+
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// validateHTTPRouteMatches verifies that every match of an HTTPRoute rule can be translated into ALB listener rule conditions.
+// ALB conditions only support exact and prefix paths without wildcards, and exact header and query param values.
+func validateHTTPRouteMatches(matches []gwv1.HTTPRouteMatch) error {
+	for _, match := range matches {
+		if match.Path != nil {
+			if match.Path.Type != nil && *match.Path.Type != gwv1.PathMatchExact && *match.Path.Type != gwv1.PathMatchPathPrefix {
+				return newUnsupportedValueError("HTTPRoute path match type %v is not supported", *match.Path.Type)
+			}
+			if match.Path.Value != nil && strings.ContainsAny(*match.Path.Value, "*?") {
+				return newUnsupportedValueError("HTTPRoute path %v shouldn't contain wildcards", *match.Path.Value)
+			}
+		}
+		for _, header := range match.Headers {
+			if header.Type != nil && *header.Type != gwv1.HeaderMatchExact {
+				return newUnsupportedValueError("HTTPRoute header match type %v for header %v is not supported", *header.Type, header.Name)
+			}
+		}
+		for _, queryParam := range match.QueryParams {
+			if queryParam.Type != nil && *queryParam.Type != gwv1.QueryParamMatchExact {
+				return newUnsupportedValueError("HTTPRoute query param match type %v for query param %v is not supported", *queryParam.Type, queryParam.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateGRPCRouteMatches verifies that every match of a GRPCRoute rule can be translated into ALB listener rule conditions.
+// ALB conditions only support exact methods, matched through the request path, and exact header values.
+func validateGRPCRouteMatches(matches []gwv1.GRPCRouteMatch) error {
+	for _, match := range matches {
+		if match.Method != nil {
+			if match.Method.Type != nil && *match.Method.Type != gwv1.GRPCMethodMatchExact {
+				return newUnsupportedValueError("GRPCRoute method match type %v is not supported", *match.Method.Type)
+			}
+			if match.Method.Service != nil && strings.ContainsAny(*match.Method.Service, "/*?") {
+				return newUnsupportedValueError("GRPCRoute service %v is invalid", *match.Method.Service)
+			}
+			if match.Method.Method != nil && strings.ContainsAny(*match.Method.Method, "/*?") {
+				return newUnsupportedValueError("GRPCRoute method %v is invalid", *match.Method.Method)
+			}
+		}
+		for _, header := range match.Headers {
+			if header.Type != nil && *header.Type != gwv1.GRPCHeaderMatchExact {
+				return newUnsupportedValueError("GRPCRoute header match type %v for header %v is not supported", *header.Type, header.Name)
+			}
+		}
+	}
+	return nil
+}
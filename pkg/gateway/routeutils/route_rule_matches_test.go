@@ -0,0 +1,63 @@
+package routeutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_validateHTTPRouteMatches(t *testing.T) {
+	pathPrefix := gwv1.PathMatchPathPrefix
+	pathRegex := gwv1.PathMatchRegularExpression
+	headerRegex := gwv1.HeaderMatchRegularExpression
+	prefixPath := "/foo"
+	wildcardPath := "/foo/*"
+	testCases := []struct {
+		name    string
+		matches []gwv1.HTTPRouteMatch
+		wantErr string
+	}{
+		{
+			name: "prefix path",
+			matches: []gwv1.HTTPRouteMatch{
+				{Path: &gwv1.HTTPPathMatch{Type: &pathPrefix, Value: &prefixPath}},
+			},
+		},
+		{
+			name: "regular expression path",
+			matches: []gwv1.HTTPRouteMatch{
+				{Path: &gwv1.HTTPPathMatch{Type: &pathRegex, Value: &prefixPath}},
+			},
+			wantErr: "HTTPRoute path match type RegularExpression is not supported",
+		},
+		{
+			name: "path with wildcard",
+			matches: []gwv1.HTTPRouteMatch{
+				{Path: &gwv1.HTTPPathMatch{Type: &pathPrefix, Value: &wildcardPath}},
+			},
+			wantErr: "HTTPRoute path /foo/* shouldn't contain wildcards",
+		},
+		{
+			name: "regular expression header",
+			matches: []gwv1.HTTPRouteMatch{
+				{Headers: []gwv1.HTTPHeaderMatch{{Type: &headerRegex, Name: "x-foo", Value: "ba.*"}}},
+			},
+			wantErr: "HTTPRoute header match type RegularExpression for header x-foo is not supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateHTTPRouteMatches(tc.matches)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *RouteValidationError
+			assert.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, gwv1.RouteReasonUnsupportedValue, validationErr.Reason)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package routeutils
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// RulePrecedence represents a single match of a route rule for a single hostname of the route.
+// Matches and hostnames of a rule are ORed together, so each combination is materialized as its own ALB listener rule and
+// needs its own position within the listener.
+type RulePrecedence struct {
+	RouteDescriptor RouteDescriptor
+	Rule            RouteRule
+
+	// Hostname is the hostname this entry represents, empty when the route matches any hostname.
+	Hostname gwv1.Hostname
+
+	// HTTPMatch is the match this entry represents when the rule belongs to an HTTPRoute.
+	HTTPMatch *gwv1.HTTPRouteMatch
+	// GRPCMatch is the match this entry represents when the rule belongs to a GRPCRoute.
+	GRPCMatch *gwv1.GRPCRouteMatch
+
+	// RuleIndex is the index of the rule within its route.
+	RuleIndex int
+	// MatchIndex is the index of the match within its rule.
+	MatchIndex int
+}
+
+// SortAllRulesByPrecedence flattens the rules of all routes attached to a listener into one entry per route hostname
+// and rule match, ordered following the Gateway API precedence rules. The first entry has the highest precedence.
+//
+// Entries are ordered by:
+//  1. most specific hostname: entries with a hostname before entries without, exact hostnames before wildcards, longer hostnames first.
+//  2. HTTPRoute: exact path before prefix path, longer prefix first, method match, most header matches, most query param matches.
+//     GRPCRoute: longer service, longer method, most header matches.
+//  3. oldest route creation timestamp.
+//  4. alphabetical order of the route's "{namespace}/{name}".
+//  5. order of the rule and match within the route.
+func SortAllRulesByPrecedence(routes []RouteDescriptor) []RulePrecedence {
+	var precedences []RulePrecedence
+	for _, route := range routes {
+		hostnames := route.GetHostnames()
+		if len(hostnames) == 0 {
+			hostnames = []gwv1.Hostname{""}
+		}
+		for _, hostname := range hostnames {
+			for ruleIndex, rule := range route.GetAttachedRules() {
+				precedences = append(precedences, expandRuleMatches(route, hostname, rule, ruleIndex)...)
+			}
+		}
+	}
+	keys := make([]precedenceKey, len(precedences))
+	for i := range precedences {
+		keys[i] = buildPrecedenceKey(precedences[i])
+	}
+	indexes := make([]int, len(precedences))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return keys[indexes[i]].less(keys[indexes[j]])
+	})
+	sortedPrecedences := make([]RulePrecedence, 0, len(precedences))
+	for _, index := range indexes {
+		sortedPrecedences = append(sortedPrecedences, precedences[index])
+	}
+	return sortedPrecedences
+}
+
+// expandRuleMatches generates one entry per match of the rule for the given hostname.
+// A rule without matches is treated as having a single match that matches all requests.
+func expandRuleMatches(route RouteDescriptor, hostname gwv1.Hostname, rule RouteRule, ruleIndex int) []RulePrecedence {
+	var precedences []RulePrecedence
+	switch rawRule := rule.GetRawRouteRule().(type) {
+	case *gwv1.HTTPRouteRule:
+		matches := rawRule.Matches
+		if len(matches) == 0 {
+			matches = []gwv1.HTTPRouteMatch{{}}
+		}
+		for matchIndex := range matches {
+			precedences = append(precedences, RulePrecedence{
+				RouteDescriptor: route,
+				Rule:            rule,
+				Hostname:        hostname,
+				HTTPMatch:       &matches[matchIndex],
+				RuleIndex:       ruleIndex,
+				MatchIndex:      matchIndex,
+			})
+		}
+	case *gwv1.GRPCRouteRule:
+		matches := rawRule.Matches
+		if len(matches) == 0 {
+			matches = []gwv1.GRPCRouteMatch{{}}
+		}
+		for matchIndex := range matches {
+			precedences = append(precedences, RulePrecedence{
+				RouteDescriptor: route,
+				Rule:            rule,
+				Hostname:        hostname,
+				GRPCMatch:       &matches[matchIndex],
+				RuleIndex:       ruleIndex,
+				MatchIndex:      matchIndex,
+			})
+		}
+	default:
+		precedences = append(precedences, RulePrecedence{
+			RouteDescriptor: route,
+			Rule:            rule,
+			Hostname:        hostname,
+			RuleIndex:       ruleIndex,
+		})
+	}
+	return precedences
+}
+
+// precedenceKey holds the attributes of a match that determine its precedence.
+// for every numeric attribute, a higher value means higher precedence.
+type precedenceKey struct {
+	hasHostname       bool
+	hasExactHostname  bool
+	hostnameLength    int
+	hostname          string
+	matchAttributes   []int
+	creationTimestamp time.Time
+	namespacedName    string
+	ruleIndex         int
+	matchIndex        int
+}
+
+func buildPrecedenceKey(precedence RulePrecedence) precedenceKey {
+	key := precedenceKey{
+		namespacedName: precedence.RouteDescriptor.GetRouteNamespacedName().String(),
+		ruleIndex:      precedence.RuleIndex,
+		matchIndex:     precedence.MatchIndex,
+	}
+	if precedence.Hostname != "" {
+		key.hasHostname = true
+		key.hasExactHostname = !strings.HasPrefix(string(precedence.Hostname), "*")
+		key.hostnameLength = len(precedence.Hostname)
+		key.hostname = strings.ToLower(string(precedence.Hostname))
+	}
+	switch {
+	case precedence.HTTPMatch != nil:
+		key.matchAttributes = buildHTTPMatchAttributes(*precedence.HTTPMatch)
+	case precedence.GRPCMatch != nil:
+		key.matchAttributes = buildGRPCMatchAttributes(*precedence.GRPCMatch)
+	}
+	key.creationTimestamp = precedence.RouteDescriptor.GetRouteCreateTimestamp()
+	return key
+}
+
+// buildHTTPMatchAttributes returns, in order: path type rank, path length, method match, header matches, query param matches.
+func buildHTTPMatchAttributes(match gwv1.HTTPRouteMatch) []int {
+	pathTypeRank := 1
+	pathLength := 1
+	if match.Path != nil {
+		if match.Path.Type != nil {
+			switch *match.Path.Type {
+			case gwv1.PathMatchExact:
+				pathTypeRank = 2
+			case gwv1.PathMatchPathPrefix:
+				pathTypeRank = 1
+			default:
+				pathTypeRank = 0
+			}
+		}
+		if match.Path.Value != nil {
+			pathLength = len(*match.Path.Value)
+		}
+	}
+	hasMethod := 0
+	if match.Method != nil {
+		hasMethod = 1
+	}
+	return []int{pathTypeRank, pathLength, hasMethod, len(match.Headers), len(match.QueryParams)}
+}
+
+// buildGRPCMatchAttributes returns, in order: service length, method length, header matches.
+func buildGRPCMatchAttributes(match gwv1.GRPCRouteMatch) []int {
+	serviceLength := 0
+	methodLength := 0
+	if match.Method != nil {
+		if match.Method.Service != nil {
+			serviceLength = len(*match.Method.Service)
+		}
+		if match.Method.Method != nil {
+			methodLength = len(*match.Method.Method)
+		}
+	}
+	return []int{serviceLength, methodLength, len(match.Headers)}
+}
+
+// less returns whether k has higher precedence than other.
+func (k precedenceKey) less(other precedenceKey) bool {
+	if k.hasHostname != other.hasHostname {
+		return k.hasHostname
+	}
+	if k.hasExactHostname != other.hasExactHostname {
+		return k.hasExactHostname
+	}
+	if k.hostnameLength != other.hostnameLength {
+		return k.hostnameLength > other.hostnameLength
+	}
+	for i := 0; i < len(k.matchAttributes) && i < len(other.matchAttributes); i++ {
+		if k.matchAttributes[i] != other.matchAttributes[i] {
+			return k.matchAttributes[i] > other.matchAttributes[i]
+		}
+	}
+	if !k.creationTimestamp.Equal(other.creationTimestamp) {
+		return k.creationTimestamp.Before(other.creationTimestamp)
+	}
+	if k.namespacedName != other.namespacedName {
+		return k.namespacedName < other.namespacedName
+	}
+	if k.hostname != other.hostname {
+		return k.hostname < other.hostname
+	}
+	if k.ruleIndex != other.ruleIndex {
+		return k.ruleIndex < other.ruleIndex
+	}
+	return k.matchIndex < other.matchIndex
+}
@@ -0,0 +1,188 @@
+package routeutils
+
+import (
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"testing"
+	"time"
+)
+
+type precedenceIdentifier struct {
+	route      string
+	hostname   gwv1.Hostname
+	ruleIndex  int
+	matchIndex int
+}
+
+func Test_SortAllRulesByPrecedence(t *testing.T) {
+	exactPathType := gwv1.PathMatchExact
+	prefixPathType := gwv1.PathMatchPathPrefix
+	getMethod := gwv1.HTTPMethodGet
+	now := time.Now()
+
+	httpRule := func(matches ...gwv1.HTTPRouteMatch) RouteRule {
+		return &MockRule{RawRule: &gwv1.HTTPRouteRule{Matches: matches}}
+	}
+	grpcRule := func(matches ...gwv1.GRPCRouteMatch) RouteRule {
+		return &MockRule{RawRule: &gwv1.GRPCRouteRule{Matches: matches}}
+	}
+	pathMatch := func(pathType *gwv1.PathMatchType, path string) gwv1.HTTPRouteMatch {
+		return gwv1.HTTPRouteMatch{
+			Path: &gwv1.HTTPPathMatch{
+				Type:  pathType,
+				Value: awssdk.String(path),
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		routes []RouteDescriptor
+		want   []precedenceIdentifier
+	}{
+		{
+			name: "no routes",
+			want: nil,
+		},
+		{
+			name: "rule without matches expands to a single entry",
+			routes: []RouteDescriptor{
+				&MockRoute{Kind: HTTPRouteKind, Namespace: "ns", Name: "route", Rules: []RouteRule{httpRule()}},
+			},
+			want: []precedenceIdentifier{
+				{route: "ns/route"},
+			},
+		},
+		{
+			name: "hostnames take precedence: exact before wildcard, longer first, none last",
+			routes: []RouteDescriptor{
+				&MockRoute{Kind: HTTPRouteKind, Namespace: "ns", Name: "no-host", Rules: []RouteRule{httpRule()}},
+				&MockRoute{Kind: HTTPRouteKind, Namespace: "ns", Name: "wildcard", Hostnames: []gwv1.Hostname{"*.example.com"}, Rules: []RouteRule{httpRule()}},
+				&MockRoute{Kind: HTTPRouteKind, Namespace: "ns", Name: "long-wildcard", Hostnames: []gwv1.Hostname{"*.foo.example.com"}, Rules: []RouteRule{httpRule()}},
+				&MockRoute{Kind: HTTPRouteKind, Namespace: "ns", Name: "exact", Hostnames: []gwv1.Hostname{"*.example.com", "foo.com"}, Rules: []RouteRule{httpRule()}},
+			},
+			want: []precedenceIdentifier{
+				{route: "ns/exact", hostname: "foo.com"},
+				{route: "ns/long-wildcard", hostname: "*.foo.example.com"},
+				{route: "ns/exact", hostname: "*.example.com"},
+				{route: "ns/wildcard", hostname: "*.example.com"},
+				{route: "ns/no-host"},
+			},
+		},
+		{
+			name: "routes with several overlapping hostnames are ordered per hostname",
+			routes: []RouteDescriptor{
+				&MockRoute{Kind: HTTPRouteKind, Namespace: "ns", Name: "api", CreationTime: now, Hostnames: []gwv1.Hostname{"api.example.com", "*.example.com"}, Rules: []RouteRule{
+					httpRule(pathMatch(&prefixPathType, "/")),
+				}},
+				&MockRoute{Kind: HTTPRouteKind, Namespace: "ns", Name: "web", CreationTime: now.Add(-time.Hour), Hostnames: []gwv1.Hostname{"*.example.com", "www.example.com", "a.example.com"}, Rules: []RouteRule{
+					httpRule(pathMatch(&exactPathType, "/login"), pathMatch(&prefixPathType, "/")),
+				}},
+			},
+			want: []precedenceIdentifier{
+				{route: "ns/web", hostname: "www.example.com", matchIndex: 0},
+				{route: "ns/web", hostname: "www.example.com", matchIndex: 1},
+				{route: "ns/api", hostname: "api.example.com"},
+				{route: "ns/web", hostname: "a.example.com", matchIndex: 0},
+				{route: "ns/web", hostname: "a.example.com", matchIndex: 1},
+				{route: "ns/web", hostname: "*.example.com", matchIndex: 0},
+				{route: "ns/web", hostname: "*.example.com", matchIndex: 1},
+				{route: "ns/api", hostname: "*.example.com"},
+			},
+		},
+		{
+			name: "exact path, longest prefix, method, headers and query params",
+			routes: []RouteDescriptor{
+				&MockRoute{Kind: HTTPRouteKind, Namespace: "ns", Name: "route", Rules: []RouteRule{
+					httpRule(
+						pathMatch(&prefixPathType, "/"),
+						pathMatch(&prefixPathType, "/api"),
+						pathMatch(&exactPathType, "/a"),
+						pathMatch(nil, "/api/v1"),
+					),
+					httpRule(
+						gwv1.HTTPRouteMatch{
+							Path:   &gwv1.HTTPPathMatch{Type: &prefixPathType, Value: awssdk.String("/api")},
+							Method: &getMethod,
+						},
+						gwv1.HTTPRouteMatch{
+							Path:    &gwv1.HTTPPathMatch{Type: &prefixPathType, Value: awssdk.String("/api")},
+							Headers: []gwv1.HTTPHeaderMatch{{Name: "x-env", Value: "canary"}},
+						},
+						gwv1.HTTPRouteMatch{
+							Path:        &gwv1.HTTPPathMatch{Type: &prefixPathType, Value: awssdk.String("/api")},
+							QueryParams: []gwv1.HTTPQueryParamMatch{{Name: "q", Value: "lbc"}},
+						},
+					),
+				}},
+			},
+			want: []precedenceIdentifier{
+				{route: "ns/route", ruleIndex: 0, matchIndex: 2},
+				{route: "ns/route", ruleIndex: 0, matchIndex: 3},
+				{route: "ns/route", ruleIndex: 1, matchIndex: 0},
+				{route: "ns/route", ruleIndex: 1, matchIndex: 1},
+				{route: "ns/route", ruleIndex: 1, matchIndex: 2},
+				{route: "ns/route", ruleIndex: 0, matchIndex: 1},
+				{route: "ns/route", ruleIndex: 0, matchIndex: 0},
+			},
+		},
+		{
+			name: "ties are broken by creation timestamp then namespaced name",
+			routes: []RouteDescriptor{
+				&MockRoute{Kind: HTTPRouteKind, Namespace: "ns-b", Name: "route", CreationTime: now, Rules: []RouteRule{httpRule()}},
+				&MockRoute{Kind: HTTPRouteKind, Namespace: "ns-a", Name: "route", CreationTime: now, Rules: []RouteRule{httpRule()}},
+				&MockRoute{Kind: HTTPRouteKind, Namespace: "ns-c", Name: "route", CreationTime: now.Add(-time.Hour), Rules: []RouteRule{httpRule()}},
+			},
+			want: []precedenceIdentifier{
+				{route: "ns-c/route"},
+				{route: "ns-a/route"},
+				{route: "ns-b/route"},
+			},
+		},
+		{
+			name: "grpc matches ordered by service, method and headers",
+			routes: []RouteDescriptor{
+				&MockRoute{Kind: GRPCRouteKind, Namespace: "ns", Name: "route", Rules: []RouteRule{
+					grpcRule(
+						gwv1.GRPCRouteMatch{},
+						gwv1.GRPCRouteMatch{Method: &gwv1.GRPCMethodMatch{Service: awssdk.String("helloworld.Greeter")}},
+						gwv1.GRPCRouteMatch{Method: &gwv1.GRPCMethodMatch{Service: awssdk.String("helloworld.Greeter"), Method: awssdk.String("SayHello")}},
+						gwv1.GRPCRouteMatch{Headers: []gwv1.GRPCHeaderMatch{{Name: "x-env", Value: "canary"}}},
+					),
+				}},
+			},
+			want: []precedenceIdentifier{
+				{route: "ns/route", matchIndex: 2},
+				{route: "ns/route", matchIndex: 1},
+				{route: "ns/route", matchIndex: 3},
+				{route: "ns/route", matchIndex: 0},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SortAllRulesByPrecedence(tt.routes)
+			var gotIdentifiers []precedenceIdentifier
+			for _, precedence := range got {
+				gotIdentifiers = append(gotIdentifiers, precedenceIdentifier{
+					route:      precedence.RouteDescriptor.GetRouteNamespacedName().String(),
+					hostname:   precedence.Hostname,
+					ruleIndex:  precedence.RuleIndex,
+					matchIndex: precedence.MatchIndex,
+				})
+			}
+			assert.Equal(t, tt.want, gotIdentifiers)
+		})
+	}
+}
+
+func Test_SortAllRulesByPrecedence_Stable(t *testing.T) {
+	routeA := &MockRoute{Kind: HTTPRouteKind, Namespace: "ns", Name: "a", Rules: []RouteRule{&MockRule{RawRule: &gwv1.HTTPRouteRule{}}}}
+	routeB := &MockRoute{Kind: HTTPRouteKind, Namespace: "ns", Name: "b", Rules: []RouteRule{&MockRule{RawRule: &gwv1.HTTPRouteRule{}}}}
+
+	first := SortAllRulesByPrecedence([]RouteDescriptor{routeA, routeB})
+	second := SortAllRulesByPrecedence([]RouteDescriptor{routeB, routeA})
+	assert.Equal(t, first, second)
+}
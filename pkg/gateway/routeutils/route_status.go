@@ -0,0 +1,59 @@
+package routeutils
+
+import (
+	"fmt"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// RouteStatusInfo describes the status of a route towards the gateway it references.
+type RouteStatusInfo struct {
+	Kind           RouteKind
+	NamespacedName types.NamespacedName
+	// Accepted indicates that the route is attached to the gateway and can be programmed onto the load balancer.
+	Accepted bool
+	// ResolvedRefs indicates that all backend references of the route could be resolved.
+	ResolvedRefs bool
+	// Reason and Message explain why the route isn't accepted, or why its references aren't resolved.
+	Reason  gwv1.RouteConditionReason
+	Message string
+	// DroppedRules describes the rules of an accepted route that aren't programmed onto the load balancer,
+	// the route is then reported as partially invalid.
+	DroppedRules []string
+}
+
+// RouteValidationError indicates that a route uses configuration that can't be programmed onto the load balancer,
+// or references a backend that can't be resolved.
+// Instead of failing the whole Gateway, the reason is reported on the route status.
+type RouteValidationError struct {
+	Reason  gwv1.RouteConditionReason
+	Message string
+	// DroppedRule is set when only a rule of the route is invalid, the other rules of the route are still programmed.
+	DroppedRule bool
+}
+
+func (e *RouteValidationError) Error() string {
+	return e.Message
+}
+
+func newUnsupportedValueError(format string, args ...interface{}) error {
+	return &RouteValidationError{
+		Reason:  gwv1.RouteReasonUnsupportedValue,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func newUnresolvedRefError(reason gwv1.RouteConditionReason, format string, args ...interface{}) error {
+	return &RouteValidationError{
+		Reason:  reason,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func newDroppedRuleError(ruleIndex int, format string, args ...interface{}) *RouteValidationError {
+	return &RouteValidationError{
+		Reason:      gwv1.RouteReasonUnsupportedValue,
+		Message:     fmt.Sprintf("Dropped Rule %d: %v", ruleIndex, fmt.Sprintf(format, args...)),
+		DroppedRule: true,
+	}
+}
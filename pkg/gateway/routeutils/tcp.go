@@ -2,6 +2,9 @@ package routeutils
 
 import (
 	"context"
+	"time"
+
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,6 +46,10 @@ func (t *convertedTCPRouteRule) GetBackends() []Backend {
 	return t.backends
 }
 
+func (t *convertedTCPRouteRule) GetListenerRuleConfig() *ListenerRuleConfig {
+	return nil
+}
+
 /* Route Description */
 
 type tcpRouteDescription struct {
@@ -55,16 +62,25 @@ func (tcpRoute *tcpRouteDescription) GetAttachedRules() []RouteRule {
 	return tcpRoute.rules
 }
 
-func (tcpRoute *tcpRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client) (RouteDescriptor, error) {
-
+func (tcpRoute *tcpRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client) (RouteDescriptor, []RouteValidationError, error) {
+	var unresolvedRefs []RouteValidationError
 	convertedRules := make([]RouteRule, 0)
-	for _, rule := range tcpRoute.route.Spec.Rules {
+	for i, rule := range tcpRoute.route.Spec.Rules {
+		if droppedRuleErr := validateL4RuleBackendWeights(i, rule.BackendRefs); droppedRuleErr != nil {
+			unresolvedRefs = append(unresolvedRefs, *droppedRuleErr)
+			continue
+		}
 		convertedBackends := make([]Backend, 0)
 
 		for _, backend := range rule.BackendRefs {
 			convertedBackend, err := tcpRoute.backendLoader(ctx, k8sClient, backend, backend, tcpRoute.GetRouteNamespacedName(), tcpRoute.GetRouteKind())
 			if err != nil {
-				return nil, err
+				var validationErr *RouteValidationError
+				if !errors.As(err, &validationErr) {
+					return nil, nil, err
+				}
+				unresolvedRefs = append(unresolvedRefs, *validationErr)
+				continue
 			}
 			if convertedBackend != nil {
 				convertedBackends = append(convertedBackends, *convertedBackend)
@@ -74,8 +90,12 @@ func (tcpRoute *tcpRouteDescription) loadAttachedRules(ctx context.Context, k8sC
 		convertedRules = append(convertedRules, convertTCPRouteRule(&rule, convertedBackends))
 	}
 
+	if len(convertedRules) == 0 && len(tcpRoute.route.Spec.Rules) != 0 {
+		return nil, nil, newUnsupportedValueError("All rules of the route forward to backends with weight 0, which isn't supported by NLB listeners")
+	}
+
 	tcpRoute.rules = convertedRules
-	return tcpRoute, nil
+	return tcpRoute, unresolvedRefs, nil
 }
 
 func (tcpRoute *tcpRouteDescription) GetHostnames() []gwv1.Hostname {
@@ -98,6 +118,10 @@ func (tcpRoute *tcpRouteDescription) GetRawRoute() interface{} {
 	return tcpRoute.route
 }
 
+func (tcpRoute *tcpRouteDescription) GetRouteCreateTimestamp() time.Time {
+	return tcpRoute.route.CreationTimestamp.Time
+}
+
 func (tcpRoute *tcpRouteDescription) GetParentRefs() []gwv1.ParentReference {
 	return tcpRoute.route.Spec.ParentRefs
 }
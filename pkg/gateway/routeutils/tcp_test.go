@@ -142,8 +142,9 @@ func Test_TCP_LoadAttachedRules(t *testing.T) {
 		backendLoader: mockLoader,
 	}
 
-	result, err := routeDescription.loadAttachedRules(context.Background(), nil)
+	result, unresolvedRefs, err := routeDescription.loadAttachedRules(context.Background(), nil)
 	assert.NoError(t, err)
+	assert.Empty(t, unresolvedRefs)
 	convertedRules := result.GetAttachedRules()
 	assert.Equal(t, 3, len(convertedRules))
 
@@ -151,3 +152,52 @@ func Test_TCP_LoadAttachedRules(t *testing.T) {
 	assert.Equal(t, 4, len(convertedRules[1].GetBackends()))
 	assert.Equal(t, 0, len(convertedRules[2].GetBackends()))
 }
+
+func Test_TCP_LoadAttachedRules_ZeroWeightRules(t *testing.T) {
+	mockLoader := func(ctx context.Context, k8sClient client.Client, typeSpecificBackend interface{}, backendRef gwv1.BackendRef, routeIdentifier types.NamespacedName, routeKind RouteKind) (*Backend, error) {
+		if backendRef.Weight != nil && *backendRef.Weight == 0 {
+			return nil, nil
+		}
+		return &Backend{Weight: 1}, nil
+	}
+	zeroWeight := gwalpha2.BackendRef{BackendObjectReference: gwv1.BackendObjectReference{Name: "zero"}, Weight: awssdk.Int32(0)}
+	defaultWeight := gwalpha2.BackendRef{BackendObjectReference: gwv1.BackendObjectReference{Name: "default"}}
+
+	t.Run("rules with only zero weight backends are dropped", func(t *testing.T) {
+		routeDescription := tcpRouteDescription{
+			route: &gwalpha2.TCPRoute{
+				Spec: gwalpha2.TCPRouteSpec{Rules: []gwalpha2.TCPRouteRule{
+					{BackendRefs: []gwalpha2.BackendRef{defaultWeight, zeroWeight}},
+					{BackendRefs: []gwalpha2.BackendRef{zeroWeight, zeroWeight}},
+				}},
+			},
+			backendLoader: mockLoader,
+		}
+		result, unresolvedRefs, err := routeDescription.loadAttachedRules(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []RouteValidationError{
+			{
+				Reason:      gwv1.RouteReasonUnsupportedValue,
+				Message:     "Dropped Rule 1: all backend references have weight 0, which isn't supported by NLB listeners",
+				DroppedRule: true,
+			},
+		}, unresolvedRefs)
+		assert.Equal(t, 1, len(result.GetAttachedRules()))
+		assert.Equal(t, 1, len(result.GetAttachedRules()[0].GetBackends()))
+	})
+
+	t.Run("route is rejected when all rules are dropped", func(t *testing.T) {
+		routeDescription := tcpRouteDescription{
+			route: &gwalpha2.TCPRoute{
+				Spec: gwalpha2.TCPRouteSpec{Rules: []gwalpha2.TCPRouteRule{
+					{BackendRefs: []gwalpha2.BackendRef{zeroWeight}},
+				}},
+			},
+			backendLoader: mockLoader,
+		}
+		_, _, err := routeDescription.loadAttachedRules(context.Background(), nil)
+		var validationErr *RouteValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, gwv1.RouteReasonUnsupportedValue, validationErr.Reason)
+	})
+}
@@ -2,6 +2,9 @@ package routeutils
 
 import (
 	"context"
+	"time"
+
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,6 +46,10 @@ func (t *convertedTLSRouteRule) GetBackends() []Backend {
 	return t.backends
 }
 
+func (t *convertedTLSRouteRule) GetListenerRuleConfig() *ListenerRuleConfig {
+	return nil
+}
+
 /* Route Description */
 
 type tlsRouteDescription struct {
@@ -55,15 +62,25 @@ func (tlsRoute *tlsRouteDescription) GetAttachedRules() []RouteRule {
 	return tlsRoute.rules
 }
 
-func (tlsRoute *tlsRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client) (RouteDescriptor, error) {
+func (tlsRoute *tlsRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client) (RouteDescriptor, []RouteValidationError, error) {
+	var unresolvedRefs []RouteValidationError
 	convertedRules := make([]RouteRule, 0)
-	for _, rule := range tlsRoute.route.Spec.Rules {
+	for i, rule := range tlsRoute.route.Spec.Rules {
+		if droppedRuleErr := validateL4RuleBackendWeights(i, rule.BackendRefs); droppedRuleErr != nil {
+			unresolvedRefs = append(unresolvedRefs, *droppedRuleErr)
+			continue
+		}
 		convertedBackends := make([]Backend, 0)
 
 		for _, backend := range rule.BackendRefs {
 			convertedBackend, err := tlsRoute.backendLoader(ctx, k8sClient, backend, backend, tlsRoute.GetRouteNamespacedName(), tlsRoute.GetRouteKind())
 			if err != nil {
-				return nil, err
+				var validationErr *RouteValidationError
+				if !errors.As(err, &validationErr) {
+					return nil, nil, err
+				}
+				unresolvedRefs = append(unresolvedRefs, *validationErr)
+				continue
 			}
 
 			if convertedBackend != nil {
@@ -74,8 +91,12 @@ func (tlsRoute *tlsRouteDescription) loadAttachedRules(ctx context.Context, k8sC
 		convertedRules = append(convertedRules, convertTLSRouteRule(&rule, convertedBackends))
 	}
 
+	if len(convertedRules) == 0 && len(tlsRoute.route.Spec.Rules) != 0 {
+		return nil, nil, newUnsupportedValueError("All rules of the route forward to backends with weight 0, which isn't supported by NLB listeners")
+	}
+
 	tlsRoute.rules = convertedRules
-	return tlsRoute, nil
+	return tlsRoute, unresolvedRefs, nil
 }
 
 func (tlsRoute *tlsRouteDescription) GetHostnames() []gwv1.Hostname {
@@ -102,6 +123,10 @@ func (tlsRoute *tlsRouteDescription) GetRawRoute() interface{} {
 	return tlsRoute.route
 }
 
+func (tlsRoute *tlsRouteDescription) GetRouteCreateTimestamp() time.Time {
+	return tlsRoute.route.CreationTimestamp.Time
+}
+
 func (tlsRoute *tlsRouteDescription) GetBackendRefs() []gwv1.BackendRef {
 	backendRefs := make([]gwv1.BackendRef, 0)
 	if tlsRoute.route.Spec.Rules != nil {
@@ -2,6 +2,9 @@ package routeutils
 
 import (
 	"context"
+	"time"
+
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,6 +46,10 @@ func (t *convertedUDPRouteRule) GetBackends() []Backend {
 	return t.backends
 }
 
+func (t *convertedUDPRouteRule) GetListenerRuleConfig() *ListenerRuleConfig {
+	return nil
+}
+
 /* Route Description */
 
 type udpRouteDescription struct {
@@ -55,15 +62,25 @@ func (udpRoute *udpRouteDescription) GetAttachedRules() []RouteRule {
 	return udpRoute.rules
 }
 
-func (udpRoute *udpRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client) (RouteDescriptor, error) {
+func (udpRoute *udpRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client) (RouteDescriptor, []RouteValidationError, error) {
+	var unresolvedRefs []RouteValidationError
 	convertedRules := make([]RouteRule, 0)
-	for _, rule := range udpRoute.route.Spec.Rules {
+	for i, rule := range udpRoute.route.Spec.Rules {
+		if droppedRuleErr := validateL4RuleBackendWeights(i, rule.BackendRefs); droppedRuleErr != nil {
+			unresolvedRefs = append(unresolvedRefs, *droppedRuleErr)
+			continue
+		}
 		convertedBackends := make([]Backend, 0)
 
 		for _, backend := range rule.BackendRefs {
 			convertedBackend, err := udpRoute.backendLoader(ctx, k8sClient, backend, backend, udpRoute.GetRouteNamespacedName(), udpRoute.GetRouteKind())
 			if err != nil {
-				return nil, err
+				var validationErr *RouteValidationError
+				if !errors.As(err, &validationErr) {
+					return nil, nil, err
+				}
+				unresolvedRefs = append(unresolvedRefs, *validationErr)
+				continue
 			}
 			if convertedBackend != nil {
 				convertedBackends = append(convertedBackends, *convertedBackend)
@@ -73,8 +90,12 @@ func (udpRoute *udpRouteDescription) loadAttachedRules(ctx context.Context, k8sC
 		convertedRules = append(convertedRules, convertUDPRouteRule(&rule, convertedBackends))
 	}
 
+	if len(convertedRules) == 0 && len(udpRoute.route.Spec.Rules) != 0 {
+		return nil, nil, newUnsupportedValueError("All rules of the route forward to backends with weight 0, which isn't supported by NLB listeners")
+	}
+
 	udpRoute.rules = convertedRules
-	return udpRoute, nil
+	return udpRoute, unresolvedRefs, nil
 }
 
 func (udpRoute *udpRouteDescription) GetHostnames() []gwv1.Hostname {
@@ -101,6 +122,10 @@ func (udpRoute *udpRouteDescription) GetRawRoute() interface{} {
 	return udpRoute.route
 }
 
+func (udpRoute *udpRouteDescription) GetRouteCreateTimestamp() time.Time {
+	return udpRoute.route.CreationTimestamp.Time
+}
+
 func (udpRoute *udpRouteDescription) GetBackendRefs() []gwv1.BackendRef {
 	backendRefs := make([]gwv1.BackendRef, 0)
 	if udpRoute.route.Spec.Rules != nil {
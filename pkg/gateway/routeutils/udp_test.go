@@ -142,8 +142,9 @@ func Test_UDP_LoadAttachedRules(t *testing.T) {
 		backendLoader: mockLoader,
 	}
 
-	result, err := routeDescription.loadAttachedRules(context.Background(), nil)
+	result, unresolvedRefs, err := routeDescription.loadAttachedRules(context.Background(), nil)
 	assert.NoError(t, err)
+	assert.Empty(t, unresolvedRefs)
 	convertedRules := result.GetAttachedRules()
 	assert.Equal(t, 3, len(convertedRules))
 
@@ -280,18 +280,10 @@ func (m *defaultGroupLoader) sortGroupMembers(members []ClassifiedIngress) ([]Cl
 
 	groupMemberWithOrderList := make([]groupMemberWithOrder, 0, len(members))
 	for _, member := range members {
-		var order = defaultGroupOrder
-		exists, err := m.annotationParser.ParseInt32Annotation(annotations.IngressSuffixGroupOrder, &order, member.Ing.Annotations)
+		order, err := LoadGroupOrder(m.annotationParser, member.Ing)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to load Ingress group order for ingress: %v", k8s.NamespacedName(member.Ing))
+			return nil, err
 		}
-		if exists {
-			if order < minGroupOrder || order > maxGroupOder {
-				return nil, errors.Errorf("explicit Ingress group order must be within [%v:%v], Ingress: %v, order: %v",
-					minGroupOrder, maxGroupOder, k8s.NamespacedName(member.Ing), order)
-			}
-		}
-
 		groupMemberWithOrderList = append(groupMemberWithOrderList, groupMemberWithOrder{member: member, order: order})
 	}
 
@@ -314,6 +306,22 @@ func (m *defaultGroupLoader) sortGroupMembers(members []ClassifiedIngress) ([]Cl
 	return sortedMembers, nil
 }
 
+// LoadGroupOrder loads the order of the Ingress within its IngressGroup, either explicit via "group.order" annotation or ${defaultGroupOrder}.
+func LoadGroupOrder(annotationParser annotations.Parser, ing *networking.Ingress) (int32, error) {
+	var order = defaultGroupOrder
+	exists, err := annotationParser.ParseInt32Annotation(annotations.IngressSuffixGroupOrder, &order, ing.Annotations)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to load Ingress group order for ingress: %v", k8s.NamespacedName(ing))
+	}
+	if exists {
+		if order < minGroupOrder || order > maxGroupOder {
+			return 0, errors.Errorf("explicit Ingress group order must be within [%v:%v], Ingress: %v, order: %v",
+				minGroupOrder, maxGroupOder, k8s.NamespacedName(ing), order)
+		}
+	}
+	return order, nil
+}
+
 // validateGroupName validates whether Ingress group name is valid
 func validateGroupName(groupName string) error {
 	if !groupNameRegex.MatchString(groupName) {
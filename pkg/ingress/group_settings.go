@@ -0,0 +1,77 @@
+package ingress
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// ValidateGroupSettings checks the settings the members of the IngressGroup share on their LoadBalancer and its listeners
+// don't conflict. It uses the same merge logic as the model builder without resolving any AWS resources, so that
+// conflicts can be rejected before they fail the model build of the whole IngressGroup.
+func ValidateGroupSettings(ctx context.Context, annotationParser annotations.Parser, ingGroup Group) error {
+	task := &defaultModelBuildTask{
+		annotationParser:    annotationParser,
+		certDiscovery:       noopCertDiscovery{},
+		logger:              logr.Discard(),
+		ingGroup:            ingGroup,
+		externalManagedTags: sets.NewString(),
+	}
+	scheme, err := task.buildLoadBalancerScheme(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := task.buildLoadBalancerIPAddressType(ctx); err != nil {
+		return err
+	}
+	if _, err := task.buildLoadBalancerName(ctx, scheme); err != nil {
+		return err
+	}
+	if _, _, err := task.buildLoadBalancerExplicitSubnets(); err != nil {
+		return err
+	}
+	if _, err := task.buildIngressGroupResourceTags(ingGroup.Members); err != nil {
+		return err
+	}
+	if _, err := task.buildIngressGroupLoadBalancerAttributes(ingGroup.Members); err != nil {
+		return err
+	}
+
+	ingListByPort := make(map[int32][]ClassifiedIngress)
+	listenPortConfigsByPort := make(map[int32][]listenPortConfigWithIngress)
+	for _, member := range ingGroup.Members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		listenPortConfigByPortForIngress, err := task.computeIngressListenPortConfigByPort(ctx, &member)
+		if err != nil {
+			return errors.Wrapf(err, "ingress: %v", ingKey.String())
+		}
+		for port, cfg := range listenPortConfigByPortForIngress {
+			ingListByPort[port] = append(ingListByPort[port], member)
+			listenPortConfigsByPort[port] = append(listenPortConfigsByPort[port], listenPortConfigWithIngress{
+				ingKey:           ingKey,
+				listenPortConfig: cfg,
+			})
+		}
+	}
+	for port, cfgs := range listenPortConfigsByPort {
+		mergedCfg, err := task.mergeListenPortConfigs(ctx, cfgs)
+		if err != nil {
+			return errors.Wrapf(err, "failed to merge listenPort config for port: %v", port)
+		}
+		if _, err := task.buildIngressGroupListenerAttributes(ctx, ingListByPort[port], mergedCfg.protocol, port); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// noopCertDiscovery discovers no certificates, discovered certificates are merged across the IngressGroup without conflicts.
+type noopCertDiscovery struct{}
+
+func (noopCertDiscovery) Discover(_ context.Context, _ []string) ([]string, error) {
+	return nil, nil
+}
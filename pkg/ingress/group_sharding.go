@@ -0,0 +1,329 @@
+package ingress
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	// the stack name of shards is used as Kubernetes label value on TargetGroupBindings.
+	maxShardStackNameLength = 63
+	// the length of the hash that tells apart truncated names of IngressGroups.
+	shardGroupNameHashLength = 8
+	// the default quota of rules per ALB, across all its listeners and excluding their default rules.
+	defaultMaxRulesPerShard = 100
+)
+
+// GroupShard is a partition of an IngressGroup, hosted by its own LoadBalancer.
+type GroupShard struct {
+	// Index is the index of the shard within the IngressGroup.
+	Index int
+
+	// Group contains the Ingresses of the shard, its ID identifies the resource stack of the shard.
+	// The first shard uses the ID of the IngressGroup, so that the LoadBalancer of an IngressGroup is kept when it's sharded.
+	Group Group
+
+	// Sharded is whether the IngressGroup is sharded, the shard of its Ingresses is only recorded then.
+	Sharded bool
+}
+
+// ShardGroup partitions the IngressGroup into the shards configured on its IngressClassParams, and additional shards
+// when the configured ones are full. IngressGroups that aren't sharded are a single shard. Shards the Ingresses were
+// last deployed to are included even when they're no longer used, without members, so that their LoadBalancers are deleted.
+func ShardGroup(annotationParser annotations.Parser, ingGroup Group) ([]GroupShard, error) {
+	sharding, err := findGroupSharding(ingGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	shardIndexes := sets.New[int](0)
+	membersByShard := map[int][]ClassifiedIngress{0: ingGroup.Members}
+	if sharding != nil {
+		membersByShard = assignShards(annotationParser, ingGroup.Members, *sharding)
+		for shardIndex := 0; shardIndex < int(sharding.Shards); shardIndex++ {
+			shardIndexes.Insert(shardIndex)
+		}
+		for shardIndex := range membersByShard {
+			shardIndexes.Insert(shardIndex)
+		}
+	}
+	for _, member := range ingGroup.Members {
+		if shardIndex, exists := GetDeployedShardIndex(member.Ing); exists {
+			shardIndexes.Insert(shardIndex)
+		}
+	}
+	for _, ing := range ingGroup.InactiveMembers {
+		if shardIndex, exists := GetDeployedShardIndex(ing); exists {
+			shardIndexes.Insert(shardIndex)
+		}
+	}
+
+	shards := make([]GroupShard, 0, shardIndexes.Len())
+	for _, shardIndex := range sets.List(shardIndexes) {
+		shards = append(shards, GroupShard{
+			Index: shardIndex,
+			Group: Group{
+				ID:              buildShardGroupID(ingGroup.ID, shardIndex),
+				Members:         membersByShard[shardIndex],
+				InactiveMembers: ingGroup.InactiveMembers,
+			},
+			Sharded: sharding != nil,
+		})
+	}
+	return shards, nil
+}
+
+// GetDeployedShardIndex returns the index of the shard of a sharded IngressGroup the Ingress was last deployed to, if any.
+func GetDeployedShardIndex(ing *networking.Ingress) (int, bool) {
+	rawShardIndex, exists := ing.Annotations[annotations.AnnotationIngressGroupShard]
+	if !exists {
+		return 0, false
+	}
+	shardIndex, err := strconv.Atoi(rawShardIndex)
+	if err != nil || shardIndex < 0 {
+		return 0, false
+	}
+	return shardIndex, true
+}
+
+// findGroupSharding finds the sharding of the IngressGroup configured on the IngressClassParams of its members.
+func findGroupSharding(ingGroup Group) (*elbv2api.IngressGroupSharding, error) {
+	var sharding *elbv2api.IngressGroupSharding
+	var shardingIngClassParams string
+	for _, member := range ingGroup.Members {
+		ingClassParams := member.IngClassConfig.IngClassParams
+		if ingClassParams == nil || ingClassParams.Spec.Group == nil || ingClassParams.Spec.Group.Sharding == nil {
+			continue
+		}
+		memberSharding := *ingClassParams.Spec.Group.Sharding
+		if memberSharding.Key == "" {
+			memberSharding.Key = elbv2api.IngressGroupShardingKeyHost
+		}
+		if memberSharding.MaxRulesPerShard == 0 {
+			memberSharding.MaxRulesPerShard = defaultMaxRulesPerShard
+		}
+		if sharding == nil {
+			sharding = &memberSharding
+			shardingIngClassParams = ingClassParams.Name
+			continue
+		}
+		if *sharding != memberSharding {
+			return nil, errors.Errorf("conflicting sharding for IngressGroup %v in IngressClassParams %v and %v",
+				ingGroup.ID, shardingIngClassParams, ingClassParams.Name)
+		}
+	}
+	return sharding, nil
+}
+
+// assignShards assigns the Ingresses to the shards of the IngressGroup.
+// Ingresses are kept on the configured shard they were deployed to, so that their hosts don't move.
+// Other Ingresses join the shard of Ingresses with the same sharding key, or are assigned to a shard by the hash of their key.
+// When the estimated rules of the Ingresses with that key would exceed the maxRulesPerShard of that shard, they're assigned
+// to the shard with the fewest rules that has room for them instead, or to a new shard when all shards are full.
+func assignShards(annotationParser annotations.Parser, members []ClassifiedIngress, sharding elbv2api.IngressGroupSharding) map[int][]ClassifiedIngress {
+	membersByShard := make(map[int][]ClassifiedIngress)
+	shardKeys := computeShardKeys(members, sharding.Key)
+	rulesByIngress := estimateLoadBalancerRules(annotationParser, members)
+	shardByKey := make(map[string]int)
+	rulesByShard := make([]int, sharding.Shards)
+	var unassignedMembers []ClassifiedIngress
+	for _, member := range members {
+		shardIndex, exists := GetDeployedShardIndex(member.Ing)
+		if !exists || shardIndex >= int(sharding.Shards) {
+			unassignedMembers = append(unassignedMembers, member)
+			continue
+		}
+		membersByShard[shardIndex] = append(membersByShard[shardIndex], member)
+		rulesByShard[shardIndex] += rulesByIngress[k8s.NamespacedName(member.Ing)]
+		shardKey := shardKeys[k8s.NamespacedName(member.Ing)]
+		if _, exists := shardByKey[shardKey]; !exists {
+			shardByKey[shardKey] = shardIndex
+		}
+	}
+
+	// Ingresses with the same key are assigned together, so the shard must have room for all of them.
+	rulesByKey := make(map[string]int)
+	for _, member := range unassignedMembers {
+		rulesByKey[shardKeys[k8s.NamespacedName(member.Ing)]] += rulesByIngress[k8s.NamespacedName(member.Ing)]
+	}
+	// the order of the members is stable, so that Ingresses added at the same time are assigned deterministically.
+	sort.SliceStable(unassignedMembers, func(i, j int) bool {
+		return k8s.NamespacedName(unassignedMembers[i].Ing).String() < k8s.NamespacedName(unassignedMembers[j].Ing).String()
+	})
+	maxRules := int(sharding.MaxRulesPerShard)
+	for _, member := range unassignedMembers {
+		shardKey := shardKeys[k8s.NamespacedName(member.Ing)]
+		shardIndex, exists := shardByKey[shardKey]
+		if !exists {
+			shardIndex = computeShardIndex(shardKey, sharding)
+			if rulesByShard[shardIndex]+rulesByKey[shardKey] > maxRules {
+				shardIndex = findShardWithCapacity(rulesByShard, rulesByKey[shardKey], maxRules)
+			}
+			if shardIndex == len(rulesByShard) {
+				rulesByShard = append(rulesByShard, 0)
+			}
+			shardByKey[shardKey] = shardIndex
+		}
+		membersByShard[shardIndex] = append(membersByShard[shardIndex], member)
+		rulesByShard[shardIndex] += rulesByIngress[k8s.NamespacedName(member.Ing)]
+	}
+	return membersByShard
+}
+
+// computeShardIndex computes the shard from the hash of the sharding key.
+func computeShardIndex(shardKey string, sharding elbv2api.IngressGroupSharding) int {
+	if sharding.Shards <= 1 {
+		return 0
+	}
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(shardKey))
+	return int(hash.Sum32() % uint32(sharding.Shards))
+}
+
+// findShardWithCapacity finds the shard with the fewest rules that has room for the rules, the first one of them on ties.
+// It returns the index of a new shard when no shard has room for them.
+func findShardWithCapacity(rulesByShard []int, rules int, maxRules int) int {
+	shardIndex := len(rulesByShard)
+	for i, shardRules := range rulesByShard {
+		if shardRules+rules > maxRules {
+			continue
+		}
+		if shardIndex == len(rulesByShard) || shardRules < rulesByShard[shardIndex] {
+			shardIndex = i
+		}
+	}
+	return shardIndex
+}
+
+// estimateLoadBalancerRules estimates the listener rules each Ingress adds to the LoadBalancer of its shard.
+// The rules quota applies to the LoadBalancer across all its listeners: each path of the Ingress results in a rule on each
+// of its listen ports, except HTTP ports when the Ingress redirects them to HTTPS.
+func estimateLoadBalancerRules(annotationParser annotations.Parser, members []ClassifiedIngress) map[types.NamespacedName]int {
+	rulesByIngress := make(map[types.NamespacedName]int, len(members))
+	for i := range members {
+		member := &members[i]
+		paths := 0
+		for _, rule := range member.Ing.Spec.Rules {
+			if rule.HTTP != nil {
+				paths += len(rule.HTTP.Paths)
+			}
+		}
+		var sslRedirectPort int32
+		sslRedirect, err := annotationParser.ParseInt32Annotation(annotations.IngressSuffixSSLRedirect, &sslRedirectPort, member.Ing.Annotations)
+		if err != nil {
+			sslRedirect = false
+		}
+		preferTLS := len(ComputeIngressExplicitTLSCertARNs(annotationParser, member)) != 0
+		listenPorts, err := ComputeIngressListenPorts(annotationParser, member.Ing, preferTLS)
+		if err != nil {
+			// the IngressGroup fails to build with invalid listen ports, count the paths once meanwhile.
+			rulesByIngress[k8s.NamespacedName(member.Ing)] = paths
+			continue
+		}
+		listeners := 0
+		for _, protocol := range listenPorts {
+			if sslRedirect && protocol == elbv2model.ProtocolHTTP {
+				continue
+			}
+			listeners++
+		}
+		rulesByIngress[k8s.NamespacedName(member.Ing)] = paths * listeners
+	}
+	return rulesByIngress
+}
+
+// computeShardKeys computes the sharding key of the Ingresses.
+// When sharding by host, Ingresses connected by common hosts, directly or through other Ingresses, share the key of the
+// lowest host in lexical order among them, so that they're hosted by the same LoadBalancer.
+// Ingresses without host, or sharded by Ingress, are keyed by their namespace and name.
+func computeShardKeys(members []ClassifiedIngress, key elbv2api.IngressGroupShardingKey) map[types.NamespacedName]string {
+	hostsByIngress := make(map[types.NamespacedName][]string, len(members))
+	hostRoots := newHostUnion()
+	if key == elbv2api.IngressGroupShardingKeyHost {
+		for _, member := range members {
+			var hosts []string
+			for _, rule := range member.Ing.Spec.Rules {
+				if rule.Host != "" {
+					hosts = append(hosts, rule.Host)
+				}
+			}
+			for _, host := range hosts {
+				hostRoots.union(hosts[0], host)
+			}
+			hostsByIngress[k8s.NamespacedName(member.Ing)] = hosts
+		}
+	}
+
+	shardKeys := make(map[types.NamespacedName]string, len(members))
+	for _, member := range members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		if hosts := hostsByIngress[ingKey]; len(hosts) != 0 {
+			shardKeys[ingKey] = hostRoots.find(hosts[0])
+		} else {
+			shardKeys[ingKey] = ingKey.String()
+		}
+	}
+	return shardKeys
+}
+
+// hostUnion is a union-find over hosts, the root of each set of connected hosts is its lowest host in lexical order.
+type hostUnion map[string]string
+
+func newHostUnion() hostUnion {
+	return make(hostUnion)
+}
+
+// find returns the root of the set of the host.
+func (u hostUnion) find(host string) string {
+	parent, exists := u[host]
+	if !exists || parent == host {
+		return host
+	}
+	root := u.find(parent)
+	u[host] = root
+	return root
+}
+
+// union merges the sets of both hosts.
+func (u hostUnion) union(host, other string) {
+	root, otherRoot := u.find(host), u.find(other)
+	if root > otherRoot {
+		root, otherRoot = otherRoot, root
+	}
+	u[root] = root
+	u[otherRoot] = root
+}
+
+// buildShardGroupID builds the ID of a shard of the IngressGroup.
+// Shard names contain an underscore, which group names can't, so that they don't collide with other IngressGroups.
+// Names too long are truncated and suffixed with the hash of the IngressGroup name, so that they don't collide with each other.
+func buildShardGroupID(ingGroupID GroupID, shardIndex int) GroupID {
+	if shardIndex == 0 {
+		return ingGroupID
+	}
+	suffix := fmt.Sprintf("_shard-%d", shardIndex)
+	name := ingGroupID.Name
+	if len(name)+len(suffix) > maxShardStackNameLength {
+		hash := sha256.New()
+		_, _ = hash.Write([]byte(ingGroupID.String()))
+		nameHash := hex.EncodeToString(hash.Sum(nil))[:shardGroupNameHashLength]
+		name = fmt.Sprintf("%s-%s", name[:maxShardStackNameLength-len(suffix)-len(nameHash)-1], nameHash)
+	}
+	return GroupID{
+		Namespace: ingGroupID.Namespace,
+		Name:      name + suffix,
+	}
+}
@@ -0,0 +1,357 @@
+package ingress
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func Test_ShardGroup(t *testing.T) {
+	ingClassParams := func(name string, sharding *elbv2api.IngressGroupSharding) *elbv2api.IngressClassParams {
+		return &elbv2api.IngressClassParams{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: elbv2api.IngressClassParamsSpec{
+				Group: &elbv2api.IngressGroup{Name: "awesome-group", Sharding: sharding},
+			},
+		}
+	}
+	ingWithHosts := func(name string, shardAnnotation string, hosts ...string) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: name},
+		}
+		if shardAnnotation != "" {
+			ing.Annotations = map[string]string{"elbv2.k8s.aws/ingress-group-shard": shardAnnotation}
+		}
+		for _, host := range hosts {
+			ing.Spec.Rules = append(ing.Spec.Rules, networking.IngressRule{Host: host})
+		}
+		return ing
+	}
+	withPaths := func(ing *networking.Ingress, paths int) *networking.Ingress {
+		ing.Spec.Rules[0].HTTP = &networking.HTTPIngressRuleValue{}
+		for i := 0; i < paths; i++ {
+			ing.Spec.Rules[0].HTTP.Paths = append(ing.Spec.Rules[0].HTTP.Paths, networking.HTTPIngressPath{Path: fmt.Sprintf("/path-%d", i)})
+		}
+		return ing
+	}
+	groupID := NewGroupIDForExplicitGroup("awesome-group")
+	sharding := &elbv2api.IngressGroupSharding{Shards: 3}
+
+	ingA := ingWithHosts("ing-a", "", "a.example.com")
+	ingB := ingWithHosts("ing-b", "", "b.example.com")
+	ingC := ingWithHosts("ing-c", "", "c.example.com")
+	// ing-d shares a host with ing-a.
+	ingD := ingWithHosts("ing-d", "", "z.example.com", "a.example.com")
+
+	type wantShard struct {
+		id      GroupID
+		members []string
+	}
+	tests := []struct {
+		name        string
+		ingGroup    Group
+		wantShards  []wantShard
+		wantSharded bool
+		wantErr     string
+	}{
+		{
+			name: "unsharded group",
+			ingGroup: Group{
+				ID: groupID,
+				Members: []ClassifiedIngress{
+					{Ing: ingA, IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", nil)}},
+					{Ing: ingB},
+				},
+			},
+			wantShards: []wantShard{
+				{id: groupID, members: []string{"ing-a", "ing-b"}},
+			},
+		},
+		{
+			name: "sharded by host",
+			ingGroup: Group{
+				ID: groupID,
+				Members: []ClassifiedIngress{
+					{Ing: ingA, IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", sharding)}},
+					{Ing: ingB, IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", sharding)}},
+					{Ing: ingC, IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", sharding)}},
+					{Ing: ingD, IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", sharding)}},
+				},
+			},
+			wantShards: []wantShard{
+				{id: groupID, members: []string{"ing-b"}},
+				{id: GroupID{Name: "awesome-group_shard-1"}, members: []string{"ing-a", "ing-d"}},
+				{id: GroupID{Name: "awesome-group_shard-2"}, members: []string{"ing-c"}},
+			},
+			wantSharded: true,
+		},
+		{
+			name: "deployed Ingresses stay on their shard while it's configured",
+			ingGroup: Group{
+				ID: groupID,
+				Members: []ClassifiedIngress{
+					{Ing: ingWithHosts("ing-a", "2", "a.example.com"), IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", sharding)}},
+					{Ing: ingB, IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", sharding)}},
+					{Ing: ingWithHosts("ing-c", "5", "c.example.com"), IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", sharding)}},
+					{Ing: ingD, IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", sharding)}},
+				},
+			},
+			wantShards: []wantShard{
+				{id: groupID, members: []string{"ing-b"}},
+				{id: GroupID{Name: "awesome-group_shard-1"}},
+				{id: GroupID{Name: "awesome-group_shard-2"}, members: []string{"ing-a", "ing-c", "ing-d"}},
+				{id: GroupID{Name: "awesome-group_shard-5"}},
+			},
+			wantSharded: true,
+		},
+		{
+			name: "new Ingresses exceeding the rules of their shard are assigned to the shard with the fewest rules",
+			ingGroup: Group{
+				ID: groupID,
+				Members: []ClassifiedIngress{
+					{Ing: withPaths(ingWithHosts("ing-a", "1", "a.example.com"), 2), IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", &elbv2api.IngressGroupSharding{Shards: 3, MaxRulesPerShard: 2})}},
+					{Ing: withPaths(ingWithHosts("ing-e", "", "e.example.com"), 1), IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", &elbv2api.IngressGroupSharding{Shards: 3, MaxRulesPerShard: 2})}},
+				},
+			},
+			wantShards: []wantShard{
+				{id: groupID, members: []string{"ing-e"}},
+				{id: GroupID{Name: "awesome-group_shard-1"}, members: []string{"ing-a"}},
+				{id: GroupID{Name: "awesome-group_shard-2"}},
+			},
+			wantSharded: true,
+		},
+		{
+			name: "new Ingresses are assigned to a new shard when all shards are full",
+			ingGroup: Group{
+				ID: groupID,
+				Members: []ClassifiedIngress{
+					{Ing: withPaths(ingWithHosts("ing-a", "0", "a.example.com"), 2), IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", &elbv2api.IngressGroupSharding{Shards: 2, MaxRulesPerShard: 2})}},
+					{Ing: withPaths(ingWithHosts("ing-b", "1", "b.example.com"), 1), IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", &elbv2api.IngressGroupSharding{Shards: 2, MaxRulesPerShard: 2})}},
+					{Ing: withPaths(ingWithHosts("ing-e", "", "e.example.com"), 2), IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", &elbv2api.IngressGroupSharding{Shards: 2, MaxRulesPerShard: 2})}},
+					{Ing: withPaths(ingWithHosts("ing-f", "", "e.example.com"), 1), IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", &elbv2api.IngressGroupSharding{Shards: 2, MaxRulesPerShard: 2})}},
+				},
+			},
+			wantShards: []wantShard{
+				{id: groupID, members: []string{"ing-a"}},
+				{id: GroupID{Name: "awesome-group_shard-1"}, members: []string{"ing-b"}},
+				{id: GroupID{Name: "awesome-group_shard-2"}, members: []string{"ing-e", "ing-f"}},
+			},
+			wantSharded: true,
+		},
+		{
+			name: "shards deployed before are kept without members",
+			ingGroup: Group{
+				ID: groupID,
+				Members: []ClassifiedIngress{
+					{Ing: ingWithHosts("ing-a", "4", "a.example.com"), IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params", nil)}},
+				},
+				InactiveMembers: []*networking.Ingress{ingWithHosts("ing-e", "2")},
+			},
+			wantShards: []wantShard{
+				{id: groupID, members: []string{"ing-a"}},
+				{id: GroupID{Name: "awesome-group_shard-2"}},
+				{id: GroupID{Name: "awesome-group_shard-4"}},
+			},
+		},
+		{
+			name: "conflicting sharding",
+			ingGroup: Group{
+				ID: groupID,
+				Members: []ClassifiedIngress{
+					{Ing: ingA, IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params-1", sharding)}},
+					{Ing: ingB, IngClassConfig: ClassConfiguration{IngClassParams: ingClassParams("params-2", &elbv2api.IngressGroupSharding{Shards: 2})}},
+				},
+			},
+			wantErr: "conflicting sharding for IngressGroup awesome-group in IngressClassParams params-1 and params-2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shards, err := ShardGroup(annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"), tt.ingGroup)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			var gotShards []wantShard
+			for i, shard := range shards {
+				assert.Equal(t, shard.Group.ID, buildShardGroupID(groupID, shard.Index), "shard %d", i)
+				gotShard := wantShard{id: shard.Group.ID}
+				for _, member := range shard.Group.Members {
+					gotShard.members = append(gotShard.members, member.Ing.Name)
+				}
+				assert.Equal(t, tt.ingGroup.InactiveMembers, shard.Group.InactiveMembers)
+				assert.Equal(t, tt.wantSharded, shard.Sharded)
+				gotShards = append(gotShards, gotShard)
+			}
+			assert.Equal(t, tt.wantShards, gotShards)
+		})
+	}
+}
+
+func Test_computeShardIndex(t *testing.T) {
+	sharding := elbv2api.IngressGroupSharding{Shards: 3, Key: elbv2api.IngressGroupShardingKeyHost}
+	assert.Equal(t, 1, computeShardIndex("a.example.com", sharding))
+	assert.Equal(t, 0, computeShardIndex("b.example.com", sharding))
+	assert.Equal(t, 0, computeShardIndex("a.example.com", elbv2api.IngressGroupSharding{Shards: 1}))
+}
+
+func Test_computeShardKeys(t *testing.T) {
+	ingWithHosts := func(name string, hosts ...string) ClassifiedIngress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: name},
+		}
+		for _, host := range hosts {
+			ing.Spec.Rules = append(ing.Spec.Rules, networking.IngressRule{Host: host})
+		}
+		return ClassifiedIngress{Ing: ing}
+	}
+	members := []ClassifiedIngress{
+		ingWithHosts("ing-a", "z.example.com", "", "b.example.com"),
+		// ing-b connects the hosts of ing-a and ing-c, although neither of them is its first host.
+		ingWithHosts("ing-b", "y.example.com", "z.example.com", "x.example.com"),
+		ingWithHosts("ing-c", "x.example.com", "c.example.com"),
+		ingWithHosts("ing-d", "d.example.com"),
+		ingWithHosts("ing-e"),
+	}
+	tests := []struct {
+		name string
+		key  elbv2api.IngressGroupShardingKey
+		want map[types.NamespacedName]string
+	}{
+		{
+			name: "sharded by host",
+			key:  elbv2api.IngressGroupShardingKeyHost,
+			want: map[types.NamespacedName]string{
+				{Namespace: "awesome-ns", Name: "ing-a"}: "b.example.com",
+				{Namespace: "awesome-ns", Name: "ing-b"}: "b.example.com",
+				{Namespace: "awesome-ns", Name: "ing-c"}: "b.example.com",
+				{Namespace: "awesome-ns", Name: "ing-d"}: "d.example.com",
+				{Namespace: "awesome-ns", Name: "ing-e"}: "awesome-ns/ing-e",
+			},
+		},
+		{
+			name: "sharded by Ingress",
+			key:  elbv2api.IngressGroupShardingKeyIngress,
+			want: map[types.NamespacedName]string{
+				{Namespace: "awesome-ns", Name: "ing-a"}: "awesome-ns/ing-a",
+				{Namespace: "awesome-ns", Name: "ing-b"}: "awesome-ns/ing-b",
+				{Namespace: "awesome-ns", Name: "ing-c"}: "awesome-ns/ing-c",
+				{Namespace: "awesome-ns", Name: "ing-d"}: "awesome-ns/ing-d",
+				{Namespace: "awesome-ns", Name: "ing-e"}: "awesome-ns/ing-e",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, computeShardKeys(members, tt.key))
+		})
+	}
+}
+
+func Test_buildShardGroupID(t *testing.T) {
+	longName := "a-very-long-ingress-group-name-that-is-exactly-sixty-three-char"
+	otherLongName := "a-very-long-ingress-group-name-that-is-exactly-sixty-three-chaz"
+	assert.Len(t, longName, 63)
+	tests := []struct {
+		name       string
+		ingGroupID GroupID
+		shardIndex int
+		want       GroupID
+	}{
+		{
+			name:       "first shard",
+			ingGroupID: NewGroupIDForExplicitGroup("awesome-group"),
+			shardIndex: 0,
+			want:       NewGroupIDForExplicitGroup("awesome-group"),
+		},
+		{
+			name:       "other shard",
+			ingGroupID: NewGroupIDForExplicitGroup("awesome-group"),
+			shardIndex: 12,
+			want:       GroupID{Name: "awesome-group_shard-12"},
+		},
+		{
+			name:       "long group name",
+			ingGroupID: NewGroupIDForExplicitGroup(longName),
+			shardIndex: 1,
+			want:       GroupID{Name: longName[:46] + "-c87e8a1a_shard-1"},
+		},
+		{
+			name:       "long group names with the same prefix",
+			ingGroupID: NewGroupIDForExplicitGroup(otherLongName),
+			shardIndex: 1,
+			want:       GroupID{Name: otherLongName[:46] + "-0c8a8b04_shard-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildShardGroupID(tt.ingGroupID, tt.shardIndex))
+		})
+	}
+}
+
+func Test_findShardWithCapacity(t *testing.T) {
+	assert.Equal(t, 1, findShardWithCapacity([]int{50, 20, 20}, 10, 100))
+	assert.Equal(t, 0, findShardWithCapacity([]int{50, 95, 98}, 10, 100))
+	assert.Equal(t, 3, findShardWithCapacity([]int{95, 95, 98}, 10, 100))
+	assert.Equal(t, 0, findShardWithCapacity(nil, 10, 100))
+}
+
+func Test_estimateLoadBalancerRules(t *testing.T) {
+	ingWithPaths := func(name string, ingAnnotations map[string]string) ClassifiedIngress {
+		return ClassifiedIngress{
+			Ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: name, Annotations: ingAnnotations},
+				Spec: networking.IngressSpec{
+					Rules: []networking.IngressRule{
+						{
+							Host: "a.example.com",
+							IngressRuleValue: networking.IngressRuleValue{
+								HTTP: &networking.HTTPIngressRuleValue{
+									Paths: []networking.HTTPIngressPath{{Path: "/a"}, {Path: "/b"}},
+								},
+							},
+						},
+						{
+							Host: "b.example.com",
+							IngressRuleValue: networking.IngressRuleValue{
+								HTTP: &networking.HTTPIngressRuleValue{
+									Paths: []networking.HTTPIngressPath{{Path: "/c"}},
+								},
+							},
+						},
+						{Host: "c.example.com"},
+					},
+				},
+			},
+		}
+	}
+	members := []ClassifiedIngress{
+		ingWithPaths("default-port", nil),
+		ingWithPaths("multiple-ports", map[string]string{
+			"alb.ingress.kubernetes.io/listen-ports": `[{"HTTP": 80}, {"HTTPS": 443}, {"HTTPS": 8443}]`,
+		}),
+		ingWithPaths("ssl-redirect", map[string]string{
+			"alb.ingress.kubernetes.io/listen-ports":    `[{"HTTP": 80}, {"HTTPS": 443}]`,
+			"alb.ingress.kubernetes.io/ssl-redirect":    "443",
+			"alb.ingress.kubernetes.io/certificate-arn": "arn:aws:acm:us-west-2:123456789012:certificate/abc",
+		}),
+		ingWithPaths("invalid-ports", map[string]string{
+			"alb.ingress.kubernetes.io/listen-ports": `[{"TCP": 80}]`,
+		}),
+	}
+	want := map[types.NamespacedName]int{
+		{Namespace: "awesome-ns", Name: "default-port"}:   3,
+		{Namespace: "awesome-ns", Name: "multiple-ports"}: 9,
+		{Namespace: "awesome-ns", Name: "ssl-redirect"}:   3,
+		{Namespace: "awesome-ns", Name: "invalid-ports"}:  3,
+	}
+	assert.Equal(t, want, estimateLoadBalancerRules(annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"), members))
+}
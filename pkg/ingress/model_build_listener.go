@@ -181,11 +181,17 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 }
 
 func (t *defaultModelBuildTask) computeIngressExplicitTLSCertARNs(_ context.Context, ing *ClassifiedIngress) []string {
+	return ComputeIngressExplicitTLSCertARNs(t.annotationParser, ing)
+}
+
+// ComputeIngressExplicitTLSCertARNs computes the certificates configured explicitly for the Ingress,
+// either in its IngressClassParams or via "certificate-arn" annotation.
+func ComputeIngressExplicitTLSCertARNs(annotationParser annotations.Parser, ing *ClassifiedIngress) []string {
 	if ing.IngClassConfig.IngClassParams != nil && len(ing.IngClassConfig.IngClassParams.Spec.CertificateArn) != 0 {
 		return ing.IngClassConfig.IngClassParams.Spec.CertificateArn
 	}
 	var rawTLSCertARNs []string
-	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixCertificateARN, &rawTLSCertARNs, ing.Ing.Annotations)
+	_ = annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixCertificateARN, &rawTLSCertARNs, ing.Ing.Annotations)
 	return rawTLSCertARNs
 }
 
@@ -203,8 +209,14 @@ func (t *defaultModelBuildTask) computeIngressInferredTLSCertARNs(ctx context.Co
 }
 
 func (t *defaultModelBuildTask) computeIngressListenPorts(_ context.Context, ing *networking.Ingress, preferTLS bool) (map[int32]elbv2model.Protocol, error) {
+	return ComputeIngressListenPorts(t.annotationParser, ing, preferTLS)
+}
+
+// ComputeIngressListenPorts computes the listen ports and protocols of the Ingress from its "listen-ports" annotation.
+// Without annotation, the Ingress listens on 443 if it prefers TLS, on 80 otherwise.
+func ComputeIngressListenPorts(annotationParser annotations.Parser, ing *networking.Ingress, preferTLS bool) (map[int32]elbv2model.Protocol, error) {
 	rawListenPorts := ""
-	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixListenPorts, &rawListenPorts, ing.Annotations); !exists {
+	if exists := annotationParser.ParseStringAnnotation(annotations.IngressSuffixListenPorts, &rawListenPorts, ing.Annotations); !exists {
 		if preferTLS {
 			return map[int32]elbv2model.Protocol{443: elbv2model.ProtocolHTTPS}, nil
 		}
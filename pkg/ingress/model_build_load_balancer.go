@@ -198,31 +198,12 @@ func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context
 }
 
 func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Context, scheme elbv2model.LoadBalancerScheme) ([]elbv2model.SubnetMapping, error) {
-	var explicitSubnetSelectorList []v1beta1.SubnetSelector
-	var explicitSubnetNameOrIDsList [][]string
-	for _, member := range t.ingGroup.Members {
-		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.Subnets != nil {
-			explicitSubnetSelectorList = append(explicitSubnetSelectorList, *member.IngClassConfig.IngClassParams.Spec.Subnets)
-			continue
-		}
-		var rawSubnetNameOrIDs []string
-		if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSubnets, &rawSubnetNameOrIDs, member.Ing.Annotations); !exists {
-			continue
-		}
-		explicitSubnetNameOrIDsList = append(explicitSubnetNameOrIDsList, rawSubnetNameOrIDs)
+	explicitSubnetSelector, explicitSubnetNameOrIDs, err := t.buildLoadBalancerExplicitSubnets()
+	if err != nil {
+		return nil, err
 	}
-
-	if len(explicitSubnetSelectorList) != 0 {
-		if len(explicitSubnetNameOrIDsList) != 0 {
-			return nil, errors.Errorf("conflicting subnet specifications: IngressClassParams versus annotation")
-		}
-		chosenSubnetSelector := explicitSubnetSelectorList[0]
-		for _, subnetSelector := range explicitSubnetSelectorList[1:] {
-			if !cmp.Equal(chosenSubnetSelector, subnetSelector) {
-				return nil, errors.Errorf("conflicting IngressClassParams subnet specifications")
-			}
-		}
-		chosenSubnets, err := t.subnetsResolver.ResolveViaSelector(ctx, chosenSubnetSelector,
+	if explicitSubnetSelector != nil {
+		chosenSubnets, err := t.subnetsResolver.ResolveViaSelector(ctx, *explicitSubnetSelector,
 			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
 			networking.WithSubnetsResolveLBScheme(scheme),
 		)
@@ -231,16 +212,8 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 		}
 		return buildLoadBalancerSubnetMappingsWithSubnets(chosenSubnets), nil
 	}
-
-	if len(explicitSubnetNameOrIDsList) != 0 {
-		chosenSubnetNameOrIDs := explicitSubnetNameOrIDsList[0]
-		for _, subnetNameOrIDs := range explicitSubnetNameOrIDsList[1:] {
-			// subnetNameOrIDs order doesn't matter
-			if !cmp.Equal(chosenSubnetNameOrIDs, subnetNameOrIDs, equality.IgnoreStringSliceOrder()) {
-				return nil, errors.Errorf("conflicting subnets: %v | %v", chosenSubnetNameOrIDs, subnetNameOrIDs)
-			}
-		}
-		chosenSubnets, err := t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, chosenSubnetNameOrIDs,
+	if len(explicitSubnetNameOrIDs) != 0 {
+		chosenSubnets, err := t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, explicitSubnetNameOrIDs,
 			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
 			networking.WithSubnetsResolveLBScheme(scheme),
 		)
@@ -276,6 +249,49 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 	return buildLoadBalancerSubnetMappingsWithSubnetIDs(subnetIDs), nil
 }
 
+// buildLoadBalancerExplicitSubnets builds the subnets configured explicitly by the members of the IngressGroup,
+// either as a selector in their IngressClassParams or via "subnets" annotation.
+func (t *defaultModelBuildTask) buildLoadBalancerExplicitSubnets() (*v1beta1.SubnetSelector, []string, error) {
+	var explicitSubnetSelectorList []v1beta1.SubnetSelector
+	var explicitSubnetNameOrIDsList [][]string
+	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.Subnets != nil {
+			explicitSubnetSelectorList = append(explicitSubnetSelectorList, *member.IngClassConfig.IngClassParams.Spec.Subnets)
+			continue
+		}
+		var rawSubnetNameOrIDs []string
+		if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSubnets, &rawSubnetNameOrIDs, member.Ing.Annotations); !exists {
+			continue
+		}
+		explicitSubnetNameOrIDsList = append(explicitSubnetNameOrIDsList, rawSubnetNameOrIDs)
+	}
+
+	if len(explicitSubnetSelectorList) != 0 {
+		if len(explicitSubnetNameOrIDsList) != 0 {
+			return nil, nil, errors.Errorf("conflicting subnet specifications: IngressClassParams versus annotation")
+		}
+		chosenSubnetSelector := explicitSubnetSelectorList[0]
+		for _, subnetSelector := range explicitSubnetSelectorList[1:] {
+			if !cmp.Equal(chosenSubnetSelector, subnetSelector) {
+				return nil, nil, errors.Errorf("conflicting IngressClassParams subnet specifications")
+			}
+		}
+		return &chosenSubnetSelector, nil, nil
+	}
+
+	if len(explicitSubnetNameOrIDsList) != 0 {
+		chosenSubnetNameOrIDs := explicitSubnetNameOrIDsList[0]
+		for _, subnetNameOrIDs := range explicitSubnetNameOrIDsList[1:] {
+			// subnetNameOrIDs order doesn't matter
+			if !cmp.Equal(chosenSubnetNameOrIDs, subnetNameOrIDs, equality.IgnoreStringSliceOrder()) {
+				return nil, nil, errors.Errorf("conflicting subnets: %v | %v", chosenSubnetNameOrIDs, subnetNameOrIDs)
+			}
+		}
+		return nil, chosenSubnetNameOrIDs, nil
+	}
+	return nil, nil, nil
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Context, listenPortConfigByPort map[int32]listenPortConfig, ipAddressType elbv2model.IPAddressType) ([]core.StringToken, error) {
 	sgNameOrIDsViaAnnotation, err := t.buildFrontendSGNameOrIDsFromAnnotation(ctx)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
@@ -63,12 +64,27 @@ func (m *PodReadinessGate) Mutate(ctx context.Context, pod *corev1.Pod) error {
 
 // computeTargetHealthReadinessGateConditionTypes computes the desired condition types for targetHealth readiness gate.
 func (m *PodReadinessGate) computeTargetHealthReadinessGateConditionTypes(ctx context.Context, namespace string, pod *corev1.Pod) ([]corev1.PodConditionType, error) {
-	tgbList := &elbv2api.TargetGroupBindingList{}
-	if err := m.k8sClient.List(ctx, tgbList, client.InNamespace(namespace)); err != nil {
-		m.logger.V(1).Info("unable to list TargetGroupBindings", "namespace", namespace)
+	tgbs, err := findIPTargetGroupBindingsForPod(ctx, m.k8sClient, m.logger, namespace, pod)
+	if err != nil {
 		return nil, errors.Wrap(err, "unable to determine targetHealth readinessGates")
 	}
 	var targetHealthCondTypes []corev1.PodConditionType
+	for i := range tgbs {
+		targetHealthCondType := targetgroupbinding.BuildTargetHealthPodConditionType(&tgbs[i])
+		targetHealthCondTypes = append(targetHealthCondTypes, targetHealthCondType)
+	}
+	return targetHealthCondTypes, nil
+}
+
+// findIPTargetGroupBindingsForPod finds the ip TargetGroupBindings on the same namespace as the pod
+// and referring to existing services matching the pod labels.
+func findIPTargetGroupBindingsForPod(ctx context.Context, k8sClient client.Client, logger logr.Logger, namespace string, pod *corev1.Pod) ([]elbv2api.TargetGroupBinding, error) {
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := k8sClient.List(ctx, tgbList, client.InNamespace(namespace)); err != nil {
+		logger.V(1).Info("unable to list TargetGroupBindings", "namespace", namespace)
+		return nil, err
+	}
+	var tgbs []elbv2api.TargetGroupBinding
 	for _, tgb := range tgbList.Items {
 		if tgb.Spec.TargetType == nil || (*tgb.Spec.TargetType) != elbv2api.TargetTypeIP {
 			continue
@@ -76,13 +92,13 @@ func (m *PodReadinessGate) computeTargetHealthReadinessGateConditionTypes(ctx co
 
 		svcKey := types.NamespacedName{Namespace: tgb.Namespace, Name: tgb.Spec.ServiceRef.Name}
 		svc := &corev1.Service{}
-		if err := m.k8sClient.Get(ctx, svcKey, svc); err != nil {
+		if err := k8sClient.Get(ctx, svcKey, svc); err != nil {
 			// If the service is not found, ignore
 			if apierrors.IsNotFound(err) {
-				m.logger.Info("unable to lookup service", "service", svcKey)
+				logger.Info("unable to lookup service", "service", svcKey)
 				continue
 			}
-			return nil, errors.Wrap(err, "unable to determine targetHealth readinessGates")
+			return nil, err
 		}
 		var svcSelector labels.Selector
 		if len(svc.Spec.Selector) == 0 {
@@ -90,12 +106,15 @@ func (m *PodReadinessGate) computeTargetHealthReadinessGateConditionTypes(ctx co
 		} else {
 			svcSelector = labels.SelectorFromSet(svc.Spec.Selector)
 		}
-		if svcSelector.Matches(labels.Set(pod.Labels)) {
-			targetHealthCondType := targetgroupbinding.BuildTargetHealthPodConditionType(&tgb)
-			targetHealthCondTypes = append(targetHealthCondTypes, targetHealthCondType)
+		podSelector, err := backend.GetPodSelector(&tgb)
+		if err != nil {
+			return nil, err
+		}
+		if svcSelector.Matches(labels.Set(pod.Labels)) && podSelector.Matches(labels.Set(pod.Labels)) {
+			tgbs = append(tgbs, tgb)
 		}
 	}
-	return targetHealthCondTypes, nil
+	return tgbs, nil
 }
 
 // removeLegacyTargetHealthReadinessGates removes existing legacy targetHealth readiness gates.
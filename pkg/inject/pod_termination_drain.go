@@ -0,0 +1,218 @@
+package inject
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/validation"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// podTerminationDrainEnabled waits for the targets of the pod to drain, up to the deregistration delay.
+	podTerminationDrainEnabled = "enabled"
+	// podTerminationDrainSleep sleeps for the whole deregistration delay, for containers without a shell.
+	podTerminationDrainSleep = "sleep"
+
+	// the pod annotations are exposed to the preStop hook through a downward API volume, so that it sees the targets being drained.
+	podTerminationDrainVolumeName      = "elbv2-termination-drain"
+	podTerminationDrainMountPath       = "/var/run/elbv2.k8s.aws/termination-drain"
+	podTerminationDrainAnnotationsFile = "annotations"
+
+	targetGroupAttributeDeregistrationDelay = "deregistration_delay.timeout_seconds"
+	// defaultDeregistrationDelaySeconds is the default deregistration delay of target groups,
+	// used when the deregistration delay of a target group cannot be determined.
+	defaultDeregistrationDelaySeconds = 300
+	// defaultTerminationGracePeriodSeconds is the default terminationGracePeriodSeconds of pods.
+	defaultTerminationGracePeriodSeconds = 30
+
+	defaultDeregistrationDelayCacheTTL = 10 * time.Minute
+)
+
+// NewPodTerminationDrain constructs new PodTerminationDrain
+func NewPodTerminationDrain(k8sClient client.Client, elbv2Client services.ELBV2, logger logr.Logger) *PodTerminationDrain {
+	return &PodTerminationDrain{
+		k8sClient:                   k8sClient,
+		elbv2Client:                 elbv2Client,
+		logger:                      logger,
+		deregistrationDelayCache:    cache.NewExpiring(),
+		deregistrationDelayCacheTTL: defaultDeregistrationDelayCacheTTL,
+	}
+}
+
+// PodTerminationDrain is a pod mutator that delays the termination of pods opted in until their targets are drained.
+// It injects a preStop hook into the containers that waits until the controller marks the targets of the pod as drained from
+// every target group binding matching the pod, through the targets drained annotations of the pod. The wait is capped by the
+// longest deregistration delay of the target groups, so that the pod keeps serving in-flight requests while its targets are
+// draining and no longer. The kubelet doesn't wait for finalizers to stop containers, the annotations are exposed to the hook
+// through a downward API volume instead.
+type PodTerminationDrain struct {
+	k8sClient   client.Client
+	elbv2Client services.ELBV2
+	logger      logr.Logger
+
+	deregistrationDelayCache    *cache.Expiring
+	deregistrationDelayCacheTTL time.Duration
+}
+
+// Mutate injects the preStop hook into the pod if it's opted in and there are target group bindings on the same namespace as the pod
+// and referring to existing services matching the pod labels.
+// Containers that already have a preStop hook are left unmodified.
+func (m *PodTerminationDrain) Mutate(ctx context.Context, pod *corev1.Pod) error {
+	drainMode := pod.Annotations[annotations.AnnotationPodTerminationDrain]
+	if drainMode != podTerminationDrainEnabled && drainMode != podTerminationDrainSleep {
+		return nil
+	}
+
+	req := webhook.ContextGetAdmissionRequest(ctx)
+	tgbs, err := findIPTargetGroupBindingsForPod(ctx, m.k8sClient, m.logger, req.Namespace, pod)
+	if err != nil {
+		return errors.Wrap(err, "unable to determine targetGroupBindings for termination drain")
+	}
+	var drainSeconds int64
+	targetsDrainedAnnotationKeys := make([]string, 0, len(tgbs))
+	for i := range tgbs {
+		drainSeconds = max(drainSeconds, m.fetchDeregistrationDelaySeconds(ctx, &tgbs[i]))
+		targetsDrainedAnnotationKey := targetgroupbinding.BuildTargetsDrainedPodAnnotationKey(&tgbs[i])
+		if errs := validation.IsQualifiedName(targetsDrainedAnnotationKey); len(errs) != 0 && drainMode == podTerminationDrainEnabled {
+			// the draining of the targets cannot be tracked on the pod, sleep for the deregistration delay instead.
+			m.logger.Info("unable to track the draining of targets, falling back to sleep", "targetGroupBinding", tgbs[i].Name,
+				"error", errs[0])
+			drainMode = podTerminationDrainSleep
+		}
+		targetsDrainedAnnotationKeys = append(targetsDrainedAnnotationKeys, targetsDrainedAnnotationKey)
+	}
+	if drainSeconds == 0 {
+		return nil
+	}
+
+	injected := false
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		if container.Lifecycle != nil && container.Lifecycle.PreStop != nil {
+			continue
+		}
+		if container.Lifecycle == nil {
+			container.Lifecycle = &corev1.Lifecycle{}
+		}
+		if drainMode == podTerminationDrainSleep {
+			container.Lifecycle.PreStop = &corev1.LifecycleHandler{
+				Sleep: &corev1.SleepAction{Seconds: drainSeconds},
+			}
+		} else {
+			container.Lifecycle.PreStop = &corev1.LifecycleHandler{
+				Exec: &corev1.ExecAction{Command: []string{"/bin/sh", "-c", buildTargetsDrainWaitScript(drainSeconds)}},
+			}
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      podTerminationDrainVolumeName,
+				MountPath: podTerminationDrainMountPath,
+				ReadOnly:  true,
+			})
+		}
+		injected = true
+	}
+	if !injected {
+		return nil
+	}
+
+	if drainMode == podTerminationDrainEnabled {
+		// the controller flips the annotations to "true" once the targets of the terminating pod are drained.
+		if pod.Annotations == nil {
+			pod.Annotations = make(map[string]string)
+		}
+		for _, targetsDrainedAnnotationKey := range targetsDrainedAnnotationKeys {
+			pod.Annotations[targetsDrainedAnnotationKey] = "false"
+		}
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: podTerminationDrainVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				DownwardAPI: &corev1.DownwardAPIVolumeSource{
+					Items: []corev1.DownwardAPIVolumeFile{
+						{
+							Path:     podTerminationDrainAnnotationsFile,
+							FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.annotations"},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	// the preStop hook counts against the termination grace period, extend it so that containers keep their time to shut down.
+	terminationGracePeriodSeconds := int64(defaultTerminationGracePeriodSeconds)
+	if pod.Spec.TerminationGracePeriodSeconds != nil {
+		terminationGracePeriodSeconds = *pod.Spec.TerminationGracePeriodSeconds
+	}
+	pod.Spec.TerminationGracePeriodSeconds = awssdk.Int64(terminationGracePeriodSeconds + drainSeconds)
+	return nil
+}
+
+// buildTargetsDrainWaitScript builds the preStop script that waits, up to drainSeconds, while any targets drained annotation
+// of the pod is "false". The downward API file contains one key="value" line per annotation.
+func buildTargetsDrainWaitScript(drainSeconds int64) string {
+	annotationsFile := path.Join(podTerminationDrainMountPath, podTerminationDrainAnnotationsFile)
+	pendingPattern := fmt.Sprintf(`^%s/[^=]*="false"$`, regexp.QuoteMeta(annotations.AnnotationPrefixPodTargetsDrained))
+	return fmt.Sprintf(`i=0; while [ "$i" -lt %d ] && grep -q '%s' %s; do sleep 1; i=$((i+1)); done`,
+		drainSeconds, pendingPattern, annotationsFile)
+}
+
+// fetchDeregistrationDelaySeconds fetches the deregistration delay of the target group of the TargetGroupBinding.
+// The default deregistration delay is used if it cannot be fetched, so that pods can still be created.
+func (m *PodTerminationDrain) fetchDeregistrationDelaySeconds(ctx context.Context, tgb *elbv2api.TargetGroupBinding) int64 {
+	tgARN := tgb.Spec.TargetGroupARN
+	if rawCacheItem, exists := m.deregistrationDelayCache.Get(tgARN); exists {
+		return rawCacheItem.(int64)
+	}
+
+	deregistrationDelaySeconds, err := m.fetchDeregistrationDelaySecondsFromAWS(ctx, tgb)
+	if err != nil {
+		m.logger.Error(err, "unable to fetch deregistration delay, using the default", "targetGroupARN", tgARN,
+			"deregistrationDelaySeconds", defaultDeregistrationDelaySeconds)
+		return defaultDeregistrationDelaySeconds
+	}
+	m.deregistrationDelayCache.Set(tgARN, deregistrationDelaySeconds, m.deregistrationDelayCacheTTL)
+	return deregistrationDelaySeconds
+}
+
+func (m *PodTerminationDrain) fetchDeregistrationDelaySecondsFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (int64, error) {
+	clientToUse := m.elbv2Client
+	if tgb.Spec.IamRoleArnToAssume != "" {
+		assumedClient, err := m.elbv2Client.AssumeRole(ctx, tgb.Spec.IamRoleArnToAssume, tgb.Spec.AssumeRoleExternalId)
+		if err != nil {
+			return 0, err
+		}
+		clientToUse = assumedClient
+	}
+	resp, err := clientToUse.DescribeTargetGroupAttributesWithContext(ctx, &elbv2sdk.DescribeTargetGroupAttributesInput{
+		TargetGroupArn: awssdk.String(tgb.Spec.TargetGroupARN),
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, attr := range resp.Attributes {
+		if awssdk.ToString(attr.Key) != targetGroupAttributeDeregistrationDelay {
+			continue
+		}
+		deregistrationDelaySeconds, err := strconv.ParseInt(awssdk.ToString(attr.Value), 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to parse attribute %v", targetGroupAttributeDeregistrationDelay)
+		}
+		return deregistrationDelaySeconds, nil
+	}
+	return defaultDeregistrationDelaySeconds, nil
+}
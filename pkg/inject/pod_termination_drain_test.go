@@ -0,0 +1,333 @@
+package inject
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func Test_PodTerminationDrain_Mutate(t *testing.T) {
+	testNS := "name-space-1"
+	svc1 := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNS,
+			Name:      "service-1",
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"app": "app-1",
+			},
+		},
+	}
+	targetTypeIP := elbv2api.TargetTypeIP
+	tgb1 := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tgb-1",
+			Namespace: testNS,
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetGroupARN: "tg-1",
+			TargetType:     &targetTypeIP,
+			ServiceRef: elbv2api.ServiceReference{
+				Name: svc1.Name,
+			},
+		},
+	}
+	tgb2 := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tgb-2",
+			Namespace: testNS,
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetGroupARN: "tg-2",
+			TargetType:     &targetTypeIP,
+			ServiceRef: elbv2api.ServiceReference{
+				Name: svc1.Name,
+			},
+		},
+	}
+	tgbLongName := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tgb-with-a-name-longer-than-the-sixty-three-characters-of-annotation-names",
+			Namespace: testNS,
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetGroupARN: "tg-3",
+			TargetType:     &targetTypeIP,
+			ServiceRef: elbv2api.ServiceReference{
+				Name: svc1.Name,
+			},
+		},
+	}
+
+	type describeTargetGroupAttributesCall struct {
+		tgARN string
+		resp  *elbv2sdk.DescribeTargetGroupAttributesOutput
+		err   error
+	}
+	deregistrationDelayResp := func(seconds string) *elbv2sdk.DescribeTargetGroupAttributesOutput {
+		return &elbv2sdk.DescribeTargetGroupAttributesOutput{
+			Attributes: []elbv2types.TargetGroupAttribute{
+				{Key: awssdk.String("stickiness.enabled"), Value: awssdk.String("false")},
+				{Key: awssdk.String("deregistration_delay.timeout_seconds"), Value: awssdk.String(seconds)},
+			},
+		}
+	}
+	optedInPodMeta := metav1.ObjectMeta{
+		Labels: map[string]string{
+			"app": "app-1",
+		},
+		Annotations: map[string]string{
+			"elbv2.k8s.aws/pod-termination-drain": "enabled",
+		},
+	}
+	sleepPodMeta := metav1.ObjectMeta{
+		Labels: map[string]string{
+			"app": "app-1",
+		},
+		Annotations: map[string]string{
+			"elbv2.k8s.aws/pod-termination-drain": "sleep",
+		},
+	}
+	waitingPodMeta := func(tgbNames ...string) metav1.ObjectMeta {
+		podMeta := *optedInPodMeta.DeepCopy()
+		for _, tgbName := range tgbNames {
+			podMeta.Annotations["targets-drained.elbv2.k8s.aws/"+tgbName] = "false"
+		}
+		return podMeta
+	}
+	waitPreStop := func(drainSeconds string) *corev1.LifecycleHandler {
+		return &corev1.LifecycleHandler{
+			Exec: &corev1.ExecAction{Command: []string{"/bin/sh", "-c",
+				`i=0; while [ "$i" -lt ` + drainSeconds + ` ] && grep -q '^targets-drained\.elbv2\.k8s\.aws/[^=]*="false"$' /var/run/elbv2.k8s.aws/termination-drain/annotations; do sleep 1; i=$((i+1)); done`,
+			}},
+		}
+	}
+	drainVolumeMount := corev1.VolumeMount{
+		Name:      "elbv2-termination-drain",
+		MountPath: "/var/run/elbv2.k8s.aws/termination-drain",
+		ReadOnly:  true,
+	}
+	drainVolume := corev1.Volume{
+		Name: "elbv2-termination-drain",
+		VolumeSource: corev1.VolumeSource{
+			DownwardAPI: &corev1.DownwardAPIVolumeSource{
+				Items: []corev1.DownwardAPIVolumeFile{
+					{Path: "annotations", FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.annotations"}},
+				},
+			},
+		},
+	}
+	existingPreStop := &corev1.LifecycleHandler{
+		Exec: &corev1.ExecAction{Command: []string{"/bin/shutdown"}},
+	}
+
+	tests := []struct {
+		name                               string
+		tgbList                            []*elbv2api.TargetGroupBinding
+		describeTargetGroupAttributesCalls []describeTargetGroupAttributesCall
+		pod                                *corev1.Pod
+		want                               *corev1.Pod
+	}{
+		{
+			name:    "pod not opted in",
+			tgbList: []*elbv2api.TargetGroupBinding{tgb1},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "app-1"}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			},
+			want: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "app-1"}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			},
+		},
+		{
+			name: "pod without matching tgb",
+			pod: &corev1.Pod{
+				ObjectMeta: optedInPodMeta,
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			},
+			want: &corev1.Pod{
+				ObjectMeta: optedInPodMeta,
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			},
+		},
+		{
+			name:    "waits for the targets to drain up to the longest deregistration delay of the matching tgbs",
+			tgbList: []*elbv2api.TargetGroupBinding{tgb1, tgb2},
+			describeTargetGroupAttributesCalls: []describeTargetGroupAttributesCall{
+				{tgARN: "tg-1", resp: deregistrationDelayResp("60")},
+				{tgARN: "tg-2", resp: deregistrationDelayResp("120")},
+			},
+			pod: &corev1.Pod{
+				ObjectMeta: optedInPodMeta,
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "app"},
+						{Name: "sidecar", Lifecycle: &corev1.Lifecycle{PreStop: existingPreStop}},
+					},
+					TerminationGracePeriodSeconds: awssdk.Int64(10),
+				},
+			},
+			want: &corev1.Pod{
+				ObjectMeta: waitingPodMeta("tgb-1", "tgb-2"),
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:         "app",
+							Lifecycle:    &corev1.Lifecycle{PreStop: waitPreStop("120")},
+							VolumeMounts: []corev1.VolumeMount{drainVolumeMount},
+						},
+						{Name: "sidecar", Lifecycle: &corev1.Lifecycle{PreStop: existingPreStop}},
+					},
+					Volumes:                       []corev1.Volume{drainVolume},
+					TerminationGracePeriodSeconds: awssdk.Int64(130),
+				},
+			},
+		},
+		{
+			name:    "sleeps for the longest deregistration delay of the matching tgbs",
+			tgbList: []*elbv2api.TargetGroupBinding{tgb1, tgb2},
+			describeTargetGroupAttributesCalls: []describeTargetGroupAttributesCall{
+				{tgARN: "tg-1", resp: deregistrationDelayResp("60")},
+				{tgARN: "tg-2", resp: deregistrationDelayResp("120")},
+			},
+			pod: &corev1.Pod{
+				ObjectMeta: sleepPodMeta,
+				Spec: corev1.PodSpec{
+					Containers:                    []corev1.Container{{Name: "app"}},
+					TerminationGracePeriodSeconds: awssdk.Int64(10),
+				},
+			},
+			want: &corev1.Pod{
+				ObjectMeta: sleepPodMeta,
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "app",
+							Lifecycle: &corev1.Lifecycle{
+								PreStop: &corev1.LifecycleHandler{Sleep: &corev1.SleepAction{Seconds: 120}},
+							},
+						},
+					},
+					TerminationGracePeriodSeconds: awssdk.Int64(130),
+				},
+			},
+		},
+		{
+			name:    "sleeps when the draining of the targets cannot be tracked on the pod",
+			tgbList: []*elbv2api.TargetGroupBinding{tgbLongName},
+			describeTargetGroupAttributesCalls: []describeTargetGroupAttributesCall{
+				{tgARN: "tg-3", resp: deregistrationDelayResp("60")},
+			},
+			pod: &corev1.Pod{
+				ObjectMeta: optedInPodMeta,
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			},
+			want: &corev1.Pod{
+				ObjectMeta: optedInPodMeta,
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "app",
+							Lifecycle: &corev1.Lifecycle{
+								PreStop: &corev1.LifecycleHandler{Sleep: &corev1.SleepAction{Seconds: 60}},
+							},
+						},
+					},
+					TerminationGracePeriodSeconds: awssdk.Int64(90),
+				},
+			},
+		},
+		{
+			name:    "uses the default deregistration delay when it cannot be fetched",
+			tgbList: []*elbv2api.TargetGroupBinding{tgb1},
+			describeTargetGroupAttributesCalls: []describeTargetGroupAttributesCall{
+				{tgARN: "tg-1", err: errors.New("access denied")},
+			},
+			pod: &corev1.Pod{
+				ObjectMeta: optedInPodMeta,
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			},
+			want: &corev1.Pod{
+				ObjectMeta: waitingPodMeta("tgb-1"),
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:         "app",
+							Lifecycle:    &corev1.Lifecycle{PreStop: waitPreStop("300")},
+							VolumeMounts: []corev1.VolumeMount{drainVolumeMount},
+						},
+					},
+					Volumes:                       []corev1.Volume{drainVolume},
+					TerminationGracePeriodSeconds: awssdk.Int64(330),
+				},
+			},
+		},
+		{
+			name:    "no delay when deregistration delay is zero",
+			tgbList: []*elbv2api.TargetGroupBinding{tgb1},
+			describeTargetGroupAttributesCalls: []describeTargetGroupAttributesCall{
+				{tgARN: "tg-1", resp: deregistrationDelayResp("0")},
+			},
+			pod: &corev1.Pod{
+				ObjectMeta: optedInPodMeta,
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			},
+			want: &corev1.Pod{
+				ObjectMeta: optedInPodMeta,
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.describeTargetGroupAttributesCalls {
+				elbv2Client.EXPECT().DescribeTargetGroupAttributesWithContext(gomock.Any(), &elbv2sdk.DescribeTargetGroupAttributesInput{
+					TargetGroupArn: awssdk.String(call.tgARN),
+				}).Return(call.resp, call.err)
+			}
+
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, svc1.DeepCopy()))
+			for _, tgb := range tt.tgbList {
+				assert.NoError(t, k8sClient.Create(ctx, tgb.DeepCopy()))
+			}
+			ctx = webhook.ContextWithAdmissionRequest(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{Namespace: testNS},
+			})
+
+			terminationDrainInjector := NewPodTerminationDrain(k8sClient, elbv2Client, logr.New(&log.NullLogSink{}))
+			pod := tt.pod.DeepCopy()
+			err := terminationDrainInjector.Mutate(ctx, pod)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, pod)
+		})
+	}
+}
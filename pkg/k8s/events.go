@@ -10,22 +10,33 @@ const (
 	IngressEventReasonFailedBuildModel        = "FailedBuildModel"
 	IngressEventReasonFailedDeployModel       = "FailedDeployModel"
 	IngressEventReasonSuccessfullyReconciled  = "SuccessfullyReconciled"
+	IngressEventReasonDryRun                  = "DryRun"
+	IngressEventReasonDeletionHeld            = "DeletionHeld"
 
 	// Service events
+	ServiceEventReasonFailedLoadGroupID      = "FailedLoadGroupID"
 	ServiceEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
 	ServiceEventReasonFailedRemoveFinalizer  = "FailedRemoveFinalizer"
 	ServiceEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
 	ServiceEventReasonFailedCleanupStatus    = "FailedCleanupStatus"
 	ServiceEventReasonFailedBuildModel       = "FailedBuildModel"
+	ServiceEventReasonConflictingGroupMember = "ConflictingGroupMember"
 	ServiceEventReasonFailedDeployModel      = "FailedDeployModel"
 	ServiceEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+	ServiceEventReasonDryRun                 = "DryRun"
+	ServiceEventReasonDeletionHeld           = "DeletionHeld"
+
+	// Drift events, recorded on the Ingresses, Services and Gateways whose load balancer resources drifted
+	DriftEventReasonDriftDetected = "DriftDetected"
 
 	// TargetGroupBinding events
-	TargetGroupBindingEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
-	TargetGroupBindingEventReasonFailedRemoveFinalizer  = "FailedRemoveFinalizer"
-	TargetGroupBindingEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
-	TargetGroupBindingEventReasonFailedCleanup          = "FailedCleanup"
-	TargetGroupBindingEventReasonFailedNetworkReconcile = "FailedNetworkReconcile"
-	TargetGroupBindingEventReasonBackendNotFound        = "BackendNotFound"
-	TargetGroupBindingEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+	TargetGroupBindingEventReasonFailedAddFinalizer               = "FailedAddFinalizer"
+	TargetGroupBindingEventReasonFailedRemoveFinalizer            = "FailedRemoveFinalizer"
+	TargetGroupBindingEventReasonFailedUpdateStatus               = "FailedUpdateStatus"
+	TargetGroupBindingEventReasonFailedCleanup                    = "FailedCleanup"
+	TargetGroupBindingEventReasonFailedNetworkReconcile           = "FailedNetworkReconcile"
+	TargetGroupBindingEventReasonBackendNotFound                  = "BackendNotFound"
+	TargetGroupBindingEventReasonInvalidPodTargetPort             = "InvalidPodTargetPort"
+	TargetGroupBindingEventReasonInvalidPodTargetAvailabilityZone = "InvalidPodTargetAvailabilityZone"
+	TargetGroupBindingEventReasonSuccessfullyReconciled           = "SuccessfullyReconciled"
 )
@@ -2,11 +2,16 @@ package k8s
 
 import (
 	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 )
 
 const (
@@ -16,8 +21,9 @@ const (
 // PodInfo contains simplified pod information we cares about.
 // We do so to minimize memory usage.
 type PodInfo struct {
-	Key types.NamespacedName
-	UID types.UID
+	Key    types.NamespacedName
+	UID    types.UID
+	Labels map[string]string
 
 	ContainerPorts []corev1.ContainerPort
 	ReadinessGates []corev1.PodReadinessGate
@@ -27,6 +33,18 @@ type PodInfo struct {
 	CreationTime   v1.Time
 
 	ENIInfos []PodENIInfo
+
+	// TargetPortOverrides maps container ports to the ports to register the pod with into TargetGroups.
+	TargetPortOverrides map[int32]int32
+	// TargetPortOverridesErr is set when the target port overrides annotation of the pod is malformed.
+	TargetPortOverridesErr error
+	// TargetAvailabilityZone is the availabilityZone to register the pod with into TargetGroups.
+	TargetAvailabilityZone string
+
+	// Terminating is set once the pod is deleted, while its containers are stopping.
+	Terminating bool
+	// TargetsDrainPending are the names of the TargetGroupBindings the pod waits for its targets to be drained from before stopping.
+	TargetsDrainPending []string
 }
 
 // PodENIInfo is a json convertible structure that stores the Branch ENI details that can be
@@ -83,6 +101,14 @@ func (i *PodInfo) LookupContainerPort(port intstr.IntOrString) (int64, error) {
 	return 0, errors.Errorf("unable to find port %s on pod %s", port.String(), i.Key)
 }
 
+// LookupTargetPort returns the port to register the pod with into TargetGroups for specific containerPort.
+func (i *PodInfo) LookupTargetPort(containerPort int32) int32 {
+	if targetPort, ok := i.TargetPortOverrides[containerPort]; ok {
+		return targetPort
+	}
+	return containerPort
+}
+
 // buildPodInfo will construct PodInfo for given pod.
 func buildPodInfo(pod *corev1.Pod) PodInfo {
 	podKey := NamespacedName(pod)
@@ -93,13 +119,20 @@ func buildPodInfo(pod *corev1.Pod) PodInfo {
 		podENIInfos = eniInfo
 	}
 
+	// we kept targetPortOverrides as nil if the annotation is malformed, the error is kept for the pod to not be registered with the wrong port.
+	targetPortOverrides, targetPortOverridesErr := buildPodTargetPortOverrides(pod)
+	if targetPortOverridesErr != nil {
+		targetPortOverridesErr = errors.Wrapf(targetPortOverridesErr, "malformed %v annotation", annotations.AnnotationTargetPortOverrides)
+	}
+
 	var containerPorts []corev1.ContainerPort
 	for _, podContainer := range pod.Spec.Containers {
 		containerPorts = append(containerPorts, podContainer.Ports...)
 	}
 	return PodInfo{
-		Key: podKey,
-		UID: pod.UID,
+		Key:    podKey,
+		UID:    pod.UID,
+		Labels: pod.Labels,
 
 		ContainerPorts: containerPorts,
 		ReadinessGates: pod.Spec.ReadinessGates,
@@ -109,7 +142,27 @@ func buildPodInfo(pod *corev1.Pod) PodInfo {
 		CreationTime:   pod.CreationTimestamp,
 
 		ENIInfos: podENIInfos,
+
+		TargetPortOverrides:    targetPortOverrides,
+		TargetPortOverridesErr: targetPortOverridesErr,
+		TargetAvailabilityZone: pod.Annotations[annotations.AnnotationTargetAvailabilityZone],
+
+		Terminating:         pod.DeletionTimestamp != nil,
+		TargetsDrainPending: buildPodTargetsDrainPending(pod),
+	}
+}
+
+// buildPodTargetsDrainPending will construct the names of the TargetGroupBindings whose targets drained annotation is "false" for given pod.
+func buildPodTargetsDrainPending(pod *corev1.Pod) []string {
+	var tgbNames []string
+	for key, value := range pod.Annotations {
+		tgbName, found := strings.CutPrefix(key, annotations.AnnotationPrefixPodTargetsDrained+"/")
+		if found && value == "false" {
+			tgbNames = append(tgbNames, tgbName)
+		}
 	}
+	sort.Strings(tgbNames)
+	return tgbNames
 }
 
 // buildPodENIInfo will construct PodENIInfo for given pod if any.
@@ -126,3 +179,38 @@ func buildPodENIInfos(pod *corev1.Pod) ([]PodENIInfo, error) {
 
 	return podENIInfos, nil
 }
+
+// buildPodTargetPortOverrides will construct the target port overrides for given pod if any.
+// the annotation contains comma separated containerPort=targetPort pairs.
+func buildPodTargetPortOverrides(pod *corev1.Pod) (map[int32]int32, error) {
+	rawAnnotation, ok := pod.Annotations[annotations.AnnotationTargetPortOverrides]
+	if !ok {
+		return nil, nil
+	}
+
+	targetPortOverrides := make(map[int32]int32)
+	for _, rawPair := range strings.Split(rawAnnotation, ",") {
+		rawContainerPort, rawTargetPort, found := strings.Cut(strings.TrimSpace(rawPair), "=")
+		if !found {
+			return nil, errors.Errorf("failed to parse target port override %q, expecting containerPort=targetPort", rawPair)
+		}
+		containerPort, err := parsePortNumber(rawContainerPort)
+		if err != nil {
+			return nil, err
+		}
+		targetPort, err := parsePortNumber(rawTargetPort)
+		if err != nil {
+			return nil, err
+		}
+		targetPortOverrides[containerPort] = targetPort
+	}
+	return targetPortOverrides, nil
+}
+
+func parsePortNumber(rawPort string) (int32, error) {
+	port, err := strconv.ParseInt(strings.TrimSpace(rawPort), 10, 32)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, errors.Errorf("invalid port number %q", rawPort)
+	}
+	return int32(port), nil
+}
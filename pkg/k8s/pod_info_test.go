@@ -531,6 +531,64 @@ func Test_buildPodInfo(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "standard case - with labels and target overrides",
+			args: args{
+				pod: &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "my-ns",
+						Name:      "pod-1",
+						UID:       "pod-uuid",
+						Labels: map[string]string{
+							"track": "canary",
+						},
+						Annotations: map[string]string{
+							"elbv2.k8s.aws/target-port-overrides":    "8080=15006",
+							"elbv2.k8s.aws/target-availability-zone": "all",
+						},
+						CreationTimestamp: metav1.Time{
+							Time: timeNow,
+						},
+					},
+					Spec: corev1.PodSpec{
+						NodeName: "ip-192-168-13-198.us-west-2.compute.internal",
+						Containers: []corev1.Container{
+							{
+								Ports: []corev1.ContainerPort{
+									{
+										Name:          "http",
+										ContainerPort: 8080,
+									},
+								},
+							},
+						},
+					},
+					Status: corev1.PodStatus{
+						PodIP: "192.168.1.1",
+					},
+				},
+			},
+			want: PodInfo{
+				Key: types.NamespacedName{Namespace: "my-ns", Name: "pod-1"},
+				UID: "pod-uuid",
+				Labels: map[string]string{
+					"track": "canary",
+				},
+				ContainerPorts: []corev1.ContainerPort{
+					{
+						Name:          "http",
+						ContainerPort: 8080,
+					},
+				},
+				NodeName:     "ip-192-168-13-198.us-west-2.compute.internal",
+				PodIP:        "192.168.1.1",
+				CreationTime: metav1.Time{Time: timeNow},
+				TargetPortOverrides: map[int32]int32{
+					8080: 15006,
+				},
+				TargetAvailabilityZone: "all",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -605,3 +663,98 @@ func Test_buildPodENIInfo(t *testing.T) {
 		})
 	}
 }
+
+func Test_buildPodInfo_malformedTargetPortOverrides(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-ns",
+			Name:      "pod-1",
+			Annotations: map[string]string{
+				"elbv2.k8s.aws/target-port-overrides": "8080:15006",
+			},
+		},
+	}
+	got := buildPodInfo(pod)
+	assert.Nil(t, got.TargetPortOverrides)
+	assert.EqualError(t, got.TargetPortOverridesErr, "malformed elbv2.k8s.aws/target-port-overrides annotation: failed to parse target port override \"8080:15006\", expecting containerPort=targetPort")
+}
+
+func Test_buildPodTargetsDrainPending(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-ns",
+			Name:      "pod-1",
+			Annotations: map[string]string{
+				"targets-drained.elbv2.k8s.aws/tgb-2": "false",
+				"targets-drained.elbv2.k8s.aws/tgb-1": "false",
+				"targets-drained.elbv2.k8s.aws/tgb-3": "true",
+				"elbv2.k8s.aws/pod-termination-drain": "enabled",
+			},
+		},
+	}
+	assert.Equal(t, []string{"tgb-1", "tgb-2"}, buildPodTargetsDrainPending(pod))
+}
+
+func TestPodInfo_LookupTargetPort(t *testing.T) {
+	pod := PodInfo{
+		Key:                 types.NamespacedName{Namespace: "ns-1", Name: "pod-1"},
+		TargetPortOverrides: map[int32]int32{8080: 15006},
+	}
+	assert.Equal(t, int32(15006), pod.LookupTargetPort(8080))
+	assert.Equal(t, int32(8443), pod.LookupTargetPort(8443))
+}
+
+func Test_buildPodTargetPortOverrides(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        map[int32]int32
+		wantErr     error
+	}{
+		{
+			name: "target-port-overrides annotation exists and valid",
+			annotations: map[string]string{
+				"elbv2.k8s.aws/target-port-overrides": "8080=15006, 8443=15443",
+			},
+			want: map[int32]int32{
+				8080: 15006,
+				8443: 15443,
+			},
+		},
+		{
+			name:        "target-port-overrides annotation didn't exist",
+			annotations: map[string]string{},
+			want:        nil,
+		},
+		{
+			name: "target-port-overrides annotation without targetPort",
+			annotations: map[string]string{
+				"elbv2.k8s.aws/target-port-overrides": "8080",
+			},
+			wantErr: errors.New("failed to parse target port override \"8080\", expecting containerPort=targetPort"),
+		},
+		{
+			name: "target-port-overrides annotation with invalid port",
+			annotations: map[string]string{
+				"elbv2.k8s.aws/target-port-overrides": "8080=70000",
+			},
+			wantErr: errors.New("invalid port number \"70000\""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tt.annotations,
+				},
+			}
+			got, err := buildPodTargetPortOverrides(pod)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	errmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/error"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ReconcileStatusConditionReconciled is the type of the condition in the reconcile status annotation.
+	ReconcileStatusConditionReconciled = "Reconciled"
+
+	reconcileStatusReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+	reconcileStatusReasonReconcileFailed        = "ReconcileFailed"
+
+	// the description of planned changes is truncated so that the annotation stays small.
+	maxPlannedChangesDescriptionLength = 1024
+	plannedChangesTruncatedSuffix      = "\n..."
+)
+
+// ReconcileStatus is the outcome of the last reconcile of an Ingress or Service, stored in its reconcile status annotation.
+type ReconcileStatus struct {
+	metav1.Condition `json:",inline"`
+
+	// LoadBalancerARN is the ARN of the load balancer of the object, as of the last reconcile that deployed it.
+	LoadBalancerARN string `json:"loadBalancerARN,omitempty"`
+
+	// LoadBalancerScheme is the scheme of the load balancer of the object, as of the last reconcile that deployed it.
+	LoadBalancerScheme string `json:"loadBalancerScheme,omitempty"`
+
+	// PlannedChanges are the changes planned by the last reconcile in dry-run mode, until the object is reconciled again without dry-run.
+	PlannedChanges *PlannedChanges `json:"plannedChanges,omitempty"`
+}
+
+// PlannedChanges summarizes the changes to the AWS resources of an object planned in dry-run mode.
+type PlannedChanges struct {
+	// ObservedGeneration is the generation of the object the changes were planned for.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	Create int `json:"create"`
+	Update int `json:"update"`
+	Delete int `json:"delete"`
+
+	// Description lists the planned changes, it's truncated when there are too many of them.
+	Description string `json:"description,omitempty"`
+}
+
+// DeployedLoadBalancer describes the load balancer deployed for an object by a reconcile.
+type DeployedLoadBalancer struct {
+	// ARN is empty when the load balancer wasn't deployed.
+	ARN    string
+	Scheme string
+}
+
+// GetReconcileStatus returns the reconcile status stored in the annotation of obj if any.
+func GetReconcileStatus(obj metav1.Object) (ReconcileStatus, bool) {
+	rawStatus, exists := obj.GetAnnotations()[annotations.AnnotationReconcileStatus]
+	if !exists {
+		return ReconcileStatus{}, false
+	}
+	var status ReconcileStatus
+	if err := json.Unmarshal([]byte(rawStatus), &status); err != nil {
+		return ReconcileStatus{}, false
+	}
+	return status, true
+}
+
+// BuildReconcileStatus builds the reconcile status of obj from the result of a reconcile.
+// The load balancer is carried over from the previous status when it's unknown, e.g. because the reconcile failed before deploying.
+func BuildReconcileStatus(obj metav1.Object, lb DeployedLoadBalancer, reconcileErr error) ReconcileStatus {
+	status, _ := GetReconcileStatus(obj)
+	conditions := []metav1.Condition{status.Condition}
+	if status.Type == "" {
+		conditions = nil
+	}
+
+	cond := metav1.Condition{
+		Type:               ReconcileStatusConditionReconciled,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: obj.GetGeneration(),
+		Reason:             reconcileStatusReasonSuccessfullyReconciled,
+	}
+	if reconcileErr != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = reconcileStatusReasonReconcileFailed
+		cond.Message = reconcileErr.Error()
+		var errWithMetrics *errmetrics.ErrorWithMetrics
+		if errors.As(reconcileErr, &errWithMetrics) {
+			cond.Reason = reconcileStatusReasonForErrorCategory(errWithMetrics.ErrorCategory)
+		}
+	}
+	meta.SetStatusCondition(&conditions, cond)
+	status.Condition = *meta.FindStatusCondition(conditions, ReconcileStatusConditionReconciled)
+	if lb.ARN != "" {
+		status.LoadBalancerARN = lb.ARN
+		status.LoadBalancerScheme = lb.Scheme
+	}
+	status.PlannedChanges = nil
+	return status
+}
+
+// UpdateReconcileStatus stores the reconcile status of obj built from the result of a reconcile into its annotation.
+// Requeues aren't reconcile outcomes, the previous status is kept for them.
+func UpdateReconcileStatus(ctx context.Context, k8sClient client.Client, obj client.Object, lb DeployedLoadBalancer, reconcileErr error) error {
+	if isRequeueNeeded(reconcileErr) {
+		return nil
+	}
+	return patchReconcileStatus(ctx, k8sClient, obj, BuildReconcileStatus(obj, lb, reconcileErr))
+}
+
+// UpdateReconcileStatusPlannedChanges stores the changes planned for obj in dry-run mode into its reconcile status annotation.
+// The outcome of the last reconcile that wasn't in dry-run mode is kept.
+func UpdateReconcileStatusPlannedChanges(ctx context.Context, k8sClient client.Client, obj client.Object, changes PlannedChanges) error {
+	status, _ := GetReconcileStatus(obj)
+	changes.ObservedGeneration = obj.GetGeneration()
+	changes.Description = truncatePlannedChangesDescription(changes.Description)
+	status.PlannedChanges = &changes
+	return patchReconcileStatus(ctx, k8sClient, obj, status)
+}
+
+func patchReconcileStatus(ctx context.Context, k8sClient client.Client, obj client.Object, status ReconcileStatus) error {
+	rawStatus, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	if obj.GetAnnotations()[annotations.AnnotationReconcileStatus] == string(rawStatus) {
+		return nil
+	}
+
+	objOld := obj.DeepCopyObject().(client.Object)
+	objAnnotations := maps.Clone(obj.GetAnnotations())
+	if objAnnotations == nil {
+		objAnnotations = make(map[string]string)
+	}
+	objAnnotations[annotations.AnnotationReconcileStatus] = string(rawStatus)
+	obj.SetAnnotations(objAnnotations)
+	if err := k8sClient.Patch(ctx, obj, client.MergeFrom(objOld)); err != nil {
+		return errors.Wrapf(err, "failed to update reconcile status: %v", NamespacedName(obj))
+	}
+	return nil
+}
+
+// EqualAnnotationsIgnoringStatus checks whether annotations are equal, apart from the annotations the controller stores status into.
+// Updates of the status alone don't need to trigger reconciles.
+func EqualAnnotationsIgnoringStatus(lhs map[string]string, rhs map[string]string) bool {
+	return maps.EqualFunc(withoutStatusAnnotations(lhs), withoutStatusAnnotations(rhs), func(l string, r string) bool {
+		return l == r
+	})
+}
+
+func withoutStatusAnnotations(objAnnotations map[string]string) map[string]string {
+	_, hasReconcileStatus := objAnnotations[annotations.AnnotationReconcileStatus]
+	_, hasIngressGroupShard := objAnnotations[annotations.AnnotationIngressGroupShard]
+	if !hasReconcileStatus && !hasIngressGroupShard {
+		return objAnnotations
+	}
+	objAnnotations = maps.Clone(objAnnotations)
+	delete(objAnnotations, annotations.AnnotationReconcileStatus)
+	delete(objAnnotations, annotations.AnnotationIngressGroupShard)
+	return objAnnotations
+}
+
+// truncatePlannedChangesDescription truncates the description to whole lines, so that no change is listed partially.
+func truncatePlannedChangesDescription(description string) string {
+	if len(description) <= maxPlannedChangesDescriptionLength {
+		return description
+	}
+	truncated := description[:maxPlannedChangesDescriptionLength-len(plannedChangesTruncatedSuffix)]
+	if i := strings.LastIndex(truncated, "\n"); i >= 0 {
+		truncated = truncated[:i]
+	}
+	return truncated + plannedChangesTruncatedSuffix
+}
+
+func isRequeueNeeded(reconcileErr error) bool {
+	var errWithMetrics *errmetrics.ErrorWithMetrics
+	if errors.As(reconcileErr, &errWithMetrics) {
+		reconcileErr = errWithMetrics.Err
+	}
+	var requeueNeeded *runtime.RequeueNeeded
+	var requeueNeededAfter *runtime.RequeueNeededAfter
+	return errors.As(reconcileErr, &requeueNeeded) || errors.As(reconcileErr, &requeueNeededAfter)
+}
+
+// reconcileStatusReasonForErrorCategory converts metric error categories like "build_model_error" into reasons like "BuildModelError".
+func reconcileStatusReasonForErrorCategory(errorCategory string) string {
+	var reason strings.Builder
+	for _, word := range strings.Split(errorCategory, "_") {
+		if word == "" {
+			continue
+		}
+		reason.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	if reason.Len() == 0 {
+		return reconcileStatusReasonReconcileFailed
+	}
+	return reason.String()
+}
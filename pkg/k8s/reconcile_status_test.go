@@ -0,0 +1,269 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	errmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/error"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	ctrlruntime "sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_BuildReconcileStatus(t *testing.T) {
+	lastTransitionTime := metav1.Unix(1700000000, 0)
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		lb           DeployedLoadBalancer
+		reconcileErr error
+		want         ReconcileStatus
+		wantNewTime  bool
+	}{
+		{
+			name: "first successful reconcile",
+			lb:   DeployedLoadBalancer{ARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb-1/abc", Scheme: "internal"},
+			want: ReconcileStatus{
+				Condition: metav1.Condition{
+					Type:               "Reconciled",
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 3,
+					Reason:             "SuccessfullyReconciled",
+				},
+				LoadBalancerARN:    "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb-1/abc",
+				LoadBalancerScheme: "internal",
+			},
+			wantNewTime: true,
+		},
+		{
+			name: "failed reconcile keeps the load balancer",
+			annotations: map[string]string{
+				"elbv2.k8s.aws/reconcile-status": `{"type":"Reconciled","status":"True","observedGeneration":2,"lastTransitionTime":"2023-11-14T22:13:20Z","reason":"SuccessfullyReconciled","message":"","loadBalancerARN":"arn:lb-1","loadBalancerScheme":"internet-facing"}`,
+			},
+			reconcileErr: errmetrics.NewErrorWithMetrics("ingress", "build_model_error", errors.New("invalid annotation"), lbcmetrics.NewMockCollector()),
+			want: ReconcileStatus{
+				Condition: metav1.Condition{
+					Type:               "Reconciled",
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: 3,
+					Reason:             "BuildModelError",
+					Message:            "invalid annotation",
+				},
+				LoadBalancerARN:    "arn:lb-1",
+				LoadBalancerScheme: "internet-facing",
+			},
+			wantNewTime: true,
+		},
+		{
+			name: "successful reconcile keeps the last transition time",
+			annotations: map[string]string{
+				"elbv2.k8s.aws/reconcile-status": `{"type":"Reconciled","status":"True","observedGeneration":2,"lastTransitionTime":"2023-11-14T22:13:20Z","reason":"SuccessfullyReconciled","message":"","loadBalancerARN":"arn:lb-1"}`,
+			},
+			lb: DeployedLoadBalancer{ARN: "arn:lb-1", Scheme: "internal"},
+			want: ReconcileStatus{
+				Condition: metav1.Condition{
+					Type:               "Reconciled",
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 3,
+					LastTransitionTime: lastTransitionTime,
+					Reason:             "SuccessfullyReconciled",
+				},
+				LoadBalancerARN:    "arn:lb-1",
+				LoadBalancerScheme: "internal",
+			},
+		},
+		{
+			name:         "failed reconcile without error category",
+			reconcileErr: errors.New("some error"),
+			want: ReconcileStatus{
+				Condition: metav1.Condition{
+					Type:               "Reconciled",
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: 3,
+					Reason:             "ReconcileFailed",
+					Message:            "some error",
+				},
+			},
+			wantNewTime: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "ns-1",
+					Name:        "svc-1",
+					Generation:  3,
+					Annotations: tt.annotations,
+				},
+			}
+			got := BuildReconcileStatus(svc, tt.lb, tt.reconcileErr)
+			if tt.wantNewTime {
+				assert.False(t, got.LastTransitionTime.IsZero())
+				got.LastTransitionTime = metav1.Time{}
+			} else {
+				assert.True(t, tt.want.LastTransitionTime.Equal(&got.LastTransitionTime))
+				got.LastTransitionTime = tt.want.LastTransitionTime
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_UpdateReconcileStatus(t *testing.T) {
+	ctx := context.Background()
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "svc-1",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type": "external",
+			},
+		},
+	}
+	assert.NoError(t, k8sClient.Create(ctx, svc))
+
+	assert.NoError(t, UpdateReconcileStatus(ctx, k8sClient, svc, DeployedLoadBalancer{ARN: "arn:lb-1", Scheme: "internal"}, nil))
+	gotSvc := &corev1.Service{}
+	assert.NoError(t, k8sClient.Get(ctx, NamespacedName(svc), gotSvc))
+	status, exists := GetReconcileStatus(gotSvc)
+	assert.True(t, exists)
+	assert.Equal(t, metav1.ConditionTrue, status.Status)
+	assert.Equal(t, "arn:lb-1", status.LoadBalancerARN)
+	assert.Equal(t, "internal", status.LoadBalancerScheme)
+	assert.Equal(t, "external", gotSvc.Annotations["service.beta.kubernetes.io/aws-load-balancer-type"])
+
+	// requeues keep the previous status.
+	resourceVersion := gotSvc.ResourceVersion
+	assert.NoError(t, UpdateReconcileStatus(ctx, k8sClient, gotSvc, DeployedLoadBalancer{}, ctrlruntime.NewRequeueNeededAfter("monitor", 0)))
+	assert.NoError(t, UpdateReconcileStatus(ctx, k8sClient, gotSvc, DeployedLoadBalancer{}, ctrlruntime.NewRequeueNeeded("monitor")))
+	// unchanged status isn't updated.
+	assert.NoError(t, UpdateReconcileStatus(ctx, k8sClient, gotSvc, DeployedLoadBalancer{ARN: "arn:lb-1", Scheme: "internal"}, nil))
+	assert.NoError(t, k8sClient.Get(ctx, NamespacedName(svc), gotSvc))
+	assert.Equal(t, resourceVersion, gotSvc.ResourceVersion)
+
+	assert.NoError(t, UpdateReconcileStatus(ctx, k8sClient, gotSvc, DeployedLoadBalancer{}, errors.New("access denied")))
+	assert.NoError(t, k8sClient.Get(ctx, NamespacedName(svc), gotSvc))
+	status, exists = GetReconcileStatus(gotSvc)
+	assert.True(t, exists)
+	assert.Equal(t, metav1.ConditionFalse, status.Status)
+	assert.Equal(t, "access denied", status.Message)
+	assert.Equal(t, "arn:lb-1", status.LoadBalancerARN)
+}
+
+func Test_EqualAnnotationsIgnoringStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		lhs  map[string]string
+		rhs  map[string]string
+		want bool
+	}{
+		{
+			name: "equal annotations",
+			lhs:  map[string]string{"alb.ingress.kubernetes.io/scheme": "internal"},
+			rhs:  map[string]string{"alb.ingress.kubernetes.io/scheme": "internal"},
+			want: true,
+		},
+		{
+			name: "only reconcile status differs",
+			lhs:  map[string]string{"alb.ingress.kubernetes.io/scheme": "internal"},
+			rhs: map[string]string{
+				"alb.ingress.kubernetes.io/scheme": "internal",
+				"elbv2.k8s.aws/reconcile-status":   `{"type":"Reconciled","status":"True"}`,
+			},
+			want: true,
+		},
+		{
+			name: "only ingress group shard differs",
+			lhs: map[string]string{
+				"alb.ingress.kubernetes.io/scheme":  "internal",
+				"elbv2.k8s.aws/ingress-group-shard": "1",
+			},
+			rhs: map[string]string{
+				"alb.ingress.kubernetes.io/scheme":  "internal",
+				"elbv2.k8s.aws/ingress-group-shard": "2",
+			},
+			want: true,
+		},
+		{
+			name: "other annotations differ",
+			lhs: map[string]string{
+				"alb.ingress.kubernetes.io/scheme": "internal",
+				"elbv2.k8s.aws/reconcile-status":   `{"type":"Reconciled","status":"True"}`,
+			},
+			rhs: map[string]string{
+				"alb.ingress.kubernetes.io/scheme": "internet-facing",
+				"elbv2.k8s.aws/reconcile-status":   `{"type":"Reconciled","status":"True"}`,
+			},
+			want: false,
+		},
+		{
+			name: "nil and empty annotations",
+			lhs:  nil,
+			rhs:  map[string]string{},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, EqualAnnotationsIgnoringStatus(tt.lhs, tt.rhs))
+		})
+	}
+}
+
+func Test_UpdateReconcileStatusPlannedChanges(t *testing.T) {
+	ctx := context.Background()
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "ns-1",
+			Name:       "svc-1",
+			Generation: 2,
+			Annotations: map[string]string{
+				"elbv2.k8s.aws/reconcile-status": `{"type":"Reconciled","status":"True","observedGeneration":1,"lastTransitionTime":"2023-11-14T22:13:20Z","reason":"SuccessfullyReconciled","message":"","loadBalancerARN":"arn:lb-1","loadBalancerScheme":"internal"}`,
+			},
+		},
+	}
+	assert.NoError(t, k8sClient.Create(ctx, svc))
+
+	// the description is truncated to whole lines.
+	description := "0 to create, 200 to update, 0 to delete"
+	for i := 0; i < 200; i++ {
+		description += "\nUpdate elasticloadbalancing:AddTags arn:tg"
+	}
+	changes := PlannedChanges{Update: 200, Description: description}
+	assert.NoError(t, UpdateReconcileStatusPlannedChanges(ctx, k8sClient, svc, changes))
+	gotSvc := &corev1.Service{}
+	assert.NoError(t, k8sClient.Get(ctx, NamespacedName(svc), gotSvc))
+	status, exists := GetReconcileStatus(gotSvc)
+	assert.True(t, exists)
+	assert.Equal(t, metav1.ConditionTrue, status.Status)
+	assert.Equal(t, int64(1), status.ObservedGeneration)
+	assert.Equal(t, "arn:lb-1", status.LoadBalancerARN)
+	if assert.NotNil(t, status.PlannedChanges) {
+		assert.Equal(t, int64(2), status.PlannedChanges.ObservedGeneration)
+		assert.Equal(t, 200, status.PlannedChanges.Update)
+		assert.LessOrEqual(t, len(status.PlannedChanges.Description), 1024)
+		assert.True(t, strings.HasSuffix(status.PlannedChanges.Description, "arn:tg\n..."))
+	}
+
+	// reconciles without dry-run clear the planned changes.
+	assert.NoError(t, UpdateReconcileStatus(ctx, k8sClient, gotSvc, DeployedLoadBalancer{ARN: "arn:lb-1", Scheme: "internal"}, nil))
+	assert.NoError(t, k8sClient.Get(ctx, NamespacedName(svc), gotSvc))
+	status, exists = GetReconcileStatus(gotSvc)
+	assert.True(t, exists)
+	assert.Nil(t, status.PlannedChanges)
+	assert.Equal(t, int64(2), status.ObservedGeneration)
+}
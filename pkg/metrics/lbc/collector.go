@@ -26,6 +26,8 @@ type MetricCollector interface {
 	ObserveWebhookMutationError(webhookName string, errorType string)
 	StartCollectTopTalkers(ctx context.Context)
 	StartCollectCacheSize(ctx context.Context)
+	// ObserveLoadBalancerDrift records the number of changes needed to revert drift of the load balancer resources of an object.
+	ObserveLoadBalancerDrift(controller string, namespace string, name string, changes int)
 }
 
 type collector struct {
@@ -61,6 +63,9 @@ func (n *noOpCollector) StartCollectTopTalkers(_ context.Context) {
 func (n *noOpCollector) StartCollectCacheSize(_ context.Context) {
 }
 
+func (n *noOpCollector) ObserveLoadBalancerDrift(_ string, _ string, _ string, _ int) {
+}
+
 func NewCollector(registerer prometheus.Registerer, mgr ctrl.Manager, reconcileCounters *metricsutil.ReconcileCounters, logger logr.Logger) MetricCollector {
 	if registerer == nil {
 		return &noOpCollector{}
@@ -128,6 +133,19 @@ func (c *collector) ObserveControllerTopThreeTalkers(controller, namespace strin
 	}).Set(float64(count))
 }
 
+func (c *collector) ObserveLoadBalancerDrift(controller string, namespace string, name string, changes int) {
+	c.instruments.loadBalancerDriftChanges.With(prometheus.Labels{
+		labelController: controller,
+		labelNamespace:  namespace,
+		labelName:       name,
+	}).Set(float64(changes))
+	if changes > 0 {
+		c.instruments.loadBalancerDriftDetections.With(prometheus.Labels{
+			labelController: controller,
+		}).Inc()
+	}
+}
+
 func (c *collector) StartCollectCacheSize(ctx context.Context) {
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
@@ -24,6 +24,10 @@ const (
 	MetricControllerCacheObjectCount = "controller_cache_object_total"
 	// MetricTopTalker tracks what resources are causing the most reconciles.
 	MetricControllerTopTalkers = "controller_top_talkers"
+	// MetricLoadBalancerDriftChanges tracks the number of changes needed to revert drift of the load balancer resources of each object.
+	MetricLoadBalancerDriftChanges = "load_balancer_drift_changes"
+	// MetricLoadBalancerDriftDetections tracks the total number of times drift was detected.
+	MetricLoadBalancerDriftDetections = "load_balancer_drift_detections_total"
 )
 
 const (
@@ -44,6 +48,8 @@ type instruments struct {
 	webhookMutationFailure        *prometheus.CounterVec
 	controllerCacheObjectCount    *prometheus.GaugeVec
 	controllerReconcileTopTalkers *prometheus.GaugeVec
+	loadBalancerDriftChanges      *prometheus.GaugeVec
+	loadBalancerDriftDetections   *prometheus.CounterVec
 }
 
 // newInstruments allocates and register new metrics to registerer
@@ -92,7 +98,20 @@ func newInstruments(registerer prometheus.Registerer) *instruments {
 		Help:      "Counts the number of reconciliations triggered per resource",
 	}, []string{labelController, labelNamespace, labelName})
 
-	registerer.MustRegister(podReadinessFlipSeconds, controllerReconcileErrors, controllerReconcileStageDuration, webhookValidationFailure, webhookMutationFailure, controllerCacheObjectCount, controllerReconcileTopTalkers)
+	loadBalancerDriftChanges := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystem,
+		Name:      MetricLoadBalancerDriftChanges,
+		Help:      "Number of changes needed to revert drift of the load balancer resources of an object, as of the last audit.",
+	}, []string{labelController, labelNamespace, labelName})
+
+	loadBalancerDriftDetections := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystem,
+		Name:      MetricLoadBalancerDriftDetections,
+		Help:      "Counts the number of audits that detected drift of load balancer resources.",
+	}, []string{labelController})
+
+	registerer.MustRegister(podReadinessFlipSeconds, controllerReconcileErrors, controllerReconcileStageDuration, webhookValidationFailure, webhookMutationFailure, controllerCacheObjectCount, controllerReconcileTopTalkers,
+		loadBalancerDriftChanges, loadBalancerDriftDetections)
 	return &instruments{
 		podReadinessFlipSeconds:       podReadinessFlipSeconds,
 		controllerReconcileErrors:     controllerReconcileErrors,
@@ -101,5 +120,7 @@ func newInstruments(registerer prometheus.Registerer) *instruments {
 		webhookMutationFailure:        webhookMutationFailure,
 		controllerCacheObjectCount:    controllerCacheObjectCount,
 		controllerReconcileTopTalkers: controllerReconcileTopTalkers,
+		loadBalancerDriftChanges:      loadBalancerDriftChanges,
+		loadBalancerDriftDetections:   loadBalancerDriftDetections,
 	}
 }
@@ -26,6 +26,13 @@ type MockHistogramMetric struct {
 	duration  time.Duration
 }
 
+type MockDriftMetric struct {
+	controller string
+	namespace  string
+	name       string
+	changes    int
+}
+
 type MockCounterMetric struct {
 	labelController    string
 	labelErrorCategory string
@@ -82,6 +89,15 @@ func (m *MockCollector) ObserveControllerTopTalkers(controller, namespace string
 	})
 }
 
+func (m *MockCollector) ObserveLoadBalancerDrift(controller string, namespace string, name string, changes int) {
+	m.Invocations[MetricLoadBalancerDriftChanges] = append(m.Invocations[MetricLoadBalancerDriftChanges], MockDriftMetric{
+		controller: controller,
+		namespace:  namespace,
+		name:       name,
+		changes:    changes,
+	})
+}
+
 func (m *MockCollector) recordHistogram(metricName string, namespace string, name string, d time.Duration) {
 	m.Invocations[metricName] = append(m.Invocations[MetricPodReadinessGateReady], MockHistogramMetric{
 		namespace: namespace,
@@ -159,6 +175,7 @@ func NewMockCollector() MetricCollector {
 	mockInvocations[MetricWebhookMutationFailure] = make([]interface{}, 0)
 	mockInvocations[MetricControllerCacheObjectCount] = make([]interface{}, 0)
 	mockInvocations[MetricControllerTopTalkers] = make([]interface{}, 0)
+	mockInvocations[MetricLoadBalancerDriftChanges] = make([]interface{}, 0)
 
 	return &MockCollector{
 		Invocations: mockInvocations,
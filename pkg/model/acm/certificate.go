@@ -0,0 +1,73 @@
+package acm
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &Certificate{}
+
+// Certificate represents a certificate imported into ACM.
+type Certificate struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of Certificate
+	Spec CertificateSpec `json:"spec"`
+
+	// observed state of Certificate
+	// +optional
+	Status *CertificateStatus `json:"status,omitempty"`
+}
+
+// NewCertificate constructs new Certificate resource.
+func NewCertificate(stack core.Stack, id string, spec CertificateSpec) *Certificate {
+	cert := &Certificate{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::CertificateManager::Certificate", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(cert)
+	return cert
+}
+
+// SetStatus sets the Certificate's status
+func (c *Certificate) SetStatus(status CertificateStatus) {
+	c.Status = &status
+}
+
+// CertificateARN returns The Amazon Resource Name (ARN) of the certificate.
+func (c *Certificate) CertificateARN() core.StringToken {
+	return core.NewResourceFieldStringToken(c, "status/certificateARN",
+		func(ctx context.Context, res core.Resource, fieldPath string) (s string, err error) {
+			cert := res.(*Certificate)
+			if cert.Status == nil {
+				return "", errors.Errorf("Certificate is not fulfilled yet: %v", cert.ID())
+			}
+			return cert.Status.CertificateARN, nil
+		},
+	)
+}
+
+// CertificateSpec defines the desired state of Certificate
+type CertificateSpec struct {
+	// The PEM encoded certificate, certificate chain and private key are never marshalled,
+	// so that the key material doesn't end up in logs.
+	Certificate      []byte `json:"-"`
+	CertificateChain []byte `json:"-"`
+	PrivateKey       []byte `json:"-"`
+
+	// The digest of the certificate material, used to detect when the certificate needs to be re-imported.
+	Digest string `json:"digest"`
+
+	// The tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// CertificateStatus defines the observed state of Certificate
+type CertificateStatus struct {
+	// The Amazon Resource Name (ARN) of the certificate.
+	CertificateARN string `json:"certificateARN"`
+}
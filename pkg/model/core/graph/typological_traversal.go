@@ -2,22 +2,12 @@ package graph
 
 import (
 	"github.com/pkg/errors"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 )
 
 // TopologicalTraversal will traversal nodes in typological order.
-// @TODO: change this traversal to be parallel.
 func TopologicalTraversal(graph ResourceGraph, visitFunc func(uid ResourceUID) error) error {
-	nodes := graph.Nodes()
-	indegreeByNode := make(map[ResourceUID]int, len(nodes))
-	for _, node := range nodes {
-		if _, ok := indegreeByNode[node]; !ok {
-			indegreeByNode[node] = 0
-		}
-		for _, outEdgeNode := range graph.OutEdgeNodes(node) {
-			indegreeByNode[outEdgeNode]++
-		}
-
-	}
+	indegreeByNode := computeIndegreeByNode(graph)
 
 	var queue []ResourceUID
 	for node, indegree := range indegreeByNode {
@@ -48,3 +38,98 @@ func TopologicalTraversal(graph ResourceGraph, visitFunc func(uid ResourceUID) e
 	}
 	return nil
 }
+
+// ParallelTopologicalTraversal will traversal nodes in typological order, visiting up to maxConcurrency nodes
+// whose dependencies are all visited concurrently.
+// Once a visit fails, no more nodes are visited and the visits in progress are waited for. The errors of failed visits
+// are returned in the order nodes were added to the graph, so that the result doesn't depend on scheduling.
+func ParallelTopologicalTraversal(graph ResourceGraph, maxConcurrency int, visitFunc func(uid ResourceUID) error) error {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+	nodes := graph.Nodes()
+	indegreeByNode := computeIndegreeByNode(graph)
+
+	var queue []ResourceUID
+	for _, node := range nodes {
+		if indegreeByNode[node] == 0 {
+			queue = append(queue, node)
+		}
+	}
+
+	type visitResult struct {
+		node ResourceUID
+		err  error
+	}
+	resultChan := make(chan visitResult)
+	errByNode := make(map[ResourceUID]error)
+	visiting := 0
+	for {
+		for len(errByNode) == 0 && len(queue) > 0 && visiting < maxConcurrency {
+			node := queue[0]
+			queue = queue[1:]
+			visiting++
+			go func() {
+				resultChan <- visitResult{node: node, err: visitFunc(node)}
+			}()
+		}
+		if visiting == 0 {
+			break
+		}
+
+		result := <-resultChan
+		visiting--
+		if result.err != nil {
+			errByNode[result.node] = result.err
+			continue
+		}
+		for _, outEdgeNode := range graph.OutEdgeNodes(result.node) {
+			indegreeByNode[outEdgeNode]--
+			if indegreeByNode[outEdgeNode] == 0 {
+				queue = append(queue, outEdgeNode)
+			}
+		}
+	}
+
+	if len(errByNode) != 0 {
+		var errs []error
+		for _, node := range nodes {
+			if err, ok := errByNode[node]; ok {
+				errs = append(errs, err)
+			}
+		}
+		return aggregateErrors(errs)
+	}
+	for _, indegree := range indegreeByNode {
+		if indegree > 0 {
+			return errors.New("ResourceGraph is not a DAG")
+		}
+	}
+	return nil
+}
+
+// aggregateErrors aggregates the errors of visits, a single error is returned as is so that it can still be inspected with errors.As.
+func aggregateErrors(errs []error) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		return utilerrors.NewAggregate(errs)
+	}
+}
+
+func computeIndegreeByNode(graph ResourceGraph) map[ResourceUID]int {
+	nodes := graph.Nodes()
+	indegreeByNode := make(map[ResourceUID]int, len(nodes))
+	for _, node := range nodes {
+		if _, ok := indegreeByNode[node]; !ok {
+			indegreeByNode[node] = 0
+		}
+		for _, outEdgeNode := range graph.OutEdgeNodes(node) {
+			indegreeByNode[outEdgeNode]++
+		}
+	}
+	return indegreeByNode
+}
@@ -0,0 +1,151 @@
+package graph
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParallelTopologicalTraversal(t *testing.T) {
+	tests := []struct {
+		name           string
+		buildGraph     func(graph ResourceGraph)
+		maxConcurrency int
+		failedNodes    map[string]error
+		wantVisited    []string
+		wantErr        error
+	}{
+		{
+			name: "visits dependencies first",
+			buildGraph: func(graph ResourceGraph) {
+				graph.AddNode(fakeResourceUID("node-A"))
+				graph.AddNode(fakeResourceUID("node-B"))
+				graph.AddNode(fakeResourceUID("node-C"))
+				graph.AddNode(fakeResourceUID("node-D"))
+				graph.AddEdge(fakeResourceUID("node-A"), fakeResourceUID("node-B"))
+				graph.AddEdge(fakeResourceUID("node-A"), fakeResourceUID("node-C"))
+				graph.AddEdge(fakeResourceUID("node-B"), fakeResourceUID("node-D"))
+				graph.AddEdge(fakeResourceUID("node-C"), fakeResourceUID("node-D"))
+			},
+			maxConcurrency: 2,
+			wantVisited:    []string{"node-A", "node-B", "node-C", "node-D"},
+		},
+		{
+			name: "visits sequentially with non-positive concurrency",
+			buildGraph: func(graph ResourceGraph) {
+				graph.AddNode(fakeResourceUID("node-A"))
+				graph.AddNode(fakeResourceUID("node-B"))
+			},
+			maxConcurrency: 0,
+			wantVisited:    []string{"node-A", "node-B"},
+		},
+		{
+			name: "stops visiting dependents of failed nodes",
+			buildGraph: func(graph ResourceGraph) {
+				graph.AddNode(fakeResourceUID("node-A"))
+				graph.AddNode(fakeResourceUID("node-B"))
+				graph.AddEdge(fakeResourceUID("node-A"), fakeResourceUID("node-B"))
+			},
+			maxConcurrency: 2,
+			failedNodes:    map[string]error{"node-A": errors.New("node-A failed")},
+			wantVisited:    []string{"node-A"},
+			wantErr:        errors.New("node-A failed"),
+		},
+		{
+			name: "aggregates errors in node order",
+			buildGraph: func(graph ResourceGraph) {
+				graph.AddNode(fakeResourceUID("node-A"))
+				graph.AddNode(fakeResourceUID("node-B"))
+				graph.AddNode(fakeResourceUID("node-C"))
+				graph.AddEdge(fakeResourceUID("node-A"), fakeResourceUID("node-C"))
+				graph.AddEdge(fakeResourceUID("node-B"), fakeResourceUID("node-C"))
+			},
+			maxConcurrency: 2,
+			failedNodes: map[string]error{
+				"node-A": errors.New("node-A failed"),
+				"node-B": errors.New("node-B failed"),
+			},
+			wantVisited: []string{"node-A", "node-B"},
+			wantErr:     errors.New("[node-A failed, node-B failed]"),
+		},
+		{
+			name: "detects cycles",
+			buildGraph: func(graph ResourceGraph) {
+				graph.AddNode(fakeResourceUID("node-A"))
+				graph.AddNode(fakeResourceUID("node-B"))
+				graph.AddNode(fakeResourceUID("node-C"))
+				graph.AddEdge(fakeResourceUID("node-A"), fakeResourceUID("node-B"))
+				graph.AddEdge(fakeResourceUID("node-B"), fakeResourceUID("node-C"))
+				graph.AddEdge(fakeResourceUID("node-C"), fakeResourceUID("node-B"))
+			},
+			maxConcurrency: 2,
+			wantVisited:    []string{"node-A"},
+			wantErr:        errors.New("ResourceGraph is not a DAG"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := NewDefaultResourceGraph()
+			tt.buildGraph(graph)
+
+			var mutex sync.Mutex
+			visitedNodes := make(map[string]bool)
+			err := ParallelTopologicalTraversal(graph, tt.maxConcurrency, func(uid ResourceUID) error {
+				mutex.Lock()
+				defer mutex.Unlock()
+				for _, node := range graph.Nodes() {
+					for _, outEdgeNode := range graph.OutEdgeNodes(node) {
+						if outEdgeNode == uid {
+							assert.True(t, visitedNodes[node.ResID], "%v visited before its dependency %v", uid.ResID, node.ResID)
+						}
+					}
+				}
+				visitedNodes[uid.ResID] = true
+				return tt.failedNodes[uid.ResID]
+			})
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			var gotVisited []string
+			for _, node := range graph.Nodes() {
+				if visitedNodes[node.ResID] {
+					gotVisited = append(gotVisited, node.ResID)
+				}
+			}
+			assert.Equal(t, tt.wantVisited, gotVisited)
+		})
+	}
+}
+
+func Test_ParallelTopologicalTraversal_concurrency(t *testing.T) {
+	graph := NewDefaultResourceGraph()
+	graph.AddNode(fakeResourceUID("node-A"))
+	graph.AddNode(fakeResourceUID("node-B"))
+	graph.AddNode(fakeResourceUID("node-C"))
+
+	// node-A and node-B only complete once both are visited, which deadlocks unless they are visited concurrently.
+	var started sync.WaitGroup
+	started.Add(2)
+	var mutex sync.Mutex
+	visiting, maxVisiting := 0, 0
+	err := ParallelTopologicalTraversal(graph, 2, func(uid ResourceUID) error {
+		mutex.Lock()
+		visiting++
+		maxVisiting = max(maxVisiting, visiting)
+		mutex.Unlock()
+		if uid.ResID != "node-C" {
+			started.Done()
+			started.Wait()
+		}
+		mutex.Lock()
+		visiting--
+		mutex.Unlock()
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, maxVisiting)
+}
@@ -57,6 +57,14 @@ func (ls *Listener) registerDependencies(stack core.Stack) {
 	for _, dep := range ls.Spec.LoadBalancerARN.Dependencies() {
 		stack.AddDependency(dep, ls)
 	}
+	for _, cert := range ls.Spec.Certificates {
+		if cert.ImportedCertificateARN == nil {
+			continue
+		}
+		for _, dep := range cert.ImportedCertificateARN.Dependencies() {
+			stack.AddDependency(dep, ls)
+		}
+	}
 }
 
 type Protocol string
@@ -310,6 +318,11 @@ type Certificate struct {
 	// The Amazon Resource Name (ARN) of the certificate.
 	// +optional
 	CertificateARN *string `json:"certificateARN,omitempty"`
+
+	// The ARN of a certificate imported into ACM by the controller, which is only known once imported.
+	// Either CertificateARN or ImportedCertificateARN is set.
+	// +optional
+	ImportedCertificateARN core.StringToken `json:"importedCertificateARN,omitempty"`
 }
 
 // ALPNPolicy ALPN policy configuration for TLS listeners forwarding to TLS target groups
@@ -0,0 +1,150 @@
+package render
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	acmsdk "github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+// newEnvironmentACM constructs an ACM client that answers read calls from the Environment.
+func newEnvironmentACM(env Environment) services.ACM {
+	return &environmentACM{env: env}
+}
+
+var _ services.ACM = &environmentACM{}
+
+type environmentACM struct {
+	env Environment
+}
+
+func (c *environmentACM) ListCertificatesAsList(_ context.Context, _ *acmsdk.ListCertificatesInput) ([]acmtypes.CertificateSummary, error) {
+	var certSummaries []acmtypes.CertificateSummary
+	for _, envCert := range c.env.Certificates {
+		certSummaries = append(certSummaries, acmtypes.CertificateSummary{
+			CertificateArn: awssdk.String(envCert.ARN),
+			DomainName:     awssdk.String(envCert.DomainName),
+			Status:         acmtypes.CertificateStatusIssued,
+		})
+	}
+	return certSummaries, nil
+}
+
+func (c *environmentACM) DescribeCertificateWithContext(_ context.Context, req *acmsdk.DescribeCertificateInput) (*acmsdk.DescribeCertificateOutput, error) {
+	for _, envCert := range c.env.Certificates {
+		if envCert.ARN != awssdk.ToString(req.CertificateArn) {
+			continue
+		}
+		sans := sets.New(envCert.SubjectAlternativeNames...).Insert(envCert.DomainName)
+		cert := &acmtypes.CertificateDetail{
+			CertificateArn:          awssdk.String(envCert.ARN),
+			DomainName:              awssdk.String(envCert.DomainName),
+			SubjectAlternativeNames: sets.List(sans),
+			Status:                  acmtypes.CertificateStatusIssued,
+			Type:                    acmtypes.CertificateTypeAmazonIssued,
+		}
+		if envCert.CertificateAuthorityARN != "" {
+			cert.CertificateAuthorityArn = awssdk.String(envCert.CertificateAuthorityARN)
+			cert.Type = acmtypes.CertificateTypePrivate
+		}
+		return &acmsdk.DescribeCertificateOutput{Certificate: cert}, nil
+	}
+	return nil, &acmtypes.ResourceNotFoundException{Message: req.CertificateArn}
+}
+
+func (c *environmentACM) ImportCertificateWithContext(_ context.Context, _ *acmsdk.ImportCertificateInput) (*acmsdk.ImportCertificateOutput, error) {
+	return nil, errOffline
+}
+
+func (c *environmentACM) DeleteCertificateWithContext(_ context.Context, _ *acmsdk.DeleteCertificateInput) (*acmsdk.DeleteCertificateOutput, error) {
+	return nil, errOffline
+}
+
+func (c *environmentACM) AddTagsToCertificateWithContext(_ context.Context, _ *acmsdk.AddTagsToCertificateInput) (*acmsdk.AddTagsToCertificateOutput, error) {
+	return nil, errOffline
+}
+
+// newEnvironmentELBV2 constructs an ELBV2 client that answers the read calls made by model builders from the Environment.
+// Model builders don't call other ELBV2 APIs, they look up existing load balancers through the TaggingManager instead.
+func newEnvironmentELBV2(env Environment) services.ELBV2 {
+	return &environmentELBV2{env: env}
+}
+
+type environmentELBV2 struct {
+	services.ELBV2
+	env Environment
+}
+
+func (c *environmentELBV2) DescribeTrustStoresWithContext(_ context.Context, input *elbv2sdk.DescribeTrustStoresInput) (*elbv2sdk.DescribeTrustStoresOutput, error) {
+	output := &elbv2sdk.DescribeTrustStoresOutput{}
+	for _, envTrustStore := range c.env.TrustStores {
+		if !matchesIDs(input.Names, envTrustStore.Name) || !matchesIDs(input.TrustStoreArns, envTrustStore.ARN) {
+			continue
+		}
+		output.TrustStores = append(output.TrustStores, elbv2types.TrustStore{
+			Name:          awssdk.String(envTrustStore.Name),
+			TrustStoreArn: awssdk.String(envTrustStore.ARN),
+		})
+	}
+	return output, nil
+}
+
+// newEmptyTaggingManager constructs a TaggingManager that finds no existing resources,
+// so that stacks are rendered as if their load balancers were created from scratch.
+func newEmptyTaggingManager() elbv2deploy.TaggingManager {
+	return &emptyTaggingManager{}
+}
+
+type emptyTaggingManager struct{}
+
+func (m *emptyTaggingManager) ReconcileTags(_ context.Context, _ string, _ map[string]string, _ ...elbv2deploy.ReconcileTagsOption) error {
+	return errOffline
+}
+
+func (m *emptyTaggingManager) ListLoadBalancers(_ context.Context, _ ...tracking.TagFilter) ([]elbv2deploy.LoadBalancerWithTags, error) {
+	return nil, nil
+}
+
+func (m *emptyTaggingManager) ListTargetGroups(_ context.Context, _ ...tracking.TagFilter) ([]elbv2deploy.TargetGroupWithTags, error) {
+	return nil, nil
+}
+
+func (m *emptyTaggingManager) ListListeners(_ context.Context, _ string) ([]elbv2deploy.ListenerWithTags, error) {
+	return nil, nil
+}
+
+func (m *emptyTaggingManager) ListListenerRules(_ context.Context, _ string) ([]elbv2deploy.ListenerRuleWithTags, error) {
+	return nil, nil
+}
+
+// autoGeneratedBackendSGID is rendered in place of the ID of the backend security group the controller would create.
+const autoGeneratedBackendSGID = "<auto-generated-backend-security-group>"
+
+// newStaticBackendSGProvider constructs a BackendSGProvider that always returns the same security group.
+func newStaticBackendSGProvider(backendSG string) networking.BackendSGProvider {
+	if backendSG == "" {
+		backendSG = autoGeneratedBackendSGID
+	}
+	return &staticBackendSGProvider{backendSG: backendSG}
+}
+
+type staticBackendSGProvider struct {
+	backendSG string
+}
+
+func (p *staticBackendSGProvider) Get(_ context.Context, _ networking.ResourceType, _ []types.NamespacedName) (string, error) {
+	return p.backendSG, nil
+}
+
+func (p *staticBackendSGProvider) Release(_ context.Context, _ networking.ResourceType, _ []types.NamespacedName) error {
+	return nil
+}
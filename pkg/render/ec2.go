@@ -0,0 +1,307 @@
+package render
+
+import (
+	"context"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	defaultAvailableIPAddressCount = 256
+	internetGatewayID              = "igw-render"
+)
+
+// errOffline is returned by the calls that would change AWS resources, which are never made while rendering.
+var errOffline = errors.New("AWS resources can't be changed while rendering offline")
+
+// newEnvironmentEC2 constructs an EC2 client that answers read calls from the Environment.
+func newEnvironmentEC2(env Environment) services.EC2 {
+	return &environmentEC2{env: env}
+}
+
+var _ services.EC2 = &environmentEC2{}
+
+type environmentEC2 struct {
+	env Environment
+}
+
+func (c *environmentEC2) DescribeInstancesAsList(_ context.Context, _ *ec2sdk.DescribeInstancesInput) ([]ec2types.Instance, error) {
+	return nil, nil
+}
+
+func (c *environmentEC2) DescribeInstancesWithContext(_ context.Context, _ *ec2sdk.DescribeInstancesInput) (*ec2sdk.DescribeInstancesOutput, error) {
+	return &ec2sdk.DescribeInstancesOutput{}, nil
+}
+
+func (c *environmentEC2) DescribeNetworkInterfacesAsList(_ context.Context, _ *ec2sdk.DescribeNetworkInterfacesInput) ([]ec2types.NetworkInterface, error) {
+	return nil, nil
+}
+
+func (c *environmentEC2) DescribeSecurityGroupsAsList(_ context.Context, input *ec2sdk.DescribeSecurityGroupsInput) ([]ec2types.SecurityGroup, error) {
+	var sgs []ec2types.SecurityGroup
+	for _, envSG := range c.env.SecurityGroups {
+		tags := envSG.Tags
+		if envSG.Name != "" {
+			tags = mergeNameTag(tags, envSG.Name)
+		}
+		fields := map[string]string{
+			"group-id":   envSG.ID,
+			"group-name": envSG.Name,
+			"vpc-id":     c.env.VPC.ID,
+		}
+		if !matchesIDs(input.GroupIds, envSG.ID) || !matchesFilters(input.Filters, fields, tags) {
+			continue
+		}
+		sgs = append(sgs, ec2types.SecurityGroup{
+			GroupId:   awssdk.String(envSG.ID),
+			GroupName: awssdk.String(envSG.Name),
+			VpcId:     awssdk.String(c.env.VPC.ID),
+			Tags:      buildEC2Tags(tags),
+		})
+	}
+	return sgs, nil
+}
+
+func (c *environmentEC2) DescribeSubnetsAsList(_ context.Context, input *ec2sdk.DescribeSubnetsInput) ([]ec2types.Subnet, error) {
+	var subnets []ec2types.Subnet
+	for _, envSubnet := range c.env.Subnets {
+		fields := map[string]string{
+			"subnet-id":            envSubnet.ID,
+			"vpc-id":               c.env.VPC.ID,
+			"availability-zone":    envSubnet.AvailabilityZone,
+			"availability-zone-id": envSubnet.AvailabilityZoneID,
+		}
+		if !matchesIDs(input.SubnetIds, envSubnet.ID) || !matchesFilters(input.Filters, fields, envSubnet.Tags) {
+			continue
+		}
+		subnets = append(subnets, buildSDKSubnet(c.env.VPC.ID, envSubnet))
+	}
+	return subnets, nil
+}
+
+func (c *environmentEC2) DescribeVPCsAsList(_ context.Context, input *ec2sdk.DescribeVpcsInput) ([]ec2types.Vpc, error) {
+	if !matchesIDs(input.VpcIds, c.env.VPC.ID) {
+		return nil, nil
+	}
+	return []ec2types.Vpc{buildSDKVPC(c.env.VPC)}, nil
+}
+
+func (c *environmentEC2) DescribeVpcsWithContext(ctx context.Context, input *ec2sdk.DescribeVpcsInput) (*ec2sdk.DescribeVpcsOutput, error) {
+	vpcs, err := c.DescribeVPCsAsList(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if len(vpcs) == 0 {
+		return nil, errors.Errorf("vpc %v not found in environment", input.VpcIds)
+	}
+	return &ec2sdk.DescribeVpcsOutput{Vpcs: vpcs}, nil
+}
+
+// DescribeRouteTablesAsList returns a main route table without a route to an internet gateway,
+// plus a route table with such a route that's associated with every public subnet.
+func (c *environmentEC2) DescribeRouteTablesAsList(_ context.Context, _ *ec2sdk.DescribeRouteTablesInput) ([]ec2types.RouteTable, error) {
+	mainRT := ec2types.RouteTable{
+		RouteTableId: awssdk.String("rtb-main"),
+		VpcId:        awssdk.String(c.env.VPC.ID),
+		Associations: []ec2types.RouteTableAssociation{
+			{
+				Main: awssdk.Bool(true),
+			},
+		},
+	}
+	publicRT := ec2types.RouteTable{
+		RouteTableId: awssdk.String("rtb-public"),
+		VpcId:        awssdk.String(c.env.VPC.ID),
+		Routes: []ec2types.Route{
+			{
+				DestinationCidrBlock: awssdk.String("0.0.0.0/0"),
+				GatewayId:            awssdk.String(internetGatewayID),
+			},
+		},
+	}
+	for _, envSubnet := range c.env.Subnets {
+		if envSubnet.Public {
+			publicRT.Associations = append(publicRT.Associations, ec2types.RouteTableAssociation{
+				Main:     awssdk.Bool(false),
+				SubnetId: awssdk.String(envSubnet.ID),
+			})
+		}
+	}
+	return []ec2types.RouteTable{mainRT, publicRT}, nil
+}
+
+func (c *environmentEC2) DescribeAvailabilityZonesWithContext(_ context.Context, input *ec2sdk.DescribeAvailabilityZonesInput) (*ec2sdk.DescribeAvailabilityZonesOutput, error) {
+	azByID := make(map[string]ec2types.AvailabilityZone)
+	for _, envSubnet := range c.env.Subnets {
+		if !matchesIDs(input.ZoneIds, envSubnet.AvailabilityZoneID) {
+			continue
+		}
+		zoneType := envSubnet.ZoneType
+		if zoneType == "" {
+			zoneType = defaultZoneType
+		}
+		azByID[envSubnet.AvailabilityZoneID] = ec2types.AvailabilityZone{
+			ZoneId:     awssdk.String(envSubnet.AvailabilityZoneID),
+			ZoneName:   awssdk.String(envSubnet.AvailabilityZone),
+			ZoneType:   awssdk.String(zoneType),
+			RegionName: awssdk.String(c.env.Region),
+		}
+	}
+	output := &ec2sdk.DescribeAvailabilityZonesOutput{}
+	for _, az := range azByID {
+		output.AvailabilityZones = append(output.AvailabilityZones, az)
+	}
+	return output, nil
+}
+
+func (c *environmentEC2) CreateTagsWithContext(_ context.Context, _ *ec2sdk.CreateTagsInput) (*ec2sdk.CreateTagsOutput, error) {
+	return nil, errOffline
+}
+
+func (c *environmentEC2) DeleteTagsWithContext(_ context.Context, _ *ec2sdk.DeleteTagsInput) (*ec2sdk.DeleteTagsOutput, error) {
+	return nil, errOffline
+}
+
+func (c *environmentEC2) CreateSecurityGroupWithContext(_ context.Context, _ *ec2sdk.CreateSecurityGroupInput) (*ec2sdk.CreateSecurityGroupOutput, error) {
+	return nil, errOffline
+}
+
+func (c *environmentEC2) DeleteSecurityGroupWithContext(_ context.Context, _ *ec2sdk.DeleteSecurityGroupInput) (*ec2sdk.DeleteSecurityGroupOutput, error) {
+	return nil, errOffline
+}
+
+func (c *environmentEC2) AuthorizeSecurityGroupIngressWithContext(_ context.Context, _ *ec2sdk.AuthorizeSecurityGroupIngressInput) (*ec2sdk.AuthorizeSecurityGroupIngressOutput, error) {
+	return nil, errOffline
+}
+
+func (c *environmentEC2) RevokeSecurityGroupIngressWithContext(_ context.Context, _ *ec2sdk.RevokeSecurityGroupIngressInput) (*ec2sdk.RevokeSecurityGroupIngressOutput, error) {
+	return nil, errOffline
+}
+
+func buildSDKSubnet(vpcID string, envSubnet Subnet) ec2types.Subnet {
+	availableIPAddressCount := int32(defaultAvailableIPAddressCount)
+	if envSubnet.AvailableIPAddressCount != nil {
+		availableIPAddressCount = *envSubnet.AvailableIPAddressCount
+	}
+	subnet := ec2types.Subnet{
+		SubnetId:                awssdk.String(envSubnet.ID),
+		VpcId:                   awssdk.String(vpcID),
+		AvailabilityZone:        awssdk.String(envSubnet.AvailabilityZone),
+		AvailabilityZoneId:      awssdk.String(envSubnet.AvailabilityZoneID),
+		CidrBlock:               awssdk.String(envSubnet.CIDRBlock),
+		AvailableIpAddressCount: awssdk.Int32(availableIPAddressCount),
+		Tags:                    buildEC2Tags(envSubnet.Tags),
+	}
+	if envSubnet.IPv6CIDRBlock != "" {
+		subnet.Ipv6CidrBlockAssociationSet = []ec2types.SubnetIpv6CidrBlockAssociation{
+			{
+				Ipv6CidrBlock: awssdk.String(envSubnet.IPv6CIDRBlock),
+				Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+					State: ec2types.SubnetCidrBlockStateCodeAssociated,
+				},
+			},
+		}
+	}
+	if envSubnet.OutpostARN != "" {
+		subnet.OutpostArn = awssdk.String(envSubnet.OutpostARN)
+	}
+	return subnet
+}
+
+func buildSDKVPC(envVPC VPC) ec2types.Vpc {
+	vpc := ec2types.Vpc{
+		VpcId: awssdk.String(envVPC.ID),
+	}
+	for i, cidr := range envVPC.CIDRBlocks {
+		if i == 0 {
+			vpc.CidrBlock = awssdk.String(cidr)
+		}
+		vpc.CidrBlockAssociationSet = append(vpc.CidrBlockAssociationSet, ec2types.VpcCidrBlockAssociation{
+			CidrBlock: awssdk.String(cidr),
+			CidrBlockState: &ec2types.VpcCidrBlockState{
+				State: ec2types.VpcCidrBlockStateCodeAssociated,
+			},
+		})
+	}
+	for _, cidr := range envVPC.IPv6CIDRBlocks {
+		vpc.Ipv6CidrBlockAssociationSet = append(vpc.Ipv6CidrBlockAssociationSet, ec2types.VpcIpv6CidrBlockAssociation{
+			Ipv6CidrBlock: awssdk.String(cidr),
+			Ipv6CidrBlockState: &ec2types.VpcCidrBlockState{
+				State: ec2types.VpcCidrBlockStateCodeAssociated,
+			},
+		})
+	}
+	return vpc
+}
+
+func buildEC2Tags(tags map[string]string) []ec2types.Tag {
+	var sdkTags []ec2types.Tag
+	for _, key := range sets.List(sets.KeySet(tags)) {
+		sdkTags = append(sdkTags, ec2types.Tag{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(tags[key]),
+		})
+	}
+	return sdkTags
+}
+
+func mergeNameTag(tags map[string]string, name string) map[string]string {
+	if _, exists := tags["Name"]; exists {
+		return tags
+	}
+	merged := make(map[string]string, len(tags)+1)
+	for key, value := range tags {
+		merged[key] = value
+	}
+	merged["Name"] = name
+	return merged
+}
+
+// matchesIDs checks whether id is in ids, an empty ids matches any id.
+func matchesIDs(ids []string, id string) bool {
+	if len(ids) == 0 {
+		return true
+	}
+	return sets.New(ids...).Has(id)
+}
+
+// matchesFilters checks whether a resource with the fields and tags matches every EC2 filter.
+// It supports the "tag:<key>" and "tag-key" filters, and filters on the given fields.
+func matchesFilters(filters []ec2types.Filter, fields map[string]string, tags map[string]string) bool {
+	for _, filter := range filters {
+		filterName := awssdk.ToString(filter.Name)
+		var value string
+		var exists bool
+		switch {
+		case strings.HasPrefix(filterName, "tag:"):
+			value, exists = tags[strings.TrimPrefix(filterName, "tag:")]
+		case filterName == "tag-key":
+			for _, key := range filter.Values {
+				if _, ok := tags[key]; ok {
+					exists = true
+				}
+			}
+			if !exists {
+				return false
+			}
+			continue
+		default:
+			value, exists = fields[filterName]
+		}
+		if !exists || !matchesFilterValues(filter.Values, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesFilterValues checks whether value equals any of the filter values.
+func matchesFilterValues(filterValues []string, value string) bool {
+	return sets.New(filterValues...).Has(value)
+}
@@ -0,0 +1,116 @@
+package render
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_environmentEC2_DescribeSubnetsAsList(t *testing.T) {
+	env := Environment{
+		VPC: VPC{ID: "vpc-1"},
+		Subnets: []Subnet{
+			{
+				ID:                 "subnet-a",
+				AvailabilityZone:   "us-west-2a",
+				AvailabilityZoneID: "usw2-az1",
+				Public:             true,
+				Tags:               map[string]string{"kubernetes.io/role/elb": "1"},
+			},
+			{
+				ID:                 "subnet-b",
+				AvailabilityZone:   "us-west-2b",
+				AvailabilityZoneID: "usw2-az2",
+				Tags:               map[string]string{"kubernetes.io/role/internal-elb": ""},
+			},
+		},
+	}
+	tests := []struct {
+		name  string
+		input *ec2sdk.DescribeSubnetsInput
+		want  []string
+	}{
+		{
+			name:  "no filters",
+			input: &ec2sdk.DescribeSubnetsInput{},
+			want:  []string{"subnet-a", "subnet-b"},
+		},
+		{
+			name:  "subnet IDs",
+			input: &ec2sdk.DescribeSubnetsInput{SubnetIds: []string{"subnet-b", "subnet-c"}},
+			want:  []string{"subnet-b"},
+		},
+		{
+			name: "tag filter",
+			input: &ec2sdk.DescribeSubnetsInput{
+				Filters: []ec2types.Filter{
+					{Name: awssdk.String("vpc-id"), Values: []string{"vpc-1"}},
+					{Name: awssdk.String("tag:kubernetes.io/role/internal-elb"), Values: []string{"", "1"}},
+				},
+			},
+			want: []string{"subnet-b"},
+		},
+		{
+			name: "tag-key filter",
+			input: &ec2sdk.DescribeSubnetsInput{
+				Filters: []ec2types.Filter{
+					{Name: awssdk.String("tag-key"), Values: []string{"kubernetes.io/role/elb"}},
+				},
+			},
+			want: []string{"subnet-a"},
+		},
+		{
+			name: "field filter without match",
+			input: &ec2sdk.DescribeSubnetsInput{
+				Filters: []ec2types.Filter{
+					{Name: awssdk.String("vpc-id"), Values: []string{"vpc-2"}},
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newEnvironmentEC2(env)
+			subnets, err := c.DescribeSubnetsAsList(context.Background(), tt.input)
+			assert.NoError(t, err)
+			var got []string
+			for _, subnet := range subnets {
+				got = append(got, awssdk.ToString(subnet.SubnetId))
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_environmentEC2_DescribeRouteTablesAsList(t *testing.T) {
+	env := Environment{
+		VPC: VPC{ID: "vpc-1"},
+		Subnets: []Subnet{
+			{ID: "subnet-a", AvailabilityZone: "us-west-2a", AvailabilityZoneID: "usw2-az1", Public: true},
+			{ID: "subnet-b", AvailabilityZone: "us-west-2b", AvailabilityZoneID: "usw2-az2"},
+		},
+	}
+	c := newEnvironmentEC2(env)
+	routeTables, err := c.DescribeRouteTablesAsList(context.Background(), &ec2sdk.DescribeRouteTablesInput{})
+	assert.NoError(t, err)
+	associatedSubnets := map[string]string{}
+	for _, routeTable := range routeTables {
+		for _, association := range routeTable.Associations {
+			if association.SubnetId != nil {
+				associatedSubnets[awssdk.ToString(association.SubnetId)] = awssdk.ToString(routeTable.RouteTableId)
+			}
+		}
+	}
+	assert.Equal(t, map[string]string{"subnet-a": "rtb-public"}, associatedSubnets)
+}
+
+func Test_environmentEC2_mutations(t *testing.T) {
+	c := newEnvironmentEC2(Environment{VPC: VPC{ID: "vpc-1"}})
+	_, err := c.CreateSecurityGroupWithContext(context.Background(), &ec2sdk.CreateSecurityGroupInput{})
+	assert.Equal(t, errOffline, err)
+}
@@ -0,0 +1,110 @@
+package render
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultZoneType = "availability-zone"
+)
+
+// Environment describes the AWS resources that manifests are rendered against.
+// It stands in for the EC2, ACM and ELBV2 APIs, so the stack can be built without AWS access.
+type Environment struct {
+	// Region of the VPC.
+	Region string `json:"region"`
+	// VPC the load balancers are created in.
+	VPC VPC `json:"vpc"`
+	// Subnets of the VPC.
+	Subnets []Subnet `json:"subnets,omitempty"`
+	// SecurityGroups of the VPC that can be referenced by name or ID.
+	SecurityGroups []SecurityGroup `json:"securityGroups,omitempty"`
+	// Certificates in ACM, used for certificate discovery.
+	Certificates []Certificate `json:"certificates,omitempty"`
+	// TrustStores that can be referenced by name for mutual authentication.
+	TrustStores []TrustStore `json:"trustStores,omitempty"`
+	// BackendSecurityGroup is the ID of the shared backend security group.
+	// When unset, the ID of the security group the controller would create is rendered as a placeholder.
+	BackendSecurityGroup string `json:"backendSecurityGroup,omitempty"`
+}
+
+// VPC describes a VPC.
+type VPC struct {
+	ID             string   `json:"id"`
+	CIDRBlocks     []string `json:"cidrBlocks,omitempty"`
+	IPv6CIDRBlocks []string `json:"ipv6CIDRBlocks,omitempty"`
+}
+
+// Subnet describes a subnet.
+type Subnet struct {
+	ID                 string `json:"id"`
+	AvailabilityZone   string `json:"availabilityZone"`
+	AvailabilityZoneID string `json:"availabilityZoneID"`
+	// ZoneType is the type of the zone, defaults to availability-zone.
+	ZoneType      string `json:"zoneType,omitempty"`
+	CIDRBlock     string `json:"cidrBlock,omitempty"`
+	IPv6CIDRBlock string `json:"ipv6CIDRBlock,omitempty"`
+	// Public specifies whether the subnet has a route to an internet gateway.
+	Public bool `json:"public,omitempty"`
+	// AvailableIPAddressCount defaults to 256.
+	AvailableIPAddressCount *int32            `json:"availableIPAddressCount,omitempty"`
+	OutpostARN              string            `json:"outpostARN,omitempty"`
+	Tags                    map[string]string `json:"tags,omitempty"`
+}
+
+// SecurityGroup describes a security group.
+type SecurityGroup struct {
+	ID   string            `json:"id"`
+	Name string            `json:"name,omitempty"`
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// Certificate describes an issued ACM certificate.
+type Certificate struct {
+	ARN                     string   `json:"arn"`
+	DomainName              string   `json:"domainName"`
+	SubjectAlternativeNames []string `json:"subjectAlternativeNames,omitempty"`
+	CertificateAuthorityARN string   `json:"certificateAuthorityARN,omitempty"`
+}
+
+// TrustStore describes an ELB trust store.
+type TrustStore struct {
+	ARN  string `json:"arn"`
+	Name string `json:"name"`
+}
+
+// LoadEnvironment loads the Environment from a YAML or JSON file.
+func LoadEnvironment(path string) (Environment, error) {
+	payload, err := os.ReadFile(path)
+	if err != nil {
+		return Environment{}, errors.Wrapf(err, "failed to read environment file %v", path)
+	}
+	var env Environment
+	if err := yaml.UnmarshalStrict(payload, &env); err != nil {
+		return Environment{}, errors.Wrapf(err, "failed to parse environment file %v", path)
+	}
+	if err := env.validate(); err != nil {
+		return Environment{}, errors.Wrapf(err, "invalid environment file %v", path)
+	}
+	return env, nil
+}
+
+func (env *Environment) validate() error {
+	if env.VPC.ID == "" {
+		return errors.New("vpc.id must be specified")
+	}
+	for _, subnet := range env.Subnets {
+		if subnet.ID == "" || subnet.AvailabilityZone == "" || subnet.AvailabilityZoneID == "" {
+			return errors.Errorf("subnet %v must specify id, availabilityZone and availabilityZoneID", subnet.ID)
+		}
+	}
+	for _, sg := range env.SecurityGroups {
+		if sg.ID == "" {
+			return errors.New("securityGroups must specify id")
+		}
+	}
+	return nil
+}
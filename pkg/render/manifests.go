@@ -0,0 +1,142 @@
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwalpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwbeta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+var (
+	// clusterScopedKinds are the kinds read by the model builders that aren't namespaced.
+	clusterScopedKinds = sets.New("Namespace", "Node", "IngressClass", "IngressClassParams", "GatewayClass")
+
+	// manifestExtensions are the extensions of the files loaded from directories.
+	manifestExtensions = sets.New(".yaml", ".yml", ".json")
+)
+
+// NewScheme constructs the scheme with every kind the controller reads.
+func NewScheme() *k8sruntime.Scheme {
+	scheme := k8sruntime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = elbv2api.AddToScheme(scheme)
+	_ = elbv2gw.AddToScheme(scheme)
+	_ = gwv1.AddToScheme(scheme)
+	_ = gwalpha2.AddToScheme(scheme)
+	_ = gwbeta1.AddToScheme(scheme)
+	return scheme
+}
+
+// LoadManifests loads the objects from YAML or JSON files, paths that are directories are loaded non-recursively.
+// Namespaced objects without a namespace are placed into defaultNamespace.
+func LoadManifests(scheme *k8sruntime.Scheme, paths []string, defaultNamespace string) ([]client.Object, error) {
+	var objects []client.Object
+	for _, path := range paths {
+		files, err := expandManifestPath(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			payload, err := os.ReadFile(file)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read manifest %v", file)
+			}
+			fileObjects, err := DecodeManifests(scheme, payload, defaultNamespace)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to decode manifest %v", file)
+			}
+			objects = append(objects, fileObjects...)
+		}
+	}
+	return objects, nil
+}
+
+// DecodeManifests decodes the objects from a multi-document YAML or JSON payload.
+func DecodeManifests(scheme *k8sruntime.Scheme, payload []byte, defaultNamespace string) ([]client.Object, error) {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(payload)))
+	var objects []client.Object
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		rawObj, gvk, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		obj, ok := rawObj.(client.Object)
+		if !ok {
+			return nil, errors.Errorf("unsupported kind %v", gvk)
+		}
+		if obj.GetNamespace() == "" && !clusterScopedKinds.Has(gvk.Kind) {
+			obj.SetNamespace(defaultNamespace)
+		}
+		applyDefaults(obj)
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+// applyDefaults sets the defaults the API server would set on the fields the model builders read.
+func applyDefaults(obj client.Object) {
+	svc, ok := obj.(*corev1.Service)
+	if !ok {
+		return
+	}
+	if svc.Spec.Type == "" {
+		svc.Spec.Type = corev1.ServiceTypeClusterIP
+	}
+	for i := range svc.Spec.Ports {
+		port := &svc.Spec.Ports[i]
+		if port.Protocol == "" {
+			port.Protocol = corev1.ProtocolTCP
+		}
+		if port.TargetPort.Type == intstr.Int && port.TargetPort.IntVal == 0 {
+			port.TargetPort = intstr.FromInt32(port.Port)
+		}
+	}
+}
+
+func expandManifestPath(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && manifestExtensions.Has(filepath.Ext(entry.Name())) {
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,69 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestDecodeManifests(t *testing.T) {
+	payload := `
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: alb
+spec:
+  controller: ingress.k8s.aws/alb
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+spec:
+  ports:
+  - port: 80
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: ing
+  namespace: app
+`
+	objects, err := DecodeManifests(NewScheme(), []byte(payload), "default")
+	assert.NoError(t, err)
+	assert.Len(t, objects, 3)
+
+	ingClass, ok := objects[0].(*networking.IngressClass)
+	assert.True(t, ok)
+	assert.Equal(t, "", ingClass.Namespace)
+
+	svc, ok := objects[1].(*corev1.Service)
+	assert.True(t, ok)
+	assert.Equal(t, "default", svc.Namespace)
+	assert.Equal(t, corev1.ServiceTypeClusterIP, svc.Spec.Type)
+	assert.Equal(t, []corev1.ServicePort{
+		{
+			Port:       80,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt32(80),
+		},
+	}, svc.Spec.Ports)
+
+	ing, ok := objects[2].(*networking.Ingress)
+	assert.True(t, ok)
+	assert.Equal(t, "app", ing.Namespace)
+}
+
+func TestDecodeManifests_unknownKind(t *testing.T) {
+	payload := `
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: w
+`
+	_, err := DecodeManifests(NewScheme(), []byte(payload), "default")
+	assert.Error(t, err)
+}
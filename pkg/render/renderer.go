@@ -0,0 +1,427 @@
+package render
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/constants"
+	gatewaymodel "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/model"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/routeutils"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shared_constants"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	// the tag prefixes and annotation prefixes match the ones of the controllers, as they're part of the stack.
+	ingressTagPrefix        = "ingress.k8s.aws"
+	serviceTagPrefix        = "service.k8s.aws"
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+
+	StackKindIngressGroup = "IngressGroup"
+	StackKindService      = "Service"
+	StackKindServiceGroup = "ServiceGroup"
+	StackKindGateway      = "Gateway"
+)
+
+// RenderedStack is the resource stack built for an IngressGroup, Service, Service group or Gateway.
+type RenderedStack struct {
+	// Kind is one of IngressGroup, Service, ServiceGroup or Gateway.
+	Kind string `json:"kind"`
+	// Name of the IngressGroup, its shard or the Service group, or the namespaced name of the Service or Gateway.
+	Name string `json:"name"`
+	// Stack is the marshalled resource stack, it's empty if the stack failed to build.
+	Stack json.RawMessage `json:"stack,omitempty"`
+	// Error is the reason the stack failed to build.
+	Error string `json:"error,omitempty"`
+}
+
+// Renderer builds the resource stacks of Ingresses, Services and Gateways from their manifests,
+// without access to a cluster or AWS. AWS resources are looked up from an Environment instead.
+type Renderer interface {
+	// Render builds the resource stack of every IngressGroup, Service and Gateway the controller would reconcile.
+	Render(ctx context.Context) ([]RenderedStack, error)
+}
+
+// NewDefaultRenderer constructs new defaultRenderer.
+func NewDefaultRenderer(scheme *k8sruntime.Scheme, objects []client.Object, env Environment, controllerConfig config.ControllerConfig, logger logr.Logger) *defaultRenderer {
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	ec2Client := newEnvironmentEC2(env)
+	azInfoProvider := networkingpkg.NewDefaultAZInfoProvider(ec2Client, logger.WithName("az-info-provider"))
+	return &defaultRenderer{
+		k8sClient:        k8sClient,
+		eventRecorder:    &record.FakeRecorder{},
+		ec2Client:        ec2Client,
+		elbv2Client:      newEnvironmentELBV2(env),
+		acmClient:        newEnvironmentACM(env),
+		vpcID:            env.VPC.ID,
+		controllerConfig: controllerConfig,
+		vpcInfoProvider:  networkingpkg.NewDefaultVPCInfoProvider(ec2Client, logger.WithName("vpc-info-provider")),
+		subnetsResolver: networkingpkg.NewDefaultSubnetsResolver(azInfoProvider, ec2Client, env.VPC.ID, controllerConfig.ClusterName,
+			controllerConfig.FeatureGates.Enabled(config.SubnetsClusterTagCheck),
+			controllerConfig.FeatureGates.Enabled(config.ALBSingleSubnet),
+			controllerConfig.FeatureGates.Enabled(config.SubnetDiscoveryByReachability),
+			logger.WithName("subnets-resolver")),
+		sgResolver:          networkingpkg.NewDefaultSecurityGroupResolver(ec2Client, env.VPC.ID),
+		backendSGProvider:   newStaticBackendSGProvider(firstNonEmpty(env.BackendSecurityGroup, controllerConfig.BackendSecurityGroup)),
+		elbv2TaggingManager: newEmptyTaggingManager(),
+		stackMarshaller:     deploy.NewDefaultStackMarshaller(),
+		metricsCollector:    lbcmetrics.NewCollector(nil, nil, nil, logger),
+		logger:              logger,
+	}
+}
+
+var _ Renderer = &defaultRenderer{}
+
+type defaultRenderer struct {
+	k8sClient           client.Client
+	eventRecorder       record.EventRecorder
+	ec2Client           services.EC2
+	elbv2Client         services.ELBV2
+	acmClient           services.ACM
+	vpcID               string
+	controllerConfig    config.ControllerConfig
+	vpcInfoProvider     networkingpkg.VPCInfoProvider
+	subnetsResolver     networkingpkg.SubnetsResolver
+	sgResolver          networkingpkg.SecurityGroupResolver
+	backendSGProvider   networkingpkg.BackendSGProvider
+	elbv2TaggingManager elbv2deploy.TaggingManager
+	stackMarshaller     deploy.StackMarshaller
+	metricsCollector    lbcmetrics.MetricCollector
+	logger              logr.Logger
+}
+
+func (r *defaultRenderer) Render(ctx context.Context) ([]RenderedStack, error) {
+	var renderedStacks []RenderedStack
+	ingressStacks, err := r.renderIngressGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	renderedStacks = append(renderedStacks, ingressStacks...)
+	if r.controllerConfig.FeatureGates.Enabled(config.EnableServiceController) {
+		serviceStacks, err := r.renderServices(ctx)
+		if err != nil {
+			return nil, err
+		}
+		renderedStacks = append(renderedStacks, serviceStacks...)
+	}
+	gatewayStacks, err := r.renderGateways(ctx)
+	if err != nil {
+		return nil, err
+	}
+	renderedStacks = append(renderedStacks, gatewayStacks...)
+	return renderedStacks, nil
+}
+
+func (r *defaultRenderer) renderIngressGroups(ctx context.Context) ([]RenderedStack, error) {
+	cfg := r.controllerConfig
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	authConfigBuilder := ingress.NewDefaultAuthConfigBuilder(annotationParser)
+	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(r.k8sClient, annotationParser, authConfigBuilder, cfg.IngressConfig.TolerateNonExistentBackendService, cfg.IngressConfig.TolerateNonExistentBackendAction)
+	trackingProvider := tracking.NewDefaultProvider(ingressTagPrefix, cfg.ClusterName)
+	modelBuilder := ingress.NewDefaultModelBuilder(r.k8sClient, r.eventRecorder,
+		r.ec2Client, r.elbv2Client, r.acmClient,
+		annotationParser, r.subnetsResolver,
+		authConfigBuilder, enhancedBackendBuilder, trackingProvider, r.elbv2TaggingManager, cfg.FeatureGates,
+		r.vpcID, cfg.ClusterName, cfg.DefaultTags, cfg.ExternalManagedTags,
+		cfg.DefaultSSLPolicy, cfg.DefaultTargetType, cfg.DefaultLoadBalancerScheme, r.backendSGProvider, r.sgResolver,
+		cfg.EnableBackendSecurityGroup, cfg.EnableManageBackendSecurityGroupRules, cfg.DisableRestrictedSGRules, cfg.IngressConfig.AllowedCertificateAuthorityARNs, cfg.FeatureGates.Enabled(config.EnableIPTargetType), r.logger, r.metricsCollector)
+	classLoader := ingress.NewDefaultClassLoader(r.k8sClient, true)
+	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(cfg.IngressConfig.IngressClass)
+	manageIngressesWithoutIngressClass := cfg.IngressConfig.IngressClass == ""
+	groupLoader := ingress.NewDefaultGroupLoader(r.k8sClient, r.eventRecorder, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass)
+
+	ingList := &networking.IngressList{}
+	if err := r.k8sClient.List(ctx, ingList); err != nil {
+		return nil, err
+	}
+	groupIDs := sets.New[ingress.GroupID]()
+	var renderedStacks []RenderedStack
+	for i := range ingList.Items {
+		ing := &ingList.Items[i]
+		groupID, err := groupLoader.LoadGroupIDIfAny(ctx, ing)
+		if err != nil {
+			renderedStacks = append(renderedStacks, RenderedStack{
+				Kind:  StackKindIngressGroup,
+				Name:  k8s.NamespacedName(ing).String(),
+				Error: err.Error(),
+			})
+			continue
+		}
+		if groupID != nil {
+			groupIDs.Insert(*groupID)
+		}
+	}
+	for _, groupID := range sortGroupIDs(groupIDs) {
+		renderedStack := RenderedStack{
+			Kind: StackKindIngressGroup,
+			Name: groupID.String(),
+		}
+		ingGroup, err := groupLoader.Load(ctx, groupID)
+		if err != nil {
+			renderedStack.Error = err.Error()
+			renderedStacks = append(renderedStacks, renderedStack)
+			continue
+		}
+		shards, err := ingress.ShardGroup(annotationParser, ingGroup)
+		if err != nil {
+			renderedStack.Error = err.Error()
+			renderedStacks = append(renderedStacks, renderedStack)
+			continue
+		}
+		for _, shard := range shards {
+			// shards without Ingresses only delete the load balancers they were last deployed with.
+			if len(shard.Group.Members) == 0 {
+				continue
+			}
+			shardStack := RenderedStack{
+				Kind: StackKindIngressGroup,
+				Name: shard.Group.ID.String(),
+			}
+			stack, _, _, _, _, _, err := modelBuilder.Build(ctx, shard.Group, r.metricsCollector)
+			renderedStacks = append(renderedStacks, r.buildRenderedStack(shardStack, stack, err))
+		}
+	}
+	return renderedStacks, nil
+}
+
+func (r *defaultRenderer) renderServices(ctx context.Context) ([]RenderedStack, error) {
+	cfg := r.controllerConfig
+	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
+	trackingProvider := tracking.NewDefaultProvider(serviceTagPrefix, cfg.ClusterName)
+	lbClassParamsLoader := service.NewDefaultLoadBalancerClassParamsLoader(r.k8sClient)
+	serviceUtils := service.NewServiceUtils(annotationParser, shared_constants.ServiceFinalizer, cfg.ServiceConfig.LoadBalancerClass, lbClassParamsLoader, cfg.FeatureGates)
+	modelBuilder := service.NewDefaultModelBuilder(annotationParser, r.subnetsResolver, r.vpcInfoProvider, r.vpcID, trackingProvider,
+		r.elbv2TaggingManager, r.ec2Client, cfg.FeatureGates, cfg.ClusterName, cfg.DefaultTags, cfg.ExternalManagedTags,
+		cfg.DefaultSSLPolicy, cfg.DefaultTargetType, cfg.DefaultLoadBalancerScheme, cfg.FeatureGates.Enabled(config.EnableIPTargetType), serviceUtils,
+		lbClassParamsLoader, r.backendSGProvider, r.sgResolver, cfg.EnableBackendSecurityGroup, cfg.EnableManageBackendSecurityGroupRules, cfg.DisableRestrictedSGRules, r.logger, r.metricsCollector, cfg.FeatureGates.Enabled(config.EnableTCPUDPListenerType))
+
+	groupLoader := service.NewDefaultGroupLoader(r.k8sClient, annotationParser, serviceUtils)
+
+	svcList := &corev1.ServiceList{}
+	if err := r.k8sClient.List(ctx, svcList); err != nil {
+		return nil, err
+	}
+	groupIDs := sets.New[service.GroupID]()
+	var renderedStacks []RenderedStack
+	for i := range svcList.Items {
+		svc := &svcList.Items[i]
+		renderedStack := RenderedStack{
+			Kind: StackKindService,
+			Name: k8s.NamespacedName(svc).String(),
+		}
+		groupID, err := groupLoader.LoadGroupIDIfAny(ctx, svc)
+		if err != nil {
+			renderedStacks = append(renderedStacks, r.buildRenderedStack(renderedStack, nil, err))
+			continue
+		}
+		if groupID == nil {
+			continue
+		}
+		if groupID.IsExplicit() {
+			groupIDs.Insert(*groupID)
+			continue
+		}
+		stack, _, _, err := modelBuilder.Build(ctx, svc, r.metricsCollector)
+		renderedStacks = append(renderedStacks, r.buildRenderedStack(renderedStack, stack, err))
+	}
+	for _, groupID := range sortGroupIDs(groupIDs) {
+		renderedStack := RenderedStack{
+			Kind: StackKindServiceGroup,
+			Name: groupID.String(),
+		}
+		svcGroup, err := groupLoader.Load(ctx, groupID)
+		if err != nil {
+			renderedStacks = append(renderedStacks, r.buildRenderedStack(renderedStack, nil, err))
+			continue
+		}
+		// Services conflicting with the Service group are left out of its load balancer, the same way the controller does.
+		svcGroup, conflicts, err := service.ExcludeConflictingGroupMembers(annotationParser, svcGroup)
+		if err != nil {
+			renderedStacks = append(renderedStacks, r.buildRenderedStack(renderedStack, nil, err))
+			continue
+		}
+		for _, conflict := range conflicts {
+			renderedStacks = append(renderedStacks, RenderedStack{
+				Kind:  StackKindService,
+				Name:  k8s.NamespacedName(conflict.Service).String(),
+				Error: fmt.Sprintf("excluded from service group %v due to %v", groupID, conflict.Err),
+			})
+		}
+		stack, _, _, err := modelBuilder.BuildGroup(ctx, svcGroup, r.metricsCollector)
+		renderedStacks = append(renderedStacks, r.buildRenderedStack(renderedStack, stack, err))
+	}
+	return renderedStacks, nil
+}
+
+func (r *defaultRenderer) renderGateways(ctx context.Context) ([]RenderedStack, error) {
+	cfg := r.controllerConfig
+	routeLoader := routeutils.NewLoader(r.k8sClient, r.logger)
+	modelBuilders := make(map[gwv1.GatewayController]gatewaymodel.Builder)
+	routeFilters := make(map[gwv1.GatewayController]routeutils.LoadRouteFilter)
+	if cfg.FeatureGates.Enabled(config.ALBGatewayAPI) {
+		modelBuilders[constants.ALBGatewayController] = r.newGatewayModelBuilder(elbv2model.LoadBalancerTypeApplication, constants.ALBGatewayTagPrefix)
+		routeFilters[constants.ALBGatewayController] = routeutils.L7RouteFilter
+	}
+	if cfg.FeatureGates.Enabled(config.NLBGatewayAPI) {
+		modelBuilders[constants.NLBGatewayController] = r.newGatewayModelBuilder(elbv2model.LoadBalancerTypeNetwork, constants.NLBGatewayTagPrefix)
+		routeFilters[constants.NLBGatewayController] = routeutils.L4RouteFilter
+	}
+
+	gwList := &gwv1.GatewayList{}
+	if err := r.k8sClient.List(ctx, gwList); err != nil {
+		return nil, err
+	}
+	var renderedStacks []RenderedStack
+	for i := range gwList.Items {
+		gw := &gwList.Items[i]
+		gwClass := &gwv1.GatewayClass{}
+		if err := r.k8sClient.Get(ctx, types.NamespacedName{Name: string(gw.Spec.GatewayClassName)}, gwClass); err != nil {
+			continue
+		}
+		modelBuilder, ok := modelBuilders[gwClass.Spec.ControllerName]
+		if !ok {
+			continue
+		}
+		renderedStack := RenderedStack{
+			Kind: StackKindGateway,
+			Name: k8s.NamespacedName(gw).String(),
+		}
+		stack, err := r.buildGatewayModel(ctx, gw, gwClass, modelBuilder, routeLoader, routeFilters[gwClass.Spec.ControllerName])
+		renderedStacks = append(renderedStacks, r.buildRenderedStack(renderedStack, stack, err))
+	}
+	return renderedStacks, nil
+}
+
+func (r *defaultRenderer) newGatewayModelBuilder(lbType elbv2model.LoadBalancerType, tagPrefix string) gatewaymodel.Builder {
+	cfg := r.controllerConfig
+	trackingProvider := tracking.NewDefaultProvider(tagPrefix, cfg.ClusterName)
+	return gatewaymodel.NewModelBuilder(r.subnetsResolver, r.vpcInfoProvider, r.vpcID, lbType, trackingProvider, r.elbv2TaggingManager, cfg, r.ec2Client, r.acmClient,
+		cfg.FeatureGates, cfg.ClusterName, cfg.DefaultTags, sets.New(cfg.ExternalManagedTags...), cfg.DefaultSSLPolicy, cfg.DefaultTargetType, cfg.DefaultLoadBalancerScheme,
+		r.backendSGProvider, r.sgResolver, cfg.EnableBackendSecurityGroup, cfg.DisableRestrictedSGRules, cfg.IngressConfig.AllowedCertificateAuthorityARNs, r.logger)
+}
+
+func (r *defaultRenderer) buildGatewayModel(ctx context.Context, gw *gwv1.Gateway, gwClass *gwv1.GatewayClass, modelBuilder gatewaymodel.Builder,
+	routeLoader routeutils.Loader, routeFilter routeutils.LoadRouteFilter) (core.Stack, error) {
+	lbConfig, err := r.resolveGatewayLoadBalancerConfig(ctx, gw, gwClass)
+	if err != nil {
+		return nil, err
+	}
+	loaderResult, err := routeLoader.LoadRoutesForGateway(ctx, *gw, routeFilter)
+	if err != nil {
+		return nil, err
+	}
+	listenerCertificates, err := routeutils.LoadListenerCertificates(ctx, r.k8sClient, *gw)
+	if err != nil {
+		return nil, err
+	}
+	stack, _, _, err := modelBuilder.Build(ctx, gw, lbConfig, loaderResult.Routes, listenerCertificates)
+	return stack, err
+}
+
+// resolveGatewayLoadBalancerConfig merges the LoadBalancerConfigurations referenced by the GatewayClass and the Gateway.
+// Unlike the controller, it doesn't require the GatewayClass to be accepted, as there is no controller to accept it.
+func (r *defaultRenderer) resolveGatewayLoadBalancerConfig(ctx context.Context, gw *gwv1.Gateway, gwClass *gwv1.GatewayClass) (elbv2gw.LoadBalancerConfiguration, error) {
+	gwClassLBConfig, err := r.loadLoadBalancerConfig(ctx, gwClass.Spec.ParametersRef)
+	if err != nil {
+		return elbv2gw.LoadBalancerConfiguration{}, err
+	}
+	var gwParametersRef *gwv1.ParametersReference
+	if gw.Spec.Infrastructure != nil && gw.Spec.Infrastructure.ParametersRef != nil {
+		ns := gwv1.Namespace(gw.Namespace)
+		gwParametersRef = &gwv1.ParametersReference{
+			Group:     gw.Spec.Infrastructure.ParametersRef.Group,
+			Kind:      gw.Spec.Infrastructure.ParametersRef.Kind,
+			Name:      gw.Spec.Infrastructure.ParametersRef.Name,
+			Namespace: &ns,
+		}
+	}
+	gwLBConfig, err := r.loadLoadBalancerConfig(ctx, gwParametersRef)
+	if err != nil {
+		return elbv2gw.LoadBalancerConfiguration{}, err
+	}
+	switch {
+	case gwClassLBConfig == nil && gwLBConfig == nil:
+		return elbv2gw.LoadBalancerConfiguration{}, nil
+	case gwClassLBConfig == nil:
+		return *gwLBConfig, nil
+	case gwLBConfig == nil:
+		return *gwClassLBConfig, nil
+	default:
+		return gateway.NewConfigMerger().Merge(*gwClassLBConfig, *gwLBConfig), nil
+	}
+}
+
+func (r *defaultRenderer) loadLoadBalancerConfig(ctx context.Context, ref *gwv1.ParametersReference) (*elbv2gw.LoadBalancerConfiguration, error) {
+	if ref == nil {
+		return nil, nil
+	}
+	if ref.Namespace == nil {
+		return nil, errors.New("Namespace must be specified in ParametersRef")
+	}
+	lbConfig := &elbv2gw.LoadBalancerConfiguration{}
+	if err := r.k8sClient.Get(ctx, types.NamespacedName{Namespace: string(*ref.Namespace), Name: ref.Name}, lbConfig); err != nil {
+		return nil, err
+	}
+	return lbConfig, nil
+}
+
+func (r *defaultRenderer) buildRenderedStack(renderedStack RenderedStack, stack core.Stack, buildErr error) RenderedStack {
+	if buildErr != nil {
+		renderedStack.Error = buildErr.Error()
+		return renderedStack
+	}
+	stackJSON, err := r.stackMarshaller.Marshal(stack)
+	if err != nil {
+		renderedStack.Error = err.Error()
+		return renderedStack
+	}
+	renderedStack.Stack = json.RawMessage(stackJSON)
+	return renderedStack
+}
+
+func sortGroupIDs[T interface {
+	comparable
+	fmt.Stringer
+}](groupIDs sets.Set[T]) []T {
+	sortedGroupIDs := groupIDs.UnsortedList()
+	sort.Slice(sortedGroupIDs, func(i, j int) bool {
+		return sortedGroupIDs[i].String() < sortedGroupIDs[j].String()
+	})
+	return sortedGroupIDs
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,325 @@
+package render
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+)
+
+func Test_defaultRenderer_Render(t *testing.T) {
+	env := newTestEnvironment()
+	manifests := `
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: alb
+spec:
+  controller: ingress.k8s.aws/alb
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  type: NodePort
+  ports:
+  - port: 80
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: web
+  annotations:
+    alb.ingress.kubernetes.io/group.name: shared
+    alb.ingress.kubernetes.io/scheme: internet-facing
+    alb.ingress.kubernetes.io/listen-ports: '[{"HTTPS":443}]'
+spec:
+  ingressClassName: alb
+  rules:
+  - host: www.example.com
+    http:
+      paths:
+      - path: /
+        pathType: Prefix
+        backend:
+          service:
+            name: web
+            port:
+              number: 80
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: invalid
+  annotations:
+    alb.ingress.kubernetes.io/listen-ports: 'HTTPS:443'
+spec:
+  ingressClassName: alb
+  defaultBackend:
+    service:
+      name: web
+      port:
+        number: 80
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: nlb
+  annotations:
+    service.beta.kubernetes.io/aws-load-balancer-type: external
+    service.beta.kubernetes.io/aws-load-balancer-nlb-target-type: ip
+    service.beta.kubernetes.io/aws-load-balancer-scheme: internet-facing
+spec:
+  type: LoadBalancer
+  ports:
+  - port: 80
+`
+	controllerConfig := config.ControllerConfig{FeatureGates: config.NewFeatureGates()}
+	fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+	controllerConfig.BindFlags(fs)
+	assert.NoError(t, fs.Parse([]string{"--cluster-name=my-cluster"}))
+
+	scheme := NewScheme()
+	objects, err := DecodeManifests(scheme, []byte(manifests), "default")
+	assert.NoError(t, err)
+	renderer := NewDefaultRenderer(scheme, objects, env, controllerConfig, logr.Discard())
+	renderedStacks, err := renderer.Render(context.Background())
+	assert.NoError(t, err)
+
+	var got []string
+	for _, renderedStack := range renderedStacks {
+		got = append(got, renderedStack.Kind+" "+renderedStack.Name)
+	}
+	assert.Equal(t, []string{"IngressGroup default/invalid", "IngressGroup shared", "Service default/nlb"}, got)
+
+	assert.Contains(t, renderedStacks[0].Error, "listen-ports")
+	assert.Empty(t, renderedStacks[0].Stack)
+
+	assert.Empty(t, renderedStacks[1].Error)
+	var ingressStack struct {
+		ID        string                                `json:"id"`
+		Resources map[string]map[string]json.RawMessage `json:"resources"`
+	}
+	assert.NoError(t, json.Unmarshal(renderedStacks[1].Stack, &ingressStack))
+	assert.Equal(t, "shared", ingressStack.ID)
+	assert.Contains(t, string(ingressStack.Resources["AWS::ElasticLoadBalancingV2::Listener"]["443"]), "arn:aws:acm:us-west-2:123456789012:certificate/abc")
+	assert.Contains(t, string(ingressStack.Resources["AWS::ElasticLoadBalancingV2::LoadBalancer"]["LoadBalancer"]), "subnet-a")
+	assert.Contains(t, string(ingressStack.Resources["AWS::ElasticLoadBalancingV2::LoadBalancer"]["LoadBalancer"]), "sg-backend")
+
+	assert.Empty(t, renderedStacks[2].Error)
+	assert.Contains(t, string(renderedStacks[2].Stack), `"network"`)
+}
+
+func Test_defaultRenderer_Render_serviceGroups(t *testing.T) {
+	manifests := `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+  annotations:
+    service.beta.kubernetes.io/aws-load-balancer-type: external
+    service.beta.kubernetes.io/aws-load-balancer-nlb-target-type: ip
+    service.beta.kubernetes.io/aws-load-balancer-scheme: internet-facing
+    service.beta.kubernetes.io/aws-load-balancer-group-name: shared
+spec:
+  type: LoadBalancer
+  ports:
+  - port: 80
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: api
+  annotations:
+    service.beta.kubernetes.io/aws-load-balancer-type: external
+    service.beta.kubernetes.io/aws-load-balancer-nlb-target-type: ip
+    service.beta.kubernetes.io/aws-load-balancer-scheme: internet-facing
+    service.beta.kubernetes.io/aws-load-balancer-group-name: shared
+spec:
+  type: LoadBalancer
+  ports:
+  - port: 8080
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: internal
+  annotations:
+    service.beta.kubernetes.io/aws-load-balancer-type: external
+    service.beta.kubernetes.io/aws-load-balancer-nlb-target-type: ip
+    service.beta.kubernetes.io/aws-load-balancer-scheme: internal
+    service.beta.kubernetes.io/aws-load-balancer-group-name: shared
+spec:
+  type: LoadBalancer
+  ports:
+  - port: 9090
+`
+	controllerConfig := config.ControllerConfig{FeatureGates: config.NewFeatureGates()}
+	fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+	controllerConfig.BindFlags(fs)
+	assert.NoError(t, fs.Parse([]string{"--cluster-name=my-cluster"}))
+
+	scheme := NewScheme()
+	objects, err := DecodeManifests(scheme, []byte(manifests), "default")
+	assert.NoError(t, err)
+	renderer := NewDefaultRenderer(scheme, objects, newTestEnvironment(), controllerConfig, logr.Discard())
+	renderedStacks, err := renderer.Render(context.Background())
+	assert.NoError(t, err)
+
+	var got []string
+	for _, renderedStack := range renderedStacks {
+		got = append(got, renderedStack.Kind+" "+renderedStack.Name)
+	}
+	assert.Equal(t, []string{"Service default/internal", "ServiceGroup shared"}, got)
+
+	assert.Contains(t, renderedStacks[0].Error, "excluded from service group shared")
+	assert.Empty(t, renderedStacks[0].Stack)
+
+	assert.Empty(t, renderedStacks[1].Error)
+	var serviceStack struct {
+		ID        string                                `json:"id"`
+		Resources map[string]map[string]json.RawMessage `json:"resources"`
+	}
+	assert.NoError(t, json.Unmarshal(renderedStacks[1].Stack, &serviceStack))
+	assert.Equal(t, "shared", serviceStack.ID)
+	assert.Len(t, serviceStack.Resources["AWS::ElasticLoadBalancingV2::LoadBalancer"], 1)
+	assert.Len(t, serviceStack.Resources["AWS::ElasticLoadBalancingV2::Listener"], 2)
+}
+
+func newTestEnvironment() Environment {
+	return Environment{
+		VPC: VPC{ID: "vpc-1", CIDRBlocks: []string{"10.0.0.0/16"}},
+		Subnets: []Subnet{
+			{
+				ID:                 "subnet-a",
+				AvailabilityZone:   "us-west-2a",
+				AvailabilityZoneID: "usw2-az1",
+				CIDRBlock:          "10.0.0.0/24",
+				Public:             true,
+				Tags:               map[string]string{"kubernetes.io/role/elb": "1"},
+			},
+			{
+				ID:                 "subnet-b",
+				AvailabilityZone:   "us-west-2b",
+				AvailabilityZoneID: "usw2-az2",
+				CIDRBlock:          "10.0.1.0/24",
+				Public:             true,
+				Tags:               map[string]string{"kubernetes.io/role/elb": "1"},
+			},
+		},
+		Certificates: []Certificate{
+			{
+				ARN:        "arn:aws:acm:us-west-2:123456789012:certificate/abc",
+				DomainName: "*.example.com",
+			},
+		},
+		BackendSecurityGroup: "sg-backend",
+	}
+}
+
+func Test_defaultRenderer_Render_shardedIngressGroup(t *testing.T) {
+	manifests := `
+apiVersion: elbv2.k8s.aws/v1beta1
+kind: IngressClassParams
+metadata:
+  name: sharded
+spec:
+  scheme: internet-facing
+  group:
+    name: shared
+    sharding:
+      shards: 1
+      key: Ingress
+      maxRulesPerShard: 1
+---
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: alb
+spec:
+  controller: ingress.k8s.aws/alb
+  parameters:
+    apiGroup: elbv2.k8s.aws
+    kind: IngressClassParams
+    name: sharded
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  type: NodePort
+  ports:
+  - port: 80
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: api
+spec:
+  ingressClassName: alb
+  rules:
+  - host: api.example.com
+    http:
+      paths:
+      - path: /
+        pathType: Prefix
+        backend:
+          service:
+            name: web
+            port:
+              number: 80
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: www
+spec:
+  ingressClassName: alb
+  rules:
+  - host: www.example.com
+    http:
+      paths:
+      - path: /
+        pathType: Prefix
+        backend:
+          service:
+            name: web
+            port:
+              number: 80
+`
+	controllerConfig := config.ControllerConfig{FeatureGates: config.NewFeatureGates()}
+	fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+	controllerConfig.BindFlags(fs)
+	assert.NoError(t, fs.Parse([]string{"--cluster-name=my-cluster"}))
+
+	scheme := NewScheme()
+	objects, err := DecodeManifests(scheme, []byte(manifests), "default")
+	assert.NoError(t, err)
+	renderer := NewDefaultRenderer(scheme, objects, newTestEnvironment(), controllerConfig, logr.Discard())
+	renderedStacks, err := renderer.Render(context.Background())
+	assert.NoError(t, err)
+
+	var got []string
+	for _, renderedStack := range renderedStacks {
+		got = append(got, renderedStack.Kind+" "+renderedStack.Name)
+	}
+	// the shard fills up with the first Ingress, the second one gets a shard of its own.
+	assert.Equal(t, []string{"IngressGroup shared", "IngressGroup shared_shard-1"}, got)
+	hosts := []string{"api.example.com", "www.example.com"}
+	for i, wantHost := range hosts {
+		assert.Empty(t, renderedStacks[i].Error)
+		var ingressStack struct {
+			Resources map[string]map[string]json.RawMessage `json:"resources"`
+		}
+		assert.NoError(t, json.Unmarshal(renderedStacks[i].Stack, &ingressStack))
+		assert.Len(t, ingressStack.Resources["AWS::ElasticLoadBalancingV2::LoadBalancer"], 1)
+		assert.Contains(t, string(renderedStacks[i].Stack), wantHost)
+		assert.NotContains(t, string(renderedStacks[i].Stack), hosts[1-i])
+	}
+}
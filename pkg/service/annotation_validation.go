@@ -0,0 +1,59 @@
+package service
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shared_constants"
+)
+
+// ValidateServiceAnnotations validates the annotations of the Service the same way they're parsed when building its model.
+// Checks depending on AWS resources, like the count of EIP allocations against auto-discovered subnets, are left to the model build.
+func ValidateServiceAnnotations(annotationParser annotations.Parser, svc *corev1.Service) error {
+	var rawTLSPorts []string
+	_ = annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSSLPorts, &rawTLSPorts, svc.Annotations)
+	if err := validateTLSPortsSet(rawTLSPorts, svc.Spec.Ports); err != nil {
+		return err
+	}
+
+	scheme, explicitSchemeSpecified, err := ParseLoadBalancerScheme(annotationParser, svc)
+	if err != nil {
+		return err
+	}
+	var eipAllocations []string
+	if annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixEIPAllocations, &eipAllocations, svc.Annotations) {
+		if explicitSchemeSpecified && scheme != elbv2model.LoadBalancerSchemeInternetFacing {
+			return errors.Errorf("EIP allocations can only be set for internet facing load balancers")
+		}
+		var rawSubnets []string
+		if annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSubnets, &rawSubnets, svc.Annotations) && len(eipAllocations) != len(rawSubnets) {
+			return errors.Errorf("count of EIP allocations (%d) and subnets (%d) must match", len(eipAllocations), len(rawSubnets))
+		}
+	}
+
+	var rawTGAttributes map[string]string
+	if _, err := annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixTargetGroupAttributes, &rawTGAttributes, svc.Annotations); err != nil {
+		return err
+	}
+	if rawPreserveIPEnabled, ok := rawTGAttributes[shared_constants.TGAttributePreserveClientIPEnabled]; ok {
+		if _, err := strconv.ParseBool(rawPreserveIPEnabled); err != nil {
+			return errors.Wrapf(err, "failed to parse attribute %v=%v", shared_constants.TGAttributePreserveClientIPEnabled, rawPreserveIPEnabled)
+		}
+	}
+	if _, _, err := parseProxyProtocolPerTargetGroup(annotationParser, svc); err != nil {
+		return err
+	}
+	proxyV2Annotation := ""
+	if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixProxyProtocol, &proxyV2Annotation, svc.Annotations); exists && proxyV2Annotation != "*" {
+		return errors.Errorf("invalid value %v for Load Balancer proxy protocol v2 annotation, only value currently supported is *", proxyV2Annotation)
+	}
+	if groupName, exists := loadGroupName(annotationParser, svc); exists {
+		if err := validateGroupName(groupName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func Test_ValidateServiceAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantErr     string
+	}{
+		{
+			name: "valid annotations",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-ports":                       "https, 8443",
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                          "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-subnets":                         "subnet-1, subnet-2",
+				"service.beta.kubernetes.io/aws-load-balancer-eip-allocations":                 "eipalloc-1, eipalloc-2",
+				"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes":         "preserve_client_ip.enabled=true",
+				"service.beta.kubernetes.io/aws-load-balancer-proxy-protocol-per-target-group": "80, 443",
+			},
+		},
+		{
+			name: "ssl-ports referencing an unknown port",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-ports": "9443",
+			},
+			wantErr: "Unused port in ssl-ports annotation [9443]",
+		},
+		{
+			name: "unknown scheme",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "public",
+			},
+			wantErr: "unknown scheme: public",
+		},
+		{
+			name: "EIP allocations for internal load balancer",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internal",
+				"service.beta.kubernetes.io/aws-load-balancer-eip-allocations": "eipalloc-1",
+			},
+			wantErr: "EIP allocations can only be set for internet facing load balancers",
+		},
+		{
+			name: "count of EIP allocations not matching subnets",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-subnets":         "subnet-1, subnet-2",
+				"service.beta.kubernetes.io/aws-load-balancer-eip-allocations": "eipalloc-1",
+			},
+			wantErr: "count of EIP allocations (1) and subnets (2) must match",
+		},
+		{
+			name: "malformed target-group-attributes",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": "preserve_client_ip.enabled",
+			},
+			wantErr: "failed to parse stringMap annotation, service.beta.kubernetes.io/aws-load-balancer-target-group-attributes: preserve_client_ip.enabled",
+		},
+		{
+			name: "invalid preserve_client_ip.enabled target group attribute",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": "preserve_client_ip.enabled=yes",
+			},
+			wantErr: "failed to parse attribute preserve_client_ip.enabled=yes: strconv.ParseBool: parsing \"yes\": invalid syntax",
+		},
+		{
+			name: "invalid proxy-protocol-per-target-group",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-proxy-protocol-per-target-group": "80, https",
+			},
+			wantErr: "invalid port number in proxy-protocol-per-target-group: https",
+		},
+		{
+			name: "invalid proxy-protocol",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-proxy-protocol": "80",
+			},
+			wantErr: "invalid value 80 for Load Balancer proxy protocol v2 annotation, only value currently supported is *",
+		},
+		{
+			name: "invalid group-name",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "Awesome_Group",
+			},
+			wantErr: "groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "default",
+					Name:        "svc",
+					Annotations: tt.annotations,
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{Name: "http", Port: 80},
+						{Name: "https", Port: 443},
+						{Name: "alt-https", Port: 8443},
+					},
+				},
+			}
+			err := ValidateServiceAnnotations(annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"), svc)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
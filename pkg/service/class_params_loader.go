@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LoadBalancerClassParamsLoader loads the LoadBalancerClassParams bound to the loadBalancerClass of Services.
+type LoadBalancerClassParamsLoader interface {
+	// Load loads the LoadBalancerClassParams bound to the loadBalancerClass of Service.
+	// Returns nil if the Service doesn't have loadBalancerClass or there is no LoadBalancerClassParams bound to it.
+	Load(ctx context.Context, svc *corev1.Service) (*elbv2api.LoadBalancerClassParams, error)
+
+	// IsLoadBalancerClassBound returns true if there is a LoadBalancerClassParams bound to the loadBalancerClass.
+	IsLoadBalancerClassBound(ctx context.Context, loadBalancerClass string) (bool, error)
+}
+
+// NewDefaultLoadBalancerClassParamsLoader constructs new defaultLoadBalancerClassParamsLoader instance.
+func NewDefaultLoadBalancerClassParamsLoader(client client.Client) *defaultLoadBalancerClassParamsLoader {
+	return &defaultLoadBalancerClassParamsLoader{
+		client: client,
+	}
+}
+
+var _ LoadBalancerClassParamsLoader = &defaultLoadBalancerClassParamsLoader{}
+
+// default implementation for LoadBalancerClassParamsLoader
+type defaultLoadBalancerClassParamsLoader struct {
+	client client.Client
+}
+
+func (l *defaultLoadBalancerClassParamsLoader) Load(ctx context.Context, svc *corev1.Service) (*elbv2api.LoadBalancerClassParams, error) {
+	if svc.Spec.LoadBalancerClass == nil {
+		return nil, nil
+	}
+	lbClassParams, err := l.findLoadBalancerClassParams(ctx, *svc.Spec.LoadBalancerClass)
+	if err != nil || lbClassParams == nil {
+		return nil, err
+	}
+	if err := l.validateLoadBalancerClassParamsNamespaceRestriction(ctx, svc, lbClassParams); err != nil {
+		return nil, err
+	}
+	return lbClassParams, nil
+}
+
+func (l *defaultLoadBalancerClassParamsLoader) IsLoadBalancerClassBound(ctx context.Context, loadBalancerClass string) (bool, error) {
+	lbClassParams, err := l.findLoadBalancerClassParams(ctx, loadBalancerClass)
+	if err != nil {
+		return false, err
+	}
+	return lbClassParams != nil, nil
+}
+
+// findLoadBalancerClassParams finds the LoadBalancerClassParams bound to the loadBalancerClass, nil if there is none.
+func (l *defaultLoadBalancerClassParamsLoader) findLoadBalancerClassParams(ctx context.Context, loadBalancerClass string) (*elbv2api.LoadBalancerClassParams, error) {
+	lbClassParamsList := &elbv2api.LoadBalancerClassParamsList{}
+	if err := l.client.List(ctx, lbClassParamsList); err != nil {
+		// the LoadBalancerClassParams CRD might not be installed, in which case no loadBalancerClass is bound.
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to list LoadBalancerClassParams")
+	}
+	var matchedLBClassParams *elbv2api.LoadBalancerClassParams
+	for i := range lbClassParamsList.Items {
+		lbClassParams := &lbClassParamsList.Items[i]
+		if lbClassParams.Spec.LoadBalancerClass != loadBalancerClass {
+			continue
+		}
+		if matchedLBClassParams != nil {
+			return nil, errors.Errorf("multiple LoadBalancerClassParams bound to loadBalancerClass %v: %v, %v",
+				loadBalancerClass, matchedLBClassParams.Name, lbClassParams.Name)
+		}
+		matchedLBClassParams = lbClassParams
+	}
+	return matchedLBClassParams, nil
+}
+
+// validateLoadBalancerClassParamsNamespaceRestriction validates the Service is allowed to use the loadBalancerClass of LoadBalancerClassParams.
+func (l *defaultLoadBalancerClassParamsLoader) validateLoadBalancerClassParamsNamespaceRestriction(ctx context.Context, svc *corev1.Service, lbClassParams *elbv2api.LoadBalancerClassParams) error {
+	if lbClassParams.Spec.NamespaceSelector == nil {
+		return nil
+	}
+
+	svcNamespace := svc.Namespace
+	// see https://github.com/kubernetes/kubernetes/issues/88282 and https://github.com/kubernetes/kubernetes/issues/76680
+	if admissionReq := webhook.ContextGetAdmissionRequest(ctx); admissionReq != nil {
+		svcNamespace = admissionReq.Namespace
+	}
+	svcNS := &corev1.Namespace{}
+	if err := l.client.Get(ctx, types.NamespacedName{Name: svcNamespace}, svcNS); err != nil {
+		return err
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(lbClassParams.Spec.NamespaceSelector)
+	if err != nil {
+		return err
+	}
+	if !selector.Matches(labels.Set(svcNS.Labels)) {
+		return errors.Errorf("namespaceSelector of LoadBalancerClassParams %v mismatch", lbClassParams.Name)
+	}
+	return nil
+}
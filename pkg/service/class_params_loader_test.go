@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func Test_defaultLoadBalancerClassParamsLoader_Load(t *testing.T) {
+	internalScheme := elbv2api.LoadBalancerSchemeInternal
+	nsAwesome := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "awesome-ns",
+			Labels: map[string]string{"team": "awesome"},
+		},
+	}
+	nsOther := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "other-ns",
+		},
+	}
+	lbClassParamsInternal := &elbv2api.LoadBalancerClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "internal",
+		},
+		Spec: elbv2api.LoadBalancerClassParamsSpec{
+			LoadBalancerClass: "example.com/internal",
+			Scheme:            &internalScheme,
+		},
+	}
+	lbClassParamsRestricted := &elbv2api.LoadBalancerClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "restricted",
+		},
+		Spec: elbv2api.LoadBalancerClassParamsSpec{
+			LoadBalancerClass: "example.com/restricted",
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "awesome"},
+			},
+		},
+	}
+	lbClassParamsInternalDuplicate := &elbv2api.LoadBalancerClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "internal-duplicate",
+		},
+		Spec: elbv2api.LoadBalancerClassParamsSpec{
+			LoadBalancerClass: "example.com/internal",
+		},
+	}
+
+	tests := []struct {
+		name              string
+		lbClassParamsList []*elbv2api.LoadBalancerClassParams
+		svc               *corev1.Service
+		admissionReq      *admission.Request
+		want              *elbv2api.LoadBalancerClassParams
+		wantErr           error
+	}{
+		{
+			name:              "service without loadBalancerClass",
+			lbClassParamsList: []*elbv2api.LoadBalancerClassParams{lbClassParamsInternal},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc"},
+			},
+			want: nil,
+		},
+		{
+			name:              "service with loadBalancerClass without LoadBalancerClassParams",
+			lbClassParamsList: []*elbv2api.LoadBalancerClassParams{lbClassParamsInternal},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc"},
+				Spec:       corev1.ServiceSpec{LoadBalancerClass: awssdk.String("service.k8s.aws/nlb")},
+			},
+			want: nil,
+		},
+		{
+			name:              "service with loadBalancerClass bound to LoadBalancerClassParams",
+			lbClassParamsList: []*elbv2api.LoadBalancerClassParams{lbClassParamsInternal, lbClassParamsRestricted},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc"},
+				Spec:       corev1.ServiceSpec{LoadBalancerClass: awssdk.String("example.com/internal")},
+			},
+			want: lbClassParamsInternal,
+		},
+		{
+			name:              "service in namespace selected by LoadBalancerClassParams",
+			lbClassParamsList: []*elbv2api.LoadBalancerClassParams{lbClassParamsRestricted},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc"},
+				Spec:       corev1.ServiceSpec{LoadBalancerClass: awssdk.String("example.com/restricted")},
+			},
+			want: lbClassParamsRestricted,
+		},
+		{
+			name:              "service in namespace not selected by LoadBalancerClassParams",
+			lbClassParamsList: []*elbv2api.LoadBalancerClassParams{lbClassParamsRestricted},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "svc"},
+				Spec:       corev1.ServiceSpec{LoadBalancerClass: awssdk.String("example.com/restricted")},
+			},
+			wantErr: errors.New("namespaceSelector of LoadBalancerClassParams restricted mismatch"),
+		},
+		{
+			name:              "service in admission request namespace selected by LoadBalancerClassParams",
+			lbClassParamsList: []*elbv2api.LoadBalancerClassParams{lbClassParamsRestricted},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "svc"},
+				Spec:       corev1.ServiceSpec{LoadBalancerClass: awssdk.String("example.com/restricted")},
+			},
+			admissionReq: &admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{Namespace: "awesome-ns"},
+			},
+			want: lbClassParamsRestricted,
+		},
+		{
+			name:              "multiple LoadBalancerClassParams bound to loadBalancerClass",
+			lbClassParamsList: []*elbv2api.LoadBalancerClassParams{lbClassParamsInternal, lbClassParamsInternalDuplicate},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc"},
+				Spec:       corev1.ServiceSpec{LoadBalancerClass: awssdk.String("example.com/internal")},
+			},
+			wantErr: errors.New("multiple LoadBalancerClassParams bound to loadBalancerClass example.com/internal: internal, internal-duplicate"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, nsAwesome.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, nsOther.DeepCopy()))
+			for _, lbClassParams := range tt.lbClassParamsList {
+				assert.NoError(t, k8sClient.Create(ctx, lbClassParams.DeepCopy()))
+			}
+			if tt.admissionReq != nil {
+				ctx = webhook.ContextWithAdmissionRequest(ctx, *tt.admissionReq)
+			}
+
+			l := NewDefaultLoadBalancerClassParamsLoader(k8sClient)
+			got, err := l.Load(ctx, tt.svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			opt := cmp.Options{
+				equality.IgnoreFakeClientPopulatedFields(),
+			}
+			assert.True(t, cmp.Equal(tt.want, got, opt),
+				"diff: %v", cmp.Diff(tt.want, got, opt))
+		})
+	}
+}
+
+func Test_defaultLoadBalancerClassParamsLoader_IsLoadBalancerClassBound(t *testing.T) {
+	ctx := context.Background()
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	elbv2api.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+	assert.NoError(t, k8sClient.Create(ctx, &elbv2api.LoadBalancerClassParams{
+		ObjectMeta: metav1.ObjectMeta{Name: "internal"},
+		Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/internal"},
+	}))
+
+	l := NewDefaultLoadBalancerClassParamsLoader(k8sClient)
+	bound, err := l.IsLoadBalancerClassBound(ctx, "example.com/internal")
+	assert.NoError(t, err)
+	assert.True(t, bound)
+	bound, err = l.IsLoadBalancerClassBound(ctx, "example.com/other")
+	assert.NoError(t, err)
+	assert.False(t, bound)
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shared_constants"
+)
+
+// FinalizerManager manages finalizer for Service groups.
+type FinalizerManager interface {
+	// AddGroupFinalizer add Service group finalizer for active member Services.
+	// Services will be in-place updated.
+	AddGroupFinalizer(ctx context.Context, groupID GroupID, members []*corev1.Service) error
+
+	// RemoveGroupFinalizer remove Service group finalizer from inactive member Services.
+	// Services will be in-place updated.
+	RemoveGroupFinalizer(ctx context.Context, groupID GroupID, inactiveMembers []*corev1.Service) error
+}
+
+// NewDefaultFinalizerManager constructs new defaultFinalizerManager
+func NewDefaultFinalizerManager(k8sFinalizerManager k8s.FinalizerManager) *defaultFinalizerManager {
+	return &defaultFinalizerManager{
+		k8sFinalizerManager: k8sFinalizerManager,
+	}
+}
+
+var _ FinalizerManager = (*defaultFinalizerManager)(nil)
+
+// default implementation of FinalizerManager
+type defaultFinalizerManager struct {
+	k8sFinalizerManager k8s.FinalizerManager
+}
+
+func (m *defaultFinalizerManager) AddGroupFinalizer(ctx context.Context, groupID GroupID, members []*corev1.Service) error {
+	finalizer := buildGroupFinalizer(groupID)
+	for _, svc := range members {
+		if err := m.k8sFinalizerManager.AddFinalizers(ctx, svc, finalizer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *defaultFinalizerManager) RemoveGroupFinalizer(ctx context.Context, groupID GroupID, inactiveMembers []*corev1.Service) error {
+	finalizer := buildGroupFinalizer(groupID)
+	for _, svc := range inactiveMembers {
+		if err := m.k8sFinalizerManager.RemoveFinalizers(ctx, svc, finalizer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildGroupFinalizer returns a finalizer for specified Service group
+// for explicit group, the format is "group.service.k8s.aws/awesome-group"
+// for implicit group, the format is "service.k8s.aws/resources"
+func buildGroupFinalizer(groupID GroupID) string {
+	if groupID.IsExplicit() {
+		return fmt.Sprintf("%s%s", shared_constants.ServiceGroupFinalizerPrefix, groupID.Name)
+	}
+	return shared_constants.ServiceFinalizer
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_buildGroupFinalizer(t *testing.T) {
+	tests := []struct {
+		name    string
+		groupID GroupID
+		want    string
+	}{
+		{
+			name: "explicit group",
+			groupID: GroupID{
+				Namespace: "",
+				Name:      "awesome-group",
+			},
+			want: "group.service.k8s.aws/awesome-group",
+		},
+		{
+			name: "implicit group",
+			groupID: GroupID{
+				Namespace: "namespace",
+				Name:      "service",
+			},
+			want: "service.k8s.aws/resources",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildGroupFinalizer(tt.groupID)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// GroupID is the unique identifier for a Service group within cluster.
+type GroupID types.NamespacedName
+
+// IsExplicit tests whether this is an explicit group.
+// Explicit groups are defined by the `aws-load-balancer-group-name` annotation on Services.
+func (groupID GroupID) IsExplicit() bool {
+	return groupID.Namespace == ""
+}
+
+// String returns the string representation of a GroupID.
+func (groupID GroupID) String() string {
+	if groupID.IsExplicit() {
+		return groupID.Name
+	}
+	return fmt.Sprintf("%s/%s", groupID.Namespace, groupID.Name)
+}
+
+// NewGroupIDForExplicitGroup generates GroupID for an explicit group.
+func NewGroupIDForExplicitGroup(groupName string) GroupID {
+	return GroupID{
+		Namespace: "",
+		Name:      groupName,
+	}
+}
+
+// NewGroupIDForImplicitGroup generates GroupID for an implicit group.
+func NewGroupIDForImplicitGroup(svcKey types.NamespacedName) GroupID {
+	return GroupID(svcKey)
+}
+
+// EncodeGroupIDToReconcileRequest encodes a GroupID into a controller-runtime reconcile request
+func EncodeGroupIDToReconcileRequest(gID GroupID) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName(gID)}
+}
+
+// DecodeGroupIDFromReconcileRequest decodes a GroupID from a controller-runtime reconcile request
+func DecodeGroupIDFromReconcileRequest(request reconcile.Request) GroupID {
+	return GroupID(request.NamespacedName)
+}
+
+// A Service Group is a group of Services that should be hosted by a single Network LoadBalancer,
+// where each member Service defines the listeners of that LoadBalancer for its ports.
+// There are two types of group: explicit and implicit.
+// Explicit groups are defined by the annotation(aws-load-balancer-group-name) on Services.
+// Implicit groups are for Services without explicit group, each Service become a standalone group of itself.
+type Group struct {
+	ID GroupID
+
+	// Members are Services that is belong to this group.
+	Members []*corev1.Service
+
+	// InactiveMembers are Services that no longer belong to this group, but still hold the finalizers.
+	InactiveMembers []*corev1.Service
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shared_constants"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	maxGroupNameLength int = 63
+)
+
+var (
+	// groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character.
+	// groupName must be no more than 63 character.
+	groupNameRegex = regexp.MustCompile("^([a-z0-9][-a-z0-9.]*)?[a-z0-9]$")
+
+	// err represents that service group is invalid.
+	errInvalidServiceGroup = errors.New("invalid service group")
+)
+
+// GroupLoader loads Service groups.
+type GroupLoader interface {
+	// Load returns a Service group given groupID.
+	Load(ctx context.Context, groupID GroupID) (Group, error)
+
+	// LoadGroupIDIfAny loads the groupID for Service if Service belong to any Service group.
+	// Services that is not managed by this controller or in deletion state won't have a groupID.
+	LoadGroupIDIfAny(ctx context.Context, svc *corev1.Service) (*GroupID, error)
+
+	// LoadGroupIDsPendingFinalization returns groupIDs that have associated finalizer on Service.
+	LoadGroupIDsPendingFinalization(ctx context.Context, svc *corev1.Service) []GroupID
+}
+
+// NewDefaultGroupLoader constructs new GroupLoader instance.
+func NewDefaultGroupLoader(client client.Client, annotationParser annotations.Parser, serviceUtils ServiceUtils) *defaultGroupLoader {
+	return &defaultGroupLoader{
+		client:           client,
+		annotationParser: annotationParser,
+		serviceUtils:     serviceUtils,
+	}
+}
+
+var _ GroupLoader = (*defaultGroupLoader)(nil)
+
+// default implementation for GroupLoader
+type defaultGroupLoader struct {
+	client           client.Client
+	annotationParser annotations.Parser
+
+	// serviceUtils checks whether Services should be managed.
+	serviceUtils ServiceUtils
+}
+
+func (m *defaultGroupLoader) Load(ctx context.Context, groupID GroupID) (Group, error) {
+	svcList := &corev1.ServiceList{}
+	if err := m.client.List(ctx, svcList); err != nil {
+		return Group{}, err
+	}
+	var members []*corev1.Service
+	var inactiveMembers []*corev1.Service
+	for index := range svcList.Items {
+		svc := &svcList.Items[index]
+		membershipType, err := m.checkGroupMembershipType(ctx, groupID, svc)
+		if err != nil {
+			return Group{}, errors.Wrapf(err, "Service: %v", k8s.NamespacedName(svc))
+		}
+		switch membershipType {
+		case groupMembershipTypeActiveMember:
+			members = append(members, svc)
+		case groupMembershipTypeInactiveMember:
+			inactiveMembers = append(inactiveMembers, svc)
+		}
+	}
+
+	// members are sorted by their full-qualified name, the first member hosts the load balancer level settings.
+	sort.Slice(members, func(i, j int) bool {
+		return k8s.NamespacedName(members[i]).String() < k8s.NamespacedName(members[j]).String()
+	})
+	return Group{
+		ID:              groupID,
+		Members:         members,
+		InactiveMembers: inactiveMembers,
+	}, nil
+}
+
+func (m *defaultGroupLoader) LoadGroupIDIfAny(ctx context.Context, svc *corev1.Service) (*GroupID, error) {
+	// Service no longer belong to any Service group when it's been deleted or no longer managed.
+	supported, err := m.serviceUtils.IsServiceSupported(ctx, svc)
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, nil
+	}
+	if groupName, exists := loadGroupName(m.annotationParser, svc); exists {
+		if err := validateGroupName(groupName); err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidServiceGroup, err.Error())
+		}
+		groupID := NewGroupIDForExplicitGroup(groupName)
+		return &groupID, nil
+	}
+	groupID := NewGroupIDForImplicitGroup(k8s.NamespacedName(svc))
+	return &groupID, nil
+}
+
+func (m *defaultGroupLoader) LoadGroupIDsPendingFinalization(_ context.Context, svc *corev1.Service) []GroupID {
+	var groupIDs []GroupID
+	for _, finalizer := range svc.GetFinalizers() {
+		if finalizer == shared_constants.ServiceFinalizer {
+			groupIDs = append(groupIDs, NewGroupIDForImplicitGroup(k8s.NamespacedName(svc)))
+		} else if strings.HasPrefix(finalizer, shared_constants.ServiceGroupFinalizerPrefix) {
+			groupName := finalizer[len(shared_constants.ServiceGroupFinalizerPrefix):]
+			groupIDs = append(groupIDs, NewGroupIDForExplicitGroup(groupName))
+		}
+	}
+	return groupIDs
+}
+
+type groupMembershipType int
+
+const (
+	groupMembershipTypeNone = iota
+	groupMembershipTypeActiveMember
+	groupMembershipTypeInactiveMember
+)
+
+// checkGroupMembershipType checks whether specified Service is members of specific Service group.
+func (m *defaultGroupLoader) checkGroupMembershipType(ctx context.Context, groupID GroupID, svc *corev1.Service) (groupMembershipType, error) {
+	hasGroupFinalizer := m.containsGroupFinalizer(groupID, svc)
+	// Services that can't be members of the Service group are skipped upfront, so that they don't fail loading it.
+	if !hasGroupFinalizer && !m.mayJoinGroup(groupID, svc) {
+		return groupMembershipTypeNone, nil
+	}
+	svcGroupID, err := m.LoadGroupIDIfAny(ctx, svc)
+	if err != nil {
+		// tolerate errInvalidServiceGroup error since a Service with a wrong group name means to leave the Service group anyway.
+		if errors.Is(err, errInvalidServiceGroup) {
+			if hasGroupFinalizer {
+				return groupMembershipTypeInactiveMember, nil
+			}
+			return groupMembershipTypeNone, nil
+		}
+		return groupMembershipTypeNone, err
+	}
+
+	if svcGroupID != nil && *svcGroupID == groupID {
+		return groupMembershipTypeActiveMember, nil
+	} else if hasGroupFinalizer {
+		return groupMembershipTypeInactiveMember, nil
+	}
+	return groupMembershipTypeNone, nil
+}
+
+// mayJoinGroup checks whether the Service specifies the Service group, regardless whether it's managed.
+func (m *defaultGroupLoader) mayJoinGroup(groupID GroupID, svc *corev1.Service) bool {
+	if groupID.IsExplicit() {
+		groupName, exists := loadGroupName(m.annotationParser, svc)
+		return exists && groupName == groupID.Name
+	}
+	_, inExplicitGroup := loadGroupName(m.annotationParser, svc)
+	return !inExplicitGroup && NewGroupIDForImplicitGroup(k8s.NamespacedName(svc)) == groupID
+}
+
+func (m *defaultGroupLoader) containsGroupFinalizer(groupID GroupID, svc *corev1.Service) bool {
+	finalizer := buildGroupFinalizer(groupID)
+	if groupID.IsExplicit() {
+		return k8s.HasFinalizer(svc, finalizer)
+	}
+
+	svcImplicitGroupID := NewGroupIDForImplicitGroup(k8s.NamespacedName(svc))
+	return svcImplicitGroupID == groupID && k8s.HasFinalizer(svc, finalizer)
+}
+
+// loadGroupName loads the name of the explicit Service group from the annotation of Service, if any.
+func loadGroupName(annotationParser annotations.Parser, svc *corev1.Service) (string, bool) {
+	groupName := ""
+	exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixGroupName, &groupName, svc.Annotations)
+	return groupName, exists
+}
+
+// validateGroupName validates whether Service group name is valid
+func validateGroupName(groupName string) error {
+	if !groupNameRegex.MatchString(groupName) {
+		return errors.New("groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character")
+	}
+	if len(groupName) > maxGroupNameLength {
+		return errors.Errorf("groupName must be no more than %v characters", maxGroupNameLength)
+	}
+	return nil
+}
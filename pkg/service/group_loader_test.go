@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestGroupLoaderService(namespace string, name string, groupName string, finalizers ...string) *corev1.Service {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  namespace,
+			Name:       name,
+			Finalizers: finalizers,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:              corev1.ServiceTypeLoadBalancer,
+			LoadBalancerClass: awssdk.String("service.k8s.aws/nlb"),
+		},
+	}
+	if groupName != "" {
+		svc.Annotations = map[string]string{
+			"service.beta.kubernetes.io/aws-load-balancer-group-name": groupName,
+		}
+	}
+	return svc
+}
+
+func newTestGroupLoader(t *testing.T, svcs ...*corev1.Service) *defaultGroupLoader {
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	elbv2api.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+	for _, svc := range svcs {
+		assert.NoError(t, k8sClient.Create(context.Background(), svc.DeepCopy()))
+	}
+	annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+	serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb",
+		NewDefaultLoadBalancerClassParamsLoader(k8sClient), config.NewFeatureGates())
+	return NewDefaultGroupLoader(k8sClient, annotationParser, serviceUtils)
+}
+
+func Test_defaultGroupLoader_Load(t *testing.T) {
+	tests := []struct {
+		name                string
+		svcs                []*corev1.Service
+		groupID             GroupID
+		wantMembers         []types.NamespacedName
+		wantInactiveMembers []types.NamespacedName
+	}{
+		{
+			name: "members are sorted by namespace and name",
+			svcs: []*corev1.Service{
+				newTestGroupLoaderService("ns-2", "svc-a", "awesome-group"),
+				newTestGroupLoaderService("ns-1", "svc-b", "awesome-group"),
+				newTestGroupLoaderService("ns-1", "svc-a", "awesome-group"),
+				newTestGroupLoaderService("ns-1", "svc-c", "another-group"),
+				newTestGroupLoaderService("ns-1", "svc-d", ""),
+			},
+			groupID: NewGroupIDForExplicitGroup("awesome-group"),
+			wantMembers: []types.NamespacedName{
+				{Namespace: "ns-1", Name: "svc-a"},
+				{Namespace: "ns-1", Name: "svc-b"},
+				{Namespace: "ns-2", Name: "svc-a"},
+			},
+		},
+		{
+			name: "services leaving the group with the group finalizer are inactive members",
+			svcs: []*corev1.Service{
+				newTestGroupLoaderService("ns-1", "svc-a", "awesome-group", "group.service.k8s.aws/awesome-group"),
+				newTestGroupLoaderService("ns-1", "svc-b", "another-group", "group.service.k8s.aws/awesome-group"),
+				newTestGroupLoaderService("ns-1", "svc-c", "", "group.service.k8s.aws/awesome-group"),
+				newTestGroupLoaderService("ns-1", "svc-d", "Invalid_Group", "group.service.k8s.aws/awesome-group"),
+				newTestGroupLoaderService("ns-1", "svc-e", "Invalid_Group"),
+				newTestGroupLoaderService("ns-1", "svc-f", "", "service.k8s.aws/resources"),
+			},
+			groupID: NewGroupIDForExplicitGroup("awesome-group"),
+			wantMembers: []types.NamespacedName{
+				{Namespace: "ns-1", Name: "svc-a"},
+			},
+			wantInactiveMembers: []types.NamespacedName{
+				{Namespace: "ns-1", Name: "svc-b"},
+				{Namespace: "ns-1", Name: "svc-c"},
+				{Namespace: "ns-1", Name: "svc-d"},
+			},
+		},
+		{
+			name: "services outside the group that can't be checked don't fail loading it",
+			svcs: []*corev1.Service{
+				newTestGroupLoaderService("ns-1", "svc-a", "awesome-group"),
+				func() *corev1.Service {
+					svc := newTestGroupLoaderService("ns-1", "svc-b", "", "service.k8s.aws/resources")
+					svc.Spec.LoadBalancerClass = awssdk.String("example.com/unbound")
+					return svc
+				}(),
+			},
+			groupID: NewGroupIDForExplicitGroup("awesome-group"),
+			wantMembers: []types.NamespacedName{
+				{Namespace: "ns-1", Name: "svc-a"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupLoader := newTestGroupLoader(t, tt.svcs...)
+			got, err := groupLoader.Load(context.Background(), tt.groupID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.groupID, got.ID)
+			assert.Equal(t, tt.wantMembers, k8s.ToSliceOfNamespacedNames(got.Members))
+			assert.ElementsMatch(t, tt.wantInactiveMembers, k8s.ToSliceOfNamespacedNames(got.InactiveMembers))
+		})
+	}
+}
+
+func Test_defaultGroupLoader_LoadGroupIDIfAny(t *testing.T) {
+	deletionTimestamp := metav1.Now()
+	unsupportedSvc := newTestGroupLoaderService("ns-1", "svc", "awesome-group")
+	unsupportedSvc.Spec.LoadBalancerClass = awssdk.String("some.other/lb")
+	deletedSvc := newTestGroupLoaderService("ns-1", "svc", "awesome-group")
+	deletedSvc.DeletionTimestamp = &deletionTimestamp
+
+	tests := []struct {
+		name    string
+		svc     *corev1.Service
+		want    *GroupID
+		wantErr error
+	}{
+		{
+			name: "explicit group",
+			svc:  newTestGroupLoaderService("ns-1", "svc", "awesome-group"),
+			want: &GroupID{Namespace: "", Name: "awesome-group"},
+		},
+		{
+			name: "implicit group",
+			svc:  newTestGroupLoaderService("ns-1", "svc", ""),
+			want: &GroupID{Namespace: "ns-1", Name: "svc"},
+		},
+		{
+			name:    "invalid group name",
+			svc:     newTestGroupLoaderService("ns-1", "svc", "-awesome-group"),
+			wantErr: errors.New("invalid service group: groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character"),
+		},
+		{
+			name: "unsupported service",
+			svc:  unsupportedSvc,
+			want: nil,
+		},
+		{
+			name: "service being deleted",
+			svc:  deletedSvc,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupLoader := newTestGroupLoader(t)
+			got, err := groupLoader.LoadGroupIDIfAny(context.Background(), tt.svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				assert.ErrorIs(t, err, errInvalidServiceGroup)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultGroupLoader_LoadGroupIDsPendingFinalization(t *testing.T) {
+	tests := []struct {
+		name string
+		svc  *corev1.Service
+		want []GroupID
+	}{
+		{
+			name: "no finalizers",
+			svc:  newTestGroupLoaderService("ns-1", "svc", ""),
+			want: nil,
+		},
+		{
+			name: "implicit group finalizer",
+			svc:  newTestGroupLoaderService("ns-1", "svc", "", "service.k8s.aws/resources"),
+			want: []GroupID{{Namespace: "ns-1", Name: "svc"}},
+		},
+		{
+			name: "explicit group finalizers",
+			svc: newTestGroupLoaderService("ns-1", "svc", "",
+				"group.service.k8s.aws/awesome-group", "some.other/finalizer", "group.service.k8s.aws/another-group"),
+			want: []GroupID{{Namespace: "", Name: "awesome-group"}, {Namespace: "", Name: "another-group"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupLoader := newTestGroupLoader(t)
+			got := groupLoader.LoadGroupIDsPendingFinalization(context.Background(), tt.svc)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_validateGroupName(t *testing.T) {
+	tests := []struct {
+		name      string
+		groupName string
+		wantErr   error
+	}{
+		{
+			name:      "lower case letters, numbers, dash and dot",
+			groupName: "aaaa-.cc-c.c42",
+			wantErr:   nil,
+		},
+		{
+			name:      "upper case letters",
+			groupName: "GROUP",
+			wantErr:   errors.New("groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character"),
+		},
+		{
+			name:      "starting with dash",
+			groupName: "-abcdef",
+			wantErr:   errors.New("groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character"),
+		},
+		{
+			name:      "63 character length",
+			groupName: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			wantErr:   nil,
+		},
+		{
+			name:      "64 character length",
+			groupName: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			wantErr:   errors.New("groupName must be no more than 63 characters"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateGroupName(tt.groupName)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestGroupID_IsExplicit(t *testing.T) {
+	tests := []struct {
+		name    string
+		groupID GroupID
+		want    bool
+	}{
+		{
+			name: "explicit group",
+			groupID: GroupID{
+				Namespace: "",
+				Name:      "awesome-group",
+			},
+			want: true,
+		},
+		{
+			name: "implicit group",
+			groupID: GroupID{
+				Namespace: "namespace",
+				Name:      "service",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.groupID.IsExplicit()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGroupID_String(t *testing.T) {
+	tests := []struct {
+		name    string
+		groupID GroupID
+		want    string
+	}{
+		{
+			name: "explicit group",
+			groupID: GroupID{
+				Namespace: "",
+				Name:      "awesome-group",
+			},
+			want: "awesome-group",
+		},
+		{
+			name: "implicit group",
+			groupID: GroupID{
+				Namespace: "namespace",
+				Name:      "service",
+			},
+			want: "namespace/service",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.groupID.String()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEncodeGroupIDToReconcileRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		groupID GroupID
+		want    reconcile.Request
+	}{
+		{
+			name:    "explicit group",
+			groupID: NewGroupIDForExplicitGroup("awesome-group"),
+			want: reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: "", Name: "awesome-group"},
+			},
+		},
+		{
+			name:    "implicit group",
+			groupID: NewGroupIDForImplicitGroup(types.NamespacedName{Namespace: "namespace", Name: "service"}),
+			want: reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: "namespace", Name: "service"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EncodeGroupIDToReconcileRequest(tt.groupID)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.groupID, DecodeGroupIDFromReconcileRequest(got))
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// groupLoadBalancerAnnotationKind is how the value of an annotation configuring the load balancer is parsed to be compared across members.
+type groupLoadBalancerAnnotationKind int
+
+const (
+	groupLoadBalancerAnnotationString groupLoadBalancerAnnotationKind = iota
+	groupLoadBalancerAnnotationBool
+	// groupLoadBalancerAnnotationStringList is a list whose order matters, like addresses assigned to subnets in order.
+	groupLoadBalancerAnnotationStringList
+	// groupLoadBalancerAnnotationStringSet is a list whose order doesn't matter.
+	groupLoadBalancerAnnotationStringSet
+	groupLoadBalancerAnnotationStringMap
+)
+
+// groupLoadBalancerAnnotations are the annotations configuring the load balancer shared by the members of a Service group.
+// The load balancer is built from the first member, so these must be consistent across members.
+var groupLoadBalancerAnnotations = []struct {
+	suffix string
+	kind   groupLoadBalancerAnnotationKind
+}{
+	{annotations.SvcLBSuffixLoadBalancerName, groupLoadBalancerAnnotationString},
+	{annotations.SvcLBSuffixScheme, groupLoadBalancerAnnotationString},
+	{annotations.SvcLBSuffixInternal, groupLoadBalancerAnnotationBool},
+	{annotations.SvcLBSuffixIPAddressType, groupLoadBalancerAnnotationString},
+	{annotations.SvcLBSuffixSubnets, groupLoadBalancerAnnotationStringSet},
+	{annotations.SvcLBSuffixEIPAllocations, groupLoadBalancerAnnotationStringList},
+	{annotations.SvcLBSuffixPrivateIpv4Addresses, groupLoadBalancerAnnotationStringList},
+	{annotations.SvcLBSuffixIpv6Addresses, groupLoadBalancerAnnotationStringList},
+	{annotations.SvcLBSuffixLoadBalancerAttributes, groupLoadBalancerAnnotationStringMap},
+	{annotations.SvcLBSuffixAccessLogEnabled, groupLoadBalancerAnnotationBool},
+	{annotations.SvcLBSuffixAccessLogS3BucketName, groupLoadBalancerAnnotationString},
+	{annotations.SvcLBSuffixAccessLogS3BucketPrefix, groupLoadBalancerAnnotationString},
+	{annotations.SvcLBSuffixCrossZoneLoadBalancingEnabled, groupLoadBalancerAnnotationBool},
+	{annotations.SvcLBSuffixLoadBalancerCapacityReservation, groupLoadBalancerAnnotationStringMap},
+	{annotations.SvcLBSuffixAdditionalTags, groupLoadBalancerAnnotationStringMap},
+	{annotations.SvcLBSuffixLoadBalancerSecurityGroups, groupLoadBalancerAnnotationStringSet},
+	{annotations.SvcLBSuffixManageSGRules, groupLoadBalancerAnnotationBool},
+	{annotations.SvcLBSuffixEnforceSGInboundRulesOnPrivateLinkTraffic, groupLoadBalancerAnnotationString},
+	{annotations.SvcLBSuffixSecurityGroupPrefixLists, groupLoadBalancerAnnotationStringSet},
+	{annotations.SvcLBSuffixSourceRanges, groupLoadBalancerAnnotationStringSet},
+	{annotations.SvcLBSuffixEnableIcmpForPathMtuDiscovery, groupLoadBalancerAnnotationString},
+	{annotations.SvcLBSuffixEnablePrefixForIpv6SourceNat, groupLoadBalancerAnnotationString},
+	{annotations.SvcLBSuffixSourceNatIpv6Prefixes, groupLoadBalancerAnnotationStringList},
+}
+
+// buildGroupListeners builds the listeners and target groups of each member of the Service group.
+func (t *defaultModelBuildTask) buildGroupListeners(ctx context.Context, scheme elbv2model.LoadBalancerScheme) error {
+	primary := t.service
+	defer t.setService(primary)
+	for _, member := range t.svcGroup.Members {
+		t.setService(member)
+		if err := t.buildListeners(ctx, scheme); err != nil {
+			return errors.Wrapf(err, "Service: %v", k8s.NamespacedName(member))
+		}
+	}
+	return nil
+}
+
+// GroupMemberConflict is a member of a Service group excluded from its load balancer because it conflicts with other members.
+type GroupMemberConflict struct {
+	Service *corev1.Service
+	Err     error
+}
+
+// ExcludeConflictingGroupMembers excludes the members joining the Service group that conflict with other members, so that they don't fail the whole Service group.
+// Members that already joined the load balancer, i.e. that have the group finalizer, are never excluded since that would delete their listeners,
+// it returns an error if they conflict with each other instead, so that the deployed load balancer is kept until the conflict is resolved.
+func ExcludeConflictingGroupMembers(annotationParser annotations.Parser, svcGroup Group) (Group, []GroupMemberConflict, error) {
+	finalizer := buildGroupFinalizer(svcGroup.ID)
+	var accepted, joiningMembers []*corev1.Service
+	for _, member := range svcGroup.Members {
+		if k8s.HasFinalizer(member, finalizer) {
+			accepted = append(accepted, member)
+		} else {
+			joiningMembers = append(joiningMembers, member)
+		}
+	}
+	if err := validateGroupMembers(annotationParser, accepted); err != nil {
+		return svcGroup, nil, err
+	}
+	var conflicts []GroupMemberConflict
+	for _, member := range joiningMembers {
+		if err := validateGroupMember(annotationParser, accepted, member); err != nil {
+			conflicts = append(conflicts, GroupMemberConflict{Service: member, Err: err})
+			continue
+		}
+		accepted = append(accepted, member)
+	}
+	if len(conflicts) == 0 {
+		return svcGroup, nil, nil
+	}
+	// the members keep their order, the first one hosts the load balancer level settings.
+	var members []*corev1.Service
+	for _, member := range svcGroup.Members {
+		if slices.Contains(accepted, member) {
+			members = append(members, member)
+		}
+	}
+	svcGroup.Members = members
+	return svcGroup, conflicts, nil
+}
+
+// validateGroupMembers validates that the members of a Service group can share a single load balancer.
+func validateGroupMembers(annotationParser annotations.Parser, members []*corev1.Service) error {
+	for i, member := range members {
+		if err := validateGroupMember(annotationParser, members[:i], member); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateGroupMember validates that the Service can share the load balancer of a Service group with its members.
+// The Service itself is skipped when it's one of the members.
+func validateGroupMember(annotationParser annotations.Parser, members []*corev1.Service, svc *corev1.Service) error {
+	svcKey := k8s.NamespacedName(svc)
+	for _, member := range members {
+		if k8s.NamespacedName(member) == svcKey {
+			continue
+		}
+		if err := validateGroupLoadBalancerSettings(annotationParser, member, svc); err != nil {
+			return err
+		}
+		if err := validateGroupListenerPorts(member, svc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateGroupLoadBalancerSettings validates that two members of a Service group agree on the settings of the load balancer.
+// Annotations are compared by their parsed values, so that they may differ in formatting or in the order of unordered lists.
+func validateGroupLoadBalancerSettings(annotationParser annotations.Parser, member *corev1.Service, svc *corev1.Service) error {
+	for _, annotation := range groupLoadBalancerAnnotations {
+		if !equalGroupLoadBalancerAnnotation(annotationParser, annotation.suffix, annotation.kind, member.Annotations, svc.Annotations) {
+			return errors.Errorf("conflicting %v annotation between Services %v and %v",
+				annotation.suffix, k8s.NamespacedName(member), k8s.NamespacedName(svc))
+		}
+	}
+	if !equality.Semantic.DeepEqual(member.Spec.LoadBalancerClass, svc.Spec.LoadBalancerClass) {
+		return errors.Errorf("conflicting loadBalancerClass between Services %v and %v",
+			k8s.NamespacedName(member), k8s.NamespacedName(svc))
+	}
+	if !sets.New(member.Spec.LoadBalancerSourceRanges...).Equal(sets.New(svc.Spec.LoadBalancerSourceRanges...)) {
+		return errors.Errorf("conflicting loadBalancerSourceRanges between Services %v and %v",
+			k8s.NamespacedName(member), k8s.NamespacedName(svc))
+	}
+	return nil
+}
+
+// equalGroupLoadBalancerAnnotation checks whether the annotation has the same parsed value on both members.
+// Values that fail to parse are compared as they are, the model build reports them.
+func equalGroupLoadBalancerAnnotation(annotationParser annotations.Parser, suffix string, kind groupLoadBalancerAnnotationKind,
+	memberAnnotations map[string]string, svcAnnotations map[string]string) bool {
+	switch kind {
+	case groupLoadBalancerAnnotationBool:
+		var memberValue, svcValue bool
+		memberExists, memberErr := annotationParser.ParseBoolAnnotation(suffix, &memberValue, memberAnnotations)
+		svcExists, svcErr := annotationParser.ParseBoolAnnotation(suffix, &svcValue, svcAnnotations)
+		if memberErr == nil && svcErr == nil {
+			return memberExists == svcExists && memberValue == svcValue
+		}
+	case groupLoadBalancerAnnotationStringList, groupLoadBalancerAnnotationStringSet:
+		var memberValue, svcValue []string
+		memberExists := annotationParser.ParseStringSliceAnnotation(suffix, &memberValue, memberAnnotations)
+		svcExists := annotationParser.ParseStringSliceAnnotation(suffix, &svcValue, svcAnnotations)
+		if kind == groupLoadBalancerAnnotationStringSet {
+			return memberExists == svcExists && sets.New(memberValue...).Equal(sets.New(svcValue...))
+		}
+		return memberExists == svcExists && slices.Equal(memberValue, svcValue)
+	case groupLoadBalancerAnnotationStringMap:
+		var memberValue, svcValue map[string]string
+		memberExists, memberErr := annotationParser.ParseStringMapAnnotation(suffix, &memberValue, memberAnnotations)
+		svcExists, svcErr := annotationParser.ParseStringMapAnnotation(suffix, &svcValue, svcAnnotations)
+		if memberErr == nil && svcErr == nil {
+			return memberExists == svcExists && maps.Equal(memberValue, svcValue)
+		}
+	}
+	var memberValue, svcValue string
+	memberExists := annotationParser.ParseStringAnnotation(suffix, &memberValue, memberAnnotations)
+	svcExists := annotationParser.ParseStringAnnotation(suffix, &svcValue, svcAnnotations)
+	return memberExists == svcExists && strings.TrimSpace(memberValue) == strings.TrimSpace(svcValue)
+}
+
+// validateGroupListenerPorts validates that two members of a Service group don't use the same listener port.
+func validateGroupListenerPorts(member *corev1.Service, svc *corev1.Service) error {
+	for _, memberPort := range member.Spec.Ports {
+		for _, svcPort := range svc.Spec.Ports {
+			if memberPort.Port == svcPort.Port {
+				return errors.Errorf("conflicting listener port %v between Services %v and %v",
+					svcPort.Port, k8s.NamespacedName(member), k8s.NamespacedName(svc))
+			}
+		}
+	}
+	return nil
+}
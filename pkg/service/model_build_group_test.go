@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func newTestGroupMember(namespace string, name string, ports []int32, extraAnnotations map[string]string) *corev1.Service {
+	svcAnnotations := map[string]string{
+		"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+		"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+	}
+	for key, value := range extraAnnotations {
+		svcAnnotations[key] = value
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			UID:         types.UID("uid-" + name),
+			Annotations: svcAnnotations,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeLoadBalancer,
+			Selector: map[string]string{"app": name},
+		},
+	}
+	for _, port := range ports {
+		svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+			Port:       port,
+			TargetPort: intstr.FromInt(int(port)),
+			Protocol:   corev1.ProtocolTCP,
+		})
+	}
+	return svc
+}
+
+func Test_defaultModelBuilder_BuildGroup(t *testing.T) {
+	tests := []struct {
+		name              string
+		svcGroup          Group
+		wantLoadBalancer  bool
+		wantListenerPorts []int32
+		wantTargetGroups  []string
+		wantErr           error
+	}{
+		{
+			name: "members share the load balancer with one listener per port",
+			svcGroup: Group{
+				ID: NewGroupIDForExplicitGroup("awesome-group"),
+				Members: []*corev1.Service{
+					newTestGroupMember("ns-1", "svc-a", []int32{80}, nil),
+					newTestGroupMember("ns-2", "svc-b", []int32{443, 8443}, nil),
+				},
+			},
+			wantLoadBalancer:  true,
+			wantListenerPorts: []int32{80, 443, 8443},
+			wantTargetGroups:  []string{"ns-1/svc-a:80", "ns-2/svc-b:443", "ns-2/svc-b:8443"},
+		},
+		{
+			name: "members using the same port",
+			svcGroup: Group{
+				ID: NewGroupIDForExplicitGroup("awesome-group"),
+				Members: []*corev1.Service{
+					newTestGroupMember("ns-1", "svc-a", []int32{80}, nil),
+					newTestGroupMember("ns-2", "svc-b", []int32{443, 80}, nil),
+				},
+			},
+			wantErr: errors.New("conflicting listener port 80 between Services ns-1/svc-a and ns-2/svc-b"),
+		},
+		{
+			name: "no members left",
+			svcGroup: Group{
+				ID: NewGroupIDForExplicitGroup("awesome-group"),
+				InactiveMembers: []*corev1.Service{
+					newTestGroupMember("ns-1", "svc-a", []int32{80}, nil),
+				},
+			},
+			wantLoadBalancer: false,
+		},
+		{
+			name: "no members left with deletion protection",
+			svcGroup: Group{
+				ID: NewGroupIDForExplicitGroup("awesome-group"),
+				InactiveMembers: []*corev1.Service{
+					newTestGroupMember("ns-1", "svc-a", []int32{80}, map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-attributes": "deletion_protection.enabled=true",
+					}),
+				},
+			},
+			wantErr: errors.New("deletion_protection is enabled, cannot delete the load balancer of service group: awesome-group"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			subnetsResolver := networking.NewMockSubnetsResolver(ctrl)
+			subnetsResolver.EXPECT().ResolveViaDiscovery(gomock.Any(), gomock.Any()).Return([]ec2types.Subnet{
+				{
+					SubnetId:  awssdk.String("subnet-1"),
+					CidrBlock: awssdk.String("192.168.0.0/19"),
+				},
+			}, nil).AnyTimes()
+			elbv2TaggingManager := elbv2.NewMockTaggingManager(ctrl)
+			elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+			featureGates := config.NewFeatureGates()
+			featureGates.Disable(config.NLBSecurityGroup)
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			trackingProvider := tracking.NewDefaultProvider("service.k8s.aws", "my-cluster")
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", nil, featureGates)
+			metricsCollector := lbcmetrics.NewMockCollector()
+			builder := NewDefaultModelBuilder(annotationParser, subnetsResolver, networking.NewMockVPCInfoProvider(ctrl), "vpc-xxx", trackingProvider,
+				elbv2TaggingManager, services.NewMockEC2(ctrl), featureGates, "my-cluster", nil, nil, "ELBSecurityPolicy-2016-08", "instance",
+				string(elbv2model.LoadBalancerSchemeInternal), true, serviceUtils, nil, networking.NewMockBackendSGProvider(ctrl),
+				networking.NewMockSecurityGroupResolver(ctrl), false, false, false, logr.New(&log.NullLogSink{}), metricsCollector, true)
+
+			stack, lb, _, err := builder.BuildGroup(context.Background(), tt.svcGroup, metricsCollector)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, core.StackID{Namespace: "", Name: "awesome-group"}, stack.StackID())
+			assert.Equal(t, tt.wantLoadBalancer, lb != nil)
+
+			var listeners []*elbv2model.Listener
+			assert.NoError(t, stack.ListResources(&listeners))
+			var listenerPorts []int32
+			for _, ls := range listeners {
+				listenerPorts = append(listenerPorts, ls.Spec.Port)
+			}
+			assert.ElementsMatch(t, tt.wantListenerPorts, listenerPorts)
+
+			var targetGroups []*elbv2model.TargetGroup
+			assert.NoError(t, stack.ListResources(&targetGroups))
+			var targetGroupIDs []string
+			for _, tg := range targetGroups {
+				targetGroupIDs = append(targetGroupIDs, tg.ID())
+			}
+			assert.ElementsMatch(t, tt.wantTargetGroups, targetGroupIDs)
+		})
+	}
+}
+
+func Test_validateGroupMembers(t *testing.T) {
+	internalMember := newTestGroupMember("ns-2", "svc-b", []int32{443}, map[string]string{
+		"service.beta.kubernetes.io/aws-load-balancer-scheme": "internal",
+	})
+	sourceRangesMember := newTestGroupMember("ns-2", "svc-b", []int32{443}, nil)
+	sourceRangesMember.Spec.LoadBalancerSourceRanges = []string{"10.0.0.0/8"}
+	lbClassMember := newTestGroupMember("ns-2", "svc-b", []int32{443}, nil)
+	lbClassMember.Spec.LoadBalancerClass = awssdk.String("service.k8s.aws/nlb")
+
+	tests := []struct {
+		name    string
+		members []*corev1.Service
+		wantErr error
+	}{
+		{
+			name: "consistent settings",
+			members: []*corev1.Service{
+				newTestGroupMember("ns-1", "svc-a", []int32{80}, map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-scheme":                  "internet-facing",
+					"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": "preserve_client_ip.enabled=false",
+				}),
+				newTestGroupMember("ns-2", "svc-b", []int32{443}, map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+				}),
+			},
+		},
+		{
+			name: "settings formatted differently",
+			members: []*corev1.Service{
+				newTestGroupMember("ns-1", "svc-a", []int32{80}, map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-subnets":                  "subnet-1,subnet-2",
+					"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "a=1,b=2",
+					"service.beta.kubernetes.io/aws-load-balancer-internal":                 "true",
+				}),
+				newTestGroupMember("ns-2", "svc-b", []int32{443}, map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-subnets":                  "subnet-2, subnet-1",
+					"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "b=2, a=1",
+					"service.beta.kubernetes.io/aws-load-balancer-internal":                 "True",
+				}),
+			},
+		},
+		{
+			name: "addresses in a different order",
+			members: []*corev1.Service{
+				newTestGroupMember("ns-1", "svc-a", []int32{80}, map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-eip-allocations": "eipalloc-1,eipalloc-2",
+				}),
+				newTestGroupMember("ns-2", "svc-b", []int32{443}, map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-eip-allocations": "eipalloc-2,eipalloc-1",
+				}),
+			},
+			wantErr: errors.New("conflicting aws-load-balancer-eip-allocations annotation between Services ns-1/svc-a and ns-2/svc-b"),
+		},
+		{
+			name: "annotation only set on one member",
+			members: []*corev1.Service{
+				newTestGroupMember("ns-1", "svc-a", []int32{80}, nil),
+				internalMember,
+			},
+			wantErr: errors.New("conflicting aws-load-balancer-scheme annotation between Services ns-1/svc-a and ns-2/svc-b"),
+		},
+		{
+			name: "different annotation values",
+			members: []*corev1.Service{
+				newTestGroupMember("ns-1", "svc-a", []int32{80}, map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+				}),
+				internalMember,
+			},
+			wantErr: errors.New("conflicting aws-load-balancer-scheme annotation between Services ns-1/svc-a and ns-2/svc-b"),
+		},
+		{
+			name: "different loadBalancerClass",
+			members: []*corev1.Service{
+				newTestGroupMember("ns-1", "svc-a", []int32{80}, nil),
+				lbClassMember,
+			},
+			wantErr: errors.New("conflicting loadBalancerClass between Services ns-1/svc-a and ns-2/svc-b"),
+		},
+		{
+			name: "different loadBalancerSourceRanges",
+			members: []*corev1.Service{
+				newTestGroupMember("ns-1", "svc-a", []int32{80}, nil),
+				sourceRangesMember,
+			},
+			wantErr: errors.New("conflicting loadBalancerSourceRanges between Services ns-1/svc-a and ns-2/svc-b"),
+		},
+		{
+			name: "same listener port",
+			members: []*corev1.Service{
+				newTestGroupMember("ns-1", "svc-a", []int32{80, 443}, nil),
+				newTestGroupMember("ns-2", "svc-b", []int32{8080}, nil),
+				newTestGroupMember("ns-2", "svc-c", []int32{443}, nil),
+			},
+			wantErr: errors.New("conflicting listener port 443 between Services ns-1/svc-a and ns-2/svc-c"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			err := validateGroupMembers(annotationParser, tt.members)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			}
+		})
+	}
+}
+
+func Test_ExcludeConflictingGroupMembers(t *testing.T) {
+	withFinalizer := func(svc *corev1.Service) *corev1.Service {
+		svc.Finalizers = []string{"group.service.k8s.aws/awesome-group"}
+		return svc
+	}
+	tests := []struct {
+		name          string
+		members       []*corev1.Service
+		wantMembers   []string
+		wantConflicts map[string]string
+		wantErr       error
+	}{
+		{
+			name: "no conflicts",
+			members: []*corev1.Service{
+				newTestGroupMember("ns-1", "svc-a", []int32{80}, nil),
+				newTestGroupMember("ns-1", "svc-b", []int32{443}, nil),
+			},
+			wantMembers: []string{"svc-a", "svc-b"},
+		},
+		{
+			name: "members joining with conflicting port or settings are excluded",
+			members: []*corev1.Service{
+				newTestGroupMember("ns-1", "svc-a", []int32{80}, nil),
+				withFinalizer(newTestGroupMember("ns-1", "svc-b", []int32{443}, nil)),
+				newTestGroupMember("ns-1", "svc-c", []int32{443}, nil),
+				newTestGroupMember("ns-1", "svc-d", []int32{8080}, map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+				}),
+			},
+			wantMembers: []string{"svc-a", "svc-b"},
+			wantConflicts: map[string]string{
+				"svc-c": "conflicting listener port 443 between Services ns-1/svc-b and ns-1/svc-c",
+				"svc-d": "conflicting aws-load-balancer-scheme annotation between Services ns-1/svc-b and ns-1/svc-d",
+			},
+		},
+		{
+			name: "members that joined the load balancer conflict with each other",
+			members: []*corev1.Service{
+				withFinalizer(newTestGroupMember("ns-1", "svc-a", []int32{80}, map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+				})),
+				withFinalizer(newTestGroupMember("ns-1", "svc-b", []int32{443}, nil)),
+				newTestGroupMember("ns-1", "svc-c", []int32{8080}, nil),
+			},
+			wantErr: errors.New("conflicting aws-load-balancer-scheme annotation between Services ns-1/svc-a and ns-1/svc-b"),
+		},
+		{
+			name: "members that joined the load balancer take precedence",
+			members: []*corev1.Service{
+				newTestGroupMember("ns-1", "svc-a", []int32{80}, map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+				}),
+				withFinalizer(newTestGroupMember("ns-1", "svc-b", []int32{80}, nil)),
+				withFinalizer(newTestGroupMember("ns-1", "svc-c", []int32{443}, nil)),
+			},
+			wantMembers: []string{"svc-b", "svc-c"},
+			wantConflicts: map[string]string{
+				"svc-a": "conflicting aws-load-balancer-scheme annotation between Services ns-1/svc-b and ns-1/svc-a",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			svcGroup := Group{ID: NewGroupIDForExplicitGroup("awesome-group"), Members: tt.members}
+			gotGroup, gotConflicts, err := ExcludeConflictingGroupMembers(annotationParser, svcGroup)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			var gotMembers []string
+			for _, member := range gotGroup.Members {
+				gotMembers = append(gotMembers, member.Name)
+			}
+			assert.Equal(t, tt.wantMembers, gotMembers)
+			var gotConflictErrs map[string]string
+			for _, conflict := range gotConflicts {
+				if gotConflictErrs == nil {
+					gotConflictErrs = make(map[string]string)
+				}
+				gotConflictErrs[conflict.Service.Name] = conflict.Err.Error()
+			}
+			assert.Equal(t, tt.wantConflicts, gotConflictErrs)
+		})
+	}
+}
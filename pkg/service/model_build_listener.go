@@ -169,6 +169,9 @@ func (t *defaultModelBuildTask) buildListenerDefaultActions(_ context.Context, t
 }
 
 func (t *defaultModelBuildTask) buildSSLNegotiationPolicy(_ context.Context) *string {
+	if t.lbClassParams != nil && t.lbClassParams.Spec.SSLPolicy != "" {
+		return &t.lbClassParams.Spec.SSLPolicy
+	}
 	rawSslPolicyStr := ""
 	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixSSLNegotiationPolicy, &rawSslPolicyStr, t.service.Annotations); exists {
 		return &rawSslPolicyStr
@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
@@ -535,3 +536,57 @@ func Test_shouldUseTCPUDP(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuilderTask_buildSSLNegotiationPolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		svc           *corev1.Service
+		lbClassParams *elbv2api.LoadBalancerClassParams
+		want          string
+	}{
+		{
+			name: "default SSL policy",
+			svc:  &corev1.Service{},
+			want: "ELBSecurityPolicy-2016-08",
+		},
+		{
+			name: "SSL policy annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-ssl-negotiation-policy": "ELBSecurityPolicy-TLS13-1-2-2021-06",
+					},
+				},
+			},
+			want: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+		},
+		{
+			name: "SSL policy of LoadBalancerClassParams takes priority over SSL policy annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-ssl-negotiation-policy": "ELBSecurityPolicy-TLS13-1-2-2021-06",
+					},
+				},
+			},
+			lbClassParams: &elbv2api.LoadBalancerClassParams{
+				Spec: elbv2api.LoadBalancerClassParamsSpec{
+					SSLPolicy: "ELBSecurityPolicy-TLS13-1-2-FIPS-2023-04",
+				},
+			},
+			want: "ELBSecurityPolicy-TLS13-1-2-FIPS-2023-04",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				service:          tt.svc,
+				lbClassParams:    tt.lbClassParams,
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				defaultSSLPolicy: "ELBSecurityPolicy-2016-08",
+			}
+			got := task.buildSSLNegotiationPolicy(context.Background())
+			assert.Equal(t, tt.want, *got)
+		})
+	}
+}
@@ -13,7 +13,7 @@ import (
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/types"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
@@ -132,7 +132,7 @@ func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Cont
 		if !t.enableBackendSG {
 			t.backendSGIDToken = managedSG.GroupID()
 		} else {
-			backendSGID, err := t.backendSGProvider.Get(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(t.service)})
+			backendSGID, err := t.backendSGProvider.Get(ctx, networking.ResourceTypeService, k8s.ToSliceOfNamespacedNames(t.members()))
 			if err != nil {
 				return nil, err
 			}
@@ -156,7 +156,7 @@ func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Cont
 			if !t.enableBackendSG {
 				return nil, errors.New("backendSG feature is required to manage worker node SG rules when frontendSG is manually specified")
 			}
-			backendSGID, err := t.backendSGProvider.Get(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(t.service)})
+			backendSGID, err := t.backendSGProvider.Get(ctx, networking.ResourceTypeService, k8s.ToSliceOfNamespacedNames(t.members()))
 			if err != nil {
 				return nil, err
 			}
@@ -233,7 +233,10 @@ func (t *defaultModelBuildTask) buildSecurityGroupsInboundRulesOnPrivateLink(_ c
 }
 
 func (t *defaultModelBuildTask) buildLoadBalancerScheme(ctx context.Context) (elbv2model.LoadBalancerScheme, error) {
-	scheme, explicitSchemeSpecified, err := t.buildLoadBalancerSchemeViaAnnotation(ctx)
+	if t.lbClassParams != nil && t.lbClassParams.Spec.Scheme != nil {
+		return elbv2model.LoadBalancerScheme(*t.lbClassParams.Spec.Scheme), nil
+	}
+	scheme, explicitSchemeSpecified, err := ParseLoadBalancerScheme(t.annotationParser, t.service)
 	if err != nil {
 		return elbv2model.LoadBalancerSchemeInternal, err
 	}
@@ -257,9 +260,10 @@ func (t *defaultModelBuildTask) buildLoadBalancerScheme(ctx context.Context) (el
 	return t.defaultLoadBalancerScheme, nil
 }
 
-func (t *defaultModelBuildTask) buildLoadBalancerSchemeViaAnnotation(ctx context.Context) (elbv2model.LoadBalancerScheme, bool, error) {
+// ParseLoadBalancerScheme parses the scheme of the Service's load balancer from its annotations, along with whether it's explicitly specified.
+func ParseLoadBalancerScheme(annotationParser annotations.Parser, svc *corev1.Service) (elbv2model.LoadBalancerScheme, bool, error) {
 	rawScheme := ""
-	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixScheme, &rawScheme, t.service.Annotations); exists {
+	if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixScheme, &rawScheme, svc.Annotations); exists {
 		switch rawScheme {
 		case string(elbv2model.LoadBalancerSchemeInternetFacing):
 			return elbv2model.LoadBalancerSchemeInternetFacing, true, nil
@@ -269,12 +273,12 @@ func (t *defaultModelBuildTask) buildLoadBalancerSchemeViaAnnotation(ctx context
 			return "", false, errors.Errorf("unknown scheme: %v", rawScheme)
 		}
 	}
-	return t.buildLoadBalancerSchemeLegacyAnnotation(ctx)
+	return parseLoadBalancerSchemeLegacyAnnotation(annotationParser, svc)
 }
 
-func (t *defaultModelBuildTask) buildLoadBalancerSchemeLegacyAnnotation(_ context.Context) (elbv2model.LoadBalancerScheme, bool, error) {
+func parseLoadBalancerSchemeLegacyAnnotation(annotationParser annotations.Parser, svc *corev1.Service) (elbv2model.LoadBalancerScheme, bool, error) {
 	internal := true
-	exists, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixInternal, &internal, t.service.Annotations)
+	exists, err := annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixInternal, &internal, svc.Annotations)
 	if err != nil {
 		return "", false, err
 	}
@@ -316,11 +320,30 @@ func (t *defaultModelBuildTask) buildAdditionalResourceTags(_ context.Context) (
 			return nil, errors.Errorf("external managed tag key %v cannot be specified on Service", tagKey)
 		}
 	}
+	lbClassParamsTags, err := t.buildLoadBalancerClassParamsTags()
+	if err != nil {
+		return nil, err
+	}
 
-	mergedTags := algorithm.MergeStringMap(t.defaultTags, annotationTags)
+	mergedTags := algorithm.MergeStringMap(t.defaultTags, lbClassParamsTags, annotationTags)
 	return mergedTags, nil
 }
 
+// buildLoadBalancerClassParamsTags builds the tags specified via LoadBalancerClassParams, which take priority over the tags annotation.
+func (t *defaultModelBuildTask) buildLoadBalancerClassParamsTags() (map[string]string, error) {
+	if t.lbClassParams == nil || len(t.lbClassParams.Spec.Tags) == 0 {
+		return nil, nil
+	}
+	lbClassParamsTags := make(map[string]string, len(t.lbClassParams.Spec.Tags))
+	for _, tag := range t.lbClassParams.Spec.Tags {
+		if t.externalManagedTags.Has(tag.Key) {
+			return nil, errors.Errorf("external managed tag key %v cannot be specified on LoadBalancerClassParams %v", tag.Key, t.lbClassParams.Name)
+		}
+		lbClassParamsTags[tag.Key] = tag.Value
+	}
+	return lbClassParamsTags, nil
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerTags(ctx context.Context) (map[string]string, error) {
 	return t.buildAdditionalResourceTags(ctx)
 }
@@ -435,6 +458,12 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(_ context.Contex
 }
 
 func (t *defaultModelBuildTask) buildLoadBalancerSubnets(ctx context.Context, scheme elbv2model.LoadBalancerScheme) ([]ec2types.Subnet, error) {
+	if t.lbClassParams != nil && t.lbClassParams.Spec.Subnets != nil {
+		return t.subnetsResolver.ResolveViaSelector(ctx, *t.lbClassParams.Spec.Subnets,
+			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+			networking.WithSubnetsResolveLBScheme(scheme),
+		)
+	}
 	var rawSubnetNameOrIDs []string
 	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSubnets, &rawSubnetNameOrIDs, t.service.Annotations); exists {
 		return t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, rawSubnetNameOrIDs,
@@ -486,10 +515,23 @@ func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) (
 	if err != nil {
 		return []elbv2model.LoadBalancerAttribute{}, err
 	}
-	mergedAttributes := algorithm.MergeStringMap(specificAttributes, loadBalancerAttributes)
+	mergedAttributes := algorithm.MergeStringMap(t.buildLoadBalancerClassParamsAttributes(), specificAttributes, loadBalancerAttributes)
 	return makeAttributesSliceFromMap(mergedAttributes), nil
 }
 
+// buildLoadBalancerClassParamsAttributes builds the load balancer attributes specified via LoadBalancerClassParams,
+// which take priority over the attributes specified via annotations.
+func (t *defaultModelBuildTask) buildLoadBalancerClassParamsAttributes() map[string]string {
+	if t.lbClassParams == nil || len(t.lbClassParams.Spec.LoadBalancerAttributes) == 0 {
+		return nil
+	}
+	attributes := make(map[string]string, len(t.lbClassParams.Spec.LoadBalancerAttributes))
+	for _, attr := range t.lbClassParams.Spec.LoadBalancerAttributes {
+		attributes[attr.Key] = attr.Value
+	}
+	return attributes
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerMinimumCapacity(_ context.Context) (*elbv2model.MinimumLoadBalancerCapacity, error) {
 	if !t.featureGates.Enabled(config.LBCapacityReservation) {
 		return nil, nil
@@ -592,6 +634,15 @@ func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme
 		}
 		return name, nil
 	}
+	if t.svcGroup != nil {
+		uuidHash := sha256.New()
+		_, _ = uuidHash.Write([]byte(t.clusterName))
+		_, _ = uuidHash.Write([]byte(t.svcGroup.ID.String()))
+		_, _ = uuidHash.Write([]byte(scheme))
+		uuid := hex.EncodeToString(uuidHash.Sum(nil))
+		payload := invalidLoadBalancerNamePattern.ReplaceAllString(t.svcGroup.ID.Name, "")
+		return fmt.Sprintf("k8s-%.17s-%.10s", payload, uuid), nil
+	}
 	uuidHash := sha256.New()
 	_, _ = uuidHash.Write([]byte(t.clusterName))
 	_, _ = uuidHash.Write([]byte(t.service.UID))
@@ -18,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
@@ -27,10 +28,11 @@ import (
 
 func Test_defaultModelBuilderTask_buildLBAttributes(t *testing.T) {
 	tests := []struct {
-		testName  string
-		svc       *corev1.Service
-		wantError bool
-		wantValue []elbv2.LoadBalancerAttribute
+		testName      string
+		svc           *corev1.Service
+		lbClassParams *elbv2api.LoadBalancerClassParams
+		wantError     bool
+		wantValue     []elbv2.LoadBalancerAttribute
 	}{
 		{
 			testName: "Default values",
@@ -169,12 +171,37 @@ func Test_defaultModelBuilderTask_buildLBAttributes(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			testName: "LoadBalancerClassParams attributes take priority over annotations",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":                              "nlb-ip",
+						"service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled": "false",
+						"service.beta.kubernetes.io/aws-load-balancer-attributes":                        "deletion_protection.enabled=false",
+					},
+				},
+			},
+			lbClassParams: &elbv2api.LoadBalancerClassParams{
+				Spec: elbv2api.LoadBalancerClassParamsSpec{
+					LoadBalancerAttributes: []elbv2api.Attribute{
+						{Key: "deletion_protection.enabled", Value: "true"},
+						{Key: "load_balancing.cross_zone.enabled", Value: "true"},
+					},
+				},
+			},
+			wantValue: []elbv2.LoadBalancerAttribute{
+				{Key: "deletion_protection.enabled", Value: "true"},
+				{Key: "load_balancing.cross_zone.enabled", Value: "true"},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
 			parser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
 			builder := &defaultModelBuildTask{
 				service:                              tt.svc,
+				lbClassParams:                        tt.lbClassParams,
 				annotationParser:                     parser,
 				defaultAccessLogsS3Bucket:            "",
 				defaultAccessLogsS3Prefix:            "",
@@ -1169,6 +1196,8 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 		listLoadBalancersCalls       []listLoadBalancerCall
 		resolveViaDiscoveryCalls     []resolveSubnetResults
 		resolveViaNameOrIDSliceCalls []resolveSubnetResults
+		resolveViaSelectorCalls      []resolveSubnetResults
+		lbClassParams                *elbv2api.LoadBalancerClassParams
 		want                         []ec2types.Subnet
 		wantErr                      error
 	}{
@@ -1351,6 +1380,42 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "subnets of LoadBalancerClassParams take priority over subnets annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-subnets": "subnet-abc,subnet-xyz",
+					},
+				},
+			},
+			scheme:   elbv2.LoadBalancerSchemeInternal,
+			provider: tracking.NewDefaultProvider("service.k8s.aws", "cluster-name"),
+			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "serviceName"})},
+			lbClassParams: &elbv2api.LoadBalancerClassParams{
+				Spec: elbv2api.LoadBalancerClassParamsSpec{
+					Subnets: &elbv2api.SubnetSelector{
+						Tags: map[string][]string{"tier": {"internal"}},
+					},
+				},
+			},
+			resolveViaSelectorCalls: []resolveSubnetResults{
+				{
+					subnets: []ec2types.Subnet{
+						{
+							SubnetId:  aws.String("subnet-c"),
+							CidrBlock: aws.String("192.168.0.0/19"),
+						},
+					},
+				},
+			},
+			want: []ec2types.Subnet{
+				{
+					SubnetId:  aws.String("subnet-c"),
+					CidrBlock: aws.String("192.168.0.0/19"),
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1369,6 +1434,9 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 			for _, call := range tt.resolveViaNameOrIDSliceCalls {
 				subnetsResolver.EXPECT().ResolveViaNameOrIDSlice(gomock.Any(), gomock.Any(), gomock.Any()).Return(call.subnets, call.err)
 			}
+			for _, call := range tt.resolveViaSelectorCalls {
+				subnetsResolver.EXPECT().ResolveViaSelector(gomock.Any(), gomock.Any(), gomock.Any()).Return(call.subnets, call.err)
+			}
 			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
 
 			clusterName := "cluster-name"
@@ -1378,6 +1446,7 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 			builder := &defaultModelBuildTask{
 				clusterName:         clusterName,
 				service:             tt.svc,
+				lbClassParams:       tt.lbClassParams,
 				stack:               tt.args.stack,
 				annotationParser:    annotationParser,
 				subnetsResolver:     subnetsResolver,
@@ -1606,6 +1675,7 @@ func Test_defaultModelBuildTask_buildLoadBalancerEnablePrefixForIpv6SourceNat(t
 func Test_defaultModelBuildTask_buildAdditionalResourceTags(t *testing.T) {
 	type fields struct {
 		service             *corev1.Service
+		lbClassParams       *elbv2api.LoadBalancerClassParams
 		defaultTags         map[string]string
 		externalManagedTags sets.String
 	}
@@ -1716,11 +1786,58 @@ func Test_defaultModelBuildTask_buildAdditionalResourceTags(t *testing.T) {
 			},
 			wantErr: errors.New("external managed tag key k3 cannot be specified on Service"),
 		},
+		{
+			name: "LoadBalancerClassParams tags take priority over tags annotation",
+			fields: fields{
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "k1=v1,k2=v2a",
+						},
+					},
+				},
+				lbClassParams: &elbv2api.LoadBalancerClassParams{
+					Spec: elbv2api.LoadBalancerClassParamsSpec{
+						Tags: []elbv2api.Tag{
+							{Key: "k2", Value: "v2"},
+							{Key: "k3", Value: "v3"},
+						},
+					},
+				},
+				defaultTags: map[string]string{
+					"k3": "v3a",
+					"k4": "v4",
+				},
+			},
+			want: map[string]string{
+				"k1": "v1",
+				"k2": "v2",
+				"k3": "v3a",
+				"k4": "v4",
+			},
+		},
+		{
+			name: "LoadBalancerClassParams tags collide with external managed tags",
+			fields: fields{
+				service: &corev1.Service{},
+				lbClassParams: &elbv2api.LoadBalancerClassParams{
+					ObjectMeta: metav1.ObjectMeta{Name: "internal"},
+					Spec: elbv2api.LoadBalancerClassParamsSpec{
+						Tags: []elbv2api.Tag{
+							{Key: "k3", Value: "v3"},
+						},
+					},
+				},
+				externalManagedTags: sets.NewString("k3"),
+			},
+			wantErr: errors.New("external managed tag key k3 cannot be specified on LoadBalancerClassParams internal"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			task := &defaultModelBuildTask{
 				service:             tt.fields.service,
+				lbClassParams:       tt.fields.lbClassParams,
 				defaultTags:         tt.fields.defaultTags,
 				externalManagedTags: tt.fields.externalManagedTags,
 				annotationParser:    annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
@@ -1958,3 +2075,65 @@ func Test_defaultModelBuildTask_buildManageSecurityGroupRulesFlag(t *testing.T)
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildLoadBalancerScheme(t *testing.T) {
+	internetFacingScheme := elbv2api.LoadBalancerSchemeInternetFacing
+	tests := []struct {
+		name          string
+		svc           *corev1.Service
+		lbClassParams *elbv2api.LoadBalancerClassParams
+		want          elbv2.LoadBalancerScheme
+	}{
+		{
+			name: "scheme annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-scheme": "internal",
+					},
+				},
+			},
+			want: elbv2.LoadBalancerSchemeInternal,
+		},
+		{
+			name: "scheme of LoadBalancerClassParams takes priority over scheme annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-scheme": "internal",
+					},
+				},
+			},
+			lbClassParams: &elbv2api.LoadBalancerClassParams{
+				Spec: elbv2api.LoadBalancerClassParamsSpec{
+					Scheme: &internetFacingScheme,
+				},
+			},
+			want: elbv2.LoadBalancerSchemeInternetFacing,
+		},
+		{
+			name: "LoadBalancerClassParams without scheme",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-scheme": "internal",
+					},
+				},
+			},
+			lbClassParams: &elbv2api.LoadBalancerClassParams{},
+			want:          elbv2.LoadBalancerSchemeInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				service:          tt.svc,
+				lbClassParams:    tt.lbClassParams,
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+			}
+			got, err := task.buildLoadBalancerScheme(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
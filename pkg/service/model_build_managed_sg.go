@@ -11,6 +11,7 @@ import (
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
@@ -51,6 +52,15 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroupSpec(ctx context.Contex
 var invalidSecurityGroupNamePtn, _ = regexp.Compile("[[:^alnum:]]")
 
 func (t *defaultModelBuildTask) buildManagedSecurityGroupName(_ context.Context) string {
+	if t.svcGroup != nil {
+		uuidHash := sha256.New()
+		_, _ = uuidHash.Write([]byte(t.clusterName))
+		_, _ = uuidHash.Write([]byte(t.svcGroup.ID.String()))
+		uuid := hex.EncodeToString(uuidHash.Sum(nil))
+		payload := invalidSecurityGroupNamePtn.ReplaceAllString(t.svcGroup.ID.Name, "")
+		return fmt.Sprintf("k8s-%.17s-%.10s", payload, uuid)
+	}
+
 	uuidHash := sha256.New()
 	_, _ = uuidHash.Write([]byte(t.clusterName))
 	_, _ = uuidHash.Write([]byte(t.service.Name))
@@ -75,7 +85,7 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(ctx
 	if err != nil {
 		return nil, err
 	}
-	for _, port := range t.service.Spec.Ports {
+	for _, port := range t.buildLoadBalancerServicePorts() {
 		listenPort := int32(port.Port)
 		for _, cidr := range cidrs {
 			if !strings.Contains(cidr, ":") {
@@ -145,14 +155,17 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(ctx
 	return permissions, nil
 }
 
-func (t *defaultModelBuildTask) buildCIDRsFromSourceRanges(_ context.Context, ipAddressType elbv2model.IPAddressType, prefixListsConfigured bool) ([]string, error) {
-	var cidrs []string
-	for _, cidr := range t.service.Spec.LoadBalancerSourceRanges {
-		cidrs = append(cidrs, cidr)
-	}
-	if len(cidrs) == 0 {
-		t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSourceRanges, &cidrs, t.service.Annotations)
+// buildLoadBalancerServicePorts returns the ports of all Services hosted by the load balancer.
+func (t *defaultModelBuildTask) buildLoadBalancerServicePorts() []corev1.ServicePort {
+	var ports []corev1.ServicePort
+	for _, svc := range t.members() {
+		ports = append(ports, svc.Spec.Ports...)
 	}
+	return ports
+}
+
+func (t *defaultModelBuildTask) buildCIDRsFromSourceRanges(ctx context.Context, ipAddressType elbv2model.IPAddressType, prefixListsConfigured bool) ([]string, error) {
+	cidrs := t.getLoadBalancerSourceRanges(ctx)
 	for _, cidr := range cidrs {
 		if strings.Contains(cidr, ":") && ipAddressType != elbv2model.IPAddressTypeDualStack {
 			return nil, errors.Errorf("unsupported v6 cidr %v when lb is not dualstack", cidr)
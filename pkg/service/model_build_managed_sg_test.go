@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
@@ -16,6 +17,7 @@ import (
 func Test_buildCIDRsFromSourceRanges_buildCIDRsFromSourceRanges(t *testing.T) {
 	type fields struct {
 		svc                   *corev1.Service
+		lbClassParams         *elbv2api.LoadBalancerClassParams
 		ipAddressType         elbv2model.IPAddressType
 		prefixListsConfigured bool
 	}
@@ -76,6 +78,31 @@ func Test_buildCIDRsFromSourceRanges_buildCIDRsFromSourceRanges(t *testing.T) {
 			wantErr: false,
 			want:    nil,
 		},
+		{
+			name: "inboundCIDRs of LoadBalancerClassParams take priority over source ranges",
+			fields: fields{
+				svc: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							"service.beta.kubernetes.io/load-balancer-source-ranges": "0.0.0.0/0",
+						},
+					},
+					Spec: corev1.ServiceSpec{
+						LoadBalancerSourceRanges: []string{"192.168.0.0/16"},
+					},
+				},
+				lbClassParams: &elbv2api.LoadBalancerClassParams{
+					Spec: elbv2api.LoadBalancerClassParamsSpec{
+						InboundCIDRs: []string{"10.0.0.0/8", "172.16.0.0/12"},
+					},
+				},
+				ipAddressType: elbv2model.IPAddressTypeIPV4,
+			},
+			want: []string{
+				"10.0.0.0/8",
+				"172.16.0.0/12",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t1 *testing.T) {
@@ -83,6 +110,7 @@ func Test_buildCIDRsFromSourceRanges_buildCIDRsFromSourceRanges(t *testing.T) {
 			task := &defaultModelBuildTask{
 				annotationParser: annotationParser,
 				service:          tt.fields.svc,
+				lbClassParams:    tt.fields.lbClassParams,
 			}
 			got, err := task.buildCIDRsFromSourceRanges(context.Background(), tt.fields.ipAddressType, tt.fields.prefixListsConfigured)
 			if tt.wantErr {
@@ -199,6 +199,26 @@ func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context, svcPort
 	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
 }
 
+// parseProxyProtocolPerTargetGroup parses the ports of the TargetGroups with proxy protocol v2 enabled, along with whether they're specified.
+func parseProxyProtocolPerTargetGroup(annotationParser annotations.Parser, svc *corev1.Service) (map[string]struct{}, bool, error) {
+	var proxyProtocolPerTG string
+	if !annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixProxyProtocolPerTargetGroup, &proxyProtocolPerTG, svc.Annotations) {
+		return nil, false, nil
+	}
+	ports := strings.Split(proxyProtocolPerTG, ",")
+	enabledPorts := make(map[string]struct{})
+	for _, p := range ports {
+		trimmedPort := strings.TrimSpace(p)
+		if trimmedPort != "" {
+			if _, err := strconv.Atoi(trimmedPort); err != nil {
+				return nil, false, errors.Errorf("invalid port number in proxy-protocol-per-target-group: %v", trimmedPort)
+			}
+			enabledPorts[trimmedPort] = struct{}{}
+		}
+	}
+	return enabledPorts, true, nil
+}
+
 func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, port corev1.ServicePort) ([]elbv2model.TargetGroupAttribute, error) {
 	var rawAttributes map[string]string
 	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixTargetGroupAttributes, &rawAttributes, t.service.Annotations); err != nil {
@@ -211,20 +231,11 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, po
 		rawAttributes[shared_constants.TGAttributeProxyProtocolV2Enabled] = strconv.FormatBool(t.defaultProxyProtocolV2Enabled)
 	}
 
-	var proxyProtocolPerTG string
-	if t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixProxyProtocolPerTargetGroup, &proxyProtocolPerTG, t.service.Annotations) {
-		ports := strings.Split(proxyProtocolPerTG, ",")
-		enabledPorts := make(map[string]struct{})
-		for _, p := range ports {
-			trimmedPort := strings.TrimSpace(p)
-			if trimmedPort != "" {
-				if _, err := strconv.Atoi(trimmedPort); err != nil {
-					return nil, errors.Errorf("invalid port number in proxy-protocol-per-target-group: %v", trimmedPort)
-				}
-				enabledPorts[trimmedPort] = struct{}{}
-			}
-		}
-
+	enabledPorts, exists, err := parseProxyProtocolPerTargetGroup(t.annotationParser, t.service)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
 		currentPortStr := strconv.FormatInt(int64(port.Port), 10)
 		if _, enabled := enabledPorts[currentPortStr]; enabled {
 			rawAttributes[shared_constants.TGAttributeProxyProtocolV2Enabled] = "true"
@@ -542,7 +553,12 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(_ context.Cont
 	}, nil
 }
 
+// getLoadBalancerSourceRanges returns the CIDRs allowed to access the load balancer.
+// The inboundCIDRs of LoadBalancerClassParams take priority over spec.loadBalancerSourceRanges and the source ranges annotation.
 func (t *defaultModelBuildTask) getLoadBalancerSourceRanges(_ context.Context) []string {
+	if t.lbClassParams != nil && len(t.lbClassParams.Spec.InboundCIDRs) != 0 {
+		return append([]string(nil), t.lbClassParams.Spec.InboundCIDRs...)
+	}
 	var sourceRanges []string
 	for _, cidr := range t.service.Spec.LoadBalancerSourceRanges {
 		sourceRanges = append(sourceRanges, cidr)
@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
@@ -37,6 +38,9 @@ const (
 type ModelBuilder interface {
 	// Build model stack for service
 	Build(ctx context.Context, service *corev1.Service, metricsCollector lbcmetrics.MetricCollector) (core.Stack, *elbv2model.LoadBalancer, bool, error)
+
+	// BuildGroup model stack for explicit Service group, whose members share a single load balancer
+	BuildGroup(ctx context.Context, svcGroup Group, metricsCollector lbcmetrics.MetricCollector) (core.Stack, *elbv2model.LoadBalancer, bool, error)
 }
 
 // NewDefaultModelBuilder construct a new defaultModelBuilder
@@ -44,7 +48,7 @@ func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver
 	vpcInfoProvider networking.VPCInfoProvider, vpcID string, trackingProvider tracking.Provider,
 	elbv2TaggingManager elbv2deploy.TaggingManager, ec2Client services.EC2, featureGates config.FeatureGates, clusterName string, defaultTags map[string]string,
 	externalManagedTags []string, defaultSSLPolicy string, defaultTargetType string, defaultLoadBalancerScheme string, enableIPTargetType bool, serviceUtils ServiceUtils,
-	backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, enableBackendSG bool, defaultEnableManageBackendSGRules bool,
+	lbClassParamsLoader LoadBalancerClassParamsLoader, backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, enableBackendSG bool, defaultEnableManageBackendSGRules bool,
 	disableRestrictedSGRules bool, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, tcpUdpEnabled bool) *defaultModelBuilder {
 	return &defaultModelBuilder{
 		annotationParser:           annotationParser,
@@ -54,6 +58,7 @@ func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver
 		elbv2TaggingManager:        elbv2TaggingManager,
 		featureGates:               featureGates,
 		serviceUtils:               serviceUtils,
+		lbClassParamsLoader:        lbClassParamsLoader,
 		clusterName:                clusterName,
 		vpcID:                      vpcID,
 		defaultTags:                defaultTags,
@@ -86,6 +91,7 @@ type defaultModelBuilder struct {
 	elbv2TaggingManager        elbv2deploy.TaggingManager
 	featureGates               config.FeatureGates
 	serviceUtils               ServiceUtils
+	lbClassParamsLoader        LoadBalancerClassParamsLoader
 	ec2Client                  services.EC2
 	enableBackendSG            bool
 	enableManageBackendSGRules bool
@@ -106,7 +112,32 @@ type defaultModelBuilder struct {
 
 func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service, metricsCollector lbcmetrics.MetricCollector) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
 	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(service)))
-	task := &defaultModelBuildTask{
+	task := b.newModelBuildTask(stack)
+	task.setService(service)
+
+	if err := task.run(ctx); err != nil {
+		return nil, nil, false, err
+	}
+	return task.stack, task.loadBalancer, task.backendSGAllocated, nil
+}
+
+func (b *defaultModelBuilder) BuildGroup(ctx context.Context, svcGroup Group, metricsCollector lbcmetrics.MetricCollector) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
+	stack := core.NewDefaultStack(core.StackID(svcGroup.ID))
+	task := b.newModelBuildTask(stack)
+	task.svcGroup = &svcGroup
+	if len(svcGroup.Members) != 0 {
+		task.setService(svcGroup.Members[0])
+	}
+
+	if err := task.runGroup(ctx); err != nil {
+		return nil, nil, false, err
+	}
+	return task.stack, task.loadBalancer, task.backendSGAllocated, nil
+}
+
+// newModelBuildTask constructs a defaultModelBuildTask for stack, its service is set separately.
+func (b *defaultModelBuilder) newModelBuildTask(stack core.Stack) *defaultModelBuildTask {
+	return &defaultModelBuildTask{
 		clusterName:                b.clusterName,
 		vpcID:                      b.vpcID,
 		annotationParser:           b.annotationParser,
@@ -118,6 +149,7 @@ func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service
 		elbv2TaggingManager:        b.elbv2TaggingManager,
 		featureGates:               b.featureGates,
 		serviceUtils:               b.serviceUtils,
+		lbClassParamsLoader:        b.lbClassParamsLoader,
 		enableIPTargetType:         b.enableIPTargetType,
 		ec2Client:                  b.ec2Client,
 		enableBackendSG:            b.enableBackendSG,
@@ -126,7 +158,6 @@ func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service
 		logger:                     b.logger,
 		metricsCollector:           b.metricsCollector,
 
-		service:   service,
 		stack:     stack,
 		tgByResID: make(map[string]*elbv2model.TargetGroup),
 
@@ -152,20 +183,14 @@ func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service
 		defaultIPv4SourceRanges:              []string{"0.0.0.0/0"},
 		defaultIPv6SourceRanges:              []string{"::/0"},
 
-		defaultHealthCheckPortForInstanceModeLocal:               strconv.Itoa(int(service.Spec.HealthCheckNodePort)),
 		defaultHealthCheckProtocolForInstanceModeLocal:           elbv2model.ProtocolHTTP,
 		defaultHealthCheckPathForInstanceModeLocal:               "/healthz",
 		defaultHealthCheckIntervalForInstanceModeLocal:           10,
 		defaultHealthCheckTimeoutForInstanceModeLocal:            6,
 		defaultHealthCheckHealthyThresholdForInstanceModeLocal:   2,
 		defaultHealthCheckUnhealthyThresholdForInstanceModeLocal: 2,
-		enableTCPUDPSupport:                                      b.enableTCPUDPSupport,
+		enableTCPUDPSupport: b.enableTCPUDPSupport,
 	}
-
-	if err := task.run(ctx); err != nil {
-		return nil, nil, false, err
-	}
-	return task.stack, task.loadBalancer, task.backendSGAllocated, nil
 }
 
 type defaultModelBuildTask struct {
@@ -180,6 +205,7 @@ type defaultModelBuildTask struct {
 	elbv2TaggingManager        elbv2deploy.TaggingManager
 	featureGates               config.FeatureGates
 	serviceUtils               ServiceUtils
+	lbClassParamsLoader        LoadBalancerClassParamsLoader
 	enableIPTargetType         bool
 	enableManageBackendSGRules bool
 	ec2Client                  services.EC2
@@ -187,6 +213,11 @@ type defaultModelBuildTask struct {
 	metricsCollector           lbcmetrics.MetricCollector
 
 	service *corev1.Service
+	// svcGroup is the explicit Service group hosted by the load balancer, nil for standalone services.
+	// For Service groups, service is the first member of svcGroup, which the load balancer level settings are built from.
+	svcGroup *Group
+	// lbClassParams is the LoadBalancerClassParams bound to the loadBalancerClass of service, nil if there is none.
+	lbClassParams *elbv2api.LoadBalancerClassParams
 
 	stack                    core.Stack
 	loadBalancer             *elbv2model.LoadBalancer
@@ -236,8 +267,20 @@ type defaultModelBuildTask struct {
 	enableTCPUDPSupport bool
 }
 
+// setService sets the service the model is built for.
+func (t *defaultModelBuildTask) setService(service *corev1.Service) {
+	t.service = service
+	t.defaultHealthCheckPortForInstanceModeLocal = strconv.Itoa(int(service.Spec.HealthCheckNodePort))
+}
+
 func (t *defaultModelBuildTask) run(ctx context.Context) error {
-	if !t.serviceUtils.IsServiceSupported(t.service) {
+	// Services of explicit Service groups are hosted by the load balancer of their group instead.
+	_, inExplicitGroup := loadGroupName(t.annotationParser, t.service)
+	supported, err := t.serviceUtils.IsServiceSupported(ctx, t.service)
+	if err != nil {
+		return err
+	}
+	if !supported || inExplicitGroup {
 		if t.serviceUtils.IsServicePendingFinalization(t.service) {
 			deletionProtectionEnabled, err := t.getDeletionProtectionViaAnnotation(*t.service)
 			if err != nil {
@@ -249,11 +292,49 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 		}
 		return nil
 	}
-	err := t.buildModel(ctx)
-	return err
+	return t.buildModel(ctx)
+}
+
+func (t *defaultModelBuildTask) runGroup(ctx context.Context) error {
+	if len(t.svcGroup.Members) == 0 {
+		for _, svc := range t.svcGroup.InactiveMembers {
+			deletionProtectionEnabled, err := t.getDeletionProtectionViaAnnotation(*svc)
+			if err != nil {
+				return err
+			}
+			if deletionProtectionEnabled {
+				return errors.Errorf("deletion_protection is enabled, cannot delete the load balancer of service group: %v", t.svcGroup.ID)
+			}
+		}
+		return nil
+	}
+	if err := validateGroupMembers(t.annotationParser, t.svcGroup.Members); err != nil {
+		return errmetrics.NewErrorWithMetrics(controllerName, "validate_service_group_error", err, t.metricsCollector)
+	}
+	return t.buildModel(ctx)
+}
+
+// members returns the Services hosted by the load balancer.
+func (t *defaultModelBuildTask) members() []*corev1.Service {
+	if t.svcGroup != nil {
+		return t.svcGroup.Members
+	}
+	return []*corev1.Service{t.service}
 }
 
 func (t *defaultModelBuildTask) buildModel(ctx context.Context) error {
+	if t.lbClassParamsLoader != nil {
+		// members of Service groups share the loadBalancerClass, but each of them must be allowed to use it.
+		for i, svc := range t.members() {
+			lbClassParams, err := t.lbClassParamsLoader.Load(ctx, svc)
+			if err != nil {
+				return errmetrics.NewErrorWithMetrics(controllerName, "load_load_balancer_class_params_error", err, t.metricsCollector)
+			}
+			if i == 0 {
+				t.lbClassParams = lbClassParams
+			}
+		}
+	}
 	scheme, err := t.buildLoadBalancerScheme(ctx)
 	if err != nil {
 		return errmetrics.NewErrorWithMetrics(controllerName, "build_load_balancer_scheme_error", err, t.metricsCollector)
@@ -266,7 +347,11 @@ func (t *defaultModelBuildTask) buildModel(ctx context.Context) error {
 	if err != nil {
 		return errmetrics.NewErrorWithMetrics(controllerName, "build_load_balancer_error", err, t.metricsCollector)
 	}
-	err = t.buildListeners(ctx, scheme)
+	if t.svcGroup != nil {
+		err = t.buildGroupListeners(ctx, scheme)
+	} else {
+		err = t.buildListeners(ctx, scheme)
+	}
 	if err != nil {
 		return errmetrics.NewErrorWithMetrics(controllerName, "build_listeners_error", err, t.metricsCollector)
 	}
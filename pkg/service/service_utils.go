@@ -1,6 +1,9 @@
 package service
 
 import (
+	"context"
+
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
@@ -10,29 +13,34 @@ import (
 // ServiceUtils to check if the service is supported by the controller
 type ServiceUtils interface {
 	// IsServiceSupported returns true if the service is supported by the controller
-	IsServiceSupported(service *corev1.Service) bool
+	// An error is returned when it can't be determined, e.g. when the LoadBalancerClassParams can't be listed.
+	IsServiceSupported(ctx context.Context, service *corev1.Service) (bool, error)
 
 	// IsServicePendingFinalization returns true if the service contains the aws-load-balancer-controller finalizer
 	IsServicePendingFinalization(service *corev1.Service) bool
 }
 
+// NewServiceUtils constructs new defaultServiceUtils instance.
+// Services with a loadBalancerClass bound to LoadBalancerClassParams are supported as well, unless lbClassParamsLoader is nil.
 func NewServiceUtils(annotationsParser annotations.Parser, serviceFinalizer string, loadBalancerClass string,
-	featureGates config.FeatureGates) *defaultServiceUtils {
+	lbClassParamsLoader LoadBalancerClassParamsLoader, featureGates config.FeatureGates) *defaultServiceUtils {
 	return &defaultServiceUtils{
-		annotationParser:  annotationsParser,
-		serviceFinalizer:  serviceFinalizer,
-		loadBalancerClass: loadBalancerClass,
-		featureGates:      featureGates,
+		annotationParser:    annotationsParser,
+		serviceFinalizer:    serviceFinalizer,
+		loadBalancerClass:   loadBalancerClass,
+		lbClassParamsLoader: lbClassParamsLoader,
+		featureGates:        featureGates,
 	}
 }
 
 var _ ServiceUtils = (*defaultServiceUtils)(nil)
 
 type defaultServiceUtils struct {
-	annotationParser  annotations.Parser
-	serviceFinalizer  string
-	loadBalancerClass string
-	featureGates      config.FeatureGates
+	annotationParser    annotations.Parser
+	serviceFinalizer    string
+	loadBalancerClass   string
+	lbClassParamsLoader LoadBalancerClassParamsLoader
+	featureGates        config.FeatureGates
 }
 
 // IsServicePendingFinalization returns true if service has the aws-load-balancer-controller finalizer
@@ -44,21 +52,38 @@ func (u *defaultServiceUtils) IsServicePendingFinalization(service *corev1.Servi
 }
 
 // IsServiceSupported returns true if the service is supported by the controller
-func (u *defaultServiceUtils) IsServiceSupported(service *corev1.Service) bool {
+func (u *defaultServiceUtils) IsServiceSupported(ctx context.Context, service *corev1.Service) (bool, error) {
 	if !service.DeletionTimestamp.IsZero() {
-		return false
+		return false, nil
 	}
 	if u.featureGates.Enabled(config.ServiceTypeLoadBalancerOnly) && service.Spec.Type != corev1.ServiceTypeLoadBalancer {
-		return false
+		return false, nil
 	}
 	if service.Spec.LoadBalancerClass != nil {
 		if *service.Spec.LoadBalancerClass == u.loadBalancerClass {
-			return true
-		} else {
-			return false
+			return true, nil
 		}
+		return u.isLoadBalancerClassBound(ctx, service)
+	}
+	return u.checkAWSLoadBalancerTypeAnnotation(service), nil
+}
+
+// isLoadBalancerClassBound returns true if there is a LoadBalancerClassParams bound to the loadBalancerClass of service.
+// spec.loadBalancerClass is immutable, so a Service with the finalizer whose loadBalancerClass is no longer bound lost its
+// LoadBalancerClassParams, an error is returned instead of deleting its load balancer.
+func (u *defaultServiceUtils) isLoadBalancerClassBound(ctx context.Context, service *corev1.Service) (bool, error) {
+	if u.lbClassParamsLoader == nil {
+		return false, nil
+	}
+	loadBalancerClass := *service.Spec.LoadBalancerClass
+	bound, err := u.lbClassParamsLoader.IsLoadBalancerClassBound(ctx, loadBalancerClass)
+	if err != nil {
+		return false, err
+	}
+	if !bound && u.IsServicePendingFinalization(service) {
+		return false, errors.Errorf("loadBalancerClass %v is no longer bound to any LoadBalancerClassParams", loadBalancerClass)
 	}
-	return u.checkAWSLoadBalancerTypeAnnotation(service)
+	return bound, nil
 }
 
 func (u *defaultServiceUtils) checkAWSLoadBalancerTypeAnnotation(service *corev1.Service) bool {
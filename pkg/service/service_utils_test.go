@@ -1,23 +1,32 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
-	"testing"
-	"time"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func Test_defaultServiceUtils_IsServiceSupported(t *testing.T) {
 	tests := []struct {
 		name                       string
 		svc                        *corev1.Service
+		lbClassParamsList          []*elbv2api.LoadBalancerClassParams
 		restrictToTypeLoadBalancer bool
 		want                       bool
+		wantErr                    error
 	}{
 		{
 			name: "service with nlb-ip annotation",
@@ -216,17 +225,110 @@ func Test_defaultServiceUtils_IsServiceSupported(t *testing.T) {
 			restrictToTypeLoadBalancer: true,
 			want:                       true,
 		},
+		{
+			name: "spec.loadBalancerClass bound to LoadBalancerClassParams",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nlb-ip",
+					Namespace: "default",
+				},
+				Spec: corev1.ServiceSpec{
+					Type:              corev1.ServiceTypeLoadBalancer,
+					LoadBalancerClass: awssdk.String("example.com/internal"),
+				},
+			},
+			lbClassParamsList: []*elbv2api.LoadBalancerClassParams{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "internal"},
+					Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/internal"},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "spec.loadBalancerClass not bound to LoadBalancerClassParams",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nlb-ip",
+					Namespace: "default",
+				},
+				Spec: corev1.ServiceSpec{
+					Type:              corev1.ServiceTypeLoadBalancer,
+					LoadBalancerClass: awssdk.String("example.com/other"),
+				},
+			},
+			lbClassParamsList: []*elbv2api.LoadBalancerClassParams{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "internal"},
+					Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/internal"},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "spec.loadBalancerClass bound to multiple LoadBalancerClassParams",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nlb-ip",
+					Namespace: "default",
+				},
+				Spec: corev1.ServiceSpec{
+					Type:              corev1.ServiceTypeLoadBalancer,
+					LoadBalancerClass: awssdk.String("example.com/internal"),
+				},
+			},
+			lbClassParamsList: []*elbv2api.LoadBalancerClassParams{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "internal-a"},
+					Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/internal"},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "internal-b"},
+					Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/internal"},
+				},
+			},
+			wantErr: errors.New("multiple LoadBalancerClassParams bound to loadBalancerClass example.com/internal: internal-a, internal-b"),
+		},
+		{
+			name: "spec.loadBalancerClass no longer bound to LoadBalancerClassParams for Service with finalizer",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "nlb-ip",
+					Namespace:  "default",
+					Finalizers: []string{"service.k8s.aws/resources"},
+				},
+				Spec: corev1.ServiceSpec{
+					Type:              corev1.ServiceTypeLoadBalancer,
+					LoadBalancerClass: awssdk.String("example.com/internal"),
+				},
+			},
+			wantErr: errors.New("loadBalancerClass example.com/internal is no longer bound to any LoadBalancerClassParams"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, lbClassParams := range tt.lbClassParamsList {
+				assert.NoError(t, k8sClient.Create(ctx, lbClassParams.DeepCopy()))
+			}
 			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
 			featureGates := config.NewFeatureGates()
 			if tt.restrictToTypeLoadBalancer {
 				featureGates.Enable(config.ServiceTypeLoadBalancerOnly)
 			}
-			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", featureGates)
-			got := serviceUtils.IsServiceSupported(tt.svc)
-			assert.Equal(t, tt.want, got)
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb",
+				NewDefaultLoadBalancerClassParamsLoader(k8sClient), featureGates)
+			got, err := serviceUtils.IsServiceSupported(ctx, tt.svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
 		})
 	}
 }
@@ -273,7 +375,7 @@ func Test_defaultServiceUtils_IsServicePendingFinalization(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
 			featureGates := config.NewFeatureGates()
-			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", featureGates)
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", nil, featureGates)
 			got := serviceUtils.IsServicePendingFinalization(tt.svc)
 			assert.Equal(t, tt.want, got)
 		})
@@ -10,6 +10,9 @@ const (
 	// ServiceFinalizer the finalizer used on service resources
 	ServiceFinalizer = "service.k8s.aws/resources"
 
+	// ServiceGroupFinalizerPrefix the prefix for finalizers applied to a service group
+	ServiceGroupFinalizerPrefix = "group.service.k8s.aws/"
+
 	// NLBGatewayFinalizer the finalizer we attach to an NLB Gateway resource
 	NLBGatewayFinalizer = "gateway.k8s.aws/nlb"
 
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"net/netip"
+	"slices"
 	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/cache"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/aws/smithy-go"
 	"github.com/go-logr/logr"
@@ -20,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
 	errmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/error"
@@ -33,9 +37,18 @@ import (
 const (
 	defaultRequeueDuration = 15 * time.Second
 	invalidVPCTTL          = 60 * time.Minute
+	// the availabilityZones of the VPC only change when subnets are created in new availabilityZones.
+	defaultVPCAvailabilityZonesCacheTTL = 60 * time.Minute
+	// the interval the target health of skipped reconciles is refreshed at, while the health of some targets is in progress.
+	defaultTargetHealthRefreshDuration = 1 * time.Minute
+	// the interval doubles while the target health counts don't change, refreshes stop once it exceeds this interval.
+	defaultMaxTargetHealthRefreshDuration = 16 * time.Minute
 )
 const (
 	controllerName = "targetGroupBinding"
+
+	// targetAvailabilityZoneAll registers targets with all the availabilityZones of the load balancer.
+	targetAvailabilityZoneAll = "all"
 )
 
 // ResourceManager manages the TargetGroupBinding resource.
@@ -61,6 +74,7 @@ func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELB
 	networkingManager := NewDefaultNetworkingManager(k8sClient, podENIResolver, nodeENIResolver, sgManager, sgReconciler, vpcID, clusterName, endpointSGTags, logger, disabledRestrictedSGRulesFlag)
 	return &defaultResourceManager{
 		k8sClient:           k8sClient,
+		ec2Client:           ec2Client,
 		targetsManager:      targetsManager,
 		endpointResolver:    endpointResolver,
 		networkingManager:   networkingManager,
@@ -75,7 +89,13 @@ func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELB
 		invalidVpcCache:    cache.NewExpiring(),
 		invalidVpcCacheTTL: defaultTargetsCacheTTL,
 
-		requeueDuration: defaultRequeueDuration,
+		vpcAvailabilityZonesCache:    cache.NewExpiring(),
+		vpcAvailabilityZonesCacheTTL: defaultVPCAvailabilityZonesCacheTTL,
+
+		requeueDuration:                defaultRequeueDuration,
+		targetHealthRefreshDuration:    defaultTargetHealthRefreshDuration,
+		maxTargetHealthRefreshDuration: defaultMaxTargetHealthRefreshDuration,
+		targetHealthRefreshCache:       cache.NewExpiring(),
 	}
 }
 
@@ -84,6 +104,7 @@ var _ ResourceManager = &defaultResourceManager{}
 // default implementation for ResourceManager.
 type defaultResourceManager struct {
 	k8sClient           client.Client
+	ec2Client           services.EC2
 	targetsManager      TargetsManager
 	endpointResolver    backend.EndpointResolver
 	networkingManager   NetworkingManager
@@ -99,7 +120,14 @@ type defaultResourceManager struct {
 	invalidVpcCacheTTL   time.Duration
 	invalidVpcCacheMutex sync.RWMutex
 
-	requeueDuration time.Duration
+	vpcAvailabilityZonesCache    *cache.Expiring
+	vpcAvailabilityZonesCacheTTL time.Duration
+
+	requeueDuration                time.Duration
+	targetHealthRefreshDuration    time.Duration
+	maxTargetHealthRefreshDuration time.Duration
+	// targetHealthRefreshCache holds the current target health refresh interval of the TargetGroupBindings.
+	targetHealthRefreshCache *cache.Expiring
 }
 
 func (m *defaultResourceManager) Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (bool, error) {
@@ -144,22 +172,105 @@ func (m *defaultResourceManager) Cleanup(ctx context.Context, tgb *elbv2api.Targ
 	if err := m.updatePodAsHealthyForDeletedTGB(ctx, tgb); err != nil {
 		return err
 	}
+	// the targets are deregistered with the TargetGroupBinding, pods don't wait for them to drain anymore.
+	if _, err := m.releaseDrainedPods(ctx, tgb, nil); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// excludePodEndpointsWithInvalidTargetPort excludes the endpoints of pods whose target port overrides annotation is malformed,
+// so that they aren't registered with the wrong port. An event is recorded for each of them.
+func (m *defaultResourceManager) excludePodEndpointsWithInvalidTargetPort(tgb *elbv2api.TargetGroupBinding, endpoints []backend.PodEndpoint) []backend.PodEndpoint {
+	validEndpoints := make([]backend.PodEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if endpoint.Pod.TargetPortOverridesErr != nil {
+			m.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonInvalidPodTargetPort,
+				fmt.Sprintf("Pod %v is not registered due to %v", endpoint.Pod.Key, endpoint.Pod.TargetPortOverridesErr))
+			continue
+		}
+		validEndpoints = append(validEndpoints, endpoint)
+	}
+	return validEndpoints
+}
+
+// excludePodEndpointsWithInvalidTargetAvailabilityZone excludes the endpoints of pods whose target availabilityZone annotation is
+// neither "all" nor an availabilityZone name or ID of the VPC, so that they don't fail the registration of the other pods.
+// An event is recorded for each of them. AvailabilityZone IDs are translated into names, which are expected by TargetGroups.
+func (m *defaultResourceManager) excludePodEndpointsWithInvalidTargetAvailabilityZone(ctx context.Context, tgb *elbv2api.TargetGroupBinding,
+	endpoints []backend.PodEndpoint) ([]backend.PodEndpoint, error) {
+	var azNameByNameOrID map[string]string
+	validEndpoints := make([]backend.PodEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		targetAZ := endpoint.Pod.TargetAvailabilityZone
+		if targetAZ == "" || targetAZ == targetAvailabilityZoneAll {
+			validEndpoints = append(validEndpoints, endpoint)
+			continue
+		}
+		if azNameByNameOrID == nil {
+			var err error
+			if azNameByNameOrID, err = m.fetchVPCAvailabilityZones(ctx); err != nil {
+				return nil, err
+			}
+		}
+		azName, ok := azNameByNameOrID[targetAZ]
+		if !ok {
+			m.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonInvalidPodTargetAvailabilityZone,
+				fmt.Sprintf("Pod %v is not registered due to invalid %v annotation %q, expecting %v or an availabilityZone of VPC %v",
+					endpoint.Pod.Key, annotations.AnnotationTargetAvailabilityZone, targetAZ, targetAvailabilityZoneAll, m.vpcID))
+			continue
+		}
+		endpoint.Pod.TargetAvailabilityZone = azName
+		validEndpoints = append(validEndpoints, endpoint)
+	}
+	return validEndpoints, nil
+}
+
+// fetchVPCAvailabilityZones fetches the availabilityZones of the subnets of the VPC the pods run in, mapping their names and IDs to their names.
+func (m *defaultResourceManager) fetchVPCAvailabilityZones(ctx context.Context) (map[string]string, error) {
+	if rawCacheItem, exists := m.vpcAvailabilityZonesCache.Get(m.vpcID); exists {
+		return rawCacheItem.(map[string]string), nil
+	}
+	subnets, err := m.ec2Client.DescribeSubnetsAsList(ctx, &ec2sdk.DescribeSubnetsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awssdk.String("vpc-id"),
+				Values: []string{m.vpcID},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	azNameByNameOrID := make(map[string]string, 2*len(subnets))
+	for _, subnet := range subnets {
+		azName := awssdk.ToString(subnet.AvailabilityZone)
+		azNameByNameOrID[azName] = azName
+		azNameByNameOrID[awssdk.ToString(subnet.AvailabilityZoneId)] = azName
+	}
+	m.vpcAvailabilityZonesCache.Set(m.vpcID, azNameByNameOrID, m.vpcAvailabilityZonesCacheTTL)
+	return azNameByNameOrID, nil
+}
+
 func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (string, string, bool, error) {
 	tgbScopedLogger := m.logger.WithValues("tgb", k8s.NamespacedName(tgb))
 	svcKey := buildServiceReferenceKey(tgb, tgb.Spec.ServiceRef)
+	previouslyDraining := tgb.Status.Targets != nil && tgb.Status.Targets.Draining != 0
+
+	podSelector, err := backend.GetPodSelector(tgb)
+	if err != nil {
+		return "", "", false, errmetrics.NewErrorWithMetrics(controllerName, "get_pod_selector_error", err, m.metricsCollector)
+	}
 
 	targetHealthCondType := BuildTargetHealthPodConditionType(tgb)
 	resolveOpts := []backend.EndpointResolveOption{
 		backend.WithPodReadinessGate(targetHealthCondType),
+		backend.WithPodSelector(podSelector),
 	}
 
 	var endpoints []backend.PodEndpoint
 	var containsPotentialReadyEndpoints bool
-	var err error
 
 	endpoints, containsPotentialReadyEndpoints, err = m.endpointResolver.ResolvePodEndpoints(ctx, svcKey, tgb.Spec.ServiceRef.Port, resolveOpts...)
 
@@ -170,6 +281,11 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 		}
 		return "", "", false, errmetrics.NewErrorWithMetrics(controllerName, "resolve_pod_endpoints_error", err, m.metricsCollector)
 	}
+	endpoints = m.excludePodEndpointsWithInvalidTargetPort(tgb, endpoints)
+	endpoints, err = m.excludePodEndpointsWithInvalidTargetAvailabilityZone(ctx, tgb, endpoints)
+	if err != nil {
+		return "", "", false, errmetrics.NewErrorWithMetrics(controllerName, "validate_target_availability_zone_error", err, m.metricsCollector)
+	}
 
 	newCheckPoint, err := calculateTGBReconcileCheckpoint(endpoints, tgb)
 
@@ -181,6 +297,9 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 
 	if !containsPotentialReadyEndpoints && oldCheckPoint == newCheckPoint {
 		tgbScopedLogger.Info("Skipping targetgroupbinding reconcile", "calculated hash", newCheckPoint)
+		if refreshAfter, refresh := m.refreshTargetsStatus(ctx, tgb); refresh {
+			return "", "", false, runtime.NewRequeueNeededAfter("refresh target health", refreshAfter)
+		}
 		return newCheckPoint, oldCheckPoint, true, nil
 	}
 
@@ -189,14 +308,17 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 		return "", "", false, errmetrics.NewErrorWithMetrics(controllerName, "list_targets_error", err, m.metricsCollector)
 	}
 
-	notDrainingTargets, _ := partitionTargetsByDrainingStatus(targets)
+	notDrainingTargets, drainingTargets := partitionTargetsByDrainingStatus(targets)
 	matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets := matchPodEndpointWithTargets(endpoints, notDrainingTargets)
 
 	needNetworkingRequeue := false
 	if err := m.networkingManager.ReconcileForPodEndpoints(ctx, tgb, endpoints); err != nil {
 		tgbScopedLogger.Error(err, "Requesting network requeue due to error from ReconcileForPodEndpoints")
 		m.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedNetworkReconcile, err.Error())
+		setNetworkingReconciledCondition(tgb, err)
 		needNetworkingRequeue = true
+	} else {
+		setNetworkingReconciledCondition(tgb, nil)
 	}
 
 	preflightNeedFurtherProbe := false
@@ -224,8 +346,9 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 	}
 
 	updateTrackedTargets := false
+	var deregisteredTargets []TargetInfo
 	if len(unmatchedTargets) > 0 {
-		updateTrackedTargets, err = m.deregisterTargets(ctx, tgb, unmatchedTargets)
+		deregisteredTargets, updateTrackedTargets, err = m.deregisterTargets(ctx, tgb, unmatchedTargets)
 		if err != nil {
 			return "", "", false, errmetrics.NewErrorWithMetrics(controllerName, "deregister_targets_error", err, m.metricsCollector)
 		}
@@ -256,17 +379,32 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 	if err := m.multiClusterManager.UpdateTrackedIPTargets(ctx, updateTrackedTargets, endpoints, tgb); err != nil {
 		return "", "", false, errmetrics.NewErrorWithMetrics(controllerName, "update_tracked_ip_targets_error", err, m.metricsCollector)
 	}
+	tgb.Status.Targets = buildTargetsStatus(targets, deregisteredTargets, len(unmatchedEndpoints))
 
 	anyPodNeedFurtherProbe, err := m.updateTargetHealthPodCondition(ctx, targetHealthCondType, matchedEndpointAndTargets, unmatchedEndpoints, tgb)
 	if err != nil {
 		return "", "", false, errmetrics.NewErrorWithMetrics(controllerName, "update_target_health_pod_condition_error", err, m.metricsCollector)
 	}
 
+	// terminating pods can only be waiting for their targets to drain when targets are or were just draining.
+	anyPodDraining := false
+	if previouslyDraining || len(drainingTargets) > 0 || len(deregisteredTargets) > 0 {
+		anyPodDraining, err = m.releaseDrainedPods(ctx, tgb, targets)
+		if err != nil {
+			return "", "", false, errmetrics.NewErrorWithMetrics(controllerName, "release_drained_pods_error", err, m.metricsCollector)
+		}
+	}
+
 	if anyPodNeedFurtherProbe {
 		tgbScopedLogger.Info("Requeue for target monitor target health")
 		return "", "", false, runtime.NewRequeueNeededAfter("monitor targetHealth", m.requeueDuration)
 	}
 
+	if anyPodDraining {
+		tgbScopedLogger.Info("Requeue for draining pods")
+		return "", "", false, runtime.NewRequeueNeededAfter("monitor draining pods", m.requeueDuration)
+	}
+
 	if containsPotentialReadyEndpoints {
 		tgbScopedLogger.Info("Requeue for potentially ready endpoints")
 		return "", "", false, runtime.NewRequeueNeededAfter("monitor potential ready endpoints", m.requeueDuration)
@@ -309,6 +447,9 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 
 	if newCheckPoint == oldCheckPoint {
 		tgbScopedLogger.Info("Skipping targetgroupbinding reconcile", "calculated hash", newCheckPoint)
+		if refreshAfter, refresh := m.refreshTargetsStatus(ctx, tgb); refresh {
+			return "", "", false, runtime.NewRequeueNeededAfter("refresh target health", refreshAfter)
+		}
 		return newCheckPoint, oldCheckPoint, true, nil
 	}
 
@@ -323,8 +464,10 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 
 	if err := m.networkingManager.ReconcileForNodePortEndpoints(ctx, tgb, endpoints); err != nil {
 		tgbScopedLogger.Error(err, "Requesting network requeue due to error from ReconcileForNodePortEndpoints")
+		setNetworkingReconciledCondition(tgb, err)
 		return "", "", false, errmetrics.NewErrorWithMetrics(controllerName, "reconcile_nodeport_endpoints_error", err, m.metricsCollector)
 	}
+	setNetworkingReconciledCondition(tgb, nil)
 
 	if len(unmatchedEndpoints) > 0 || len(unmatchedTargets) > 0 {
 		// Same thought process, see the IP target registration code as to why we clear out the check point.
@@ -336,9 +479,9 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 	}
 
 	updateTrackedTargets := false
-
+	var deregisteredTargets []TargetInfo
 	if len(unmatchedTargets) > 0 {
-		updateTrackedTargets, err = m.deregisterTargets(ctx, tgb, unmatchedTargets)
+		deregisteredTargets, updateTrackedTargets, err = m.deregisterTargets(ctx, tgb, unmatchedTargets)
 		if err != nil {
 			return "", "", false, errmetrics.NewErrorWithMetrics(controllerName, "deregister_targets_error", err, m.metricsCollector)
 		}
@@ -358,11 +501,103 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 	if err := m.multiClusterManager.UpdateTrackedInstanceTargets(ctx, updateTrackedTargets, endpoints, tgb); err != nil {
 		return "", "", false, errmetrics.NewErrorWithMetrics(controllerName, "update_tracked_instance_targets_error", err, m.metricsCollector)
 	}
+	tgb.Status.Targets = buildTargetsStatus(targets, deregisteredTargets, len(unmatchedEndpoints))
 
 	tgbScopedLogger.Info("Successful reconcile", "checkpoint", newCheckPoint)
 	return newCheckPoint, oldCheckPoint, false, nil
 }
 
+// refreshTargetsStatus refreshes the target health counts in the status of a TargetGroupBinding whose reconcile is skipped,
+// so that they don't go stale while its endpoints don't change. Only the health of the targets is listed.
+// It returns whether and when the target health should be refreshed again. Draining targets are refreshed at a fixed interval,
+// as they're deregistered within the deregistration delay. Otherwise the interval doubles while the counts don't change,
+// so that targets that stay unhealthy don't list the target health forever.
+func (m *defaultResourceManager) refreshTargetsStatus(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (time.Duration, bool) {
+	tgbKey := k8s.NamespacedName(tgb)
+	targets, err := m.targetsManager.ListTargets(ctx, tgb)
+	if err != nil {
+		// the counts are refreshed by the next reconcile that lists targets.
+		m.logger.V(1).Info("failed to refresh targets status", "tgb", tgbKey, "error", err.Error())
+		return 0, false
+	}
+	previousStatus := tgb.Status.Targets
+	tgb.Status.Targets = buildTargetsStatus(targets, nil, 0)
+	previouslyDraining := previousStatus != nil && previousStatus.Draining != 0
+	if previouslyDraining || tgb.Status.Targets.Draining != 0 {
+		if _, err := m.releaseDrainedPods(ctx, tgb, targets); err != nil {
+			// the pods are released by the next reconcile, or once their preStop hook times out.
+			m.logger.V(1).Info("failed to release drained pods", "tgb", tgbKey, "error", err.Error())
+		}
+	}
+
+	if tgb.Status.Targets.Draining != 0 {
+		m.targetHealthRefreshCache.Delete(tgbKey)
+		return m.targetHealthRefreshDuration, true
+	}
+	if tgb.Status.Targets.Initial == 0 && tgb.Status.Targets.Unhealthy == 0 {
+		m.targetHealthRefreshCache.Delete(tgbKey)
+		return 0, false
+	}
+	refreshAfter := m.targetHealthRefreshDuration
+	if rawRefreshAfter, exists := m.targetHealthRefreshCache.Get(tgbKey); exists && previousStatus != nil && *previousStatus == *tgb.Status.Targets {
+		refreshAfter = rawRefreshAfter.(time.Duration) * 2
+	}
+	if refreshAfter > m.maxTargetHealthRefreshDuration {
+		// the counts are refreshed by the next reconcile that lists targets.
+		m.targetHealthRefreshCache.Delete(tgbKey)
+		return 0, false
+	}
+	m.targetHealthRefreshCache.Set(tgbKey, refreshAfter, 2*m.maxTargetHealthRefreshDuration)
+	return refreshAfter, true
+}
+
+// releaseDrainedPods marks the targets of the terminating pods waiting for them to drain from the TargetGroupBinding as drained
+// once none of them is registered anymore, which ends the wait of the preStop hook of the pods.
+// It returns whether some pods are still waiting for their targets to drain.
+func (m *defaultResourceManager) releaseDrainedPods(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targets []TargetInfo) (bool, error) {
+	registeredTargetIPs := sets.New[string]()
+	for _, target := range targets {
+		registeredTargetIPs.Insert(awssdk.ToString(target.Target.Id))
+	}
+
+	anyPodDraining := false
+	for _, podKey := range m.podInfoRepo.ListKeys(ctx) {
+		if podKey.Namespace != tgb.Namespace {
+			continue
+		}
+		pod, exists, err := m.podInfoRepo.Get(ctx, podKey)
+		if err != nil {
+			return false, err
+		}
+		if !exists || !pod.Terminating || !slices.Contains(pod.TargetsDrainPending, tgb.Name) {
+			continue
+		}
+		if pod.PodIP != "" && registeredTargetIPs.Has(pod.PodIP) {
+			anyPodDraining = true
+			continue
+		}
+
+		targetsDrainedAnnotationKey := BuildTargetsDrainedPodAnnotationKey(tgb)
+		podPatchSource := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   pod.Key.Namespace,
+				Name:        pod.Key.Name,
+				Annotations: map[string]string{targetsDrainedAnnotationKey: "false"},
+			},
+		}
+		podPatchTarget := podPatchSource.DeepCopy()
+		podPatchTarget.UID = pod.UID // only put the uid in the new object to ensure it appears in the patch as a precondition
+		podPatchTarget.Annotations[targetsDrainedAnnotationKey] = "true"
+		if err := m.k8sClient.Patch(ctx, podPatchTarget, client.MergeFrom(podPatchSource)); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+	}
+	return anyPodDraining, nil
+}
+
 func (m *defaultResourceManager) cleanupTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
 	targets, err := m.targetsManager.ListTargets(ctx, tgb)
 	if err != nil {
@@ -374,7 +609,7 @@ func (m *defaultResourceManager) cleanupTargets(ctx context.Context, tgb *elbv2a
 		return err
 	}
 
-	_, err = m.deregisterTargets(ctx, tgb, targets)
+	_, _, err = m.deregisterTargets(ctx, tgb, targets)
 
 	if err != nil {
 		if isELBV2TargetGroupNotFoundError(err) {
@@ -540,21 +775,25 @@ func (m *defaultResourceManager) updatePodAsHealthyForDeletedTGB(ctx context.Con
 	return nil
 }
 
-func (m *defaultResourceManager) deregisterTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targets []TargetInfo) (bool, error) {
+// deregisterTargets deregisters the targets that aren't owned by other clusters, it returns the deregistered targets.
+func (m *defaultResourceManager) deregisterTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targets []TargetInfo) ([]TargetInfo, bool, error) {
 	filteredTargets, updateTrackedTargets, err := m.multiClusterManager.FilterTargetsForDeregistration(ctx, tgb, targets)
 	if err != nil {
-		return false, err
+		return nil, false, err
 	}
 
 	if len(filteredTargets) == 0 {
-		return updateTrackedTargets, nil
+		return nil, updateTrackedTargets, nil
 	}
 
 	sdkTargets := make([]elbv2types.TargetDescription, 0, len(targets))
 	for _, target := range filteredTargets {
 		sdkTargets = append(sdkTargets, target.Target)
 	}
-	return true, m.targetsManager.DeregisterTargets(ctx, tgb, sdkTargets)
+	if err := m.targetsManager.DeregisterTargets(ctx, tgb, sdkTargets); err != nil {
+		return nil, true, err
+	}
+	return filteredTargets, true, nil
 }
 
 func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, tgb *elbv2api.TargetGroupBinding, endpoints []backend.PodEndpoint) error {
@@ -589,8 +828,10 @@ func (m *defaultResourceManager) prepareRegistrationCall(endpoints []backend.Pod
 		if err != nil {
 			return sdkTargets, err
 		}
-		if doAzOverride(podIP) {
-			target.AvailabilityZone = awssdk.String("all")
+		if endpoint.Pod.TargetAvailabilityZone != "" {
+			target.AvailabilityZone = awssdk.String(endpoint.Pod.TargetAvailabilityZone)
+		} else if doAzOverride(podIP) {
+			target.AvailabilityZone = awssdk.String(targetAvailabilityZoneAll)
 		}
 		sdkTargets = append(sdkTargets, target)
 	}
@@ -2,17 +2,22 @@ package targetgroupbinding
 
 import (
 	"context"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/smithy-go"
 	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"net/netip"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
 	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"testing"
+	"time"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/go-logr/logr"
@@ -24,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -702,3 +708,409 @@ func Test_defaultResourceManager_GenerateOverrideAzFn(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultResourceManager_prepareRegistrationCall(t *testing.T) {
+	outOfVPCAddr := netip.MustParseAddr("100.64.0.1")
+	doAzOverride := func(addr netip.Addr) bool {
+		return addr == outOfVPCAddr
+	}
+	tests := []struct {
+		name      string
+		endpoints []backend.PodEndpoint
+		want      []elbv2types.TargetDescription
+		wantErr   error
+	}{
+		{
+			name: "targets in vpc",
+			endpoints: []backend.PodEndpoint{
+				{IP: "192.168.1.1", Port: 8080},
+				{IP: "192.168.1.2", Port: 15006},
+			},
+			want: []elbv2types.TargetDescription{
+				{Id: awssdk.String("192.168.1.1"), Port: awssdk.Int32(8080)},
+				{Id: awssdk.String("192.168.1.2"), Port: awssdk.Int32(15006)},
+			},
+		},
+		{
+			name: "targets out of vpc",
+			endpoints: []backend.PodEndpoint{
+				{IP: "100.64.0.1", Port: 8080},
+			},
+			want: []elbv2types.TargetDescription{
+				{Id: awssdk.String("100.64.0.1"), Port: awssdk.Int32(8080), AvailabilityZone: awssdk.String("all")},
+			},
+		},
+		{
+			name: "targets with availabilityZone from pod",
+			endpoints: []backend.PodEndpoint{
+				{IP: "192.168.1.1", Port: 8080, Pod: k8s.PodInfo{TargetAvailabilityZone: "us-west-2a"}},
+				{IP: "100.64.0.1", Port: 8080, Pod: k8s.PodInfo{TargetAvailabilityZone: "us-west-2b"}},
+			},
+			want: []elbv2types.TargetDescription{
+				{Id: awssdk.String("192.168.1.1"), Port: awssdk.Int32(8080), AvailabilityZone: awssdk.String("us-west-2a")},
+				{Id: awssdk.String("100.64.0.1"), Port: awssdk.Int32(8080), AvailabilityZone: awssdk.String("us-west-2b")},
+			},
+		},
+		{
+			name: "invalid target ip",
+			endpoints: []backend.PodEndpoint{
+				{IP: "not-an-ip", Port: 8080},
+			},
+			want:    []elbv2types.TargetDescription{},
+			wantErr: errors.New("ParseAddr(\"not-an-ip\"): unable to parse IP"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &defaultResourceManager{}
+			got, err := m.prepareRegistrationCall(tt.endpoints, doAzOverride)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultResourceManager_excludePodEndpointsWithInvalidTargetPort(t *testing.T) {
+	validEndpoint := backend.PodEndpoint{
+		IP:   "192.168.1.1",
+		Port: 15006,
+		Pod:  k8s.PodInfo{Key: types.NamespacedName{Namespace: "ns-1", Name: "pod-1"}, TargetPortOverrides: map[int32]int32{8080: 15006}},
+	}
+	invalidEndpoint := backend.PodEndpoint{
+		IP:   "192.168.1.2",
+		Port: 8080,
+		Pod:  k8s.PodInfo{Key: types.NamespacedName{Namespace: "ns-1", Name: "pod-2"}, TargetPortOverridesErr: errors.New("malformed annotation")},
+	}
+	eventRecorder := record.NewFakeRecorder(10)
+	m := &defaultResourceManager{eventRecorder: eventRecorder}
+	tgb := &elbv2api.TargetGroupBinding{}
+
+	got := m.excludePodEndpointsWithInvalidTargetPort(tgb, []backend.PodEndpoint{validEndpoint, invalidEndpoint})
+	assert.Equal(t, []backend.PodEndpoint{validEndpoint}, got)
+	assert.Len(t, eventRecorder.Events, 1)
+	assert.Equal(t, "Warning InvalidPodTargetPort Pod ns-1/pod-2 is not registered due to malformed annotation", <-eventRecorder.Events)
+}
+
+func Test_defaultResourceManager_excludePodEndpointsWithInvalidTargetAvailabilityZone(t *testing.T) {
+	newEndpoint := func(name string, ip string, targetAZ string) backend.PodEndpoint {
+		return backend.PodEndpoint{
+			IP:   ip,
+			Port: 8080,
+			Pod:  k8s.PodInfo{Key: types.NamespacedName{Namespace: "ns-1", Name: name}, TargetAvailabilityZone: targetAZ},
+		}
+	}
+	withTargetAZ := func(endpoint backend.PodEndpoint, targetAZ string) backend.PodEndpoint {
+		endpoint.Pod.TargetAvailabilityZone = targetAZ
+		return endpoint
+	}
+	noAZEndpoint := newEndpoint("pod-1", "192.168.1.1", "")
+	allAZEndpoint := newEndpoint("pod-2", "192.168.1.2", "all")
+	azNameEndpoint := newEndpoint("pod-3", "192.168.1.3", "us-west-2a")
+	azIDEndpoint := newEndpoint("pod-4", "192.168.1.4", "usw2-az2")
+	invalidAZEndpoint := newEndpoint("pod-5", "192.168.1.5", "us-east-1a")
+	vpcSubnets := []ec2types.Subnet{
+		{SubnetId: aws.String("subnet-1"), AvailabilityZone: aws.String("us-west-2a"), AvailabilityZoneId: aws.String("usw2-az1")},
+		{SubnetId: aws.String("subnet-2"), AvailabilityZone: aws.String("us-west-2b"), AvailabilityZoneId: aws.String("usw2-az2")},
+	}
+
+	tests := []struct {
+		name            string
+		endpoints       []backend.PodEndpoint
+		describeSubnets bool
+		describeErr     error
+		want            []backend.PodEndpoint
+		wantEvents      []string
+		wantErr         error
+	}{
+		{
+			name:      "endpoints without availabilityZone or with all don't need the availabilityZones of the VPC",
+			endpoints: []backend.PodEndpoint{noAZEndpoint, allAZEndpoint},
+			want:      []backend.PodEndpoint{noAZEndpoint, allAZEndpoint},
+		},
+		{
+			name:            "availabilityZone names and IDs of the VPC are registered with the availabilityZone name",
+			endpoints:       []backend.PodEndpoint{noAZEndpoint, azNameEndpoint, azIDEndpoint, invalidAZEndpoint},
+			describeSubnets: true,
+			want:            []backend.PodEndpoint{noAZEndpoint, azNameEndpoint, withTargetAZ(azIDEndpoint, "us-west-2b")},
+			wantEvents: []string{
+				"Warning InvalidPodTargetAvailabilityZone Pod ns-1/pod-5 is not registered due to invalid elbv2.k8s.aws/target-availability-zone annotation \"us-east-1a\", expecting all or an availabilityZone of VPC vpc-1",
+			},
+		},
+		{
+			name:            "availabilityZones of the VPC cannot be fetched",
+			endpoints:       []backend.PodEndpoint{azNameEndpoint},
+			describeSubnets: true,
+			describeErr:     errors.New("access denied"),
+			wantErr:         errors.New("access denied"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			if tt.describeSubnets {
+				ec2Client.EXPECT().DescribeSubnetsAsList(gomock.Any(), &ec2sdk.DescribeSubnetsInput{
+					Filters: []ec2types.Filter{{Name: aws.String("vpc-id"), Values: []string{"vpc-1"}}},
+				}).Return(vpcSubnets, tt.describeErr)
+			}
+			eventRecorder := record.NewFakeRecorder(10)
+			m := &defaultResourceManager{
+				ec2Client:                    ec2Client,
+				eventRecorder:                eventRecorder,
+				vpcID:                        "vpc-1",
+				vpcAvailabilityZonesCache:    cache.NewExpiring(),
+				vpcAvailabilityZonesCacheTTL: defaultVPCAvailabilityZonesCacheTTL,
+			}
+			got, err := m.excludePodEndpointsWithInvalidTargetAvailabilityZone(context.Background(), &elbv2api.TargetGroupBinding{}, tt.endpoints)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			var gotEvents []string
+			for len(eventRecorder.Events) > 0 {
+				gotEvents = append(gotEvents, <-eventRecorder.Events)
+			}
+			assert.Equal(t, tt.wantEvents, gotEvents)
+		})
+	}
+}
+
+// fakeTargetsManager lists the given targets, or fails with the given error.
+type fakeTargetsManager struct {
+	TargetsManager
+	targets []TargetInfo
+	err     error
+}
+
+func (m *fakeTargetsManager) ListTargets(_ context.Context, _ *elbv2api.TargetGroupBinding) ([]TargetInfo, error) {
+	return m.targets, m.err
+}
+
+func Test_defaultResourceManager_refreshTargetsStatus(t *testing.T) {
+	healthyTarget := TargetInfo{
+		Target:       elbv2types.TargetDescription{Id: awssdk.String("192.168.1.1"), Port: awssdk.Int32(8080)},
+		TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumHealthy},
+	}
+	initialTarget := TargetInfo{
+		Target:       elbv2types.TargetDescription{Id: awssdk.String("192.168.1.2"), Port: awssdk.Int32(8080)},
+		TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumInitial},
+	}
+	staleStatus := &elbv2api.TargetGroupBindingTargetsStatus{Registered: 3, Initial: 3}
+	tests := []struct {
+		name             string
+		targetsManager   *fakeTargetsManager
+		wantStatus       *elbv2api.TargetGroupBindingTargetsStatus
+		wantRefresh      bool
+		wantRefreshAfter time.Duration
+	}{
+		{
+			name:           "all targets healthy",
+			targetsManager: &fakeTargetsManager{targets: []TargetInfo{healthyTarget}},
+			wantStatus:     &elbv2api.TargetGroupBindingTargetsStatus{Registered: 1, Healthy: 1},
+		},
+		{
+			name:             "target health in progress",
+			targetsManager:   &fakeTargetsManager{targets: []TargetInfo{healthyTarget, initialTarget}},
+			wantStatus:       &elbv2api.TargetGroupBindingTargetsStatus{Registered: 2, Healthy: 1, Initial: 1},
+			wantRefresh:      true,
+			wantRefreshAfter: time.Minute,
+		},
+		{
+			name:           "listing targets fails",
+			targetsManager: &fakeTargetsManager{err: errors.New("throttled")},
+			wantStatus:     staleStatus,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &defaultResourceManager{
+				targetsManager:                 tt.targetsManager,
+				logger:                         logr.Discard(),
+				targetHealthRefreshDuration:    time.Minute,
+				maxTargetHealthRefreshDuration: 16 * time.Minute,
+				targetHealthRefreshCache:       cache.NewExpiring(),
+			}
+			tgb := &elbv2api.TargetGroupBinding{
+				Status: elbv2api.TargetGroupBindingStatus{Targets: staleStatus.DeepCopy()},
+			}
+			gotRefreshAfter, gotRefresh := m.refreshTargetsStatus(context.Background(), tgb)
+			assert.Equal(t, tt.wantRefresh, gotRefresh)
+			assert.Equal(t, tt.wantRefreshAfter, gotRefreshAfter)
+			assert.Equal(t, tt.wantStatus, tgb.Status.Targets)
+		})
+	}
+}
+
+func Test_defaultResourceManager_refreshTargetsStatus_backoff(t *testing.T) {
+	healthyTarget := TargetInfo{
+		Target:       elbv2types.TargetDescription{Id: awssdk.String("192.168.1.1"), Port: awssdk.Int32(8080)},
+		TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumHealthy},
+	}
+	unhealthyTarget := TargetInfo{
+		Target:       elbv2types.TargetDescription{Id: awssdk.String("192.168.1.2"), Port: awssdk.Int32(8080)},
+		TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumUnhealthy},
+	}
+	drainingTarget := TargetInfo{
+		Target:       elbv2types.TargetDescription{Id: awssdk.String("192.168.1.3"), Port: awssdk.Int32(8080)},
+		TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumDraining},
+	}
+	newResourceManager := func(targetsManager TargetsManager) *defaultResourceManager {
+		return &defaultResourceManager{
+			targetsManager:                 targetsManager,
+			logger:                         logr.Discard(),
+			targetHealthRefreshDuration:    time.Minute,
+			maxTargetHealthRefreshDuration: 16 * time.Minute,
+			targetHealthRefreshCache:       cache.NewExpiring(),
+		}
+	}
+	newTGB := func() *elbv2api.TargetGroupBinding {
+		return &elbv2api.TargetGroupBinding{ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-tgb"}}
+	}
+
+	t.Run("steady unhealthy targets stop being refreshed", func(t *testing.T) {
+		m := newResourceManager(&fakeTargetsManager{targets: []TargetInfo{healthyTarget, unhealthyTarget}})
+		tgb := newTGB()
+		var gotRefreshAfters []time.Duration
+		for i := 0; i < 10; i++ {
+			refreshAfter, refresh := m.refreshTargetsStatus(context.Background(), tgb)
+			if !refresh {
+				break
+			}
+			gotRefreshAfters = append(gotRefreshAfters, refreshAfter)
+		}
+		assert.Equal(t, []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 16 * time.Minute}, gotRefreshAfters)
+	})
+
+	t.Run("changing counts reset the refresh interval", func(t *testing.T) {
+		targetsManager := &fakeTargetsManager{targets: []TargetInfo{healthyTarget, unhealthyTarget}}
+		m := newResourceManager(targetsManager)
+		tgb := newTGB()
+		m.refreshTargetsStatus(context.Background(), tgb)
+		refreshAfter, _ := m.refreshTargetsStatus(context.Background(), tgb)
+		assert.Equal(t, 2*time.Minute, refreshAfter)
+
+		targetsManager.targets = []TargetInfo{unhealthyTarget}
+		refreshAfter, refresh := m.refreshTargetsStatus(context.Background(), tgb)
+		assert.True(t, refresh)
+		assert.Equal(t, time.Minute, refreshAfter)
+	})
+
+	t.Run("draining targets are refreshed at a fixed interval", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		podInfoRepo := k8s.NewMockPodInfoRepo(ctrl)
+		podInfoRepo.EXPECT().ListKeys(gomock.Any()).Return(nil).AnyTimes()
+		m := newResourceManager(&fakeTargetsManager{targets: []TargetInfo{unhealthyTarget, drainingTarget}})
+		m.podInfoRepo = podInfoRepo
+		tgb := newTGB()
+		for i := 0; i < 10; i++ {
+			refreshAfter, refresh := m.refreshTargetsStatus(context.Background(), tgb)
+			assert.True(t, refresh)
+			assert.Equal(t, time.Minute, refreshAfter)
+		}
+	})
+}
+
+func Test_defaultResourceManager_releaseDrainedPods(t *testing.T) {
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-tgb"},
+	}
+	newPod := func(namespace string, name string, podIP string, tgbName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+				Annotations: map[string]string{
+					"targets-drained.elbv2.k8s.aws/" + tgbName: "false",
+				},
+			},
+			Status: corev1.PodStatus{PodIP: podIP},
+		}
+	}
+	drainedPod := newPod("my-ns", "drained", "192.168.1.1", "my-tgb")
+	drainingPod := newPod("my-ns", "draining", "192.168.1.2", "my-tgb")
+	runningPod := newPod("my-ns", "running", "192.168.1.3", "my-tgb")
+	otherTGBPod := newPod("my-ns", "other-tgb", "192.168.1.4", "other-tgb")
+	otherNamespacePod := newPod("other-ns", "other-namespace", "192.168.1.5", "my-tgb")
+	podInfos := map[types.NamespacedName]k8s.PodInfo{
+		k8s.NamespacedName(drainedPod):        {Key: k8s.NamespacedName(drainedPod), PodIP: "192.168.1.1", Terminating: true, TargetsDrainPending: []string{"my-tgb"}},
+		k8s.NamespacedName(drainingPod):       {Key: k8s.NamespacedName(drainingPod), PodIP: "192.168.1.2", Terminating: true, TargetsDrainPending: []string{"my-tgb"}},
+		k8s.NamespacedName(runningPod):        {Key: k8s.NamespacedName(runningPod), PodIP: "192.168.1.3", TargetsDrainPending: []string{"my-tgb"}},
+		k8s.NamespacedName(otherTGBPod):       {Key: k8s.NamespacedName(otherTGBPod), PodIP: "192.168.1.4", Terminating: true, TargetsDrainPending: []string{"other-tgb"}},
+		k8s.NamespacedName(otherNamespacePod): {Key: k8s.NamespacedName(otherNamespacePod), PodIP: "192.168.1.5", Terminating: true, TargetsDrainPending: []string{"my-tgb"}},
+	}
+	drainingTarget := TargetInfo{
+		Target:       elbv2types.TargetDescription{Id: awssdk.String("192.168.1.2"), Port: awssdk.Int32(8080)},
+		TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumDraining},
+	}
+
+	tests := []struct {
+		name            string
+		targets         []TargetInfo
+		wantPodDraining bool
+		wantDrainedPods []types.NamespacedName
+	}{
+		{
+			name:            "releases the terminating pods whose targets are drained",
+			targets:         []TargetInfo{drainingTarget},
+			wantPodDraining: true,
+			wantDrainedPods: []types.NamespacedName{k8s.NamespacedName(drainedPod)},
+		},
+		{
+			name:            "releases all the terminating pods once the targets are deregistered",
+			wantDrainedPods: []types.NamespacedName{k8s.NamespacedName(drainedPod), k8s.NamespacedName(drainingPod)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			podInfoRepo := k8s.NewMockPodInfoRepo(ctrl)
+			var podKeys []types.NamespacedName
+			for podKey, podInfo := range podInfos {
+				podKeys = append(podKeys, podKey)
+				podInfoRepo.EXPECT().Get(gomock.Any(), podKey).Return(podInfo, true, nil).AnyTimes()
+			}
+			podInfoRepo.EXPECT().ListKeys(gomock.Any()).Return(podKeys)
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			ctx := context.Background()
+			for _, pod := range []*corev1.Pod{drainedPod, drainingPod, runningPod, otherTGBPod, otherNamespacePod} {
+				assert.NoError(t, k8sClient.Create(ctx, pod.DeepCopy()))
+			}
+
+			m := &defaultResourceManager{
+				k8sClient:   k8sClient,
+				podInfoRepo: podInfoRepo,
+				logger:      logr.Discard(),
+			}
+			gotPodDraining, err := m.releaseDrainedPods(ctx, tgb, tt.targets)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPodDraining, gotPodDraining)
+
+			var gotDrainedPods []types.NamespacedName
+			for _, pod := range []*corev1.Pod{drainedPod, drainingPod, runningPod, otherTGBPod, otherNamespacePod} {
+				updatedPod := &corev1.Pod{}
+				assert.NoError(t, k8sClient.Get(ctx, k8s.NamespacedName(pod), updatedPod))
+				for key, value := range updatedPod.Annotations {
+					if value == "true" {
+						assert.Equal(t, "targets-drained.elbv2.k8s.aws/my-tgb", key)
+						gotDrainedPods = append(gotDrainedPods, k8s.NamespacedName(pod))
+					}
+				}
+			}
+			assert.Equal(t, tt.wantDrainedPods, gotDrainedPods)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package targetgroupbinding
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	errmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/error"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+)
+
+const (
+	conditionReasonReconciled              = "Reconciled"
+	conditionReasonReconcileFailed         = "ReconcileFailed"
+	conditionReasonTargetGroupFound        = "TargetGroupFound"
+	conditionReasonTargetGroupNotFound     = "TargetGroupNotFound"
+	conditionReasonNetworkingReconciled    = "NetworkingReconciled"
+	conditionReasonNetworkingNotReconciled = "NetworkingNotReconciled"
+	conditionReasonAssumeRoleSucceeded     = "AssumeRoleSucceeded"
+	conditionReasonAssumeRoleFailed        = "AssumeRoleFailed"
+)
+
+// AssumeRoleError is returned when the IAM role to manage the TargetGroup couldn't be assumed.
+type AssumeRoleError struct {
+	RoleARN string
+	Err     error
+}
+
+func (e *AssumeRoleError) Error() string {
+	return "failed to assume role " + e.RoleARN + ": " + e.Err.Error()
+}
+
+func (e *AssumeRoleError) Unwrap() error {
+	return e.Err
+}
+
+// SetReconcileStatus sets the conditions of the TargetGroupBinding and its last reconcile error from the result of a reconcile.
+// Requeues to monitor targets aren't failures, the TargetGroupBinding is reconciled when they're requested.
+func SetReconcileStatus(tgb *elbv2api.TargetGroupBinding, reconcileErr error) {
+	var errWithMetrics *errmetrics.ErrorWithMetrics
+	if errors.As(reconcileErr, &errWithMetrics) {
+		reconcileErr = errWithMetrics.Err
+	}
+	var requeueNeededAfter *runtime.RequeueNeededAfter
+	if errors.As(reconcileErr, &requeueNeededAfter) {
+		reconcileErr = nil
+	}
+
+	if reconcileErr != nil {
+		tgb.Status.LastReconcileError = reconcileErr.Error()
+	} else {
+		tgb.Status.LastReconcileError = ""
+	}
+
+	if isELBV2TargetGroupNotFoundError(reconcileErr) {
+		setCondition(tgb, elbv2api.TargetGroupBindingConditionTargetGroupNotFound, metav1.ConditionTrue, conditionReasonTargetGroupNotFound, reconcileErr.Error())
+	} else {
+		setCondition(tgb, elbv2api.TargetGroupBindingConditionTargetGroupNotFound, metav1.ConditionFalse, conditionReasonTargetGroupFound, "")
+	}
+
+	var assumeRoleErr *AssumeRoleError
+	if errors.As(reconcileErr, &assumeRoleErr) {
+		setCondition(tgb, elbv2api.TargetGroupBindingConditionAssumeRoleFailed, metav1.ConditionTrue, conditionReasonAssumeRoleFailed, assumeRoleErr.Error())
+	} else if tgb.Spec.IamRoleArnToAssume != "" {
+		setCondition(tgb, elbv2api.TargetGroupBindingConditionAssumeRoleFailed, metav1.ConditionFalse, conditionReasonAssumeRoleSucceeded, "")
+	} else {
+		meta.RemoveStatusCondition(&tgb.Status.Conditions, string(elbv2api.TargetGroupBindingConditionAssumeRoleFailed))
+	}
+
+	networkingCond := meta.FindStatusCondition(tgb.Status.Conditions, string(elbv2api.TargetGroupBindingConditionNetworkingReconciled))
+	switch {
+	case reconcileErr != nil:
+		setCondition(tgb, elbv2api.TargetGroupBindingConditionReady, metav1.ConditionFalse, conditionReasonReconcileFailed, reconcileErr.Error())
+	case networkingCond != nil && networkingCond.Status == metav1.ConditionFalse:
+		setCondition(tgb, elbv2api.TargetGroupBindingConditionReady, metav1.ConditionFalse, conditionReasonNetworkingNotReconciled, networkingCond.Message)
+	default:
+		setCondition(tgb, elbv2api.TargetGroupBindingConditionReady, metav1.ConditionTrue, conditionReasonReconciled, "")
+	}
+}
+
+// setNetworkingReconciledCondition sets whether the security group rules for traffic to the targets are reconciled.
+func setNetworkingReconciledCondition(tgb *elbv2api.TargetGroupBinding, networkingErr error) {
+	if networkingErr != nil {
+		setCondition(tgb, elbv2api.TargetGroupBindingConditionNetworkingReconciled, metav1.ConditionFalse, conditionReasonNetworkingNotReconciled, networkingErr.Error())
+		return
+	}
+	setCondition(tgb, elbv2api.TargetGroupBindingConditionNetworkingReconciled, metav1.ConditionTrue, conditionReasonNetworkingReconciled, "")
+}
+
+func setCondition(tgb *elbv2api.TargetGroupBinding, condType elbv2api.TargetGroupBindingConditionType, status metav1.ConditionStatus, reason string, message string) {
+	meta.SetStatusCondition(&tgb.Status.Conditions, metav1.Condition{
+		Type:               string(condType),
+		Status:             status,
+		ObservedGeneration: tgb.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// buildTargetsStatus counts the targets of the TargetGroup once a reconcile completed, from the targets listed before it,
+// the targets it deregistered and the number of targets it registered. Registered targets are initial until health checked.
+func buildTargetsStatus(listedTargets []TargetInfo, deregisteredTargets []TargetInfo, registeredCount int) *elbv2api.TargetGroupBindingTargetsStatus {
+	deregisteredTargetIDs := sets.New[string]()
+	for _, target := range deregisteredTargets {
+		deregisteredTargetIDs.Insert(UniqueIDForTargetDescription(target.Target))
+	}
+	targetsStatus := &elbv2api.TargetGroupBindingTargetsStatus{
+		Initial: int32(registeredCount),
+	}
+	for _, target := range listedTargets {
+		switch {
+		case target.IsDraining() || deregisteredTargetIDs.Has(UniqueIDForTargetDescription(target.Target)):
+			targetsStatus.Draining++
+		case target.IsHealthy():
+			targetsStatus.Healthy++
+		case target.IsInitial():
+			targetsStatus.Initial++
+		default:
+			targetsStatus.Unhealthy++
+		}
+	}
+	targetsStatus.Registered = targetsStatus.Healthy + targetsStatus.Unhealthy + targetsStatus.Initial
+	return targetsStatus
+}
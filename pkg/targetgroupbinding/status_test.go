@@ -0,0 +1,178 @@
+package targetgroupbinding
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	errmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/error"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+)
+
+func Test_buildTargetsStatus(t *testing.T) {
+	targetWithState := func(id string, state elbv2types.TargetHealthStateEnum) TargetInfo {
+		return TargetInfo{
+			Target:       elbv2types.TargetDescription{Id: awssdk.String(id), Port: awssdk.Int32(8080)},
+			TargetHealth: &elbv2types.TargetHealth{State: state},
+		}
+	}
+	tests := []struct {
+		name                string
+		listedTargets       []TargetInfo
+		deregisteredTargets []TargetInfo
+		registeredCount     int
+		want                *elbv2api.TargetGroupBindingTargetsStatus
+	}{
+		{
+			name: "no targets",
+			want: &elbv2api.TargetGroupBindingTargetsStatus{},
+		},
+		{
+			name: "targets by health",
+			listedTargets: []TargetInfo{
+				targetWithState("192.168.1.1", elbv2types.TargetHealthStateEnumHealthy),
+				targetWithState("192.168.1.2", elbv2types.TargetHealthStateEnumHealthy),
+				targetWithState("192.168.1.3", elbv2types.TargetHealthStateEnumUnhealthy),
+				targetWithState("192.168.1.4", elbv2types.TargetHealthStateEnumUnavailable),
+				targetWithState("192.168.1.5", elbv2types.TargetHealthStateEnumInitial),
+				targetWithState("192.168.1.6", elbv2types.TargetHealthStateEnumDraining),
+				targetWithState("192.168.1.7", elbv2types.TargetHealthStateEnumUnhealthyDraining),
+			},
+			want: &elbv2api.TargetGroupBindingTargetsStatus{
+				Registered: 5,
+				Healthy:    2,
+				Unhealthy:  2,
+				Initial:    1,
+				Draining:   2,
+			},
+		},
+		{
+			name: "deregistered targets are draining and registered targets are initial",
+			listedTargets: []TargetInfo{
+				targetWithState("192.168.1.1", elbv2types.TargetHealthStateEnumHealthy),
+				targetWithState("192.168.1.2", elbv2types.TargetHealthStateEnumHealthy),
+			},
+			deregisteredTargets: []TargetInfo{
+				targetWithState("192.168.1.2", elbv2types.TargetHealthStateEnumHealthy),
+			},
+			registeredCount: 3,
+			want: &elbv2api.TargetGroupBindingTargetsStatus{
+				Registered: 4,
+				Healthy:    1,
+				Initial:    3,
+				Draining:   1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildTargetsStatus(tt.listedTargets, tt.deregisteredTargets, tt.registeredCount)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_SetReconcileStatus(t *testing.T) {
+	type wantCondition struct {
+		status metav1.ConditionStatus
+		reason string
+	}
+	tests := []struct {
+		name                   string
+		iamRoleArnToAssume     string
+		networkingErr          error
+		reconcileErr           error
+		wantLastReconcileError string
+		wantConditions         map[elbv2api.TargetGroupBindingConditionType]wantCondition
+	}{
+		{
+			name: "reconciled",
+			wantConditions: map[elbv2api.TargetGroupBindingConditionType]wantCondition{
+				elbv2api.TargetGroupBindingConditionReady:                {metav1.ConditionTrue, "Reconciled"},
+				elbv2api.TargetGroupBindingConditionTargetGroupNotFound:  {metav1.ConditionFalse, "TargetGroupFound"},
+				elbv2api.TargetGroupBindingConditionNetworkingReconciled: {metav1.ConditionTrue, "NetworkingReconciled"},
+			},
+		},
+		{
+			name:         "requeued to monitor target health",
+			reconcileErr: runtime.NewRequeueNeededAfter("monitor targetHealth", 0),
+			wantConditions: map[elbv2api.TargetGroupBindingConditionType]wantCondition{
+				elbv2api.TargetGroupBindingConditionReady:                {metav1.ConditionTrue, "Reconciled"},
+				elbv2api.TargetGroupBindingConditionTargetGroupNotFound:  {metav1.ConditionFalse, "TargetGroupFound"},
+				elbv2api.TargetGroupBindingConditionNetworkingReconciled: {metav1.ConditionTrue, "NetworkingReconciled"},
+			},
+		},
+		{
+			name:          "networking not reconciled",
+			networkingErr: errors.New("sg rules failed"),
+			reconcileErr:  runtime.NewRequeueNeededAfter("networking reconciliation", 0),
+			wantConditions: map[elbv2api.TargetGroupBindingConditionType]wantCondition{
+				elbv2api.TargetGroupBindingConditionReady:                {metav1.ConditionFalse, "NetworkingNotReconciled"},
+				elbv2api.TargetGroupBindingConditionTargetGroupNotFound:  {metav1.ConditionFalse, "TargetGroupFound"},
+				elbv2api.TargetGroupBindingConditionNetworkingReconciled: {metav1.ConditionFalse, "NetworkingNotReconciled"},
+			},
+		},
+		{
+			name: "target group not found",
+			reconcileErr: errmetrics.NewErrorWithMetrics("targetGroupBinding", "list_targets_error",
+				&elbv2types.TargetGroupNotFoundException{Message: awssdk.String("tg not found")}, lbcmetrics.NewMockCollector()),
+			wantLastReconcileError: "TargetGroupNotFound: tg not found",
+			wantConditions: map[elbv2api.TargetGroupBindingConditionType]wantCondition{
+				elbv2api.TargetGroupBindingConditionReady:                {metav1.ConditionFalse, "ReconcileFailed"},
+				elbv2api.TargetGroupBindingConditionTargetGroupNotFound:  {metav1.ConditionTrue, "TargetGroupNotFound"},
+				elbv2api.TargetGroupBindingConditionNetworkingReconciled: {metav1.ConditionTrue, "NetworkingReconciled"},
+			},
+		},
+		{
+			name:                   "assume role failed",
+			iamRoleArnToAssume:     "arn:aws:iam::123456789012:role/tg-manager",
+			reconcileErr:           &AssumeRoleError{RoleARN: "arn:aws:iam::123456789012:role/tg-manager", Err: errors.New("access denied")},
+			wantLastReconcileError: "failed to assume role arn:aws:iam::123456789012:role/tg-manager: access denied",
+			wantConditions: map[elbv2api.TargetGroupBindingConditionType]wantCondition{
+				elbv2api.TargetGroupBindingConditionReady:                {metav1.ConditionFalse, "ReconcileFailed"},
+				elbv2api.TargetGroupBindingConditionTargetGroupNotFound:  {metav1.ConditionFalse, "TargetGroupFound"},
+				elbv2api.TargetGroupBindingConditionNetworkingReconciled: {metav1.ConditionTrue, "NetworkingReconciled"},
+				elbv2api.TargetGroupBindingConditionAssumeRoleFailed:     {metav1.ConditionTrue, "AssumeRoleFailed"},
+			},
+		},
+		{
+			name:               "assume role succeeded",
+			iamRoleArnToAssume: "arn:aws:iam::123456789012:role/tg-manager",
+			wantConditions: map[elbv2api.TargetGroupBindingConditionType]wantCondition{
+				elbv2api.TargetGroupBindingConditionReady:                {metav1.ConditionTrue, "Reconciled"},
+				elbv2api.TargetGroupBindingConditionTargetGroupNotFound:  {metav1.ConditionFalse, "TargetGroupFound"},
+				elbv2api.TargetGroupBindingConditionNetworkingReconciled: {metav1.ConditionTrue, "NetworkingReconciled"},
+				elbv2api.TargetGroupBindingConditionAssumeRoleFailed:     {metav1.ConditionFalse, "AssumeRoleSucceeded"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tgb := &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					IamRoleArnToAssume: tt.iamRoleArnToAssume,
+				},
+			}
+			setNetworkingReconciledCondition(tgb, tt.networkingErr)
+			SetReconcileStatus(tgb, tt.reconcileErr)
+
+			assert.Equal(t, tt.wantLastReconcileError, tgb.Status.LastReconcileError)
+			assert.Len(t, tgb.Status.Conditions, len(tt.wantConditions))
+			for condType, want := range tt.wantConditions {
+				cond := meta.FindStatusCondition(tgb.Status.Conditions, string(condType))
+				if assert.NotNil(t, cond, condType) {
+					assert.Equal(t, want.status, cond.Status, condType)
+					assert.Equal(t, want.reason, cond.Reason, condType)
+					assert.Equal(t, int64(2), cond.ObservedGeneration, condType)
+				}
+			}
+		})
+	}
+}
@@ -90,7 +90,7 @@ func (m *cachedTargetsManager) RegisterTargets(ctx context.Context, tgb *elbv2ap
 			"arn", tgARN,
 			"targets", targetsChunk)
 
-		clientToUse, err := m.elbv2Client.AssumeRole(ctx, tgb.Spec.IamRoleArnToAssume, tgb.Spec.AssumeRoleExternalId)
+		clientToUse, err := m.assumeRole(ctx, tgb)
 		if err != nil {
 			return err
 		}
@@ -118,7 +118,7 @@ func (m *cachedTargetsManager) DeregisterTargets(ctx context.Context, tgb *elbv2
 		m.logger.Info("deRegistering targets",
 			"arn", tgARN,
 			"targets", targetsChunk)
-		clientToUse, err := m.elbv2Client.AssumeRole(ctx, tgb.Spec.IamRoleArnToAssume, tgb.Spec.AssumeRoleExternalId)
+		clientToUse, err := m.assumeRole(ctx, tgb)
 		if err != nil {
 			return err
 		}
@@ -211,7 +211,7 @@ func (m *cachedTargetsManager) listTargetsFromAWS(ctx context.Context, tgb *elbv
 		TargetGroupArn: aws.String(tgARN),
 		Targets:        pointerizeTargetDescriptions(targets),
 	}
-	clientToUse, err := m.elbv2Client.AssumeRole(ctx, tgb.Spec.IamRoleArnToAssume, tgb.Spec.AssumeRoleExternalId)
+	clientToUse, err := m.assumeRole(ctx, tgb)
 	if err != nil {
 		return nil, err
 	}
@@ -230,6 +230,15 @@ func (m *cachedTargetsManager) listTargetsFromAWS(ctx context.Context, tgb *elbv
 	return listedTargets, nil
 }
 
+// assumeRole returns the ELBV2 client to manage the TargetGroup with, using the IAM role of the TargetGroupBinding if any.
+func (m *cachedTargetsManager) assumeRole(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (services.ELBV2, error) {
+	clientToUse, err := m.elbv2Client.AssumeRole(ctx, tgb.Spec.IamRoleArnToAssume, tgb.Spec.AssumeRoleExternalId)
+	if err != nil {
+		return nil, &AssumeRoleError{RoleARN: tgb.Spec.IamRoleArnToAssume, Err: err}
+	}
+	return clientToUse, nil
+}
+
 // recordSuccessfulRegisterTargetsOperation will record a successful deregisterTarget operation
 func (m *cachedTargetsManager) recordSuccessfulRegisterTargetsOperation(tgARN string, targets []elbv2types.TargetDescription) {
 	m.targetsCacheMutex.RLock()
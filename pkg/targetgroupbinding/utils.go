@@ -32,6 +32,11 @@ func BuildTargetHealthPodConditionType(tgb *elbv2api.TargetGroupBinding) corev1.
 	return corev1.PodConditionType(fmt.Sprintf("%s/%s", TargetHealthPodConditionTypePrefix, tgb.Name))
 }
 
+// BuildTargetsDrainedPodAnnotationKey constructs the key of the pod annotation tracking whether the targets of the pod are drained from the TargetGroupBinding.
+func BuildTargetsDrainedPodAnnotationKey(tgb *elbv2api.TargetGroupBinding) string {
+	return fmt.Sprintf("%s/%s", annotations.AnnotationPrefixPodTargetsDrained, tgb.Name)
+}
+
 // IndexFuncServiceRefName is IndexFunc for "ServiceReference" index.
 func IndexFuncServiceRefName(obj client.Object) []string {
 	tgb := obj.(*elbv2api.TargetGroupBinding)
@@ -25,6 +25,8 @@ $MOCKGEN -package=networking -destination=./pkg/networking/backend_sg_provider_m
 $MOCKGEN -package=networking -destination=./pkg/networking/security_group_resolver_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/networking SecurityGroupResolver
 $MOCKGEN -package=certs -destination=./pkg/certs/cert_discovery_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/certs CertDiscovery
 $MOCKGEN -package=elbv2 -destination=./pkg/deploy/elbv2/tagging_manager_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2 TaggingManager
+$MOCKGEN -package=deploy -destination=./pkg/deploy/stack_deployer_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy StackDeployer
+$MOCKGEN -package=acm -destination=./pkg/deploy/acm/certificate_manager_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/acm CertificateManager
 $MOCKGEN -package=shield -destination=./pkg/deploy/shield/protection_manager_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/shield ProtectionManager
 $MOCKGEN -package=wafv2 -destination=./pkg/deploy/wafv2/web_acl_association_manager_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/wafv2 WebACLAssociationManager
 $MOCKGEN -package=wafregional -destination=./pkg/deploy/wafregional/web_acl_association_manager_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/wafregional WebACLAssociationManager
\ No newline at end of file
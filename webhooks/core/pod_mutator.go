@@ -17,18 +17,20 @@ const (
 )
 
 // NewPodMutator returns a mutator for Pod.
-func NewPodMutator(podReadinessGateInjector *inject.PodReadinessGate, metricsCollector lbcmetrics.MetricCollector) *podMutator {
+func NewPodMutator(podReadinessGateInjector *inject.PodReadinessGate, podTerminationDrainInjector *inject.PodTerminationDrain, metricsCollector lbcmetrics.MetricCollector) *podMutator {
 	return &podMutator{
-		podReadinessGateInjector: podReadinessGateInjector,
-		metricsCollector:         metricsCollector,
+		podReadinessGateInjector:    podReadinessGateInjector,
+		podTerminationDrainInjector: podTerminationDrainInjector,
+		metricsCollector:            metricsCollector,
 	}
 }
 
 var _ webhook.Mutator = &podMutator{}
 
 type podMutator struct {
-	podReadinessGateInjector *inject.PodReadinessGate
-	metricsCollector         lbcmetrics.MetricCollector
+	podReadinessGateInjector    *inject.PodReadinessGate
+	podTerminationDrainInjector *inject.PodTerminationDrain
+	metricsCollector            lbcmetrics.MetricCollector
 }
 
 func (m *podMutator) Prototype(_ admission.Request) (runtime.Object, error) {
@@ -41,6 +43,10 @@ func (m *podMutator) MutateCreate(ctx context.Context, obj runtime.Object) (runt
 		m.metricsCollector.ObserveWebhookMutationError(apiPathMutatePod, "podReadinessGateInjector")
 		return pod, err
 	}
+	if err := m.podTerminationDrainInjector.Mutate(ctx, pod); err != nil {
+		m.metricsCollector.ObserveWebhookMutationError(apiPathMutatePod, "podTerminationDrainInjector")
+		return pod, err
+	}
 	return pod, nil
 }
 
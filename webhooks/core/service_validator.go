@@ -0,0 +1,216 @@
+package core
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shared_constants"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	apiPathValidateService = "/validate-v1-service"
+
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+)
+
+// NewServiceValidator returns a validator for Service.
+func NewServiceValidator(k8sClient client.Client, lbClass string, featureGates config.FeatureGates, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector) *serviceValidator {
+	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
+	lbClassParamsLoader := service.NewDefaultLoadBalancerClassParamsLoader(k8sClient)
+	return &serviceValidator{
+		annotationParser:    annotationParser,
+		serviceUtils:        service.NewServiceUtils(annotationParser, shared_constants.ServiceFinalizer, lbClass, lbClassParamsLoader, featureGates),
+		lbClassParamsLoader: lbClassParamsLoader,
+		logger:              logger,
+		metricsCollector:    metricsCollector,
+	}
+}
+
+var _ webhook.Validator = &serviceValidator{}
+
+type serviceValidator struct {
+	annotationParser    annotations.Parser
+	serviceUtils        service.ServiceUtils
+	lbClassParamsLoader service.LoadBalancerClassParamsLoader
+	logger              logr.Logger
+	metricsCollector    lbcmetrics.MetricCollector
+}
+
+func (v *serviceValidator) Prototype(_ admission.Request) (runtime.Object, error) {
+	return &corev1.Service{}, nil
+}
+
+func (v *serviceValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	svc := obj.(*corev1.Service)
+	supported, err := v.serviceUtils.IsServiceSupported(ctx, svc)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return nil
+	}
+	if err := v.checkLoadBalancerClassParamsUsage(ctx, svc, nil); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateService, "checkLoadBalancerClassParamsUsage")
+		return err
+	}
+	if err := v.checkServiceAnnotations(svc, nil); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateService, "checkServiceAnnotations")
+		return err
+	}
+	return nil
+}
+
+func (v *serviceValidator) ValidateUpdate(ctx context.Context, obj runtime.Object, oldObj runtime.Object) error {
+	svc := obj.(*corev1.Service)
+	oldSvc := oldObj.(*corev1.Service)
+	if err := v.checkLoadBalancerReplacement(ctx, svc, oldSvc); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateService, "checkLoadBalancerReplacement")
+		return err
+	}
+	supported, err := v.serviceUtils.IsServiceSupported(ctx, svc)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return nil
+	}
+	if err := v.checkLoadBalancerClassParamsUsage(ctx, svc, oldSvc); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateService, "checkLoadBalancerClassParamsUsage")
+		return err
+	}
+	if err := v.checkServiceAnnotations(svc, oldSvc); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateService, "checkServiceAnnotations")
+		return err
+	}
+	return nil
+}
+
+func (v *serviceValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+// checkLoadBalancerClassParamsUsage checks the Service is allowed to use its loadBalancerClass per namespaceSelector of the LoadBalancerClassParams bound to it.
+// Services that already weren't allowed before an update are tolerated, so that they can still be updated, e.g. to remove the finalizer.
+func (v *serviceValidator) checkLoadBalancerClassParamsUsage(ctx context.Context, svc *corev1.Service, oldSvc *corev1.Service) error {
+	_, err := v.lbClassParamsLoader.Load(ctx, svc)
+	if err == nil {
+		return nil
+	}
+	if oldSvc != nil {
+		if _, oldErr := v.lbClassParamsLoader.Load(ctx, oldSvc); oldErr != nil {
+			return nil
+		}
+	}
+	return errors.Wrapf(err, "invalid loadBalancerClass of Service %s/%s", svc.Namespace, svc.Name)
+}
+
+// checkServiceAnnotations checks the "aws-load-balancer-*" annotations of the Service are parsed successfully when building its model.
+// Annotations that already failed to parse before an update are tolerated, so that unrelated updates of the Service aren't blocked.
+func (v *serviceValidator) checkServiceAnnotations(svc *corev1.Service, oldSvc *corev1.Service) error {
+	err := service.ValidateServiceAnnotations(v.annotationParser, svc)
+	if err == nil || (oldSvc != nil && service.ValidateServiceAnnotations(v.annotationParser, oldSvc) != nil) {
+		return nil
+	}
+	return errors.Wrapf(err, "invalid annotations on Service %s/%s", svc.Namespace, svc.Name)
+}
+
+// checkLoadBalancerReplacement checks the update of a Service whose load balancer is provisioned doesn't replace or delete it,
+// by changing its scheme or by no longer being handled by the controller, e.g. because of its type.
+// Such updates are only allowed when acknowledged via "aws-load-balancer-allow-replacement" annotation.
+func (v *serviceValidator) checkLoadBalancerReplacement(ctx context.Context, svc *corev1.Service, oldSvc *corev1.Service) error {
+	if !svc.DeletionTimestamp.IsZero() || !v.hasLoadBalancerFinalizer(oldSvc) {
+		return nil
+	}
+	// the Service being handled can't be determined when its LoadBalancerClassParams can't be loaded,
+	// in which case the controller doesn't delete its load balancer either.
+	oldSupported, err := v.serviceUtils.IsServiceSupported(ctx, oldSvc)
+	if err != nil || !oldSupported {
+		return nil
+	}
+	allowReplacement := false
+	if _, err := v.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixAllowLoadBalancerReplacement, &allowReplacement, svc.Annotations); err == nil && allowReplacement {
+		return nil
+	}
+
+	supported, err := v.serviceUtils.IsServiceSupported(ctx, svc)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return errors.Errorf("Service %s/%s would no longer be handled by the controller and its load balancer would be deleted, set the %s/%s annotation to \"true\" to allow it",
+			svc.Namespace, svc.Name, serviceAnnotationPrefix, annotations.SvcLBSuffixAllowLoadBalancerReplacement)
+	}
+	scheme, explicitSchemeSpecified, err := v.parseExplicitLoadBalancerScheme(ctx, svc)
+	if err != nil || !explicitSchemeSpecified {
+		// without explicit scheme, the scheme of the existing load balancer is kept.
+		return nil
+	}
+	oldScheme, exists := v.findDeployedLoadBalancerScheme(ctx, oldSvc)
+	if !exists {
+		return nil
+	}
+	if scheme != oldScheme {
+		return errors.Errorf("changing the scheme of Service %s/%s from %v to %v replaces its load balancer, set the %s/%s annotation to \"true\" to allow it",
+			svc.Namespace, svc.Name, oldScheme, scheme, serviceAnnotationPrefix, annotations.SvcLBSuffixAllowLoadBalancerReplacement)
+	}
+	return nil
+}
+
+// hasLoadBalancerFinalizer checks whether the Service holds the finalizer of its own load balancer or of the load balancer of a Service group.
+func (v *serviceValidator) hasLoadBalancerFinalizer(svc *corev1.Service) bool {
+	if v.serviceUtils.IsServicePendingFinalization(svc) {
+		return true
+	}
+	for _, finalizer := range svc.Finalizers {
+		if strings.HasPrefix(finalizer, shared_constants.ServiceGroupFinalizerPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseExplicitLoadBalancerScheme parses the scheme of the Service's load balancer from its LoadBalancerClassParams or annotations,
+// in the same precedence as the model builder, along with whether it's explicitly specified.
+func (v *serviceValidator) parseExplicitLoadBalancerScheme(ctx context.Context, svc *corev1.Service) (elbv2model.LoadBalancerScheme, bool, error) {
+	lbClassParams, err := v.lbClassParamsLoader.Load(ctx, svc)
+	if err != nil {
+		return "", false, err
+	}
+	if lbClassParams != nil && lbClassParams.Spec.Scheme != nil {
+		return elbv2model.LoadBalancerScheme(*lbClassParams.Spec.Scheme), true, nil
+	}
+	return service.ParseLoadBalancerScheme(v.annotationParser, svc)
+}
+
+// findDeployedLoadBalancerScheme finds the scheme of the load balancer deployed for the Service.
+// It's recorded in the reconcile status of the Service, Services that weren't reconciled since fall back to their explicit scheme.
+func (v *serviceValidator) findDeployedLoadBalancerScheme(ctx context.Context, svc *corev1.Service) (elbv2model.LoadBalancerScheme, bool) {
+	if status, exists := k8s.GetReconcileStatus(svc); exists && status.LoadBalancerScheme != "" {
+		return elbv2model.LoadBalancerScheme(status.LoadBalancerScheme), true
+	}
+	scheme, explicitSchemeSpecified, err := v.parseExplicitLoadBalancerScheme(ctx, svc)
+	if err != nil || !explicitSchemeSpecified {
+		return "", false
+	}
+	return scheme, true
+}
+
+// +kubebuilder:webhook:path=/validate-v1-service,mutating=false,failurePolicy=fail,groups="",resources=services,verbs=create;update,versions=v1,name=vservice.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
+
+func (v *serviceValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(apiPathValidateService, webhook.ValidatingWebhookForValidator(v, mgr.GetScheme()))
+}
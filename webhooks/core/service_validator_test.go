@@ -0,0 +1,263 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_serviceValidator_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		svc     *corev1.Service
+		wantErr string
+	}{
+		{
+			name: "service with valid annotations",
+			svc: buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-ports": "443",
+			}, nil),
+		},
+		{
+			name: "service with invalid annotations",
+			svc: buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-ports": "8443",
+			}, nil),
+			wantErr: "invalid annotations on Service default/svc: Unused port in ssl-ports annotation [8443]",
+		},
+		{
+			name: "service not handled by the controller",
+			svc: func() *corev1.Service {
+				svc := buildValidatorTestService(map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-ssl-ports": "8443",
+				}, nil)
+				svc.Spec.LoadBalancerClass = stringPtr("other-class")
+				return svc
+			}(),
+		},
+		{
+			name: "service with loadBalancerClass bound to LoadBalancerClassParams",
+			svc: func() *corev1.Service {
+				svc := buildValidatorTestService(nil, nil)
+				svc.Spec.LoadBalancerClass = stringPtr("example.com/internal")
+				return svc
+			}(),
+		},
+		{
+			name: "service with loadBalancerClass of LoadBalancerClassParams not selecting its namespace",
+			svc: func() *corev1.Service {
+				svc := buildValidatorTestService(nil, nil)
+				svc.Spec.LoadBalancerClass = stringPtr("example.com/restricted")
+				return svc
+			}(),
+			wantErr: "invalid loadBalancerClass of Service default/svc: namespaceSelector of LoadBalancerClassParams restricted mismatch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewServiceValidator(buildValidatorTestK8sClient(t), "service.k8s.aws/nlb", config.NewFeatureGates(), logr.New(&log.NullLogSink{}), lbcmetrics.NewMockCollector())
+			err := v.ValidateCreate(context.Background(), tt.svc)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_serviceValidator_ValidateUpdate(t *testing.T) {
+	finalizers := []string{"service.k8s.aws/resources"}
+	withDeployedScheme := func(svc *corev1.Service, scheme string) *corev1.Service {
+		if svc.Annotations == nil {
+			svc.Annotations = map[string]string{}
+		}
+		svc.Annotations["elbv2.k8s.aws/reconcile-status"] = `{"type":"Reconciled","status":"True","lastTransitionTime":"2023-11-14T22:13:20Z","reason":"SuccessfullyReconciled","message":"","loadBalancerARN":"arn:lb-1","loadBalancerScheme":"` + scheme + `"}`
+		return svc
+	}
+	tests := []struct {
+		name    string
+		svc     *corev1.Service
+		oldSvc  *corev1.Service
+		wantErr string
+	}{
+		{
+			name: "service keeping invalid annotations",
+			svc: buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-ports": "8443",
+				"service.beta.kubernetes.io/aws-load-balancer-name":      "lb",
+			}, finalizers),
+			oldSvc: buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-ports": "8443",
+			}, finalizers),
+		},
+		{
+			name: "service changing its scheme",
+			svc: buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+			}, finalizers),
+			oldSvc:  withDeployedScheme(buildValidatorTestService(nil, finalizers), "internal"),
+			wantErr: "changing the scheme of Service default/svc from internal to internet-facing replaces its load balancer, set the service.beta.kubernetes.io/aws-load-balancer-allow-replacement annotation to \"true\" to allow it",
+		},
+		{
+			name: "service specifying the scheme of its deployed load balancer",
+			svc: buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+			}, finalizers),
+			oldSvc: withDeployedScheme(buildValidatorTestService(nil, finalizers), "internet-facing"),
+		},
+		{
+			name: "service changing its explicit scheme before the deployed scheme is recorded",
+			svc: buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+			}, finalizers),
+			oldSvc: buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internal",
+			}, finalizers),
+			wantErr: "changing the scheme of Service default/svc from internal to internet-facing replaces its load balancer, set the service.beta.kubernetes.io/aws-load-balancer-allow-replacement annotation to \"true\" to allow it",
+		},
+		{
+			name: "service specifying a scheme before the deployed scheme is recorded",
+			svc: buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+			}, finalizers),
+			oldSvc: buildValidatorTestService(nil, finalizers),
+		},
+		{
+			name: "service whose LoadBalancerClassParams scheme differs from the deployed scheme",
+			svc: func() *corev1.Service {
+				svc := buildValidatorTestService(nil, finalizers)
+				svc.Spec.LoadBalancerClass = stringPtr("example.com/internet-facing")
+				return svc
+			}(),
+			oldSvc: func() *corev1.Service {
+				svc := withDeployedScheme(buildValidatorTestService(nil, finalizers), "internal")
+				svc.Spec.LoadBalancerClass = stringPtr("example.com/internet-facing")
+				return svc
+			}(),
+			wantErr: "changing the scheme of Service default/svc from internal to internet-facing replaces its load balancer, set the service.beta.kubernetes.io/aws-load-balancer-allow-replacement annotation to \"true\" to allow it",
+		},
+		{
+			name: "service group member changing its scheme",
+			svc: buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":     "internet-facing",
+			}, []string{"group.service.k8s.aws/awesome-group"}),
+			oldSvc: withDeployedScheme(buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+			}, []string{"group.service.k8s.aws/awesome-group"}), "internal"),
+			wantErr: "changing the scheme of Service default/svc from internal to internet-facing replaces its load balancer, set the service.beta.kubernetes.io/aws-load-balancer-allow-replacement annotation to \"true\" to allow it",
+		},
+		{
+			name: "service changing its scheme with acknowledgement",
+			svc: buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":            "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-allow-replacement": "true",
+			}, finalizers),
+			oldSvc: buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internal",
+			}, finalizers),
+		},
+		{
+			name: "service without provisioned load balancer changing its scheme",
+			svc: buildValidatorTestService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+			}, nil),
+			oldSvc: buildValidatorTestService(nil, nil),
+		},
+		{
+			name: "service no longer handled by the controller",
+			svc: func() *corev1.Service {
+				svc := buildValidatorTestService(nil, finalizers)
+				svc.Spec.Type = corev1.ServiceTypeClusterIP
+				svc.Spec.LoadBalancerClass = nil
+				return svc
+			}(),
+			oldSvc:  buildValidatorTestService(nil, finalizers),
+			wantErr: "Service default/svc would no longer be handled by the controller and its load balancer would be deleted, set the service.beta.kubernetes.io/aws-load-balancer-allow-replacement annotation to \"true\" to allow it",
+		},
+		{
+			name: "service being deleted",
+			svc: func() *corev1.Service {
+				svc := buildValidatorTestService(nil, nil)
+				svc.DeletionTimestamp = &metav1.Time{}
+				return svc
+			}(),
+			oldSvc: buildValidatorTestService(nil, finalizers),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewServiceValidator(buildValidatorTestK8sClient(t), "service.k8s.aws/nlb", config.NewFeatureGates(), logr.New(&log.NullLogSink{}), lbcmetrics.NewMockCollector())
+			err := v.ValidateUpdate(context.Background(), tt.svc, tt.oldSvc)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func buildValidatorTestK8sClient(t *testing.T) client.Client {
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	elbv2api.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+	ctx := context.Background()
+	assert.NoError(t, k8sClient.Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+	}))
+	assert.NoError(t, k8sClient.Create(ctx, &elbv2api.LoadBalancerClassParams{
+		ObjectMeta: metav1.ObjectMeta{Name: "internal"},
+		Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/internal"},
+	}))
+	internetFacing := elbv2api.LoadBalancerSchemeInternetFacing
+	assert.NoError(t, k8sClient.Create(ctx, &elbv2api.LoadBalancerClassParams{
+		ObjectMeta: metav1.ObjectMeta{Name: "internet-facing"},
+		Spec: elbv2api.LoadBalancerClassParamsSpec{
+			LoadBalancerClass: "example.com/internet-facing",
+			Scheme:            &internetFacing,
+		},
+	}))
+	assert.NoError(t, k8sClient.Create(ctx, &elbv2api.LoadBalancerClassParams{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted"},
+		Spec: elbv2api.LoadBalancerClassParamsSpec{
+			LoadBalancerClass: "example.com/restricted",
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "awesome"},
+			},
+		},
+	}))
+	return k8sClient
+}
+
+func buildValidatorTestService(svcAnnotations map[string]string, finalizers []string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "svc",
+			Annotations: svcAnnotations,
+			Finalizers:  finalizers,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:              corev1.ServiceTypeLoadBalancer,
+			LoadBalancerClass: stringPtr("service.k8s.aws/nlb"),
+			Ports: []corev1.ServicePort{
+				{Name: "https", Port: 443},
+			},
+		},
+	}
+}
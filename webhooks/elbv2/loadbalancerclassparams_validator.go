@@ -0,0 +1,86 @@
+package elbv2
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const apiPathValidateELBv2LoadBalancerClassParams = "/validate-elbv2-k8s-aws-v1beta1-loadbalancerclassparams"
+
+// NewLoadBalancerClassParamsValidator returns a validator for the LoadBalancerClassParams CRD.
+func NewLoadBalancerClassParamsValidator(k8sClient client.Client, metricsCollector lbcmetrics.MetricCollector) *loadBalancerClassParamsValidator {
+	return &loadBalancerClassParamsValidator{
+		k8sClient:        k8sClient,
+		metricsCollector: metricsCollector,
+	}
+}
+
+var _ webhook.Validator = &loadBalancerClassParamsValidator{}
+
+type loadBalancerClassParamsValidator struct {
+	k8sClient        client.Client
+	metricsCollector lbcmetrics.MetricCollector
+}
+
+func (v *loadBalancerClassParamsValidator) Prototype(_ admission.Request) (runtime.Object, error) {
+	return &elbv2api.LoadBalancerClassParams{}, nil
+}
+
+func (v *loadBalancerClassParamsValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	lbcp := obj.(*elbv2api.LoadBalancerClassParams)
+	if err := v.checkLoadBalancerClassUniqueness(ctx, lbcp); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2LoadBalancerClassParams, "checkLoadBalancerClassUniqueness")
+		return err
+	}
+	return nil
+}
+
+func (v *loadBalancerClassParamsValidator) ValidateUpdate(ctx context.Context, obj runtime.Object, oldObj runtime.Object) error {
+	lbcp := obj.(*elbv2api.LoadBalancerClassParams)
+	oldLBCP := oldObj.(*elbv2api.LoadBalancerClassParams)
+	if lbcp.Spec.LoadBalancerClass == oldLBCP.Spec.LoadBalancerClass {
+		return nil
+	}
+	if err := v.checkLoadBalancerClassUniqueness(ctx, lbcp); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2LoadBalancerClassParams, "checkLoadBalancerClassUniqueness")
+		return err
+	}
+	return nil
+}
+
+func (v *loadBalancerClassParamsValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+// checkLoadBalancerClassUniqueness checks no other LoadBalancerClassParams is bound to the loadBalancerClass,
+// the Services with it would no longer be reconciled otherwise.
+func (v *loadBalancerClassParamsValidator) checkLoadBalancerClassUniqueness(ctx context.Context, lbcp *elbv2api.LoadBalancerClassParams) error {
+	lbcpList := &elbv2api.LoadBalancerClassParamsList{}
+	if err := v.k8sClient.List(ctx, lbcpList); err != nil {
+		return errors.Wrap(err, "failed to list LoadBalancerClassParams")
+	}
+	for _, existingLBCP := range lbcpList.Items {
+		if existingLBCP.Name == lbcp.Name || existingLBCP.Spec.LoadBalancerClass != lbcp.Spec.LoadBalancerClass {
+			continue
+		}
+		fieldPath := field.NewPath("spec", "loadBalancerClass")
+		return field.ErrorList{field.Invalid(fieldPath, lbcp.Spec.LoadBalancerClass,
+			"already bound to LoadBalancerClassParams "+existingLBCP.Name)}.ToAggregate()
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-elbv2-k8s-aws-v1beta1-loadbalancerclassparams,mutating=false,failurePolicy=fail,groups=elbv2.k8s.aws,resources=loadbalancerclassparams,verbs=create;update,versions=v1beta1,name=vloadbalancerclassparams.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
+
+func (v *loadBalancerClassParamsValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(apiPathValidateELBv2LoadBalancerClassParams, webhook.ValidatingWebhookForValidator(v, mgr.GetScheme()))
+}
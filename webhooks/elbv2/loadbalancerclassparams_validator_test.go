@@ -0,0 +1,133 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_loadBalancerClassParamsValidator_ValidateCreate(t *testing.T) {
+	existingLBCPs := []*elbv2api.LoadBalancerClassParams{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "internal"},
+			Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/internal"},
+		},
+	}
+	tests := []struct {
+		name       string
+		obj        *elbv2api.LoadBalancerClassParams
+		wantErr    string
+		wantMetric bool
+	}{
+		{
+			name: "loadBalancerClass not bound yet",
+			obj: &elbv2api.LoadBalancerClassParams{
+				ObjectMeta: metav1.ObjectMeta{Name: "external"},
+				Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/external"},
+			},
+		},
+		{
+			name: "loadBalancerClass already bound",
+			obj: &elbv2api.LoadBalancerClassParams{
+				ObjectMeta: metav1.ObjectMeta{Name: "internal-2"},
+				Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/internal"},
+			},
+			wantErr:    "spec.loadBalancerClass: Invalid value: \"example.com/internal\": already bound to LoadBalancerClassParams internal",
+			wantMetric: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, lbcp := range existingLBCPs {
+				assert.NoError(t, k8sClient.Create(ctx, lbcp.DeepCopy()))
+			}
+			mockMetricsCollector := lbcmetrics.NewMockCollector()
+			v := NewLoadBalancerClassParamsValidator(k8sClient, mockMetricsCollector)
+			err := v.ValidateCreate(ctx, tt.obj)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockCollector := v.metricsCollector.(*lbcmetrics.MockCollector)
+			assert.Equal(t, tt.wantMetric, len(mockCollector.Invocations[lbcmetrics.MetricWebhookValidationFailure]) == 1)
+		})
+	}
+}
+
+func Test_loadBalancerClassParamsValidator_ValidateUpdate(t *testing.T) {
+	existingLBCPs := []*elbv2api.LoadBalancerClassParams{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "internal"},
+			Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/internal"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "external"},
+			Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/external"},
+		},
+	}
+	tests := []struct {
+		name    string
+		obj     *elbv2api.LoadBalancerClassParams
+		oldObj  *elbv2api.LoadBalancerClassParams
+		wantErr string
+	}{
+		{
+			name: "loadBalancerClass unchanged",
+			obj: &elbv2api.LoadBalancerClassParams{
+				ObjectMeta: metav1.ObjectMeta{Name: "internal"},
+				Spec: elbv2api.LoadBalancerClassParamsSpec{
+					LoadBalancerClass: "example.com/internal",
+					SSLPolicy:         "ELBSecurityPolicy-TLS13-1-2-2021-06",
+				},
+			},
+			oldObj: &elbv2api.LoadBalancerClassParams{
+				ObjectMeta: metav1.ObjectMeta{Name: "internal"},
+				Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/internal"},
+			},
+		},
+		{
+			name: "loadBalancerClass changed to one already bound",
+			obj: &elbv2api.LoadBalancerClassParams{
+				ObjectMeta: metav1.ObjectMeta{Name: "internal"},
+				Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/external"},
+			},
+			oldObj: &elbv2api.LoadBalancerClassParams{
+				ObjectMeta: metav1.ObjectMeta{Name: "internal"},
+				Spec:       elbv2api.LoadBalancerClassParamsSpec{LoadBalancerClass: "example.com/internal"},
+			},
+			wantErr: "spec.loadBalancerClass: Invalid value: \"example.com/external\": already bound to LoadBalancerClassParams external",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, lbcp := range existingLBCPs {
+				assert.NoError(t, k8sClient.Create(ctx, lbcp.DeepCopy()))
+			}
+			v := NewLoadBalancerClassParamsValidator(k8sClient, lbcmetrics.NewMockCollector())
+			err := v.ValidateUpdate(ctx, tt.obj, tt.oldObj)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
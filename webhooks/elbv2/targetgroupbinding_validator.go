@@ -13,6 +13,7 @@ import (
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
@@ -67,6 +68,10 @@ func (v *targetGroupBindingValidator) ValidateCreate(ctx context.Context, obj ru
 		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2TargetGroupBinding, "checkNodeSelector")
 		return err
 	}
+	if err := v.checkPodSelector(tgb); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2TargetGroupBinding, "checkPodSelector")
+		return err
+	}
 	if err := v.checkExistingTargetGroups(tgb); err != nil {
 		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2TargetGroupBinding, "checkExistingTargetGroups")
 		return err
@@ -102,6 +107,10 @@ func (v *targetGroupBindingValidator) ValidateUpdate(ctx context.Context, obj ru
 		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2TargetGroupBinding, "checkNodeSelector")
 		return err
 	}
+	if err := v.checkPodSelector(tgb); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2TargetGroupBinding, "checkPodSelector")
+		return err
+	}
 	if err := v.checkAssumeRoleConfig(tgb); err != nil {
 		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2TargetGroupBinding, "checkAssumeRoleConfig")
 		return err
@@ -216,6 +225,20 @@ func (v *targetGroupBindingValidator) checkNodeSelector(tgb *elbv2api.TargetGrou
 	return nil
 }
 
+// checkPodSelector ensures that PodSelector is only set when TargetType is ip, and is a valid label selector
+func (v *targetGroupBindingValidator) checkPodSelector(tgb *elbv2api.TargetGroupBinding) error {
+	if tgb.Spec.PodSelector == nil {
+		return nil
+	}
+	if *tgb.Spec.TargetType != elbv2api.TargetTypeIP {
+		return errors.Errorf("TargetGroupBinding cannot set PodSelector when TargetType is %v", *tgb.Spec.TargetType)
+	}
+	if _, err := metav1.LabelSelectorAsSelector(tgb.Spec.PodSelector); err != nil {
+		return errors.Wrap(err, "invalid PodSelector")
+	}
+	return nil
+}
+
 // checkTargetGroupIPAddressType ensures IP address type matches with that on the AWS target group
 func (v *targetGroupBindingValidator) checkTargetGroupIPAddressType(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
 	targetGroupIPAddressType, err := v.getTargetGroupIPAddressTypeFromAWS(ctx, tgb)
@@ -957,6 +957,82 @@ func Test_targetGroupBindingValidator_checkNodeSelector(t *testing.T) {
 	}
 }
 
+func Test_targetGroupBindingValidator_checkPodSelector(t *testing.T) {
+	instanceTargetType := elbv2api.TargetTypeInstance
+	ipTargetType := elbv2api.TargetTypeIP
+	tests := []struct {
+		name    string
+		tgb     *elbv2api.TargetGroupBinding
+		wantErr error
+	}{
+		{
+			name: "[ok] targetType is instance, podSelector is nil",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN: "tg-1",
+					TargetType:     &instanceTargetType,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[ok] targetType is ip, podSelector is set",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN: "tg-2",
+					TargetType:     &ipTargetType,
+					PodSelector: &v1.LabelSelector{
+						MatchLabels: map[string]string{"track": "canary"},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[err] targetType is instance, podSelector is set",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN: "tg-3",
+					TargetType:     &instanceTargetType,
+					PodSelector: &v1.LabelSelector{
+						MatchLabels: map[string]string{"track": "canary"},
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding cannot set PodSelector when TargetType is instance"),
+		},
+		{
+			name: "[err] targetType is ip, podSelector is invalid",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN: "tg-4",
+					TargetType:     &ipTargetType,
+					PodSelector: &v1.LabelSelector{
+						MatchExpressions: []v1.LabelSelectorRequirement{
+							{Key: "track", Operator: "BadOperatorValue", Values: []string{"canary"}},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("invalid PodSelector: \"BadOperatorValue\" is not a valid label selector operator"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &targetGroupBindingValidator{
+				logger:           logr.New(&log.NullLogSink{}),
+				metricsCollector: lbcmetrics.NewMockCollector(),
+			}
+			err := v.checkPodSelector(tt.tgb)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_targetGroupBindingValidator_checkExistingTargetGroups(t *testing.T) {
 
 	type env struct {
@@ -0,0 +1,158 @@
+package gateway
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	gatewaymodel "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/model"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const apiPathValidateGatewayLoadBalancerConfiguration = "/validate-gateway-k8s-aws-v1beta1-loadbalancerconfiguration"
+
+// NewLoadBalancerConfigurationValidator returns a validator for the LoadBalancerConfiguration CRD.
+func NewLoadBalancerConfigurationValidator(metricsCollector lbcmetrics.MetricCollector) *loadBalancerConfigurationValidator {
+	return &loadBalancerConfigurationValidator{
+		metricsCollector: metricsCollector,
+	}
+}
+
+var _ webhook.Validator = &loadBalancerConfigurationValidator{}
+
+type loadBalancerConfigurationValidator struct {
+	metricsCollector lbcmetrics.MetricCollector
+}
+
+func (v *loadBalancerConfigurationValidator) Prototype(_ admission.Request) (runtime.Object, error) {
+	return &elbv2gw.LoadBalancerConfiguration{}, nil
+}
+
+func (v *loadBalancerConfigurationValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	lbConf := obj.(*elbv2gw.LoadBalancerConfiguration)
+	return v.validate(lbConf, nil)
+}
+
+func (v *loadBalancerConfigurationValidator) ValidateUpdate(ctx context.Context, obj runtime.Object, oldObj runtime.Object) error {
+	lbConf := obj.(*elbv2gw.LoadBalancerConfiguration)
+	oldLBConf := oldObj.(*elbv2gw.LoadBalancerConfiguration)
+	return v.validate(lbConf, oldLBConf)
+}
+
+func (v *loadBalancerConfigurationValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+// validate validates the LoadBalancerConfiguration, oldLBConf is the LoadBalancerConfiguration before an update if any.
+// Checks that already failed before an update are tolerated, so that unrelated updates of the LoadBalancerConfiguration aren't blocked.
+func (v *loadBalancerConfigurationValidator) validate(lbConf *elbv2gw.LoadBalancerConfiguration, oldLBConf *elbv2gw.LoadBalancerConfiguration) error {
+	allErrs := field.ErrorList{}
+	if errs := v.checkListenerConfigurations(lbConf); len(errs) > 0 && (oldLBConf == nil || len(v.checkListenerConfigurations(oldLBConf)) == 0) {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateGatewayLoadBalancerConfiguration, "checkListenerConfigurations")
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := v.checkLoadBalancerAttributes(lbConf); len(errs) > 0 && (oldLBConf == nil || len(v.checkLoadBalancerAttributes(oldLBConf)) == 0) {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateGatewayLoadBalancerConfiguration, "checkLoadBalancerAttributes")
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := v.checkSecurityGroups(lbConf); len(errs) > 0 && (oldLBConf == nil || len(v.checkSecurityGroups(oldLBConf)) == 0) {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateGatewayLoadBalancerConfiguration, "checkSecurityGroups")
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := v.checkLoadBalancerSubnets(lbConf); len(errs) > 0 && (oldLBConf == nil || len(v.checkLoadBalancerSubnets(oldLBConf)) == 0) {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateGatewayLoadBalancerConfiguration, "checkLoadBalancerSubnets")
+		allErrs = append(allErrs, errs...)
+	}
+	return allErrs.ToAggregate()
+}
+
+// checkListenerConfigurations checks that the listener configurations have a valid and unique protocolPort.
+// Listener configurations are matched with the Gateway listeners by port, so ports can't be shared by multiple protocols.
+func (v *loadBalancerConfigurationValidator) checkListenerConfigurations(lbConf *elbv2gw.LoadBalancerConfiguration) (allErrs field.ErrorList) {
+	if lbConf.Spec.ListenerConfigurations == nil {
+		return nil
+	}
+	seenPorts := make(map[int32]bool)
+	for idx, lsCfg := range *lbConf.Spec.ListenerConfigurations {
+		fieldPath := field.NewPath("spec", "listenerConfigurations").Index(idx).Child("protocolPort")
+		_, port, err := gatewaymodel.ParseProtocolPort(lsCfg.ProtocolPort)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath, lsCfg.ProtocolPort, err.Error()))
+			continue
+		}
+		if seenPorts[port] {
+			allErrs = append(allErrs, field.Duplicate(fieldPath, lsCfg.ProtocolPort))
+		}
+		seenPorts[port] = true
+	}
+	return allErrs
+}
+
+// checkLoadBalancerAttributes checks that the load balancer attributes are unique.
+// Attribute keys aren't checked against a list, as ELB keeps adding attributes, the ELB API rejects unknown ones when the load balancer is deployed.
+func (v *loadBalancerConfigurationValidator) checkLoadBalancerAttributes(lbConf *elbv2gw.LoadBalancerConfiguration) (allErrs field.ErrorList) {
+	seenKeys := make(map[string]bool)
+	for idx, attr := range lbConf.Spec.LoadBalancerAttributes {
+		fieldPath := field.NewPath("spec", "loadBalancerAttributes").Index(idx).Child("key")
+		if seenKeys[attr.Key] {
+			allErrs = append(allErrs, field.Duplicate(fieldPath, attr.Key))
+		}
+		seenKeys[attr.Key] = true
+	}
+	return allErrs
+}
+
+// checkSecurityGroups checks that securityGroupPrefixes aren't set along with securityGroups.
+// The prefixes are only allowed into the security group managed by the controller, which isn't created with explicit security groups.
+func (v *loadBalancerConfigurationValidator) checkSecurityGroups(lbConf *elbv2gw.LoadBalancerConfiguration) (allErrs field.ErrorList) {
+	if lbConf.Spec.SecurityGroups == nil || len(*lbConf.Spec.SecurityGroups) == 0 {
+		return nil
+	}
+	if lbConf.Spec.SecurityGroupPrefixes != nil && len(*lbConf.Spec.SecurityGroupPrefixes) != 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "securityGroupPrefixes"), "may not be set along with `securityGroups`"))
+	}
+	return allErrs
+}
+
+// checkLoadBalancerSubnets checks that the subnet configurations are consistent, with the scheme and IP address type if they're set.
+func (v *loadBalancerConfigurationValidator) checkLoadBalancerSubnets(lbConf *elbv2gw.LoadBalancerConfiguration) (allErrs field.ErrorList) {
+	if lbConf.Spec.LoadBalancerSubnets == nil {
+		return nil
+	}
+	fieldPath := field.NewPath("spec", "loadBalancerSubnets")
+	subnetsConfig := *lbConf.Spec.LoadBalancerSubnets
+	seenIdentifiers := make(map[string]bool)
+	for idx, subnetConfig := range subnetsConfig {
+		if subnetConfig.Identifier == "" {
+			continue
+		}
+		if seenIdentifiers[subnetConfig.Identifier] {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Index(idx).Child("identifier"), subnetConfig.Identifier))
+		}
+		seenIdentifiers[subnetConfig.Identifier] = true
+	}
+
+	var scheme elbv2model.LoadBalancerScheme
+	if lbConf.Spec.Scheme != nil {
+		scheme = elbv2model.LoadBalancerScheme(*lbConf.Spec.Scheme)
+	}
+	var ipAddressType elbv2model.IPAddressType
+	if lbConf.Spec.IpAddressType != nil {
+		ipAddressType = elbv2model.IPAddressType(*lbConf.Spec.IpAddressType)
+	}
+	if err := gatewaymodel.ValidateSubnetConfigurations(subnetsConfig, scheme, ipAddressType); err != nil {
+		allErrs = append(allErrs, field.Forbidden(fieldPath, err.Error()))
+	}
+	return allErrs
+}
+
+// +kubebuilder:webhook:path=/validate-gateway-k8s-aws-v1beta1-loadbalancerconfiguration,mutating=false,failurePolicy=fail,groups=gateway.k8s.aws,resources=loadbalancerconfigurations,verbs=create;update,versions=v1beta1,name=vloadbalancerconfiguration.gateway.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
+
+func (v *loadBalancerConfigurationValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(apiPathValidateGatewayLoadBalancerConfiguration, webhook.ValidatingWebhookForValidator(v, mgr.GetScheme()))
+}
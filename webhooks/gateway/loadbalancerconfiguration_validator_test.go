@@ -0,0 +1,211 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+)
+
+func Test_loadBalancerConfigurationValidator_ValidateCreate(t *testing.T) {
+	internal := elbv2gw.LoadBalancerSchemeInternal
+	tests := []struct {
+		name       string
+		spec       elbv2gw.LoadBalancerConfigurationSpec
+		wantErr    string
+		wantMetric bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid configuration",
+			spec: elbv2gw.LoadBalancerConfigurationSpec{
+				ListenerConfigurations: &[]elbv2gw.ListenerConfiguration{
+					{ProtocolPort: "HTTP:80"},
+					{ProtocolPort: "HTTPS:443"},
+				},
+				LoadBalancerAttributes: []elbv2gw.LoadBalancerAttribute{
+					{Key: "idle_timeout.timeout_seconds", Value: "120"},
+				},
+				SecurityGroupPrefixes: &[]string{"pl-00000000"},
+				LoadBalancerSubnets: &[]elbv2gw.SubnetConfiguration{
+					{Identifier: "subnet-1"},
+					{Identifier: "subnet-2"},
+				},
+			},
+		},
+		{
+			name: "protocolPort without port",
+			spec: elbv2gw.LoadBalancerConfigurationSpec{
+				ListenerConfigurations: &[]elbv2gw.ListenerConfiguration{
+					{ProtocolPort: "HTTP:"},
+				},
+			},
+			wantErr:    "spec.listenerConfigurations[0].protocolPort: Invalid value: \"HTTP:\": protocolPort HTTP: must be of the form PROTOCOL:PORT",
+			wantMetric: true,
+		},
+		{
+			name: "protocolPorts sharing a port",
+			spec: elbv2gw.LoadBalancerConfigurationSpec{
+				ListenerConfigurations: &[]elbv2gw.ListenerConfiguration{
+					{ProtocolPort: "TCP:80"},
+					{ProtocolPort: "UDP:80"},
+				},
+			},
+			wantErr:    "spec.listenerConfigurations[1].protocolPort: Duplicate value: \"UDP:80\"",
+			wantMetric: true,
+		},
+		{
+			name: "load balancer attribute the controller doesn't know of",
+			spec: elbv2gw.LoadBalancerConfigurationSpec{
+				LoadBalancerAttributes: []elbv2gw.LoadBalancerAttribute{
+					{Key: "health_check_logs.s3.enabled", Value: "true"},
+				},
+			},
+		},
+		{
+			name: "duplicate load balancer attribute",
+			spec: elbv2gw.LoadBalancerConfigurationSpec{
+				LoadBalancerAttributes: []elbv2gw.LoadBalancerAttribute{
+					{Key: "deletion_protection.enabled", Value: "true"},
+					{Key: "deletion_protection.enabled", Value: "false"},
+				},
+			},
+			wantErr:    "spec.loadBalancerAttributes[1].key: Duplicate value: \"deletion_protection.enabled\"",
+			wantMetric: true,
+		},
+		{
+			name: "securityGroupPrefixes along with securityGroups",
+			spec: elbv2gw.LoadBalancerConfigurationSpec{
+				SecurityGroups:        &[]string{"sg-1"},
+				SecurityGroupPrefixes: &[]string{"pl-00000000"},
+			},
+			wantErr:    "spec.securityGroupPrefixes: Forbidden: may not be set along with `securityGroups`",
+			wantMetric: true,
+		},
+		{
+			name: "duplicate subnet identifiers",
+			spec: elbv2gw.LoadBalancerConfigurationSpec{
+				LoadBalancerSubnets: &[]elbv2gw.SubnetConfiguration{
+					{Identifier: "subnet-1"},
+					{Identifier: "subnet-1"},
+				},
+			},
+			wantErr:    "spec.loadBalancerSubnets[1].identifier: Duplicate value: \"subnet-1\"",
+			wantMetric: true,
+		},
+		{
+			name: "subnets partially identified",
+			spec: elbv2gw.LoadBalancerConfigurationSpec{
+				LoadBalancerSubnets: &[]elbv2gw.SubnetConfiguration{
+					{Identifier: "subnet-1"},
+					{},
+				},
+			},
+			wantErr:    "spec.loadBalancerSubnets: Forbidden: Either specify all subnet identifiers or none.",
+			wantMetric: true,
+		},
+		{
+			name: "eip allocations for an internal load balancer",
+			spec: elbv2gw.LoadBalancerConfigurationSpec{
+				Scheme: &internal,
+				LoadBalancerSubnets: &[]elbv2gw.SubnetConfiguration{
+					{Identifier: "subnet-1", EIPAllocation: awssdk.String("eipalloc-1")},
+				},
+			},
+			wantErr:    "spec.loadBalancerSubnets: Forbidden: EIPAllocation can only be set for internet facing load balancers",
+			wantMetric: true,
+		},
+		{
+			name: "eip allocations without scheme",
+			spec: elbv2gw.LoadBalancerConfigurationSpec{
+				LoadBalancerSubnets: &[]elbv2gw.SubnetConfiguration{
+					{Identifier: "subnet-1", EIPAllocation: awssdk.String("eipalloc-1")},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMetricsCollector := lbcmetrics.NewMockCollector()
+			v := NewLoadBalancerConfigurationValidator(mockMetricsCollector)
+			lbConf := &elbv2gw.LoadBalancerConfiguration{Spec: tt.spec}
+			t.Run("create", func(t *testing.T) {
+				err := v.ValidateCreate(context.Background(), lbConf)
+				if tt.wantErr != "" {
+					assert.EqualError(t, err, tt.wantErr)
+				} else {
+					assert.NoError(t, err)
+				}
+			})
+			t.Run("update", func(t *testing.T) {
+				err := v.ValidateUpdate(context.Background(), lbConf, &elbv2gw.LoadBalancerConfiguration{})
+				if tt.wantErr != "" {
+					assert.EqualError(t, err, tt.wantErr)
+				} else {
+					assert.NoError(t, err)
+				}
+			})
+
+			mockCollector := mockMetricsCollector.(*lbcmetrics.MockCollector)
+			assert.Equal(t, tt.wantMetric, len(mockCollector.Invocations[lbcmetrics.MetricWebhookValidationFailure]) == 2)
+		})
+	}
+}
+
+func Test_loadBalancerConfigurationValidator_ValidateUpdate(t *testing.T) {
+	duplicateAttributes := []elbv2gw.LoadBalancerAttribute{
+		{Key: "deletion_protection.enabled", Value: "true"},
+		{Key: "deletion_protection.enabled", Value: "false"},
+	}
+	tests := []struct {
+		name       string
+		oldSpec    elbv2gw.LoadBalancerConfigurationSpec
+		spec       elbv2gw.LoadBalancerConfigurationSpec
+		wantErr    string
+		wantMetric bool
+	}{
+		{
+			name: "error the configuration already had is tolerated",
+			oldSpec: elbv2gw.LoadBalancerConfigurationSpec{
+				LoadBalancerAttributes: duplicateAttributes,
+			},
+			spec: elbv2gw.LoadBalancerConfigurationSpec{
+				LoadBalancerAttributes: duplicateAttributes,
+				SecurityGroups:         &[]string{"sg-1"},
+			},
+		},
+		{
+			name: "error introduced by the update is rejected",
+			oldSpec: elbv2gw.LoadBalancerConfigurationSpec{
+				LoadBalancerAttributes: duplicateAttributes,
+			},
+			spec: elbv2gw.LoadBalancerConfigurationSpec{
+				LoadBalancerAttributes: duplicateAttributes,
+				SecurityGroups:         &[]string{"sg-1"},
+				SecurityGroupPrefixes:  &[]string{"pl-00000000"},
+			},
+			wantErr:    "spec.securityGroupPrefixes: Forbidden: may not be set along with `securityGroups`",
+			wantMetric: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMetricsCollector := lbcmetrics.NewMockCollector()
+			v := NewLoadBalancerConfigurationValidator(mockMetricsCollector)
+			err := v.ValidateUpdate(context.Background(), &elbv2gw.LoadBalancerConfiguration{Spec: tt.spec}, &elbv2gw.LoadBalancerConfiguration{Spec: tt.oldSpec})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockCollector := mockMetricsCollector.(*lbcmetrics.MockCollector)
+			assert.Equal(t, tt.wantMetric, len(mockCollector.Invocations[lbcmetrics.MetricWebhookValidationFailure]) == 1)
+		})
+	}
+}
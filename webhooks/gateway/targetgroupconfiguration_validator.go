@@ -0,0 +1,130 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/routeutils"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const apiPathValidateGatewayTargetGroupConfiguration = "/validate-gateway-k8s-aws-v1beta1-targetgroupconfiguration"
+
+// NewTargetGroupConfigurationValidator returns a validator for the TargetGroupConfiguration CRD.
+func NewTargetGroupConfigurationValidator(k8sClient client.Client, metricsCollector lbcmetrics.MetricCollector) *targetGroupConfigurationValidator {
+	return &targetGroupConfigurationValidator{
+		k8sClient:        k8sClient,
+		metricsCollector: metricsCollector,
+	}
+}
+
+var _ webhook.Validator = &targetGroupConfigurationValidator{}
+
+type targetGroupConfigurationValidator struct {
+	k8sClient        client.Client
+	metricsCollector lbcmetrics.MetricCollector
+}
+
+func (v *targetGroupConfigurationValidator) Prototype(_ admission.Request) (runtime.Object, error) {
+	return &elbv2gw.TargetGroupConfiguration{}, nil
+}
+
+func (v *targetGroupConfigurationValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	tgConfig := obj.(*elbv2gw.TargetGroupConfiguration)
+	return v.validate(ctx, tgConfig, nil)
+}
+
+func (v *targetGroupConfigurationValidator) ValidateUpdate(ctx context.Context, obj runtime.Object, oldObj runtime.Object) error {
+	tgConfig := obj.(*elbv2gw.TargetGroupConfiguration)
+	oldTGConfig := oldObj.(*elbv2gw.TargetGroupConfiguration)
+	return v.validate(ctx, tgConfig, oldTGConfig)
+}
+
+func (v *targetGroupConfigurationValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+// validate validates the TargetGroupConfiguration, oldTGConfig is the TargetGroupConfiguration before an update if any.
+// Checks that already failed before an update are tolerated, so that unrelated updates of the TargetGroupConfiguration aren't blocked.
+func (v *targetGroupConfigurationValidator) validate(ctx context.Context, tgConfig *elbv2gw.TargetGroupConfiguration, oldTGConfig *elbv2gw.TargetGroupConfiguration) error {
+	allErrs := field.ErrorList{}
+	errs, err := v.checkTargetReference(ctx, tgConfig)
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 && oldTGConfig != nil {
+		oldErrs, err := v.checkTargetReference(ctx, oldTGConfig)
+		if err != nil {
+			return err
+		}
+		if len(oldErrs) > 0 {
+			errs = nil
+		}
+	}
+	if len(errs) > 0 {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateGatewayTargetGroupConfiguration, "checkTargetReference")
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := v.checkTargetGroupAttributes(tgConfig); len(errs) > 0 && (oldTGConfig == nil || len(v.checkTargetGroupAttributes(oldTGConfig)) == 0) {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateGatewayTargetGroupConfiguration, "checkTargetGroupAttributes")
+		allErrs = append(allErrs, errs...)
+	}
+	return allErrs.ToAggregate()
+}
+
+// checkTargetReference checks that no other TargetGroupConfiguration references the same Service.
+// Only one of them would be applied to the TargetGroups of the Service otherwise.
+func (v *targetGroupConfigurationValidator) checkTargetReference(ctx context.Context, tgConfig *elbv2gw.TargetGroupConfiguration) (field.ErrorList, error) {
+	tgConfigList := &elbv2gw.TargetGroupConfigurationList{}
+	if err := v.k8sClient.List(ctx, tgConfigList, client.InNamespace(tgConfig.Namespace)); err != nil {
+		return nil, err
+	}
+	allErrs := field.ErrorList{}
+	for _, otherTGConfig := range tgConfigList.Items {
+		if otherTGConfig.Name == tgConfig.Name {
+			continue
+		}
+		if routeutils.IsTargetGroupConfigurationForService(tgConfig, otherTGConfig.Spec.TargetReference.Name) &&
+			routeutils.IsTargetGroupConfigurationForService(&otherTGConfig, tgConfig.Spec.TargetReference.Name) {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "targetReference"),
+				fmt.Sprintf("TargetGroupConfiguration %v already references Service %v", otherTGConfig.Name, tgConfig.Spec.TargetReference.Name)))
+		}
+	}
+	return allErrs, nil
+}
+
+// checkTargetGroupAttributes checks that the target group attributes of the default and route configurations are unique.
+// Attribute keys aren't checked against a list, as ELB keeps adding attributes, the ELB API rejects unknown ones when the target group is deployed.
+func (v *targetGroupConfigurationValidator) checkTargetGroupAttributes(tgConfig *elbv2gw.TargetGroupConfiguration) (allErrs field.ErrorList) {
+	allErrs = append(allErrs, validateTargetGroupProps(tgConfig.Spec.DefaultConfiguration, field.NewPath("spec", "defaultConfiguration"))...)
+	for idx, routeConfig := range tgConfig.Spec.RouteConfigurations {
+		fieldPath := field.NewPath("spec", "routeConfigurations").Index(idx).Child("targetGroupProps")
+		allErrs = append(allErrs, validateTargetGroupProps(routeConfig.TargetGroupProps, fieldPath)...)
+	}
+	return allErrs
+}
+
+func validateTargetGroupProps(tgProps elbv2gw.TargetGroupProps, fieldPath *field.Path) (allErrs field.ErrorList) {
+	seenKeys := make(map[string]bool)
+	for idx, attr := range tgProps.TargetGroupAttributes {
+		fieldPath := fieldPath.Child("targetGroupAttributes").Index(idx).Child("key")
+		if seenKeys[attr.Key] {
+			allErrs = append(allErrs, field.Duplicate(fieldPath, attr.Key))
+		}
+		seenKeys[attr.Key] = true
+	}
+	return allErrs
+}
+
+// +kubebuilder:webhook:path=/validate-gateway-k8s-aws-v1beta1-targetgroupconfiguration,mutating=false,failurePolicy=fail,groups=gateway.k8s.aws,resources=targetgroupconfigurations,verbs=create;update,versions=v1beta1,name=vtargetgroupconfiguration.gateway.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
+
+func (v *targetGroupConfigurationValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(apiPathValidateGatewayTargetGroupConfiguration, webhook.ValidatingWebhookForValidator(v, mgr.GetScheme()))
+}
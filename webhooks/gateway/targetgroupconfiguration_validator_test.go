@@ -0,0 +1,212 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/apis/gateway/v1beta1"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_targetGroupConfigurationValidator_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name              string
+		existingTGConfigs []elbv2gw.TargetGroupConfiguration
+		spec              elbv2gw.TargetGroupConfigurationSpec
+		wantErr           string
+		wantMetric        bool
+	}{
+		{
+			name: "valid configuration",
+			existingTGConfigs: []elbv2gw.TargetGroupConfiguration{
+				buildTargetGroupConfiguration("ns", "other-svc-config", "other-svc", nil),
+				buildTargetGroupConfiguration("other-ns", "svc-config", "svc", nil),
+			},
+			spec: elbv2gw.TargetGroupConfigurationSpec{
+				TargetReference: elbv2gw.Reference{Name: "svc"},
+				DefaultConfiguration: elbv2gw.TargetGroupProps{
+					TargetGroupAttributes: []elbv2gw.TargetGroupAttribute{
+						{Key: "deregistration_delay.timeout_seconds", Value: "30"},
+					},
+				},
+			},
+		},
+		{
+			name: "the configuration itself references the service",
+			existingTGConfigs: []elbv2gw.TargetGroupConfiguration{
+				buildTargetGroupConfiguration("ns", "svc-config", "svc", nil),
+			},
+			spec: elbv2gw.TargetGroupConfigurationSpec{
+				TargetReference: elbv2gw.Reference{Name: "svc"},
+			},
+		},
+		{
+			name: "another configuration references a resource of another kind",
+			existingTGConfigs: []elbv2gw.TargetGroupConfiguration{
+				buildTargetGroupConfiguration("ns", "other-config", "svc", awssdk.String("ServiceImport")),
+			},
+			spec: elbv2gw.TargetGroupConfigurationSpec{
+				TargetReference: elbv2gw.Reference{Name: "svc"},
+			},
+		},
+		{
+			name: "another configuration references the service",
+			existingTGConfigs: []elbv2gw.TargetGroupConfiguration{
+				buildTargetGroupConfiguration("ns", "other-config", "svc", awssdk.String("Service")),
+			},
+			spec: elbv2gw.TargetGroupConfigurationSpec{
+				TargetReference: elbv2gw.Reference{Name: "svc"},
+			},
+			wantErr:    "spec.targetReference: Forbidden: TargetGroupConfiguration other-config already references Service svc",
+			wantMetric: true,
+		},
+		{
+			name: "duplicate target group attributes",
+			spec: elbv2gw.TargetGroupConfigurationSpec{
+				TargetReference: elbv2gw.Reference{Name: "svc"},
+				DefaultConfiguration: elbv2gw.TargetGroupProps{
+					TargetGroupAttributes: []elbv2gw.TargetGroupAttribute{
+						{Key: "stickiness.enabled", Value: "true"},
+					},
+				},
+				RouteConfigurations: []elbv2gw.RouteConfiguration{
+					{
+						Identifier: "HTTPRoute:ns:route",
+						TargetGroupProps: elbv2gw.TargetGroupProps{
+							TargetGroupAttributes: []elbv2gw.TargetGroupAttribute{
+								{Key: "stickiness.enabled", Value: "true"},
+								{Key: "stickiness.enabled", Value: "false"},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    "spec.routeConfigurations[0].targetGroupProps.targetGroupAttributes[1].key: Duplicate value: \"stickiness.enabled\"",
+			wantMetric: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2gw.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, tgConfig := range tt.existingTGConfigs {
+				assert.NoError(t, k8sClient.Create(context.Background(), tgConfig.DeepCopy()))
+			}
+
+			mockMetricsCollector := lbcmetrics.NewMockCollector()
+			v := NewTargetGroupConfigurationValidator(k8sClient, mockMetricsCollector)
+			tgConfig := &elbv2gw.TargetGroupConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc-config"},
+				Spec:       tt.spec,
+			}
+			t.Run("create", func(t *testing.T) {
+				err := v.ValidateCreate(context.Background(), tgConfig)
+				if tt.wantErr != "" {
+					assert.EqualError(t, err, tt.wantErr)
+				} else {
+					assert.NoError(t, err)
+				}
+			})
+			t.Run("update", func(t *testing.T) {
+				err := v.ValidateUpdate(context.Background(), tgConfig, &elbv2gw.TargetGroupConfiguration{})
+				if tt.wantErr != "" {
+					assert.EqualError(t, err, tt.wantErr)
+				} else {
+					assert.NoError(t, err)
+				}
+			})
+
+			mockCollector := mockMetricsCollector.(*lbcmetrics.MockCollector)
+			assert.Equal(t, tt.wantMetric, len(mockCollector.Invocations[lbcmetrics.MetricWebhookValidationFailure]) == 2)
+		})
+	}
+}
+
+func Test_targetGroupConfigurationValidator_ValidateUpdate(t *testing.T) {
+	duplicateAttributes := []elbv2gw.TargetGroupAttribute{
+		{Key: "stickiness.enabled", Value: "true"},
+		{Key: "stickiness.enabled", Value: "false"},
+	}
+	tests := []struct {
+		name              string
+		existingTGConfigs []elbv2gw.TargetGroupConfiguration
+		oldSpec           elbv2gw.TargetGroupConfigurationSpec
+		spec              elbv2gw.TargetGroupConfigurationSpec
+		wantErr           string
+		wantMetric        bool
+	}{
+		{
+			name: "errors the configuration already had are tolerated",
+			existingTGConfigs: []elbv2gw.TargetGroupConfiguration{
+				buildTargetGroupConfiguration("ns", "other-config", "svc", nil),
+			},
+			oldSpec: elbv2gw.TargetGroupConfigurationSpec{
+				TargetReference:      elbv2gw.Reference{Name: "svc"},
+				DefaultConfiguration: elbv2gw.TargetGroupProps{TargetGroupAttributes: duplicateAttributes},
+			},
+			spec: elbv2gw.TargetGroupConfigurationSpec{
+				TargetReference:      elbv2gw.Reference{Name: "svc"},
+				DefaultConfiguration: elbv2gw.TargetGroupProps{TargetGroupAttributes: duplicateAttributes, HealthCheckConfig: &elbv2gw.HealthCheckConfiguration{}},
+			},
+		},
+		{
+			name: "error introduced by the update is rejected",
+			existingTGConfigs: []elbv2gw.TargetGroupConfiguration{
+				buildTargetGroupConfiguration("ns", "other-config", "other-svc", nil),
+			},
+			oldSpec: elbv2gw.TargetGroupConfigurationSpec{
+				TargetReference:      elbv2gw.Reference{Name: "svc"},
+				DefaultConfiguration: elbv2gw.TargetGroupProps{TargetGroupAttributes: duplicateAttributes},
+			},
+			spec: elbv2gw.TargetGroupConfigurationSpec{
+				TargetReference:      elbv2gw.Reference{Name: "other-svc"},
+				DefaultConfiguration: elbv2gw.TargetGroupProps{TargetGroupAttributes: duplicateAttributes},
+			},
+			wantErr:    "spec.targetReference: Forbidden: TargetGroupConfiguration other-config already references Service other-svc",
+			wantMetric: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2gw.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, tgConfig := range tt.existingTGConfigs {
+				assert.NoError(t, k8sClient.Create(context.Background(), tgConfig.DeepCopy()))
+			}
+
+			mockMetricsCollector := lbcmetrics.NewMockCollector()
+			v := NewTargetGroupConfigurationValidator(k8sClient, mockMetricsCollector)
+			objectMeta := metav1.ObjectMeta{Namespace: "ns", Name: "svc-config"}
+			err := v.ValidateUpdate(context.Background(),
+				&elbv2gw.TargetGroupConfiguration{ObjectMeta: objectMeta, Spec: tt.spec},
+				&elbv2gw.TargetGroupConfiguration{ObjectMeta: objectMeta, Spec: tt.oldSpec})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockCollector := mockMetricsCollector.(*lbcmetrics.MockCollector)
+			assert.Equal(t, tt.wantMetric, len(mockCollector.Invocations[lbcmetrics.MetricWebhookValidationFailure]) == 1)
+		})
+	}
+}
+
+func buildTargetGroupConfiguration(namespace string, name string, serviceName string, kind *string) elbv2gw.TargetGroupConfiguration {
+	return elbv2gw.TargetGroupConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: elbv2gw.TargetGroupConfigurationSpec{
+			TargetReference: elbv2gw.Reference{Name: serviceName, Kind: kind},
+		},
+	}
+}
@@ -9,9 +9,12 @@ import (
 	"github.com/pkg/errors"
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics/lbc"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -25,13 +28,20 @@ const (
 
 // NewIngressValidator returns a validator for Ingress API.
 func NewIngressValidator(client client.Client, ingConfig config.IngressConfig, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector) *ingressValidator {
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(ingConfig.IngressClass)
+	classLoader := ingress.NewDefaultClassLoader(client, false)
+	manageIngressesWithoutIngressClass := ingConfig.IngressClass == ""
 	return &ingressValidator{
-		annotationParser:                   annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress),
-		classAnnotationMatcher:             ingress.NewDefaultClassAnnotationMatcher(ingConfig.IngressClass),
-		classLoader:                        ingress.NewDefaultClassLoader(client, false),
+		annotationParser:       annotationParser,
+		classAnnotationMatcher: classAnnotationMatcher,
+		classLoader:            classLoader,
+		groupLoader:            ingress.NewDefaultGroupLoader(client, nil, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass),
+		backendBuilder: ingress.NewDefaultEnhancedBackendBuilder(client, annotationParser, nil,
+			ingConfig.TolerateNonExistentBackendService, ingConfig.TolerateNonExistentBackendAction),
 		disableIngressClassAnnotation:      ingConfig.DisableIngressClassAnnotation,
 		disableIngressGroupAnnotation:      ingConfig.DisableIngressGroupNameAnnotation,
-		manageIngressesWithoutIngressClass: ingConfig.IngressClass == "",
+		manageIngressesWithoutIngressClass: manageIngressesWithoutIngressClass,
 		logger:                             logger,
 		metricsCollector:                   metricsCollector,
 	}
@@ -43,6 +53,8 @@ type ingressValidator struct {
 	annotationParser              annotations.Parser
 	classAnnotationMatcher        ingress.ClassAnnotationMatcher
 	classLoader                   ingress.ClassLoader
+	groupLoader                   ingress.GroupLoader
+	backendBuilder                ingress.EnhancedBackendBuilder
 	disableIngressClassAnnotation bool
 	disableIngressGroupAnnotation bool
 	// manageIngressesWithoutIngressClass specifies whether ingresses without "kubernetes.io/ingress.class" annotation
@@ -78,6 +90,14 @@ func (v *ingressValidator) ValidateCreate(ctx context.Context, obj runtime.Objec
 		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateNetworkingIngress, "checkIngressAnnotationConditions")
 		return err
 	}
+	if err := v.checkIngressAnnotationsParsing(ctx, ing, nil); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateNetworkingIngress, "checkIngressAnnotationsParsing")
+		return err
+	}
+	if err := v.checkIngressGroupCollisions(ctx, ing, nil); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateNetworkingIngress, "checkIngressGroupCollisions")
+		return err
+	}
 	return nil
 }
 
@@ -104,6 +124,14 @@ func (v *ingressValidator) ValidateUpdate(ctx context.Context, obj runtime.Objec
 		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateNetworkingIngress, "checkIngressAnnotationConditions")
 		return err
 	}
+	if err := v.checkIngressAnnotationsParsing(ctx, ing, oldIng); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateNetworkingIngress, "checkIngressAnnotationsParsing")
+		return err
+	}
+	if err := v.checkIngressGroupCollisions(ctx, ing, oldIng); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateNetworkingIngress, "checkIngressGroupCollisions")
+		return err
+	}
 	return nil
 }
 
@@ -239,6 +267,168 @@ func (v *ingressValidator) checkIngressAnnotationConditions(ing *networking.Ingr
 	return nil
 }
 
+// checkIngressAnnotationsParsing checks the annotations of the Ingress are parsed successfully when building the model of its IngressGroup,
+// including the "listen-ports", "target-group-attributes", "group.order" annotations and the actions and conditions of its backends.
+// A full model build isn't run since it involves AWS API calls and side effects like creating the backend security group.
+// Annotations that already failed to parse before an update are tolerated, so that unrelated updates of the Ingress aren't blocked.
+func (v *ingressValidator) checkIngressAnnotationsParsing(ctx context.Context, ing *networking.Ingress, oldIng *networking.Ingress) error {
+	err := v.parseIngressAnnotations(ctx, ing)
+	if err == nil || (oldIng != nil && v.parseIngressAnnotations(ctx, oldIng) != nil) {
+		return nil
+	}
+	return errors.Wrapf(err, "invalid annotations on Ingress %s/%s", ing.Namespace, ing.Name)
+}
+
+func (v *ingressValidator) parseIngressAnnotations(ctx context.Context, ing *networking.Ingress) error {
+	if _, err := ingress.ComputeIngressListenPorts(v.annotationParser, ing, false); err != nil {
+		return err
+	}
+	var rawTGAttributes map[string]string
+	if _, err := v.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTargetGroupAttributes, &rawTGAttributes, ing.Annotations); err != nil {
+		return err
+	}
+	if _, err := ingress.LoadGroupOrder(v.annotationParser, ing); err != nil {
+		return err
+	}
+	for _, backend := range listIngressBackends(ing) {
+		if _, err := v.backendBuilder.Build(ctx, ing, backend, ingress.WithLoadBackendServices(false, nil)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkIngressGroupCollisions checks the Ingress doesn't collide with the other members of its IngressGroup within the same
+// shard, which fails the model build of the IngressGroup: it must not define a default backend on the same listen port as
+// another member, nor LoadBalancer or listener settings that conflict with theirs. Identical hosts and paths don't collide,
+// their precedence is decided by group.order. Collisions that already existed before an update are tolerated.
+func (v *ingressValidator) checkIngressGroupCollisions(ctx context.Context, ing *networking.Ingress, oldIng *networking.Ingress) error {
+	groupID, err := v.groupLoader.LoadGroupIDIfAny(ctx, ing)
+	if err != nil || groupID == nil || !groupID.IsExplicit() {
+		return nil
+	}
+	ingGroup, err := v.groupLoader.Load(ctx, *groupID)
+	if err != nil {
+		// the IngressGroup fails to load regardless of this Ingress, its errors are surfaced by the controller.
+		v.logger.V(1).Info("skipping IngressGroup collision check", "ingress", k8s.NamespacedName(ing), "error", err.Error())
+		return nil
+	}
+	classifiedIng, err := v.classifyIngress(ctx, ing)
+	if err != nil {
+		return nil
+	}
+	members := []ingress.ClassifiedIngress{classifiedIng}
+	for _, member := range ingGroup.Members {
+		if k8s.NamespacedName(member.Ing) != k8s.NamespacedName(ing) {
+			members = append(members, member)
+		}
+	}
+	shards, err := ingress.ShardGroup(v.annotationParser, ingress.Group{ID: *groupID, Members: members})
+	if err != nil {
+		return nil
+	}
+	var others []ingress.ClassifiedIngress
+	for _, shard := range shards {
+		var shardOthers []ingress.ClassifiedIngress
+		inShard := false
+		for _, member := range shard.Group.Members {
+			if member.Ing == ing {
+				inShard = true
+			} else {
+				shardOthers = append(shardOthers, member)
+			}
+		}
+		if inShard {
+			others = shardOthers
+			break
+		}
+	}
+	var oldClassifiedIng *ingress.ClassifiedIngress
+	if oldIng != nil {
+		oldClassifiedIng = &ingress.ClassifiedIngress{Ing: oldIng, IngClassConfig: classifiedIng.IngClassConfig}
+	}
+
+	if ing.Spec.DefaultBackend != nil {
+		existingCollision := false
+		if oldClassifiedIng != nil && oldIng.Spec.DefaultBackend != nil {
+			_, existingCollision = v.findCollidingDefaultBackend(*oldClassifiedIng, members[1:])
+		}
+		if collidingIng, collided := v.findCollidingDefaultBackend(classifiedIng, others); collided && !existingCollision {
+			return errors.Errorf("default backend collides with Ingress %v in IngressGroup %v", collidingIng, groupID)
+		}
+	}
+	if err := v.checkIngressGroupSettings(ctx, *groupID, classifiedIng, oldClassifiedIng, others); err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkIngressGroupSettings checks the LoadBalancer and listener settings of the Ingress don't conflict with the other members of
+// its IngressGroup shard. Conflicts among the other members, or of the Ingress before an update, are surfaced by the controller.
+func (v *ingressValidator) checkIngressGroupSettings(ctx context.Context, groupID ingress.GroupID, ing ingress.ClassifiedIngress, oldIng *ingress.ClassifiedIngress, others []ingress.ClassifiedIngress) error {
+	err := ingress.ValidateGroupSettings(ctx, v.annotationParser, ingress.Group{ID: groupID, Members: append([]ingress.ClassifiedIngress{ing}, others...)})
+	if err == nil {
+		return nil
+	}
+	if ingress.ValidateGroupSettings(ctx, v.annotationParser, ingress.Group{ID: groupID, Members: others}) != nil {
+		return nil
+	}
+	if oldIng != nil && ingress.ValidateGroupSettings(ctx, v.annotationParser, ingress.Group{ID: groupID, Members: append([]ingress.ClassifiedIngress{*oldIng}, others...)}) != nil {
+		return nil
+	}
+	return errors.Wrapf(err, "settings conflict with IngressGroup %v", groupID)
+}
+
+// findCollidingDefaultBackend finds the first other Ingress with a default backend on a listen port of the Ingress.
+func (v *ingressValidator) findCollidingDefaultBackend(ing ingress.ClassifiedIngress, others []ingress.ClassifiedIngress) (types.NamespacedName, bool) {
+	ports := v.computeListenPorts(ing)
+	for _, other := range others {
+		if other.Ing.Spec.DefaultBackend != nil && ports.HasAny(sets.List(v.computeListenPorts(other))...) {
+			return k8s.NamespacedName(other.Ing), true
+		}
+	}
+	return types.NamespacedName{}, false
+}
+
+func (v *ingressValidator) computeListenPorts(ing ingress.ClassifiedIngress) sets.Set[int32] {
+	preferTLS := len(ingress.ComputeIngressExplicitTLSCertARNs(v.annotationParser, &ing)) != 0
+	listenPorts, _ := ingress.ComputeIngressListenPorts(v.annotationParser, ing.Ing, preferTLS)
+	ports := sets.New[int32]()
+	for port := range listenPorts {
+		ports.Insert(port)
+	}
+	return ports
+}
+
+// classifyIngress classifies the Ingress the same way the IngressGroup loader does.
+func (v *ingressValidator) classifyIngress(ctx context.Context, ing *networking.Ingress) (ingress.ClassifiedIngress, error) {
+	if _, exists := ing.Annotations[annotations.IngressClass]; exists {
+		return ingress.ClassifiedIngress{Ing: ing}, nil
+	}
+	ingClassConfig, err := v.classLoader.Load(ctx, ing)
+	if err != nil {
+		return ingress.ClassifiedIngress{}, err
+	}
+	return ingress.ClassifiedIngress{Ing: ing, IngClassConfig: ingClassConfig}, nil
+}
+
+// listIngressBackends lists the default backend and the backends of the paths of the Ingress.
+func listIngressBackends(ing *networking.Ingress) []networking.IngressBackend {
+	var backends []networking.IngressBackend
+	if ing.Spec.DefaultBackend != nil {
+		backends = append(backends, *ing.Spec.DefaultBackend)
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			backends = append(backends, path.Backend)
+		}
+	}
+	return backends
+}
+
 // +kubebuilder:webhook:path=/validate-networking-v1-ingress,mutating=false,failurePolicy=fail,groups=networking.k8s.io,resources=ingresses,verbs=create;update,versions=v1,name=vingress.elbv2.k8s.aws,sideEffects=None,matchPolicy=Equivalent,webhookVersions=v1,admissionReviewVersions=v1beta1
 
 func (v *ingressValidator) SetupWithManager(mgr ctrl.Manager) {
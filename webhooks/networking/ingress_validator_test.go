@@ -1171,3 +1171,345 @@ func Test_ingressValidator_checkIngressAnnotationConditions(t *testing.T) {
 		})
 	}
 }
+
+func Test_ingressValidator_checkIngressAnnotationsParsing(t *testing.T) {
+	tests := []struct {
+		name    string
+		ing     *networking.Ingress
+		oldIng  *networking.Ingress
+		wantErr string
+	}{
+		{
+			name: "ingress with valid annotations",
+			ing: buildValidatorTestIngress("ing-1", map[string]string{
+				"alb.ingress.kubernetes.io/listen-ports":            `[{"HTTP": 80}, {"HTTPS": 443}]`,
+				"alb.ingress.kubernetes.io/target-group-attributes": "stickiness.enabled=true",
+				"alb.ingress.kubernetes.io/group.order":             "10",
+				"alb.ingress.kubernetes.io/actions.svc-1":           `{"type":"fixed-response","fixedResponseConfig":{"statusCode":"404"}}`,
+			}, "host.example.com", "/path", "svc-1", "use-annotation"),
+		},
+		{
+			name: "ingress with malformed actions annotation",
+			ing: buildValidatorTestIngress("ing-1", map[string]string{
+				"alb.ingress.kubernetes.io/actions.svc-1": `{"type":"fixed-response"`,
+			}, "host.example.com", "/path", "svc-1", "use-annotation"),
+			wantErr: "invalid annotations on Ingress ns-1/ing-1: failed to parse json annotation, alb.ingress.kubernetes.io/actions.svc-1: {\"type\":\"fixed-response\": unexpected end of JSON input",
+		},
+		{
+			name: "ingress with invalid actions annotation",
+			ing: buildValidatorTestIngress("ing-1", map[string]string{
+				"alb.ingress.kubernetes.io/actions.svc-1": `{"type":"fixed-response"}`,
+			}, "host.example.com", "/path", "svc-1", "use-annotation"),
+			wantErr: "invalid annotations on Ingress ns-1/ing-1: missing FixedResponseConfig",
+		},
+		{
+			name: "ingress with invalid listen-ports annotation",
+			ing: buildValidatorTestIngress("ing-1", map[string]string{
+				"alb.ingress.kubernetes.io/listen-ports": `[{"TCP": 80}]`,
+			}, "host.example.com", "/path", "svc-1", "http"),
+			wantErr: "invalid annotations on Ingress ns-1/ing-1: listen protocol must be within [HTTP, HTTPS]: TCP",
+		},
+		{
+			name: "ingress with unparseable target-group-attributes annotation",
+			ing: buildValidatorTestIngress("ing-1", map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "stickiness.enabled",
+			}, "host.example.com", "/path", "svc-1", "http"),
+			wantErr: "invalid annotations on Ingress ns-1/ing-1: failed to parse stringMap annotation, alb.ingress.kubernetes.io/target-group-attributes: stickiness.enabled",
+		},
+		{
+			name: "ingress with out of range group.order annotation",
+			ing: buildValidatorTestIngress("ing-1", map[string]string{
+				"alb.ingress.kubernetes.io/group.order": "1001",
+			}, "host.example.com", "/path", "svc-1", "http"),
+			wantErr: "invalid annotations on Ingress ns-1/ing-1: explicit Ingress group order must be within [-1000:1000], Ingress: ns-1/ing-1, order: 1001",
+		},
+		{
+			name: "ingress update keeping invalid annotations",
+			ing: buildValidatorTestIngress("ing-1", map[string]string{
+				"alb.ingress.kubernetes.io/group.order": "1001",
+			}, "host.example.com", "/path", "svc-1", "http"),
+			oldIng: buildValidatorTestIngress("ing-1", map[string]string{
+				"alb.ingress.kubernetes.io/group.order": "1001",
+			}, "host.example.com", "/old-path", "svc-1", "http"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			v := &ingressValidator{
+				annotationParser: annotationParser,
+				backendBuilder:   ingress.NewDefaultEnhancedBackendBuilder(nil, annotationParser, nil, false, false),
+				logger:           logr.New(&log.NullLogSink{}),
+			}
+			err := v.checkIngressAnnotationsParsing(context.Background(), tt.ing, tt.oldIng)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_ingressValidator_checkIngressGroupCollisions(t *testing.T) {
+	groupAnnotations := map[string]string{
+		"kubernetes.io/ingress.class":          "alb",
+		"alb.ingress.kubernetes.io/group.name": "awesome-group",
+	}
+	withGroupAnnotations := func(extraAnnotations map[string]string) map[string]string {
+		ingAnnotations := make(map[string]string, len(groupAnnotations)+len(extraAnnotations))
+		for key, value := range groupAnnotations {
+			ingAnnotations[key] = value
+		}
+		for key, value := range extraAnnotations {
+			ingAnnotations["alb.ingress.kubernetes.io/"+key] = value
+		}
+		return ingAnnotations
+	}
+	withDefaultBackend := func(ing *networking.Ingress) *networking.Ingress {
+		ing.Spec.DefaultBackend = &networking.IngressBackend{
+			Service: &networking.IngressServiceBackend{
+				Name: "default-svc",
+				Port: networking.ServiceBackendPort{Name: "http"},
+			},
+		}
+		return ing
+	}
+	tests := []struct {
+		name        string
+		existingIng []*networking.Ingress
+		ing         *networking.Ingress
+		oldIng      *networking.Ingress
+		wantErr     string
+	}{
+		{
+			name: "ingress routing the same host and path as another group member",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", groupAnnotations, "host.example.com", "/path", "svc-2", "http"),
+			},
+			ing: buildValidatorTestIngress("ing-1", groupAnnotations, "host.example.com", "/path", "svc-1", "http"),
+		},
+		{
+			name: "default backend colliding with another group member",
+			existingIng: []*networking.Ingress{
+				withDefaultBackend(buildValidatorTestIngress("ing-2", groupAnnotations, "host.example.com", "/path-2", "svc-2", "http")),
+			},
+			ing:     withDefaultBackend(buildValidatorTestIngress("ing-1", groupAnnotations, "host.example.com", "/path-1", "svc-1", "http")),
+			wantErr: "default backend collides with Ingress ns-1/ing-2 in IngressGroup awesome-group",
+		},
+		{
+			name: "default backends on different listen ports",
+			existingIng: []*networking.Ingress{
+				withDefaultBackend(buildValidatorTestIngress("ing-2", groupAnnotations, "host.example.com", "/path-2", "svc-2", "http")),
+			},
+			ing: withDefaultBackend(buildValidatorTestIngress("ing-1", map[string]string{
+				"kubernetes.io/ingress.class":            "alb",
+				"alb.ingress.kubernetes.io/group.name":   "awesome-group",
+				"alb.ingress.kubernetes.io/listen-ports": `[{"HTTP": 8080}]`,
+			}, "host.example.com", "/path-1", "svc-1", "http")),
+		},
+		{
+			name: "default backend colliding with an ingress outside the group",
+			existingIng: []*networking.Ingress{
+				withDefaultBackend(buildValidatorTestIngress("ing-2", map[string]string{"kubernetes.io/ingress.class": "alb"}, "host.example.com", "/path-2", "svc-2", "http")),
+			},
+			ing: withDefaultBackend(buildValidatorTestIngress("ing-1", groupAnnotations, "host.example.com", "/path-1", "svc-1", "http")),
+		},
+		{
+			name: "ingress update keeping an existing collision",
+			existingIng: []*networking.Ingress{
+				withDefaultBackend(buildValidatorTestIngress("ing-1", groupAnnotations, "host.example.com", "/path-1", "svc-1", "http")),
+				withDefaultBackend(buildValidatorTestIngress("ing-2", groupAnnotations, "host.example.com", "/path-2", "svc-2", "http")),
+			},
+			ing:    withDefaultBackend(buildValidatorTestIngress("ing-1", groupAnnotations, "host.example.com", "/path-1", "svc-1", "https")),
+			oldIng: withDefaultBackend(buildValidatorTestIngress("ing-1", groupAnnotations, "host.example.com", "/path-1", "svc-1", "http")),
+		},
+		{
+			name: "scheme conflicting with another group member",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"scheme": "internal"}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing:     buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"scheme": "internet-facing"}), "host.example.com", "/path-1", "svc-1", "http"),
+			wantErr: "settings conflict with IngressGroup awesome-group: conflicting scheme: map[internal:{} internet-facing:{}]",
+		},
+		{
+			name: "ip address type conflicting with another group member",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"ip-address-type": "ipv4"}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing:     buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"ip-address-type": "dualstack"}), "host.example.com", "/path-1", "svc-1", "http"),
+			wantErr: "settings conflict with IngressGroup awesome-group: conflicting IPAddressType: [dualstack ipv4]",
+		},
+		{
+			name: "load balancer name conflicting with another group member",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"load-balancer-name": "lb-2"}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing:     buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"load-balancer-name": "lb-1"}), "host.example.com", "/path-1", "svc-1", "http"),
+			wantErr: "settings conflict with IngressGroup awesome-group: conflicting load balancer name: map[lb-1:{} lb-2:{}]",
+		},
+		{
+			name: "subnets conflicting with another group member",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"subnets": "subnet-a, subnet-b"}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing:     buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"subnets": "subnet-a, subnet-c"}), "host.example.com", "/path-1", "svc-1", "http"),
+			wantErr: "settings conflict with IngressGroup awesome-group: conflicting subnets: [subnet-a subnet-c] | [subnet-a subnet-b]",
+		},
+		{
+			name: "subnets in a different order than another group member",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"subnets": "subnet-a, subnet-b"}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing: buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"subnets": "subnet-b,subnet-a"}), "host.example.com", "/path-1", "svc-1", "http"),
+		},
+		{
+			name: "tags conflicting with another group member",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"tags": "team=a, env=prod"}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing:     buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"tags": "team=b"}), "host.example.com", "/path-1", "svc-1", "http"),
+			wantErr: "settings conflict with IngressGroup awesome-group: conflicting tag team: b | a",
+		},
+		{
+			name: "load balancer attributes conflicting with another group member",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"load-balancer-attributes": "idle_timeout.timeout_seconds=60"}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing:     buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"load-balancer-attributes": "idle_timeout.timeout_seconds=120"}), "host.example.com", "/path-1", "svc-1", "http"),
+			wantErr: "settings conflict with IngressGroup awesome-group: conflicting load balancer attributes idle_timeout.timeout_seconds: 120 | 60",
+		},
+		{
+			name: "listen port protocol conflicting with another group member",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"listen-ports": `[{"HTTP": 8080}]`}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing:     buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"listen-ports": `[{"HTTPS": 8080}]`, "certificate-arn": "arn:aws:acm:us-west-2:123456789012:certificate/abc"}), "host.example.com", "/path-1", "svc-1", "http"),
+			wantErr: "settings conflict with IngressGroup awesome-group: failed to merge listenPort config for port: 8080: conflicting protocol, ns-1/ing-1: HTTPS | ns-1/ing-2: HTTP",
+		},
+		{
+			name: "inbound cidrs conflicting with another group member",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"inbound-cidrs": "10.0.0.0/8"}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing:     buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"inbound-cidrs": "192.168.0.0/16"}), "host.example.com", "/path-1", "svc-1", "http"),
+			wantErr: "settings conflict with IngressGroup awesome-group: failed to merge listenPort config for port: 80: conflicting inbound-cidrs, ns-1/ing-1: [192.168.0.0/16], [] | ns-1/ing-2: [10.0.0.0/8], []",
+		},
+		{
+			name: "ssl policy conflicting with another group member",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"listen-ports": `[{"HTTPS": 443}]`, "certificate-arn": "arn:aws:acm:us-west-2:123456789012:certificate/abc", "ssl-policy": "ELBSecurityPolicy-2016-08"}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing:     buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"listen-ports": `[{"HTTPS": 443}]`, "certificate-arn": "arn:aws:acm:us-west-2:123456789012:certificate/abc", "ssl-policy": "ELBSecurityPolicy-TLS13-1-2-2021-06"}), "host.example.com", "/path-1", "svc-1", "http"),
+			wantErr: "settings conflict with IngressGroup awesome-group: failed to merge listenPort config for port: 443: conflicting sslPolicy, ns-1/ing-1: ELBSecurityPolicy-TLS13-1-2-2021-06 | ns-1/ing-2: ELBSecurityPolicy-2016-08",
+		},
+		{
+			name: "listener attributes conflicting with another group member",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"listener-attributes.HTTP-80": "routing.http.response.server.enabled=true"}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing:     buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"listener-attributes.HTTP-80": "routing.http.response.server.enabled=false"}), "host.example.com", "/path-1", "svc-1", "http"),
+			wantErr: "settings conflict with IngressGroup awesome-group: conflicting listener attributes routing.http.response.server.enabled: false | true for ingress ns-1/ing-2",
+		},
+		{
+			name: "inbound cidrs on different listen ports",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"inbound-cidrs": "10.0.0.0/8"}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing: buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"inbound-cidrs": "192.168.0.0/16", "listen-ports": `[{"HTTP": 8080}]`}), "host.example.com", "/path-1", "svc-1", "http"),
+		},
+		{
+			name: "settings conflicting among other group members",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"scheme": "internal"}), "host.example.com", "/path-2", "svc-2", "http"),
+				buildValidatorTestIngress("ing-3", withGroupAnnotations(map[string]string{"scheme": "internet-facing"}), "host.example.com", "/path-3", "svc-3", "http"),
+			},
+			ing: buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"scheme": "internal"}), "host.example.com", "/path-1", "svc-1", "http"),
+		},
+		{
+			name: "ingress update keeping an existing settings conflict",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"scheme": "internet-facing"}), "host.example.com", "/path-1", "svc-1", "http"),
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"scheme": "internal"}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing:    buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"scheme": "internet-facing"}), "host.example.com", "/path-1", "svc-1", "https"),
+			oldIng: buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"scheme": "internet-facing"}), "host.example.com", "/path-1", "svc-1", "http"),
+		},
+		{
+			name: "ingress update introducing a settings conflict",
+			existingIng: []*networking.Ingress{
+				buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"scheme": "internal"}), "host.example.com", "/path-1", "svc-1", "http"),
+				buildValidatorTestIngress("ing-2", withGroupAnnotations(map[string]string{"scheme": "internal"}), "host.example.com", "/path-2", "svc-2", "http"),
+			},
+			ing:     buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"scheme": "internet-facing"}), "host.example.com", "/path-1", "svc-1", "http"),
+			oldIng:  buildValidatorTestIngress("ing-1", withGroupAnnotations(map[string]string{"scheme": "internal"}), "host.example.com", "/path-1", "svc-1", "http"),
+			wantErr: "settings conflict with IngressGroup awesome-group: conflicting scheme: map[internal:{} internet-facing:{}]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().
+				WithScheme(k8sSchema).
+				Build()
+			for _, ing := range tt.existingIng {
+				assert.NoError(t, k8sClient.Create(ctx, ing.DeepCopy()))
+			}
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher("alb")
+			classLoader := ingress.NewDefaultClassLoader(k8sClient, false)
+			v := &ingressValidator{
+				annotationParser:       annotationParser,
+				classAnnotationMatcher: classAnnotationMatcher,
+				classLoader:            classLoader,
+				groupLoader:            ingress.NewDefaultGroupLoader(k8sClient, nil, annotationParser, classLoader, classAnnotationMatcher, false),
+				logger:                 logr.New(&log.NullLogSink{}),
+			}
+			err := v.checkIngressGroupCollisions(ctx, tt.ing, tt.oldIng)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func buildValidatorTestIngress(name string, ingAnnotations map[string]string, host string, path string, svcName string, svcPortName string) *networking.Ingress {
+	pathType := networking.PathTypePrefix
+	return &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "ns-1",
+			Name:        name,
+			Annotations: ingAnnotations,
+		},
+		Spec: networking.IngressSpec{
+			Rules: []networking.IngressRule{
+				{
+					Host: host,
+					IngressRuleValue: networking.IngressRuleValue{
+						HTTP: &networking.HTTPIngressRuleValue{
+							Paths: []networking.HTTPIngressPath{
+								{
+									Path:     path,
+									PathType: &pathType,
+									Backend: networking.IngressBackend{
+										Service: &networking.IngressServiceBackend{
+											Name: svcName,
+											Port: networking.ServiceBackendPort{
+												Name: svcPortName,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}